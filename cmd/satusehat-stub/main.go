@@ -0,0 +1,173 @@
+// Command satusehat-stub is a local stand-in for the SATUSEHAT API for
+// testing the integration without staging credentials. It issues access
+// tokens for any client credentials, accepts Encounter and Condition
+// submissions, assigns them IDs and logs them.
+//
+//	go run ./cmd/satusehat-stub
+//
+// Run the API with SATUSEHAT_AUTH_URL=http://localhost:9091/oauth2/v1,
+// SATUSEHAT_BASE_URL=http://localhost:9091/fhir-r4/v1 and any
+// SATUSEHAT_CLIENT_ID. Set SATUSEHAT_STUB_FAIL_RATE (0-100) to answer a share
+// of the submissions with 500 to exercise retries, and
+// SATUSEHAT_STUB_TOKEN_SECONDS to make tokens expire sooner.
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/env"
+)
+
+var resourceTypes = map[string]bool{"Encounter": true, "Condition": true}
+
+type stub struct {
+	tokenLifetime time.Duration
+	failRate      int
+
+	mu        sync.Mutex
+	tokens    map[string]time.Time
+	resources map[string]json.RawMessage
+}
+
+func main() {
+	addr := env.GetString("SATUSEHAT_STUB_ADDR", ":9091")
+	s := &stub{
+		tokenLifetime: time.Duration(env.GetInt("SATUSEHAT_STUB_TOKEN_SECONDS", 3599)) * time.Second,
+		failRate:      env.GetInt("SATUSEHAT_STUB_FAIL_RATE", 0),
+		tokens:        map[string]time.Time{},
+		resources:     map[string]json.RawMessage{},
+	}
+
+	http.HandleFunc("/oauth2/v1/accesstoken", s.accessToken)
+	http.HandleFunc("/fhir-r4/v1/", s.resource)
+
+	log.Printf("SATUSEHAT stub listening on %s", addr)
+	log.Fatal(http.ListenAndServe(addr, nil))
+}
+
+func (s *stub) accessToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Query().Get("grant_type") != "client_credentials" {
+		outcome(w, http.StatusBadRequest, "invalid", "POST with grant_type=client_credentials")
+		return
+	}
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("client_id") == "" || r.PostForm.Get("client_secret") == "" {
+		outcome(w, http.StatusUnauthorized, "security", "client_id and client_secret are required")
+		return
+	}
+
+	token := newID()
+	s.mu.Lock()
+	s.tokens[token] = time.Now().Add(s.tokenLifetime)
+	s.mu.Unlock()
+
+	log.Printf("issued token to %s", r.PostForm.Get("client_id"))
+	respond(w, http.StatusOK, map[string]string{
+		"access_token": token,
+		"token_type":   "BearerToken",
+		"expires_in":   strconv.Itoa(int(s.tokenLifetime / time.Second)),
+		"status":       "approved",
+	})
+}
+
+func (s *stub) resource(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.mu.Lock()
+	expiresAt, ok := s.tokens[token]
+	s.mu.Unlock()
+	if !ok || time.Now().After(expiresAt) {
+		outcome(w, http.StatusUnauthorized, "security", "invalid or expired access token")
+		return
+	}
+
+	resourceType, id, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/fhir-r4/v1/"), "/")
+	if !resourceTypes[resourceType] {
+		outcome(w, http.StatusNotFound, "not-supported", "unsupported resource type "+resourceType)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		outcome(w, http.StatusBadRequest, "invalid", "failed to read body")
+		return
+	}
+	var resource map[string]any
+	if err := json.Unmarshal(body, &resource); err != nil {
+		outcome(w, http.StatusBadRequest, "invalid", "invalid JSON")
+		return
+	}
+	if resource["resourceType"] != resourceType {
+		outcome(w, http.StatusBadRequest, "invalid", fmt.Sprintf("resourceType must be %s", resourceType))
+		return
+	}
+
+	if s.failRate > 0 && mathrand.Intn(100) < s.failRate {
+		log.Printf("failing %s %s on purpose", r.Method, r.URL.Path)
+		outcome(w, http.StatusInternalServerError, "exception", "simulated failure")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && id == "":
+		id = newID()
+	case r.Method == http.MethodPut && id != "":
+		s.mu.Lock()
+		_, exists := s.resources[resourceType+"/"+id]
+		s.mu.Unlock()
+		if !exists {
+			outcome(w, http.StatusNotFound, "not-found", resourceType+"/"+id+" not found")
+			return
+		}
+		if resource["id"] != id {
+			outcome(w, http.StatusBadRequest, "invalid", "id in the body must match the URL")
+			return
+		}
+	default:
+		outcome(w, http.StatusMethodNotAllowed, "not-supported", "POST a new resource or PUT an existing one")
+		return
+	}
+
+	resource["id"] = id
+	stored, _ := json.Marshal(resource)
+	s.mu.Lock()
+	s.resources[resourceType+"/"+id] = stored
+	s.mu.Unlock()
+
+	log.Printf("%s %s/%s: %s", r.Method, resourceType, id, stored)
+	status := http.StatusOK
+	if r.Method == http.MethodPost {
+		status = http.StatusCreated
+	}
+	respond(w, status, resource)
+}
+
+func outcome(w http.ResponseWriter, status int, code, diagnostics string) {
+	respond(w, status, map[string]any{
+		"resourceType": "OperationOutcome",
+		"issue": []map[string]string{
+			{"severity": "error", "code": code, "diagnostics": diagnostics},
+		},
+	})
+}
+
+func respond(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/ekastn/hms-api/internal/domain"
 	"github.com/ekastn/hms-api/internal/env"
 	"github.com/ekastn/hms-api/internal/repository"
 	"github.com/ekastn/hms-api/internal/seed"
@@ -50,13 +51,28 @@ func main() {
 	appointmentRepo := repository.NewAppointmentRepository(db.Collection("appointments"))
 	medicalRecordRepo := repository.NewMedicalRecordRepository(db.Collection("medical_records"))
 	activityRepo := repository.NewActivityRepository(db.Collection("activities"))
+	labOrderRepo := repository.NewLabOrderRepository(db.Collection("lab_orders"))
+	tariffRepo := repository.NewTariffRepository(db.Collection("tariffs"))
+	invoiceRepo := repository.NewInvoiceRepository(db.Collection("invoices"))
+	paymentRepo := repository.NewPaymentRepository(db.Collection("payments"))
+	outboxRepo := repository.NewOutboxRepository(db.Collection("outbox"))
+	departmentRepo := repository.NewDepartmentRepository(db.Collection("departments"))
+	clinicRepo := repository.NewClinicRepository(db.Collection("clinics"))
+	clinicRoomRepo := repository.NewClinicRoomRepository(db.Collection("clinic_rooms"))
+	shiftTemplateRepo := repository.NewShiftTemplateRepository(db.Collection("shift_templates"))
+	shiftAssignmentRepo := repository.NewShiftAssignmentRepository(db.Collection("shift_assignments"))
+	shiftSwapRepo := repository.NewShiftSwapRepository(db.Collection("shift_swaps"))
 
-	activityService := service.NewActivityService(activityRepo)
+	activityService := service.NewActivityService(activityRepo, outboxRepo)
 	userService := service.NewUserService(userRepo)
-	doctorService := service.NewDoctorService(doctorRepo, appointmentRepo, patientRepo, activityService)
-	patientService := service.NewPatientService(patientRepo, appointmentRepo, medicalRecordRepo, activityService)
-	appointmentService := service.NewAppointmentService(appointmentRepo, patientRepo, medicalRecordRepo, activityService, client)
-	medicalRecordService := service.NewMedicalRecordService(medicalRecordRepo, activityService)
+	doctorService := service.NewDoctorService(doctorRepo, appointmentRepo, patientRepo, departmentRepo, userRepo, activityService, client)
+	patientService := service.NewPatientService(patientRepo, appointmentRepo, medicalRecordRepo, labOrderRepo, activityService, client)
+	billingService := service.NewBillingService(tariffRepo, invoiceRepo, paymentRepo, patientRepo, appointmentRepo, activityService, client)
+	facilityService := service.NewFacilityService(departmentRepo, clinicRepo, clinicRoomRepo, appointmentRepo, time.Local)
+	// Seed appointments are booked without checking the roster
+	rosterService := service.NewRosterService(shiftTemplateRepo, shiftAssignmentRepo, shiftSwapRepo, userRepo, doctorRepo, activityService, client, time.Local, domain.AvailabilityNone)
+	appointmentService := service.NewAppointmentService(appointmentRepo, patientRepo, medicalRecordRepo, doctorRepo, activityService, billingService, facilityService, rosterService, client)
+	medicalRecordService := service.NewMedicalRecordService(medicalRecordRepo, activityService, client)
 
 	seeder := seed.NewSeeder(db, userService, doctorService, patientService, appointmentService, medicalRecordService)
 
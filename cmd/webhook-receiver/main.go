@@ -0,0 +1,70 @@
+// Command webhook-receiver is a local HTTP receiver for testing webhook
+// subscriptions. It verifies the signature of every delivery and logs it.
+//
+//	WEBHOOK_SECRET=... go run ./cmd/webhook-receiver
+//
+// Point a subscription at http://localhost:9090/ and use the secret returned
+// when the subscription was created. Set WEBHOOK_FAIL_RATE (0-100) to answer a
+// share of the deliveries with 500 to exercise retries and the dead-letter list.
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/env"
+	"github.com/ekastn/hms-api/internal/webhook"
+)
+
+const signatureTolerance = 5 * time.Minute
+
+func main() {
+	addr := env.GetString("WEBHOOK_RECEIVER_ADDR", ":9090")
+	secret := env.GetString("WEBHOOK_SECRET", "")
+	failRate := env.GetInt("WEBHOOK_FAIL_RATE", 0)
+
+	if secret == "" {
+		log.Println("WEBHOOK_SECRET is not set, signatures will not be checked")
+	}
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		if secret != "" && !webhook.Verify(secret, r.Header.Get(webhook.HeaderTimestamp), r.Header.Get(webhook.HeaderSignature), body, signatureTolerance) {
+			log.Printf("rejected delivery %s: invalid signature", r.Header.Get(webhook.HeaderDelivery))
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		if failRate > 0 && rand.Intn(100) < failRate {
+			log.Printf("failing delivery %s on purpose", r.Header.Get(webhook.HeaderDelivery))
+			http.Error(w, "simulated failure", http.StatusInternalServerError)
+			return
+		}
+
+		var event map[string]interface{}
+		if err := json.Unmarshal(body, &event); err != nil {
+			http.Error(w, "invalid JSON", http.StatusBadRequest)
+			return
+		}
+
+		log.Printf("delivery %s: %s %v", r.Header.Get(webhook.HeaderDelivery), r.Header.Get(webhook.HeaderEvent), event["entityId"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	log.Println("webhook receiver listening on", addr)
+	log.Fatal(http.ListenAndServe(addr, nil))
+}
@@ -31,17 +31,27 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of all recorded activities. Admin access required.",
+                "description": "Get a list of all recorded activities, or download it as CSV or Excel. Admin access required.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
                     "Activities"
                 ],
                 "summary": "Get all activities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "Activities retrieved successfully",
@@ -64,6 +74,12 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Invalid export format",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Failed to retrieve activities",
                         "schema": {
@@ -73,14 +89,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/appointments": {
+        "/admissions": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve a list of all appointments.",
+                "description": "Retrieve inpatient admissions, optionally filtered by status.",
                 "consumes": [
                     "application/json"
                 ],
@@ -88,12 +104,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Appointments"
+                    "Admissions"
+                ],
+                "summary": "Get all admissions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Admission status (Admitted or Discharged)",
+                        "name": "status",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all appointments",
                 "responses": {
                     "200": {
-                        "description": "List of appointments",
+                        "description": "List of admissions",
                         "schema": {
                             "allOf": [
                                 {
@@ -105,7 +129,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.AppointmentDTO"
+                                                "$ref": "#/definitions/domain.AdmissionDTO"
                                             }
                                         }
                                     }
@@ -114,7 +138,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve appointments",
+                        "description": "Failed to retrieve admissions",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -127,7 +151,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new appointment.",
+                "description": "Admit a patient into an available bed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -135,23 +159,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Appointments"
+                    "Admissions"
                 ],
-                "summary": "Create a new appointment",
+                "summary": "Admit a patient",
                 "parameters": [
                     {
-                        "description": "Appointment object to be created",
-                        "name": "appointment",
+                        "description": "Admission details",
+                        "name": "admission",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.CreateAppointmentRequest"
+                            "$ref": "#/definitions/domain.AdmitPatientRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Appointment created successfully",
+                        "description": "Patient admitted successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -180,7 +204,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to create appointment",
+                        "description": "Failed to admit patient",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -188,14 +212,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/appointments/{id}": {
+        "/admissions/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve a single appointment by its ID.",
+                "description": "Retrieve a single admission, including transfers and the discharge summary when discharged.",
                 "consumes": [
                     "application/json"
                 ],
@@ -203,13 +227,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Appointments"
+                    "Admissions"
                 ],
-                "summary": "Get appointment by ID",
+                "summary": "Get admission by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Appointment ID",
+                        "description": "Admission ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -217,7 +241,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Appointment retrieved successfully",
+                        "description": "Admission retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -227,40 +251,36 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/domain.AppointmentDTO"
+                                            "$ref": "#/definitions/domain.AdmissionDTO"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Appointment ID is required",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "404": {
-                        "description": "Appointment not found",
+                        "description": "Admission not found",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve appointment",
+                        "description": "Failed to retrieve admission",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admissions/{id}/discharge": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update details of an existing appointment.",
+                "description": "Discharge an admitted patient, free the bed and generate the discharge summary from the records written during the stay.",
                 "consumes": [
                     "application/json"
                 ],
@@ -268,32 +288,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Appointments"
+                    "Admissions"
                 ],
-                "summary": "Update an existing appointment",
+                "summary": "Discharge a patient",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Appointment ID",
+                        "description": "Admission ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Appointment object with updated fields",
-                        "name": "appointment",
+                        "description": "Discharge details",
+                        "name": "discharge",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.UpdateAppointmentRequest"
+                            "$ref": "#/definitions/domain.DischargePatientRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Appointment updated successfully",
+                    "200": {
+                        "description": "Patient discharged successfully",
                         "schema": {
-                            "$ref": "#/definitions/utils.SuccessResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.DischargeSummary"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -303,20 +335,22 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to update appointment",
+                        "description": "Failed to discharge patient",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admissions/{id}/transfer": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Cancel an appointment (soft delete by changing status to 'Cancelled').",
+                "description": "Move an admitted patient to another available bed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -324,33 +358,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Appointments"
+                    "Admissions"
                 ],
-                "summary": "Cancel an appointment",
+                "summary": "Transfer a patient",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Appointment ID",
+                        "description": "Admission ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Transfer details",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.TransferPatientRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Appointment cancelled successfully",
+                        "description": "Patient transferred successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.SuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Appointment ID is required",
+                        "description": "Invalid request body or validation failed",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to cancel appointment",
+                        "description": "Failed to transfer patient",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -358,36 +401,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/appointments/{id}/detail": {
+        "/analytics/appointments": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve detailed information for a single appointment, including patient and medical history.",
+                "description": "Count appointments per day, week or month, optionally broken down by status, type, doctor or specialty. The range defaults to the last 30 days and the time zone to the hospital's.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Appointments"
+                    "Analytics"
                 ],
-                "summary": "Get detailed appointment information",
+                "summary": "Get appointment trend",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Appointment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone, e.g. Asia/Jakarta",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket size (day, week, month)",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Breakdown (status, type, doctor, specialty)",
+                        "name": "groupBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Appointment details retrieved successfully",
+                        "description": "Appointment trend",
                         "schema": {
                             "allOf": [
                                 {
@@ -397,7 +477,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/domain.AppointmentDetailResponse"
+                                            "$ref": "#/definitions/domain.AppointmentTrendResponse"
                                         }
                                     }
                                 }
@@ -405,19 +485,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Appointment ID is required",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Appointment not found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Failed to retrieve appointment details",
+                        "description": "Invalid parameters",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -425,91 +493,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/appointments/{id}/status": {
-            "put": {
+        "/analytics/appointments/rates": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update the status of an existing appointment.",
+                "description": "Count completed, cancelled and no-show appointments per day, week or month and over the whole range. A no-show is a past appointment that was never completed or cancelled.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Appointments"
+                    "Analytics"
                 ],
-                "summary": "Update appointment status",
+                "summary": "Get appointment no-show and cancellation rates",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Appointment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
                     },
                     {
-                        "description": "New status for the appointment",
-                        "name": "status",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/domain.UpdateAppointmentStatusRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "Appointment status updated successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.SuccessResponse"
-                        }
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Invalid request body or validation failed",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Failed to update appointment status",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/auth/login": {
-            "post": {
-                "description": "Authenticate user and return JWT token.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Authentication"
-                ],
-                "summary": "User login",
-                "parameters": [
                     {
-                        "description": "User credentials",
-                        "name": "credentials",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/domain.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "IANA time zone, e.g. Asia/Jakarta",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket size (day, week, month)",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "Appointment rates",
                         "schema": {
                             "allOf": [
                                 {
@@ -519,7 +563,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/domain.LoginResponse"
+                                            "$ref": "#/definitions/domain.AppointmentRatesResponse"
                                         }
                                     }
                                 }
@@ -527,13 +571,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or validation failed",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Invalid credentials",
+                        "description": "Invalid parameters",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -541,33 +579,79 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard": {
+        "/analytics/diagnoses": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve various statistics and recent activities for the dashboard. Admin or Management access required.",
+                "description": "Rank the diagnoses recorded in medical records in the range, with how many distinct patients had each.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Dashboard"
+                    "Analytics"
+                ],
+                "summary": "Get top diagnoses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone, e.g. Asia/Jakarta",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of diagnoses, up to 100 (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get dashboard data",
                 "responses": {
                     "200": {
-                        "description": "Dashboard data retrieved successfully",
+                        "description": "Top diagnoses",
                         "schema": {
-                            "$ref": "#/definitions/domain.DashboardResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.TopDiagnosesResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "500": {
-                        "description": "Failed to get dashboard data",
+                    "400": {
+                        "description": "Invalid parameters",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -575,27 +659,273 @@ const docTemplate = `{
                 }
             }
         },
-        "/doctors": {
+        "/analytics/doctors": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve a list of all registered doctors.",
+                "description": "Rank the active doctors by booked over available minutes, where available minutes come from each doctor's weekly availability. Includes completed, cancelled and no-show appointments, average lead time from booking to visit and medical records written.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Doctors"
+                    "Analytics"
                 ],
-                "summary": "Get all doctors",
-                "responses": {
+                "summary": "Get doctor utilization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone, e.g. Asia/Jakarta",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
                     "200": {
-                        "description": "List of doctors",
+                        "description": "Doctor utilization",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.DoctorUtilizationReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/doctors/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the doctor utilization report as CSV or Excel, one row per doctor in rank order.",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Export doctor utilization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File format, csv (default) or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone, e.g. Asia/Jakarta",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Doctor utilization report",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/patients": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Count the patients seen per day, week or month, split into those on their first visit and those who had been before. Cancelled appointments are not visits.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Get new vs returning patients",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone, e.g. Asia/Jakarta",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket size (day, week, month)",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New and returning patients",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.PatientMixResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/appointments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of all appointments, optionally only those of a department, or download it as CSV or Excel.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Appointments"
+                ],
+                "summary": "Get all appointments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of appointments",
                         "schema": {
                             "allOf": [
                                 {
@@ -607,7 +937,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.DoctorDTO"
+                                                "$ref": "#/definitions/domain.AppointmentDTO"
                                             }
                                         }
                                     }
@@ -615,8 +945,14 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Invalid export format",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to retrieve doctors",
+                        "description": "Failed to retrieve appointments",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -629,7 +965,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new doctor entry.",
+                "description": "Create a new appointment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -637,23 +973,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctors"
+                    "Appointments"
                 ],
-                "summary": "Create a new doctor",
+                "summary": "Create a new appointment",
                 "parameters": [
                     {
-                        "description": "Doctor object to be created",
-                        "name": "doctor",
+                        "description": "Appointment object to be created",
+                        "name": "appointment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.CreateDoctorRequet"
+                            "$ref": "#/definitions/domain.CreateAppointmentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Doctor created successfully",
+                        "description": "Appointment created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -682,7 +1018,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to create doctor",
+                        "description": "Failed to create appointment",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -690,14 +1026,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/doctors/{id}": {
+        "/appointments/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve a single doctor by their ID.",
+                "description": "Retrieve a single appointment by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -705,13 +1041,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctors"
+                    "Appointments"
                 ],
-                "summary": "Get doctor by ID",
+                "summary": "Get appointment by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Doctor ID",
+                        "description": "Appointment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -719,7 +1055,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Doctor details",
+                        "description": "Appointment retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -729,21 +1065,27 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/domain.DoctorDTO"
+                                            "$ref": "#/definitions/domain.AppointmentDTO"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Appointment ID is required",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Doctor not found",
+                        "description": "Appointment not found",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve doctor",
+                        "description": "Failed to retrieve appointment",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -756,7 +1098,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update details of an existing doctor.",
+                "description": "Update details of an existing appointment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -764,30 +1106,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctors"
+                    "Appointments"
                 ],
-                "summary": "Update an existing doctor",
+                "summary": "Update an existing appointment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Doctor ID",
+                        "description": "Appointment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Doctor object with updated fields",
-                        "name": "doctor",
+                        "description": "Appointment object with updated fields",
+                        "name": "appointment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.UpdateDoctorRequet"
+                            "$ref": "#/definitions/domain.UpdateAppointmentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Doctor updated successfully",
+                        "description": "Appointment updated successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.SuccessResponse"
                         }
@@ -798,14 +1140,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Doctor not found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
-                        "description": "Failed to update doctor",
+                        "description": "Failed to update appointment",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -818,7 +1154,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a doctor entry.",
+                "description": "Cancel an appointment (soft delete by changing status to 'Cancelled').",
                 "consumes": [
                     "application/json"
                 ],
@@ -826,13 +1162,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctors"
+                    "Appointments"
                 ],
-                "summary": "Delete a doctor",
+                "summary": "Cancel an appointment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Doctor ID",
+                        "description": "Appointment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -840,28 +1176,34 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "204": {
-                        "description": "Doctor deleted successfully",
+                        "description": "Appointment cancelled successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.SuccessResponse"
                         }
                     },
-                    "500": {
-                        "description": "Failed to delete doctor",
+                    "400": {
+                        "description": "Appointment ID is required",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    }
-                }
+                    },
+                    "500": {
+                        "description": "Failed to cancel appointment",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
             }
         },
-        "/doctors/{id}/detail": {
+        "/appointments/{id}/detail": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve detailed information for a single doctor, including recent patients.",
+                "description": "Retrieve detailed information for a single appointment, including patient and medical history.",
                 "consumes": [
                     "application/json"
                 ],
@@ -869,13 +1211,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Doctors"
+                    "Appointments"
                 ],
-                "summary": "Get detailed doctor information",
+                "summary": "Get detailed appointment information",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Doctor ID",
+                        "description": "Appointment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -883,7 +1225,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Doctor details with recent patients",
+                        "description": "Appointment details retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -893,106 +1235,42 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/domain.DoctorDetailResponse"
+                                            "$ref": "#/definitions/domain.AppointmentDetailResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Doctor not found",
+                    "400": {
+                        "description": "Appointment ID is required",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Failed to retrieve doctor details",
+                    "404": {
+                        "description": "Appointment not found",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "Checks if the server is healthy",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "summary": "Health check endpoint",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            }
-        },
-        "/patients": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Retrieve a list of all registered patients.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Patients"
-                ],
-                "summary": "Get all patients",
-                "responses": {
-                    "200": {
-                        "description": "List of patients",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/utils.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/domain.PatientDTO"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     },
                     "500": {
-                        "description": "Failed to retrieve patients",
+                        "description": "Failed to retrieve appointment details",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/appointments/{id}/eligibility": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new patient entry.",
+                "description": "Check the patient's insurance with the payer for the appointment date and store the result on the appointment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1000,23 +1278,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patients"
+                    "Insurance"
                 ],
-                "summary": "Create a new patient",
+                "summary": "Check appointment eligibility",
                 "parameters": [
                     {
-                        "description": "Patient object to be created",
-                        "name": "patient",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/domain.CreatePatientRequest"
-                        }
+                        "type": "string",
+                        "description": "Appointment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Patient created successfully",
+                    "200": {
+                        "description": "Eligibility checked successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1026,26 +1302,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object",
-                                            "properties": {
-                                                "id": {
-                                                    "type": "string"
-                                                }
-                                            }
+                                            "$ref": "#/definitions/domain.EligibilityCheck"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body or validation failed",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
-                        "description": "Failed to create patient",
+                        "description": "Failed to check eligibility",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1053,28 +1318,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/patients/{id}": {
+        "/appointments/{id}/pdf": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve a single patient by their ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Render the summary of an appointment, with triage and the patient's latest medical record, as a PDF for the patient. The QR code on each page links to the public verification endpoint.",
                 "produces": [
-                    "application/json"
+                    "application/pdf"
                 ],
                 "tags": [
-                    "Patients"
+                    "Documents"
                 ],
-                "summary": "Get patient by ID",
+                "summary": "Print visit summary",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Patient ID",
+                        "description": "Appointment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1082,44 +1344,34 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Patient details",
+                        "description": "Visit summary PDF",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/utils.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/domain.PatientDTO"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
                         }
                     },
                     "404": {
-                        "description": "Patient not found",
+                        "description": "Appointment not found",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve patient",
+                        "description": "Failed to print visit summary",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/appointments/{id}/status": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update details of an existing patient.",
+                "description": "Update the status of an existing appointment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1127,30 +1379,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patients"
+                    "Appointments"
                 ],
-                "summary": "Update an existing patient",
+                "summary": "Update appointment status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Patient ID",
+                        "description": "Appointment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Patient object with updated fields",
-                        "name": "patient",
+                        "description": "New status for the appointment",
+                        "name": "status",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.UpdatePatientRequest"
+                            "$ref": "#/definitions/domain.UpdateAppointmentStatusRequest"
                         }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Patient updated successfully",
+                        "description": "Appointment status updated successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.SuccessResponse"
                         }
@@ -1161,27 +1413,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Patient not found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
-                        "description": "Failed to update patient",
+                        "description": "Failed to update appointment status",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/appointments/{id}/triage": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a patient entry.",
+                "description": "Record the Emergency Severity Index level, chief complaint and vital signs of an emergency appointment. If the patient is waiting in the queue, the ticket moves up by ESI level.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1189,64 +1437,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Patients"
+                    "Appointments"
                 ],
-                "summary": "Delete a patient",
+                "summary": "Triage an emergency appointment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Patient ID",
+                        "description": "Appointment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "Patient deleted successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.SuccessResponse"
-                        }
                     },
-                    "500": {
-                        "description": "Failed to delete patient",
+                    {
+                        "description": "Triage assessment",
+                        "name": "triage",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
+                            "$ref": "#/definitions/domain.TriageRequest"
                         }
                     }
-                }
-            }
-        },
-        "/patients/{id}/detail": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Retrieve comprehensive information for a single patient, including recent appointments and medical history.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Patients"
-                ],
-                "summary": "Get detailed patient information",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Patient ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Patient details with appointments and medical history",
+                        "description": "Appointment triaged successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1256,21 +1470,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/domain.PatientDetailResponse"
+                                            "$ref": "#/definitions/domain.AppointmentDTO"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "404": {
-                        "description": "Patient not found",
+                    "400": {
+                        "description": "Invalid request body or validation failed",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve patient details",
+                        "description": "Failed to triage appointment",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1278,14 +1492,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/records": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Retrieve a list of all medical records.",
+        "/auth/login": {
+            "post": {
+                "description": "Authenticate user and return JWT token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1293,12 +1502,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medical Records"
+                    "Authentication"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.LoginRequest"
+                        }
+                    }
                 ],
-                "summary": "Get all medical records",
                 "responses": {
                     "200": {
-                        "description": "Medical records retrieved successfully",
+                        "description": "Login successful",
                         "schema": {
                             "allOf": [
                                 {
@@ -1308,31 +1528,36 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/domain.MedicalRecordDTO"
-                                            }
+                                            "$ref": "#/definitions/domain.LoginResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Failed to get medical records",
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/beds": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new medical record entry.",
+                "description": "Create a new bed inside a room. New beds start as Available.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1340,23 +1565,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medical Records"
+                    "Wards"
                 ],
-                "summary": "Create a new medical record",
+                "summary": "Create a new bed",
                 "parameters": [
                     {
-                        "description": "Medical record object to be created",
-                        "name": "medicalRecord",
+                        "description": "Bed object to be created",
+                        "name": "bed",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.CreateMedicalRecordRequest"
+                            "$ref": "#/definitions/domain.CreateBedRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Medical record created",
+                        "description": "Bed created successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1385,7 +1610,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to create medical record",
+                        "description": "Failed to create bed",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1393,14 +1618,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/records/date-range": {
+        "/beds/board": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve a list of medical records within a specified date range.",
+                "description": "Retrieve every active ward with its beds, their status and the admitted patient.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1408,28 +1633,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medical Records"
-                ],
-                "summary": "Get medical records by date range",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Start date (RFC3339 format)",
-                        "name": "start",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date (RFC3339 format)",
-                        "name": "end",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Wards"
                 ],
+                "summary": "Get bed occupancy board",
                 "responses": {
                     "200": {
-                        "description": "Medical records by date range",
+                        "description": "Bed occupancy board",
                         "schema": {
                             "allOf": [
                                 {
@@ -1441,7 +1650,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/domain.MedicalRecordDTO"
+                                                "$ref": "#/definitions/domain.WardBoard"
                                             }
                                         }
                                     }
@@ -1449,14 +1658,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Both start and end dates are required or invalid format",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
-                        "description": "Failed to get medical records",
+                        "description": "Failed to retrieve bed occupancy board",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1464,14 +1667,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/records/patient/{patientId}": {
-            "get": {
+        "/beds/{id}/status": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve a list of medical records for a specific patient.",
+                "description": "Mark a bed that is not occupied as Available, Cleaning or Maintenance.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1479,48 +1682,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medical Records"
+                    "Wards"
                 ],
-                "summary": "Get medical records by patient ID",
+                "summary": "Update bed status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Patient ID",
-                        "name": "patientId",
+                        "description": "Bed ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New status for the bed",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.UpdateBedStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Patient medical records",
+                    "204": {
+                        "description": "Bed status updated successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/utils.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/domain.MedicalRecordDTO"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/utils.SuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Patient ID is required",
+                        "description": "Invalid request body or validation failed",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to get medical records",
+                        "description": "Failed to update bed status",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1528,14 +1725,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/records/{id}": {
+        "/claims": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Retrieve a single medical record by its ID.",
+                "description": "Retrieve insurance claims, optionally filtered by status and payer type.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1543,21 +1740,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medical Records"
+                    "Insurance"
                 ],
-                "summary": "Get medical record by ID",
+                "summary": "Get insurance claims",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Medical Record ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Claim status (Draft, Submitted, Approved, Rejected, Paid)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payer type (BPJS or Private)",
+                        "name": "payerType",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Medical record details",
+                        "description": "List of claims",
                         "schema": {
                             "allOf": [
                                 {
@@ -1567,7 +1769,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/domain.MedicalRecordDTO"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ClaimDTO"
+                                            }
                                         }
                                     }
                                 }
@@ -1575,32 +1780,26 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Record ID is required",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Medical record not found",
+                        "description": "Invalid claim status",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to get medical record",
+                        "description": "Failed to retrieve claims",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update details of an existing medical record.",
+                "description": "Generate a draft insurance claim for the outstanding balance of an invoice. The patient's policy covering the service date is used unless a policy is given.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1608,32 +1807,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Medical Records"
+                    "Insurance"
                 ],
-                "summary": "Update an existing medical record",
+                "summary": "Create a claim",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Medical Record ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Medical record object with updated fields",
-                        "name": "medicalRecord",
+                        "description": "Invoice to claim",
+                        "name": "claim",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.UpdateMedicalRecordRequest"
+                            "$ref": "#/definitions/domain.CreateClaimRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Medical record updated successfully",
+                    "201": {
+                        "description": "Claim created successfully",
                         "schema": {
-                            "$ref": "#/definitions/utils.SuccessResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1642,61 +1851,59 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Medical record not found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
-                        "description": "Failed to update medical record",
+                        "description": "Failed to create claim",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/claims/export": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a medical record entry.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Download the matching claims as a CSV batch file with one row per claimed item.",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "Medical Records"
+                    "Insurance"
                 ],
-                "summary": "Delete a medical record",
+                "summary": "Export claims",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Medical Record ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Claim status (Draft, Submitted, Approved, Rejected, Paid)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payer type (BPJS or Private)",
+                        "name": "payerType",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Medical record deleted successfully",
+                        "description": "Claim batch file",
                         "schema": {
-                            "$ref": "#/definitions/utils.SuccessResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Record ID is required",
+                        "description": "Invalid claim status",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to delete medical record",
+                        "description": "Failed to export claims",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1704,14 +1911,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/claims/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of all registered users. Admin access required.",
+                "description": "Retrieve a single insurance claim with its status history.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1719,12 +1926,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Insurance"
+                ],
+                "summary": "Get claim by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all users",
                 "responses": {
                     "200": {
-                        "description": "Users retrieved successfully",
+                        "description": "Claim retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1734,31 +1950,36 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/domain.UserDTO"
-                                            }
+                                            "$ref": "#/definitions/domain.ClaimDTO"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "404": {
+                        "description": "Claim not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to retrieve users",
+                        "description": "Failed to retrieve claim",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/claims/{id}/status": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new user account. Admin access required.",
+                "description": "Record approval, rejection or payment of a claim, or reopen a rejected claim as a draft. Paying a claim posts an insurance payment on the invoice.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1766,28 +1987,190 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Insurance"
                 ],
-                "summary": "Create a new user",
+                "summary": "Update claim status",
                 "parameters": [
                     {
-                        "description": "User object to be created",
-                        "name": "user",
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New claim status",
+                        "name": "status",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.CreateUserRequest"
+                            "$ref": "#/definitions/domain.UpdateClaimStatusRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "User created successfully",
+                    "204": {
+                        "description": "Claim status updated successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/utils.SuccessResponse"
-                                },
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update claim status",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/claims/{id}/submit": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Send a draft claim to the payer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Insurance"
+                ],
+                "summary": "Submit a claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Claim submitted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to submit claim",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/clinic-rooms": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve all clinic rooms, optionally only those of a clinic or department.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clinic Rooms"
+                ],
+                "summary": "Get all clinic rooms",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Clinic ID",
+                        "name": "clinicId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of rooms",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ClinicRoomEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve rooms",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a bookable room in a clinic with its capacity. Operating hours, when given, override the clinic's.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clinic Rooms"
+                ],
+                "summary": "Create a new clinic room",
+                "parameters": [
+                    {
+                        "description": "Room object to be created",
+                        "name": "room",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ClinicRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Room created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
                                 {
                                     "type": "object",
                                     "properties": {
@@ -1811,7 +2194,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to create user",
+                        "description": "Failed to create room",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1819,14 +2202,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/clinic-rooms/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a single user by their ID. Admin access required.",
+                "description": "Retrieve a single clinic room by its ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1834,13 +2217,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Clinic Rooms"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Get clinic room by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Room ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1848,7 +2231,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "User retrieved successfully",
+                        "description": "Room retrieved successfully",
                         "schema": {
                             "allOf": [
                                 {
@@ -1858,7 +2241,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/domain.UserDTO"
+                                            "$ref": "#/definitions/domain.ClinicRoomEntity"
                                         }
                                     }
                                 }
@@ -1866,13 +2249,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Room not found",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Failed to retrieve user",
+                        "description": "Failed to retrieve room",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1885,7 +2268,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update details of an existing user. Admin access required.",
+                "description": "Update details of an existing clinic room.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1893,30 +2276,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Clinic Rooms"
                 ],
-                "summary": "Update an existing user",
+                "summary": "Update an existing clinic room",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Room ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "User object with updated fields",
-                        "name": "user",
+                        "description": "Room object with updated fields",
+                        "name": "room",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.UpdateUserRequest"
+                            "$ref": "#/definitions/domain.ClinicRoomRequest"
                         }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "User updated successfully",
+                        "description": "Room updated successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.SuccessResponse"
                         }
@@ -1928,20 +2311,22 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to update user",
+                        "description": "Failed to update room",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/clinics": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Deactivate a user account (soft delete). Admin access required.",
+                "description": "Retrieve all outpatient clinics, optionally only those of a department.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1949,42 +2334,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Clinics"
                 ],
-                "summary": "Deactivate a user",
+                "summary": "Get all clinics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "User deactivated successfully",
+                    "200": {
+                        "description": "List of clinics",
                         "schema": {
-                            "$ref": "#/definitions/utils.SuccessResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ClinicEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
-                        "description": "Failed to deactivate user",
+                        "description": "Failed to retrieve clinics",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/users/{id}/password": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Change the password for a specific user. Admin access required.",
+                "description": "Create a new outpatient clinic in a department, with its operating hours.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1992,32 +2389,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Clinics"
                 ],
-                "summary": "Change user password",
+                "summary": "Create a new clinic",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "New password details",
-                        "name": "password",
+                        "description": "Clinic object to be created",
+                        "name": "clinic",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.ChangePasswordRequest"
+                            "$ref": "#/definitions/domain.ClinicRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Password changed successfully",
+                    "201": {
+                        "description": "Clinic created successfully",
                         "schema": {
-                            "$ref": "#/definitions/utils.SuccessResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2027,501 +2434,13818 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Failed to change password",
+                        "description": "Failed to create clinic",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.Activity": {
-            "description": "Activity log entry",
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "Appointment for patient John Doe with doctor Jane Smith on 2025-07-17."
-                },
-                "id": {
-                    "type": "string",
-                    "example": "60d0fe4f53115a001f000001"
-                },
-                "timestamp": {
-                    "type": "string",
-                    "example": "2025-07-17T10:30:00Z"
-                },
-                "title": {
-                    "type": "string",
-                    "example": "New Appointment Scheduled"
-                },
-                "type": {
-                    "description": "e.g., \"APPOINTMENT\", \"MEDICAL_RECORD\"",
-                    "type": "string",
-                    "example": "APPOINTMENT"
-                }
-            }
         },
-        "domain.AppointmentDTO": {
-            "type": "object",
-            "required": [
+        "/clinics/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single clinic by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clinics"
+                ],
+                "summary": "Get clinic by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Clinic ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Clinic retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ClinicEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Clinic not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve clinic",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update details of an existing clinic. Moving it to another department moves its rooms too.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Clinics"
+                ],
+                "summary": "Update an existing clinic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Clinic ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clinic object with updated fields",
+                        "name": "clinic",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ClinicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Clinic updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update clinic",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dashboard": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve various statistics and recent activities for the dashboard. Admin or Management access required. With a department, the patient, doctor and appointment figures only cover that department. The top five doctors by utilization over the last 30 days are included.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard"
+                ],
+                "summary": "Get dashboard data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dashboard data retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/domain.DashboardResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get dashboard data",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/departments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve all hospital departments.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "Get all departments",
+                "responses": {
+                    "200": {
+                        "description": "List of departments",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.DepartmentEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve departments",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new hospital department.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "Create a new department",
+                "parameters": [
+                    {
+                        "description": "Department object to be created",
+                        "name": "department",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.DepartmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Department created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create department",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/departments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single department by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "Get department by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Department retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.DepartmentEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Department not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve department",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update details of an existing department.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Departments"
+                ],
+                "summary": "Update an existing department",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Department object with updated fields",
+                        "name": "department",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.DepartmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Department updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update department",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/doctors": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of all registered doctors, optionally only those of a department.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctors"
+                ],
+                "summary": "Get all doctors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Department ID",
+                        "name": "departmentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of doctors",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.DoctorDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve doctors",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new doctor entry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctors"
+                ],
+                "summary": "Create a new doctor",
+                "parameters": [
+                    {
+                        "description": "Doctor object to be created",
+                        "name": "doctor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateDoctorRequet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Doctor created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create doctor",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/doctors/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single doctor by their ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctors"
+                ],
+                "summary": "Get doctor by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Doctor details",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.DoctorDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Doctor not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve doctor",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update details of an existing doctor.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctors"
+                ],
+                "summary": "Update an existing doctor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Doctor object with updated fields",
+                        "name": "doctor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.UpdateDoctorRequet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Doctor updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Doctor not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update doctor",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a doctor entry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctors"
+                ],
+                "summary": "Delete a doctor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Doctor deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete doctor",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/doctors/{id}/detail": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve detailed information for a single doctor, including recent patients.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Doctors"
+                ],
+                "summary": "Get detailed doctor information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Doctor details with recent patients",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.DoctorDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Doctor not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve doctor details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Open a Server-Sent Events stream of domain events (activity entries and entity changes). Events are filtered to the topics the caller's role may see, optionally narrowed with the topics parameter. Clients that cannot set headers, such as EventSource, may pass the JWT as access_token. On reconnect, send the Last-Event-ID header (or lastEventId parameter) to replay buffered events published since. The stream is closed when the JWT expires; reconnect with a fresh token.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Stream domain events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated topics, e.g. APPOINTMENT,PATIENT",
+                        "name": "topics",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received",
+                        "name": "lastEventId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JWT, for clients that cannot set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event stream",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid JWT",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "No topics allowed for the role",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Query patients, doctors, appointments, medical records and activities, with their relations, in one request. Each query and restricted field is limited to the roles of the matching REST endpoint; a field the caller may not see is null with a FORBIDDEN error. Queries above the complexity limit are refused. The schema is available through introspection.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GraphQL"
+                ],
+                "summary": "Run a GraphQL query",
+                "parameters": [
+                    {
+                        "description": "GraphQL operation",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.GraphQLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Query result, with any errors",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GraphQLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Checks if the server is healthy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Health check endpoint",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/hl7/messages": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the most recent HL7 v2 messages received and sent over MLLP, with their acknowledgments. Filter outbound messages by status DeadLetter to get the dead-letter list.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HL7"
+                ],
+                "summary": "Get HL7 messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Direction (inbound, outbound)",
+                        "name": "direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status (Processed, Error, Rejected, Pending, Sent, Failed, DeadLetter)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Message type, e.g. ADT^A08",
+                        "name": "messageType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "patientId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of HL7 messages",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.HL7MessageEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hl7/messages/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single HL7 message with its raw content, acknowledgment and attempt log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HL7"
+                ],
+                "summary": "Get HL7 message by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "HL7 message ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "HL7 message retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.HL7MessageEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "HL7 message not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve HL7 message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hl7/messages/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put a failed or dead-lettered outbound message back in the send queue with a fresh attempt budget.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HL7"
+                ],
+                "summary": "Retry an outbound HL7 message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "HL7 message ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "HL7 message queued for retry",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retry HL7 message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import/doctors": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a CSV file with the columns name, specialty, phone and email, and optionally departmentId and userId, validated like a create doctor request. Rows whose name, email or phone matches an existing doctor or an earlier row are skipped as duplicates. The file is imported in the background; poll the returned job for progress. With dryRun nothing is created and the job reports what would happen to each row.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Import doctors from CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only check the rows",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ImportJobDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or invalid file",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import/jobs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the most recent import jobs, optionally of one kind. Only admins see doctor imports.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Get import jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "patients or doctors",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of import jobs",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ImportJobDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid kind",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import/jobs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve an import job with its progress and the rows that were not, or in a dry run would not be, imported, with the reason for each.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Get import job by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import job retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ImportJobDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Import job not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve import job",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import/jobs/{id}/result": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the uploaded rows with the columns import_status, import_id and import_errors added, as CSV or Excel. Rows not processed yet have no status.",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Download import result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File format, csv (default) or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import result",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export format",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Import job not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve import result",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import/patients": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a CSV file with the columns name, age, gender, phone, email and address, validated like a create patient request. Rows whose email or phone matches an existing patient or an earlier row are skipped as duplicates. The file is imported in the background; poll the returned job for progress. With dryRun nothing is created and the job reports what would happen to each row.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Import"
+                ],
+                "summary": "Import patients from CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only check the rows",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ImportJobDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or invalid file",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/insurance/policies": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve insurance policies, optionally filtered by patient.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Insurance"
+                ],
+                "summary": "Get insurance policies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "patientId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of insurance policies",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.InsurancePolicyDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve insurance policies",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Register a BPJS or private insurance policy for a patient.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Insurance"
+                ],
+                "summary": "Create an insurance policy",
+                "parameters": [
+                    {
+                        "description": "Insurance policy to be created",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.InsurancePolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Insurance policy created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create insurance policy",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/insurance/policies/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single insurance policy by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Insurance"
+                ],
+                "summary": "Get insurance policy by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Policy ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Insurance policy retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.InsurancePolicyDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Insurance policy not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve insurance policy",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update the payer, member number or validity of an insurance policy.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Insurance"
+                ],
+                "summary": "Update an insurance policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Policy ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Insurance policy with updated fields",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.InsurancePolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Insurance policy updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update insurance policy",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve invoices, optionally filtered by patient and status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get all invoices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "patientId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invoice status (Unpaid, PartiallyPaid, Paid, Refunded, Void)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of invoices",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.InvoiceDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid invoice status",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve invoices",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Raise an invoice manually from tariff items or free-text charges.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Create a new invoice",
+                "parameters": [
+                    {
+                        "description": "Invoice object to be created",
+                        "name": "invoice",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateInvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Invoice created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create invoice",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single invoice with its line items and balance.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get invoice by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.InvoiceDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Invoice not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve invoice",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}/payments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the payments and refunds recorded against an invoice.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get invoice payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of payments",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.PaymentDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve payments",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}/pdf": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Render an invoice with its line items, totals and balance as a PDF.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Print invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice PDF",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Invoice not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to print invoice",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}/void": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Void an invoice that has no outstanding payments.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Void an invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason for voiding",
+                        "name": "void",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.VoidInvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Invoice voided successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to void invoice",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/labs/orders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of all lab orders, most recent first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labs"
+                ],
+                "summary": "Get all lab orders",
+                "responses": {
+                    "200": {
+                        "description": "List of lab orders",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.LabOrderDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve lab orders",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Place a lab order for a patient, linked to one of their appointments.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labs"
+                ],
+                "summary": "Place a lab order",
+                "parameters": [
+                    {
+                        "description": "Lab order to be placed",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateLabOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Lab order created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create lab order",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/labs/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single lab order, including its results when available.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labs"
+                ],
+                "summary": "Get lab order by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lab Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lab order retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.LabOrderDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Lab order not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve lab order",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/labs/orders/{id}/results": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Enter results for every test on a lab order and mark it as completed. Flags are derived from the reference range when omitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labs"
+                ],
+                "summary": "Enter lab results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lab Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lab results",
+                        "name": "results",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.EnterLabResultsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Lab results entered successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to enter lab results",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/labs/orders/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move an open lab order to InProgress, back to Ordered, or cancel it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labs"
+                ],
+                "summary": "Update lab order status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lab Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status for the lab order",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.UpdateLabOrderStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Lab order status updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update lab order status",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/labs/patients/{patientId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve all lab orders placed for a specific patient.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labs"
+                ],
+                "summary": "Get lab orders by patient ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "patientId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Patient lab orders",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.LabOrderDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve lab orders",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/labs/worklist": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve open lab orders (Ordered and InProgress), ordered by priority and age.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labs"
+                ],
+                "summary": "Get lab worklist",
+                "responses": {
+                    "200": {
+                        "description": "Lab worklist",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.LabWorklistItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve lab worklist",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outbox/dead": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the most recent events that could not be dispatched after the maximum number of attempts, with their last error. Their activity, stream, webhook, HL7 and SATUSEHAT deliveries are on hold until requeued.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outbox"
+                ],
+                "summary": "Get dead outbox messages",
+                "responses": {
+                    "200": {
+                        "description": "List of dead outbox messages",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.OutboxMessageEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve dead outbox messages",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/outbox/{id}/requeue": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put a dead outbox message back in the queue with a fresh attempt budget.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outbox"
+                ],
+                "summary": "Requeue a dead outbox message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Outbox message (event) ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Outbox message requeued",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to requeue outbox message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/patients": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of all registered patients, or download it as CSV or Excel.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "Get all patients",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of patients",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.PatientDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export format",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve patients",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new patient entry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "Create a new patient",
+                "parameters": [
+                    {
+                        "description": "Patient object to be created",
+                        "name": "patient",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreatePatientRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Patient created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create patient",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/patients/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single patient by their ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "Get patient by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Patient details",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.PatientDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Patient not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve patient",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update details of an existing patient.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "Update an existing patient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Patient object with updated fields",
+                        "name": "patient",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.UpdatePatientRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Patient updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Patient not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update patient",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a patient entry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "Delete a patient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Patient deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete patient",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/patients/{id}/balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the billed, paid, refunded and outstanding totals of a patient across all non-void invoices.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get patient balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Patient balance retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.PatientBalance"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve patient balance",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/patients/{id}/detail": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve comprehensive information for a single patient, including recent appointments and medical history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Patients"
+                ],
+                "summary": "Get detailed patient information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Patient details with appointments and medical history",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.PatientDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Patient not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve patient details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/patients/{id}/pdf": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Render the patient's details, medical records, recent appointments and lab results as a PDF.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Print patient history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Patient history PDF",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Patient not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to print patient history",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/patients/{id}/reminder-preferences": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the reminder opt-out, language and channels of a patient. Hospital defaults are returned when the patient has not set any.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reminders"
+                ],
+                "summary": "Get patient reminder preferences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reminder preferences retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ReminderPreferenceEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid patient ID",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Opt a patient out of appointment reminders, or choose their language (id, en) and channels (email, sms, whatsapp). Empty values fall back to the hospital defaults.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reminders"
+                ],
+                "summary": "Update patient reminder preferences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reminder preferences",
+                        "name": "preference",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ReminderPreferenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Reminder preferences updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update reminder preferences",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve payments and refunds, optionally filtered by invoice.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get all payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "invoiceId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of payments",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.PaymentDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve payments",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record a full or partial payment against an invoice. Amounts are in minor currency units and may not exceed the outstanding balance.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Record a payment",
+                "parameters": [
+                    {
+                        "description": "Payment details",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreatePaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Payment recorded successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to record payment",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single payment or refund.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get payment by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.PaymentDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Payment not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve payment",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments/{id}/refund": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Refund part or all of a payment. The refund is recorded as a separate payment entry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Refund a payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refund details",
+                        "name": "refund",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.RefundPaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Payment refunded successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to refund payment",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/alerts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve low-stock levels and batches that are expired or close to expiry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Get stock alerts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stock location",
+                        "name": "location",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stock alerts",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.StockAlert"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve stock alerts",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/batches": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve batches that still hold stock, ordered by expiry date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Get stock batches",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pharmacy item ID",
+                        "name": "itemId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Stock location",
+                        "name": "location",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of stock batches",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.StockBatchEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve stock batches",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/batches/{id}/expire": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Write off the remaining quantity of a batch as expired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Write off an expired batch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stock batch ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Batch written off successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to write off batch",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/dispense": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Dispense items to a patient from the unexpired batches at a location, first-expiry-first-out. Nothing is dispensed if any item is short.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Dispense items",
+                "parameters": [
+                    {
+                        "description": "Items to dispense",
+                        "name": "dispense",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.DispenseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Items dispensed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.StockMovementEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to dispense items",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/items": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the catalogue of drugs and consumables.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Get all pharmacy items",
+                "responses": {
+                    "200": {
+                        "description": "List of pharmacy items",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.PharmacyItemDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve pharmacy items",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a drug or consumable to the pharmacy catalogue.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Create a new pharmacy item",
+                "parameters": [
+                    {
+                        "description": "Pharmacy item object to be created",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.PharmacyItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Pharmacy item created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create pharmacy item",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/items/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single pharmacy item.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Get pharmacy item by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pharmacy item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pharmacy item retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.PharmacyItemDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Pharmacy item not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve pharmacy item",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a pharmacy item, including its reorder level.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Update an existing pharmacy item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pharmacy item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pharmacy item object with updated fields",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.PharmacyItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Pharmacy item updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update pharmacy item",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/movements": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the most recent stock movements, optionally filtered by item and type.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Get stock movements",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pharmacy item ID",
+                        "name": "itemId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Movement type (Received, Dispensed, Adjusted, Expired)",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of stock movements",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.StockMovementEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/stock": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the unexpired stock of every item per location, optionally for a single location.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Get stock levels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stock location",
+                        "name": "location",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stock levels",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.StockLevel"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve stock levels",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/stock/adjust": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Apply a manual correction to a batch, e.g. after a stock count. A reason is required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Adjust stock",
+                "parameters": [
+                    {
+                        "description": "Stock adjustment",
+                        "name": "adjustment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.AdjustStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Stock adjusted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to adjust stock",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pharmacy/stock/receive": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Book goods received into a batch with its expiry date. Receiving an existing batch number at the same location tops it up.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pharmacy"
+                ],
+                "summary": "Receive stock",
+                "parameters": [
+                    {
+                        "description": "Goods received",
+                        "name": "stock",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ReceiveStockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Stock received successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to receive stock",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/appointments/respond": {
+            "get": {
+                "description": "Show the appointment and action behind a confirmation or cancellation link without using it. Link previews and scanners may fetch links, so this endpoint never changes anything.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Look up an appointment link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Appointment link is valid",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.AppointmentLinkInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid, expired or no longer applicable link",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Use a link token from an appointment reminder to move the appointment to Confirmed or Cancelled. Each token works once; cancellation is only possible up to the configured cut-off before the appointment.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Confirm or cancel an appointment",
+                "parameters": [
+                    {
+                        "description": "Link token",
+                        "name": "link",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.AppointmentLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Appointment updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.AppointmentLinkInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid, expired, used or no longer applicable link",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/documents/verify": {
+            "get": {
+                "description": "Check the token from the QR code of a printed document. Tells whether the hospital issued it and whether the record has changed since. The patient's name is masked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Public"
+                ],
+                "summary": "Verify a printed document",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Document token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Document is genuine",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.DocumentVerification"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid document code",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the outpatient queues of a day, optionally filtered by doctor or polyclinic.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Get queues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD), defaults to today",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Doctor ID",
+                        "name": "doctorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Polyclinic name",
+                        "name": "polyclinic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of queues",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.QueueEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/check-in": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put a patient with an appointment today into the doctor's queue. Checking in again returns the existing ticket.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Check in an appointment",
+                "parameters": [
+                    {
+                        "description": "Appointment to check in",
+                        "name": "checkIn",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Patient checked in successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueTicketEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to check in",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/tickets": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Issue a ticket in today's queue of a doctor or polyclinic for a walk-in patient. Unregistered patients can be queued by name.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Issue a walk-in ticket",
+                "parameters": [
+                    {
+                        "description": "Walk-in ticket",
+                        "name": "ticket",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.WalkInTicketRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Ticket issued successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueTicketEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to issue ticket",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/tickets/{id}/complete": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Mark a called ticket as served.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Complete a ticket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ticket ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ticket completed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueTicketEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Ticket cannot be completed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/tickets/{id}/recall": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Call a called or skipped ticket again, e.g. when the patient turns up late.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Recall a ticket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ticket ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ticket recalled",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueTicketEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Ticket cannot be recalled",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/tickets/{id}/skip": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Take a ticket whose patient did not answer the call out of the queue. It can be recalled later.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Skip a ticket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ticket ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ticket skipped",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueTicketEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Ticket cannot be skipped",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/tickets/{id}/triage": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record the Emergency Severity Index level, chief complaint and vital signs of a waiting patient, such as an emergency walk-in. ESI 1 is called first, ESI 5 last, before untriaged tickets.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Triage a queue ticket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ticket ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Triage assessment",
+                        "name": "triage",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.TriageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ticket triaged successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueTicketEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to triage ticket",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a queue with its tickets in calling order and the estimated wait of each waiting ticket.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Get queue by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Queue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Queue retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/{id}/board": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve what the waiting-room display shows: tickets being called and the next ones up, without patient names. Subscribe to the QUEUE topic on /events for live updates.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Get queue display board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Queue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Queue board retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueBoardResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queues/{id}/call-next": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Call the next waiting ticket, by priority and then ticket number, to the counter. The ticket previously called at the same counter is completed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Queues"
+                ],
+                "summary": "Call the next patient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Queue ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Counter or room the patient is called to",
+                        "name": "call",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CallNextRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ticket called",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.QueueTicketEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or nobody waiting",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/records": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of all medical records, or download it as CSV or Excel.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Medical Records"
+                ],
+                "summary": "Get all medical records",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Download as csv or xlsx instead of JSON (or send Accept: text/csv)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Medical records retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.MedicalRecordDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid export format",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get medical records",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new medical record entry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medical Records"
+                ],
+                "summary": "Create a new medical record",
+                "parameters": [
+                    {
+                        "description": "Medical record object to be created",
+                        "name": "medicalRecord",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateMedicalRecordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Medical record created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create medical record",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/records/date-range": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of medical records within a specified date range.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medical Records"
+                ],
+                "summary": "Get medical records by date range",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (RFC3339 format)",
+                        "name": "start",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (RFC3339 format)",
+                        "name": "end",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Medical records by date range",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.MedicalRecordDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Both start and end dates are required or invalid format",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get medical records",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/records/patient/{patientId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of medical records for a specific patient.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medical Records"
+                ],
+                "summary": "Get medical records by patient ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "patientId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Patient medical records",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.MedicalRecordDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Patient ID is required",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get medical records",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/records/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single medical record by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medical Records"
+                ],
+                "summary": "Get medical record by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medical Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Medical record details",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.MedicalRecordDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Record ID is required",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Medical record not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to get medical record",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update details of an existing medical record.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medical Records"
+                ],
+                "summary": "Update an existing medical record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medical Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Medical record object with updated fields",
+                        "name": "medicalRecord",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.UpdateMedicalRecordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Medical record updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Medical record not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update medical record",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a medical record entry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Medical Records"
+                ],
+                "summary": "Delete a medical record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medical Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Medical record deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Record ID is required",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete medical record",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/records/{id}/pdf": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Render a medical record, with the medication dispensed against it, as a PDF.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Documents"
+                ],
+                "summary": "Print medical record",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Medical Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Medical record PDF",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Medical record not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to print medical record",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referrals": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve referrals, newest first, optionally filtered by patient, referring doctor and status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Get referrals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "patientId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Referring doctor ID",
+                        "name": "fromDoctorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Referral status (Sent, Accepted, Declined, Completed)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of referrals",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ReferralEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Refer a patient to a doctor or to a specialty, with the reason, urgency and supporting medical records.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Refer a patient",
+                "parameters": [
+                    {
+                        "description": "Referral",
+                        "name": "referral",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateReferralRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Referral sent successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ReferralEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to send referral",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referrals/inbox": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the referrals waiting on a specialty, or on a doctor together with the untaken referrals of their specialty. Most urgent first, then oldest first. Without a status only open referrals (Sent, Accepted) are returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Get referral inbox",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Specialty, e.g. Cardiology",
+                        "name": "specialty",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Receiving doctor ID",
+                        "name": "doctorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Referral status (Sent, Accepted, Declined, Completed)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Referral inbox",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ReferralInboxItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referrals/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single referral by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Get referral by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Referral ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Referral retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ReferralEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid referral ID",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Referral not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referrals/{id}/appointment": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record the appointment booked for an accepted referral. The appointment must be for the referred patient with the referred doctor or a doctor of the referred specialty.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Link referral appointment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Referral ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Appointment",
+                        "name": "appointment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.LinkReferralAppointmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Appointment linked successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ReferralEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to link appointment",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referrals/{id}/complete": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Close an accepted referral once the patient has been seen.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Complete a referral",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Referral ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Referral completed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ReferralEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to complete referral",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referrals/{id}/respond": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Accept or decline a sent referral. Declining requires notes. A specialty referral can be assigned to the accepting doctor.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Accept or decline a referral",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Referral ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Response",
+                        "name": "response",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.RespondReferralRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Referral updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ReferralEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to respond to referral",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reminders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the most recent appointment reminders and their delivery outcome.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reminders"
+                ],
+                "summary": "Get appointment reminders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Appointment ID",
+                        "name": "appointmentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "patientId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reminder status (Pending, Sent, Failed, Skipped)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of reminders",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ReminderEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/rooms": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new room inside a ward.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wards"
+                ],
+                "summary": "Create a new room",
+                "parameters": [
+                    {
+                        "description": "Room object to be created",
+                        "name": "room",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Room created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create room",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roster/assignments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the scheduled shifts dated from..to inclusive, optionally only those of a user or role. Both dates default to today.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Get the roster",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role, e.g. Nurse",
+                        "name": "role",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Roster",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ShiftAssignmentEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Roster a staff member on a shift template for a day, optionally as on-call. The shift must run on that day and must not overlap another of their shifts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Roster a shift",
+                "parameters": [
+                    {
+                        "description": "Shift assignment",
+                        "name": "assignment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateShiftAssignmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Shift assigned successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ShiftAssignmentEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to assign shift",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roster/assignments/{id}/cancel": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Take a staff member off a shift. Shifts with a pending swap request cannot be cancelled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Cancel a rostered shift",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shift assignment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shift cancelled successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ShiftAssignmentEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to cancel shift",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roster/coverage": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Compare the shifts rostered from..to against the staff per role each active shift template needs. On-call shifts do not count. Both dates default to today.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Get roster coverage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only return understaffed slots",
+                        "name": "gapsOnly",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Roster coverage",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.CoverageSlot"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid date range",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roster/on-call": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the staff on call at a time, or now.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Get on-call staff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Time (RFC 3339), defaults to now",
+                        "name": "at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "On-call staff",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.OnCallEntry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid time",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roster/swaps": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve shift swap requests, newest first, optionally filtered by status and by a user on either side.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Get shift swaps",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Swap status (Pending, Approved, Rejected, Cancelled)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of shift swaps",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ShiftSwapEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Ask to hand a shift over to a colleague of the same role, or to trade it for one of theirs. Staff can only offer their own shifts. The swap takes effect once approved.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Request a shift swap",
+                "parameters": [
+                    {
+                        "description": "Shift swap",
+                        "name": "swap",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateShiftSwapRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Shift swap requested successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ShiftSwapEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to request shift swap",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roster/swaps/{id}/decide": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Decide a pending shift swap. Approving moves the shift to the colleague, and theirs back for a trade, as long as nobody ends up with overlapping shifts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Approve or reject a shift swap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shift swap ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.DecideShiftSwapRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shift swap decided successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ShiftSwapEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to decide shift swap",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roster/templates": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve all shift templates, in order of start time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Get all shift templates",
+                "responses": {
+                    "200": {
+                        "description": "List of shift templates",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.ShiftTemplateEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve shift templates",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a reusable shift, with its hours, the days it runs on and the staff per role it needs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Create a shift template",
+                "parameters": [
+                    {
+                        "description": "Shift template to be created",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ShiftTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Shift template created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create shift template",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roster/templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single shift template by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Get shift template by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shift template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shift template retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.ShiftTemplateEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Shift template not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve shift template",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a shift template. Shifts already rostered keep their times.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roster"
+                ],
+                "summary": "Update a shift template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shift template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shift template with updated fields",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ShiftTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Shift template updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update shift template",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/satusehat/reconciliation": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Compare the completed appointments and diagnosed medical records in a period with what was submitted to SATUSEHAT: counts per sync status, including records that were never queued, and the list of records that are not synced.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SATUSEHAT"
+                ],
+                "summary": "Get SATUSEHAT reconciliation report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD), defaults to 30 days before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), defaults to today",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SATUSEHAT reconciliation report",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.SatuSehatReconciliationReport"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid date range",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/satusehat/references": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the SATUSEHAT IDs registered for patients (IHS numbers), doctors (practitioner IDs) and clinic rooms (location IDs).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SATUSEHAT"
+                ],
+                "summary": "Get SATUSEHAT references",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kind (patient, practitioner, location)",
+                        "name": "kind",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of SATUSEHAT references",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.SatuSehatReferenceEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid kind",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/satusehat/references/{kind}/{localId}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Register the SATUSEHAT ID of a patient, doctor or clinic room, replacing the one registered before.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SATUSEHAT"
+                ],
+                "summary": "Set a SATUSEHAT reference",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kind (patient, practitioner, location)",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Patient, doctor or clinic room ID",
+                        "name": "localId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SATUSEHAT ID",
+                        "name": "reference",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.SatuSehatReferenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SATUSEHAT reference set successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.SatuSehatReferenceEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove the SATUSEHAT ID registered for a patient, doctor or clinic room.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SATUSEHAT"
+                ],
+                "summary": "Delete a SATUSEHAT reference",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kind (patient, practitioner, location)",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Patient, doctor or clinic room ID",
+                        "name": "localId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "SATUSEHAT reference deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid kind or ID",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/satusehat/syncs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the most recently updated submissions of completed appointments (as Encounters) and medical record diagnoses (as Conditions) to SATUSEHAT. Filter by status DeadLetter to get the records that need attention.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SATUSEHAT"
+                ],
+                "summary": "Get SATUSEHAT syncs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Source type (appointment, medical_record)",
+                        "name": "sourceType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status (Pending, Synced, Failed, DeadLetter, Skipped)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Patient ID",
+                        "name": "patientId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of SATUSEHAT syncs",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.SatuSehatSyncEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/satusehat/syncs/backfill": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Queue the completed appointments and diagnosed medical records in a period that were never submitted, or whose submission failed or was dead-lettered. Use it for records that predate the integration and after fixing reference mappings.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SATUSEHAT"
+                ],
+                "summary": "Backfill SATUSEHAT syncs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD), defaults to 30 days before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), defaults to today",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Records queued",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.SatuSehatBackfillResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid date range or integration not configured",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/satusehat/syncs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single sync entry with the payload last sent and its attempt log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SATUSEHAT"
+                ],
+                "summary": "Get SATUSEHAT sync by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SATUSEHAT sync ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SATUSEHAT sync retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.SatuSehatSyncEntity"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "SATUSEHAT sync not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve SATUSEHAT sync",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/satusehat/syncs/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put a failed or dead-lettered record back in the submission queue with a fresh attempt budget, e.g. after registering a missing SATUSEHAT ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SATUSEHAT"
+                ],
+                "summary": "Retry a SATUSEHAT sync",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SATUSEHAT sync ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "SATUSEHAT sync queued for retry",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retry SATUSEHAT sync",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tariffs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the service tariff catalogue. Prices are in minor currency units.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get all tariffs",
+                "responses": {
+                    "200": {
+                        "description": "List of tariffs",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.TariffDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve tariffs",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a service to the tariff catalogue. A tariff linked to an appointment type is billed automatically when such an appointment is completed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Create a new tariff",
+                "parameters": [
+                    {
+                        "description": "Tariff object to be created",
+                        "name": "tariff",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.TariffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Tariff created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create tariff",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tariffs/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a tariff. Existing invoices keep the price they were issued with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Update an existing tariff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tariff ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tariff object with updated fields",
+                        "name": "tariff",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.TariffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Tariff updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update tariff",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a list of all registered users. Admin access required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "200": {
+                        "description": "Users retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.UserDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve users",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new user account. Admin access required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User object to be created",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create user",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a single user by their ID. Admin access required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.UserDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve user",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update details of an existing user. Admin access required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update an existing user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User object with updated fields",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "User updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update user",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deactivate a user account (soft delete). Admin access required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Deactivate a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "User deactivated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to deactivate user",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/password": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change the password for a specific user. Admin access required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Change user password",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New password details",
+                        "name": "password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Password changed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to change password",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wards": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of all inpatient wards.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wards"
+                ],
+                "summary": "Get all wards",
+                "responses": {
+                    "200": {
+                        "description": "List of wards",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.WardDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve wards",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new inpatient ward.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wards"
+                ],
+                "summary": "Create a new ward",
+                "parameters": [
+                    {
+                        "description": "Ward object to be created",
+                        "name": "ward",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.WardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Ward created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create ward",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wards/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single ward by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wards"
+                ],
+                "summary": "Get ward by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ward ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ward retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.WardDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Ward not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve ward",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update details of an existing ward.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wards"
+                ],
+                "summary": "Update an existing ward",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ward ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ward object with updated fields",
+                        "name": "ward",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.WardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Ward updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update ward",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wards/{id}/beds": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the beds belonging to a ward with their current status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wards"
+                ],
+                "summary": "Get beds of a ward",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ward ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of beds",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.BedDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve beds",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/wards/{id}/rooms": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the rooms belonging to a ward.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wards"
+                ],
+                "summary": "Get rooms of a ward",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ward ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of rooms",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.RoomDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve rooms",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the webhook subscriptions. Secrets are never returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get all webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "List of webhook subscriptions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.WebhookSubscriptionDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve webhook subscriptions",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Subscribe a URL to event types such as appointment.created, appointment.status_changed or patient.updated. Deliveries are signed with HMAC-SHA256 over \"\u003cX-HMS-Timestamp\u003e.\u003cbody\u003e\" and sent in the X-HMS-Signature header. A secret is generated when none is given; it is only returned in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create a new webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Webhook subscription object to be created",
+                        "name": "subscription",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.WebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Webhook subscription created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "id": {
+                                                    "type": "string"
+                                                },
+                                                "secret": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create webhook subscription",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve the most recent deliveries with their attempt log. Filter by status DeadLetter to get the dead-letter list.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "subscriptionId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Delivery status (Pending, Delivered, Failed, DeadLetter)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of webhook deliveries",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/domain.WebhookDeliveryEntity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put a failed or dead-lettered delivery back in the queue with a fresh attempt budget.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Retry a webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Webhook delivery queued for retry",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retry webhook delivery",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve a single webhook subscription.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook subscription by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook subscription retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/domain.WebhookSubscriptionDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to retrieve webhook subscription",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a webhook subscription. The secret is only rotated when a new one is given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update an existing webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook subscription object with updated fields",
+                        "name": "subscription",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.WebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Webhook subscription updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to update webhook subscription",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a webhook subscription. Queued deliveries for it are dead-lettered.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Webhook subscription deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete webhook subscription",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/test": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Queue a webhook.ping event for the subscription, regardless of its event types.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Send a test event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Test event queued",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "properties": {
+                                                "eventId": {
+                                                    "type": "string"
+                                                }
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to queue test event",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.AbnormalFlag": {
+            "type": "string",
+            "enum": [
+                "N",
+                "L",
+                "H",
+                "LL",
+                "HH",
+                "A"
+            ],
+            "x-enum-varnames": [
+                "AbnormalFlagNormal",
+                "AbnormalFlagLow",
+                "AbnormalFlagHigh",
+                "AbnormalFlagCriticalLow",
+                "AbnormalFlagCriticalHigh",
+                "AbnormalFlagAbnormal"
+            ]
+        },
+        "domain.Activity": {
+            "description": "Activity log entry",
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Appointment for patient John Doe with doctor Jane Smith on 2025-07-17."
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2025-07-17T10:30:00Z"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "New Appointment Scheduled"
+                },
+                "type": {
+                    "description": "e.g., \"APPOINTMENT\", \"MEDICAL_RECORD\"",
+                    "type": "string",
+                    "example": "APPOINTMENT"
+                }
+            }
+        },
+        "domain.ActivityEntity": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/domain.ActivityType"
+                }
+            }
+        },
+        "domain.ActivityType": {
+            "type": "string",
+            "enum": [
+                "APPOINTMENT",
+                "MEDICAL_RECORD",
+                "PATIENT",
+                "DOCTOR",
+                "LAB",
+                "ADMISSION",
+                "BILLING",
+                "INSURANCE",
+                "PHARMACY",
+                "QUEUE",
+                "REFERRAL",
+                "ROSTER",
+                "EXPORT",
+                "DOCUMENT",
+                "IMPORT"
+            ],
+            "x-enum-varnames": [
+                "ActivityTypeAppointment",
+                "ActivityTypeMedicalRecord",
+                "ActivityTypePatient",
+                "ActivityTypeDoctor",
+                "ActivityTypeLab",
+                "ActivityTypeAdmission",
+                "ActivityTypeBilling",
+                "ActivityTypeInsurance",
+                "ActivityTypePharmacy",
+                "ActivityTypeQueue",
+                "ActivityTypeReferral",
+                "ActivityTypeRoster",
+                "ActivityTypeExport",
+                "ActivityTypeDocument",
+                "ActivityTypeImport"
+            ]
+        },
+        "domain.AdjustStockRequest": {
+            "description": "Request body for a manual stock adjustment",
+            "type": "object",
+            "required": [
+                "batchId",
+                "delta",
+                "reason"
+            ],
+            "properties": {
+                "batchId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "delta": {
+                    "type": "integer",
+                    "example": -2
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Stock count correction"
+                }
+            }
+        },
+        "domain.AdmissionDTO": {
+            "description": "Admission data transfer object",
+            "type": "object",
+            "properties": {
+                "admittedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "bedId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000012"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "dischargeSummary": {
+                    "$ref": "#/definitions/domain.DischargeSummary"
+                },
+                "dischargedAt": {
+                    "type": "string"
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000013"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Dengue fever"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AdmissionStatus"
+                        }
+                    ],
+                    "example": "Admitted"
+                },
+                "transfers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.BedTransfer"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "wardId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000010"
+                }
+            }
+        },
+        "domain.AdmissionStatus": {
+            "type": "string",
+            "enum": [
+                "Admitted",
+                "Discharged"
+            ],
+            "x-enum-varnames": [
+                "AdmissionStatusAdmitted",
+                "AdmissionStatusDischarged"
+            ]
+        },
+        "domain.AdmitPatientRequest": {
+            "description": "Request body for admitting a patient",
+            "type": "object",
+            "required": [
+                "bedId",
+                "doctorId",
+                "patientId",
+                "reason"
+            ],
+            "properties": {
+                "bedId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000012"
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 3,
+                    "example": "Dengue fever"
+                }
+            }
+        },
+        "domain.AnalyticsInterval": {
+            "type": "string",
+            "enum": [
+                "day",
+                "week",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "AnalyticsIntervalDay",
+                "AnalyticsIntervalWeek",
+                "AnalyticsIntervalMonth"
+            ]
+        },
+        "domain.AppointmentBump": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "byAppointmentId": {
+                    "type": "string"
+                },
+                "notifiedAt": {
+                    "type": "string"
+                },
+                "previousDateTime": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.AppointmentCountPoint": {
+            "description": "Appointment count of one period, and of one group when broken down",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "group": {
+                    "description": "Group is the status, type, doctor ID or specialty counted.",
+                    "type": "string",
+                    "example": "Completed"
+                },
+                "label": {
+                    "description": "Label names the group when Group is an ID.",
+                    "type": "string",
+                    "example": "Dr. Jane Smith"
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2025-07-17"
+                }
+            }
+        },
+        "domain.AppointmentDTO": {
+            "type": "object",
+            "required": [
+                "doctorId",
+                "duration",
+                "location",
+                "patientId",
+                "status",
+                "type"
+            ],
+            "properties": {
+                "bump": {
+                    "$ref": "#/definitions/domain.AppointmentBump"
+                },
+                "conflictOverride": {
+                    "$ref": "#/definitions/domain.ConflictOverride"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "dateTime": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "departmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "eligibility": {
+                    "$ref": "#/definitions/domain.EligibilityCheck"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Room 101"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Patient complained of headache"
+                },
+                "patientHistory": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "No significant medical history"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "roomId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000032"
+                },
+                "status": {
+                    "enum": [
+                        "Scheduled",
+                        "Confirmed",
+                        "Completed",
+                        "Cancelled"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentStatus"
+                        }
+                    ],
+                    "example": "Scheduled"
+                },
+                "triage": {
+                    "$ref": "#/definitions/domain.TriageAssessment"
+                },
+                "type": {
+                    "enum": [
+                        "check-up",
+                        "follow-up",
+                        "consultation",
+                        "procedure",
+                        "emergency"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentType"
+                        }
+                    ],
+                    "example": "check-up"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                }
+            }
+        },
+        "domain.AppointmentDetailResponse": {
+            "description": "Detailed appointment information",
+            "type": "object",
+            "properties": {
+                "appointment": {
+                    "$ref": "#/definitions/domain.AppointmentDTO"
+                },
+                "lastRecord": {
+                    "$ref": "#/definitions/domain.MedicalRecordDTO"
+                },
+                "patient": {
+                    "$ref": "#/definitions/domain.PatientDTO"
+                }
+            }
+        },
+        "domain.AppointmentGroupBy": {
+            "type": "string",
+            "enum": [
+                "",
+                "status",
+                "type",
+                "doctor",
+                "specialty"
+            ],
+            "x-enum-varnames": [
+                "AppointmentGroupByNone",
+                "AppointmentGroupByStatus",
+                "AppointmentGroupByType",
+                "AppointmentGroupByDoctor",
+                "AppointmentGroupBySpecialty"
+            ]
+        },
+        "domain.AppointmentLinkAction": {
+            "type": "string",
+            "enum": [
+                "confirm",
+                "cancel"
+            ],
+            "x-enum-varnames": [
+                "AppointmentLinkConfirm",
+                "AppointmentLinkCancel"
+            ]
+        },
+        "domain.AppointmentLinkInfo": {
+            "description": "Appointment and action behind a confirmation or cancellation link",
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentLinkAction"
+                        }
+                    ],
+                    "example": "confirm"
+                },
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "dateTime": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Room 101"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentStatus"
+                        }
+                    ],
+                    "example": "Scheduled"
+                }
+            }
+        },
+        "domain.AppointmentLinkRequest": {
+            "description": "Request body for using a confirmation or cancellation link",
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "domain.AppointmentRatePoint": {
+            "description": "Appointment outcomes of one period. No-shows are past appointments never completed or cancelled.",
+            "type": "object",
+            "properties": {
+                "cancellationRate": {
+                    "description": "CancellationRate is cancelled over all appointments.",
+                    "type": "number",
+                    "example": 0.15
+                },
+                "cancelled": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "completed": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "noShow": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "noShowRate": {
+                    "description": "NoShowRate is no-shows over the past appointments that were not\ncancelled.",
+                    "type": "number",
+                    "example": 0.1176
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2025-07-17"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 20
+                }
+            }
+        },
+        "domain.AppointmentRatesResponse": {
+            "description": "No-show and cancellation rates per period and over the whole range",
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
+                "interval": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AnalyticsInterval"
+                        }
+                    ],
+                    "example": "day"
+                },
+                "overall": {
+                    "$ref": "#/definitions/domain.AppointmentRatePoint"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.AppointmentRatePoint"
+                    }
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Jakarta"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-07-31"
+                }
+            }
+        },
+        "domain.AppointmentStatus": {
+            "type": "string",
+            "enum": [
+                "Scheduled",
+                "Confirmed",
+                "Completed",
+                "Cancelled"
+            ],
+            "x-enum-varnames": [
+                "AppointmentStatusScheduled",
+                "AppointmentStatusConfirmed",
+                "AppointmentStatusCompleted",
+                "AppointmentStatusCancelled"
+            ]
+        },
+        "domain.AppointmentTrendResponse": {
+            "description": "Appointments per period, optionally broken down by status, type, doctor or specialty",
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
+                "groupBy": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentGroupBy"
+                        }
+                    ],
+                    "example": "status"
+                },
+                "interval": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AnalyticsInterval"
+                        }
+                    ],
+                    "example": "day"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.AppointmentCountPoint"
+                    }
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Jakarta"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-07-31"
+                }
+            }
+        },
+        "domain.AppointmentType": {
+            "type": "string",
+            "enum": [
+                "check-up",
+                "follow-up",
+                "consultation",
+                "procedure",
+                "emergency"
+            ],
+            "x-enum-varnames": [
+                "AppointmentTypeCheckUp",
+                "AppointmentTypeFollowUp",
+                "AppointmentTypeConsultation",
+                "AppointmentTypeProcedure",
+                "AppointmentTypeEmergency"
+            ]
+        },
+        "domain.BedBoardEntry": {
+            "description": "A bed on the occupancy board",
+            "type": "object",
+            "properties": {
+                "admittedAt": {
+                    "type": "string"
+                },
+                "bed": {
+                    "$ref": "#/definitions/domain.BedDTO"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "patientName": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "roomNumber": {
+                    "type": "string",
+                    "example": "201"
+                }
+            }
+        },
+        "domain.BedDTO": {
+            "description": "Bed data transfer object",
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "currentAdmissionId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000013"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000012"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "201-A"
+                },
+                "roomId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000011"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BedStatus"
+                        }
+                    ],
+                    "example": "Available"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "wardId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000010"
+                }
+            }
+        },
+        "domain.BedOccupancyStats": {
+            "description": "Bed occupancy statistics",
+            "type": "object",
+            "properties": {
+                "availableBeds": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "occupancyRate": {
+                    "description": "percentage of total beds",
+                    "type": "number",
+                    "example": 77.5
+                },
+                "occupiedBeds": {
+                    "type": "integer",
+                    "example": 31
+                },
+                "totalBeds": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "unavailableBeds": {
+                    "description": "cleaning or maintenance",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "domain.BedStatus": {
+            "type": "string",
+            "enum": [
+                "Available",
+                "Occupied",
+                "Cleaning",
+                "Maintenance"
+            ],
+            "x-enum-varnames": [
+                "BedStatusAvailable",
+                "BedStatusOccupied",
+                "BedStatusCleaning",
+                "BedStatusMaintenance"
+            ]
+        },
+        "domain.BedTransfer": {
+            "type": "object",
+            "properties": {
+                "fromBedId": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Moved to isolation"
+                },
+                "toBedId": {
+                    "type": "string"
+                },
+                "transferredAt": {
+                    "type": "string"
+                },
+                "transferredBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CallNextRequest": {
+            "description": "Request body for calling the next ticket",
+            "type": "object",
+            "properties": {
+                "counter": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Room 101"
+                }
+            }
+        },
+        "domain.ChangePasswordRequest": {
+            "description": "Request body for changing user password",
+            "type": "object",
+            "required": [
+                "confirmPassword",
+                "newPassword"
+            ],
+            "properties": {
+                "confirmPassword": {
+                    "type": "string",
+                    "example": "VeryStrongNewPassword123"
+                },
+                "newPassword": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "VeryStrongNewPassword123"
+                }
+            }
+        },
+        "domain.CheckInRequest": {
+            "description": "Request body for checking in a scheduled appointment",
+            "type": "object",
+            "required": [
+                "appointmentId"
+            ],
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                }
+            }
+        },
+        "domain.ClaimDTO": {
+            "description": "Insurance claim data transfer object",
+            "type": "object",
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "approvedAmount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "claimedAmount": {
+                    "type": "integer",
+                    "example": 15000000
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ClaimStatusChange"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "invoiceId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000005"
+                },
+                "invoiceNumber": {
+                    "type": "string",
+                    "example": "INV-20250717-1F000001"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.InvoiceLineItem"
+                    }
+                },
+                "memberNumber": {
+                    "type": "string",
+                    "example": "0001234567890"
+                },
+                "number": {
+                    "type": "string",
+                    "example": "CLM-20250717-1F000001"
+                },
+                "paidAmount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "payerName": {
+                    "type": "string",
+                    "example": "BPJS Kesehatan"
+                },
+                "payerReference": {
+                    "type": "string",
+                    "example": "FAKE-CLM-20250717-1F000001"
+                },
+                "payerType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PayerType"
+                        }
+                    ],
+                    "example": "BPJS"
+                },
+                "policyId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000006"
+                },
+                "rejectionReason": {
+                    "type": "string"
+                },
+                "serviceDate": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ClaimStatus"
+                        }
+                    ],
+                    "example": "Draft"
+                },
+                "submittedAt": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                }
+            }
+        },
+        "domain.ClaimStatus": {
+            "type": "string",
+            "enum": [
+                "Draft",
+                "Submitted",
+                "Approved",
+                "Rejected",
+                "Paid"
+            ],
+            "x-enum-varnames": [
+                "ClaimStatusDraft",
+                "ClaimStatusSubmitted",
+                "ClaimStatusApproved",
+                "ClaimStatusRejected",
+                "ClaimStatusPaid"
+            ]
+        },
+        "domain.ClaimStatusChange": {
+            "type": "object",
+            "properties": {
+                "changedAt": {
+                    "type": "string"
+                },
+                "changedBy": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ClaimStatus"
+                        }
+                    ],
+                    "example": "Submitted"
+                }
+            }
+        },
+        "domain.ClinicEntity": {
+            "description": "Outpatient clinic (polyclinic) of a department",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "PJ"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "departmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000031"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Building A, 2nd floor"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Poli Jantung"
+                },
+                "operatingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OperatingHours"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ClinicRequest": {
+            "description": "Request body for creating or updating a clinic",
+            "type": "object",
+            "required": [
+                "code",
+                "departmentId",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 2,
+                    "example": "PJ"
+                },
+                "departmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Building A, 2nd floor"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Poli Jantung"
+                },
+                "operatingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OperatingHours"
+                    }
+                }
+            }
+        },
+        "domain.ClinicRoomEntity": {
+            "description": "Consultation or procedure room of a clinic that appointments are booked into",
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "description": "Capacity is how many appointments the room takes at the same time.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "clinicId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000031"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "departmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000032"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Room 101"
+                },
+                "operatingHours": {
+                    "description": "OperatingHours override the clinic's hours when set.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OperatingHours"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ClinicRoomRequest": {
+            "description": "Request body for creating or updating a clinic room",
+            "type": "object",
+            "required": [
+                "capacity",
+                "clinicId",
+                "name"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "clinicId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000031"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "example": "Room 101"
+                },
+                "operatingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OperatingHours"
+                    }
+                }
+            }
+        },
+        "domain.ConflictOverride": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "by": {
+                    "type": "string"
+                },
+                "displaced": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Suspected myocardial infarction"
+                }
+            }
+        },
+        "domain.CoverageSlot": {
+            "description": "Staffing of one shift for one role on a day, against what the shift needs",
+            "type": "object",
+            "properties": {
+                "assigned": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-07-17"
+                },
+                "required": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Role"
+                        }
+                    ],
+                    "example": "Nurse"
+                },
+                "shortfall": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000040"
+                },
+                "templateName": {
+                    "type": "string",
+                    "example": "Morning"
+                },
+                "userIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "domain.CreateAppointmentRequest": {
+            "description": "Request body for creating a new appointment",
+            "type": "object",
+            "required": [
+                "doctorId",
+                "duration",
+                "patientId",
+                "type"
+            ],
+            "properties": {
+                "dateTime": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Room 101"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Patient complained of headache"
+                },
+                "overrideReason": {
+                    "description": "OverrideReason lets an emergency booking take a slot the doctor is\nalready booked for; the appointments in the way are moved back.",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Suspected myocardial infarction"
+                },
+                "patientHistory": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "No significant medical history"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "roomId": {
+                    "description": "RoomID books the appointment into a clinic room; the location is then\ntaken from the room.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000032"
+                },
+                "type": {
+                    "enum": [
+                        "check-up",
+                        "follow-up",
+                        "consultation",
+                        "procedure",
+                        "emergency"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentType"
+                        }
+                    ],
+                    "example": "check-up"
+                }
+            }
+        },
+        "domain.CreateBedRequest": {
+            "description": "Request body for creating a bed",
+            "type": "object",
+            "required": [
+                "label",
+                "roomId"
+            ],
+            "properties": {
+                "label": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1,
+                    "example": "201-A"
+                },
+                "roomId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000011"
+                }
+            }
+        },
+        "domain.CreateClaimRequest": {
+            "description": "Request body for generating a claim from an invoice",
+            "type": "object",
+            "required": [
+                "invoiceId"
+            ],
+            "properties": {
+                "invoiceId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000005"
+                },
+                "policyId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000006"
+                }
+            }
+        },
+        "domain.CreateDoctorRequet": {
+            "description": "Request body for creating a new doctor",
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "phone",
+                "specialty"
+            ],
+            "properties": {
+                "departmentId": {
+                    "description": "DepartmentID assigns the doctor to a department.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane.smith@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Dr. Jane Smith"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+1987654321"
+                },
+                "specialty": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Pediatrics"
+                },
+                "userId": {
+                    "description": "UserID links the doctor to their login account.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                }
+            }
+        },
+        "domain.CreateInvoiceRequest": {
+            "description": "Request body for creating an invoice manually",
+            "type": "object",
+            "required": [
+                "items",
+                "patientId"
+            ],
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/domain.InvoiceItemRequest"
+                    }
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                }
+            }
+        },
+        "domain.CreateLabOrderRequest": {
+            "description": "Request body for placing a new lab order",
+            "type": "object",
+            "required": [
+                "appointmentId",
+                "patientId",
+                "priority",
+                "tests"
+            ],
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "clinicalNotes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Suspected anemia"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "priority": {
+                    "enum": [
+                        "routine",
+                        "urgent",
+                        "stat"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.LabOrderPriority"
+                        }
+                    ],
+                    "example": "routine"
+                },
+                "tests": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/domain.LabTest"
+                    }
+                }
+            }
+        },
+        "domain.CreateMedicalRecordRequest": {
+            "description": "Request body for creating a new medical record",
+            "type": "object",
+            "required": [
+                "description",
+                "diagnosis",
                 "doctorId",
-                "duration",
+                "patientId",
+                "recordType",
+                "treatment"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 10,
+                    "example": "Patient presented with flu-like symptoms."
+                },
+                "diagnosis": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 5,
+                    "example": "Influenza A"
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Advised patient to stay hydrated."
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "recordType": {
+                    "type": "string",
+                    "enum": [
+                        "checkup",
+                        "followup",
+                        "procedure",
+                        "emergency"
+                    ],
+                    "example": "checkup"
+                },
+                "treatment": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 5,
+                    "example": "Prescribed Tamiflu and rest."
+                }
+            }
+        },
+        "domain.CreatePatientRequest": {
+            "description": "Request body for creating a new patient",
+            "type": "object",
+            "required": [
+                "address",
+                "age",
+                "email",
+                "gender",
+                "name",
+                "phone"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 10,
+                    "example": "123 Main St, Anytown, USA"
+                },
+                "age": {
+                    "type": "integer",
+                    "maximum": 120
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john.doe@example.com"
+                },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "Male",
+                        "Female",
+                        "Other"
+                    ],
+                    "example": "Male"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "John Doe"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+1234567890"
+                }
+            }
+        },
+        "domain.CreatePaymentRequest": {
+            "description": "Request body for recording a payment against an invoice",
+            "type": "object",
+            "required": [
+                "amount",
+                "invoiceId",
+                "method"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 10000000
+                },
+                "invoiceId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000005"
+                },
+                "method": {
+                    "enum": [
+                        "Cash",
+                        "Card",
+                        "Transfer",
+                        "Insurance"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PaymentMethod"
+                        }
+                    ],
+                    "example": "Cash"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "reference": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "EDC-123456"
+                }
+            }
+        },
+        "domain.CreateReferralRequest": {
+            "description": "Request body for referring a patient. Give either a doctor or a specialty.",
+            "type": "object",
+            "required": [
+                "fromDoctorId",
+                "patientId",
+                "reason",
+                "urgency"
+            ],
+            "properties": {
+                "fromDoctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Exertional chest pain, abnormal ECG"
+                },
+                "recordIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "toDoctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000005"
+                },
+                "toSpecialty": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Cardiology"
+                },
+                "urgency": {
+                    "enum": [
+                        "routine",
+                        "urgent",
+                        "emergency"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReferralUrgency"
+                        }
+                    ],
+                    "example": "urgent"
+                }
+            }
+        },
+        "domain.CreateRoomRequest": {
+            "description": "Request body for creating a room",
+            "type": "object",
+            "required": [
+                "number",
+                "wardId"
+            ],
+            "properties": {
+                "class": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "VIP"
+                },
+                "number": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1,
+                    "example": "201"
+                },
+                "wardId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000010"
+                }
+            }
+        },
+        "domain.CreateShiftAssignmentRequest": {
+            "description": "Request body for rostering a staff member on a shift",
+            "type": "object",
+            "required": [
+                "date",
+                "templateId",
+                "userId"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-07-17"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Covers ICU handover"
+                },
+                "onCall": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000040"
+                },
+                "userId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                }
+            }
+        },
+        "domain.CreateShiftSwapRequest": {
+            "description": "Request body for asking to hand over or trade a shift",
+            "type": "object",
+            "required": [
+                "assignmentId",
+                "toUserId"
+            ],
+            "properties": {
+                "assignmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000041"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Family event"
+                },
+                "targetAssignmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000043"
+                },
+                "toUserId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                }
+            }
+        },
+        "domain.CreateUserRequest": {
+            "description": "Request body for creating a new user",
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "jane.doe@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Jane Doe"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "StrongPassword123"
+                },
+                "role": {
+                    "enum": [
+                        "Admin",
+                        "Doctor",
+                        "Nurse",
+                        "Receptionist",
+                        "Management",
+                        "Lab"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Role"
+                        }
+                    ],
+                    "example": "Receptionist"
+                }
+            }
+        },
+        "domain.DashboardResponse": {
+            "description": "Dashboard response containing statistics, recent activities, and upcoming appointments",
+            "type": "object",
+            "properties": {
+                "bedOccupancy": {
+                    "$ref": "#/definitions/domain.BedOccupancyStats"
+                },
+                "doctorUtilization": {
+                    "description": "DoctorUtilization ranks the doctors by utilization over the last 30 days.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DoctorUtilization"
+                    }
+                },
+                "recentActivities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Activity"
+                    }
+                },
+                "stats": {
+                    "$ref": "#/definitions/domain.DashboardStats"
+                },
+                "upcomingAppointments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.UpcomingAppointment"
+                    }
+                }
+            }
+        },
+        "domain.DashboardStats": {
+            "description": "Dashboard statistics",
+            "type": "object",
+            "properties": {
+                "appointmentsCount": {
+                    "type": "integer"
+                },
+                "doctorsCount": {
+                    "type": "integer"
+                },
+                "medicalRecordsCount": {
+                    "type": "integer"
+                },
+                "patientsCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.DecideShiftSwapRequest": {
+            "description": "Request body for approving or rejecting a shift swap",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Approved, coverage unchanged"
+                },
+                "status": {
+                    "enum": [
+                        "Approved",
+                        "Rejected"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ShiftSwapStatus"
+                        }
+                    ],
+                    "example": "Approved"
+                }
+            }
+        },
+        "domain.DepartmentEntity": {
+            "description": "Hospital department, e.g. Internal Medicine",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "CARD"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Heart and blood vessel care"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Cardiology"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.DepartmentRequest": {
+            "description": "Request body for creating or updating a department",
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 2,
+                    "example": "CARD"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Heart and blood vessel care"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Cardiology"
+                }
+            }
+        },
+        "domain.DiagnosisCount": {
+            "description": "How often a diagnosis was recorded",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 31
+                },
+                "diagnosis": {
+                    "type": "string",
+                    "example": "Influenza A"
+                },
+                "patients": {
+                    "type": "integer",
+                    "example": 29
+                }
+            }
+        },
+        "domain.DischargePatientRequest": {
+            "description": "Request body for discharging a patient",
+            "type": "object",
+            "required": [
+                "disposition"
+            ],
+            "properties": {
+                "disposition": {
+                    "type": "string",
+                    "enum": [
+                        "Home",
+                        "Referred",
+                        "AMA",
+                        "Deceased"
+                    ],
+                    "example": "Home"
+                },
+                "instructions": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Oral rehydration, control in 7 days"
+                }
+            }
+        },
+        "domain.DischargeSummary": {
+            "description": "Discharge summary built from the medical records written during the stay",
+            "type": "object",
+            "properties": {
+                "admissionReason": {
+                    "type": "string",
+                    "example": "Dengue fever"
+                },
+                "admittedAt": {
+                    "type": "string"
+                },
+                "diagnoses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dischargedAt": {
+                    "type": "string"
+                },
+                "disposition": {
+                    "type": "string",
+                    "example": "Home"
+                },
+                "instructions": {
+                    "type": "string",
+                    "example": "Oral rehydration, control in 7 days"
+                },
+                "lengthOfStay": {
+                    "description": "in days",
+                    "type": "integer",
+                    "example": 3
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.MedicalRecordDTO"
+                    }
+                },
+                "treatments": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "domain.DispenseItemRequest": {
+            "description": "A single item to dispense",
+            "type": "object",
+            "required": [
+                "itemId",
+                "quantity"
+            ],
+            "properties": {
+                "itemId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "domain.DispenseRequest": {
+            "description": "Request body for dispensing items to a patient",
+            "type": "object",
+            "required": [
+                "items",
+                "location",
+                "patientId"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/domain.DispenseItemRequest"
+                    }
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "MAIN"
+                },
+                "medicalRecordId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "reference": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "RX-0001"
+                }
+            }
+        },
+        "domain.DoctorDTO": {
+            "description": "Doctor data transfer object",
+            "type": "object",
+            "properties": {
+                "availability": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.TimeSlot"
+                    }
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "departmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john.doe@example.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Dr. John Doe"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "1234567890"
+                },
+                "specialty": {
+                    "type": "string",
+                    "example": "Cardiology"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "userId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                }
+            }
+        },
+        "domain.DoctorDetailResponse": {
+            "description": "Detailed doctor information including recent patients",
+            "type": "object",
+            "properties": {
+                "doctor": {
+                    "$ref": "#/definitions/domain.DoctorDTO"
+                },
+                "recentPatients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PatientDTO"
+                    }
+                }
+            }
+        },
+        "domain.DoctorUtilization": {
+            "description": "Utilization and productivity of a doctor over a date range",
+            "type": "object",
+            "properties": {
+                "appointments": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "availableMinutes": {
+                    "description": "AvailableMinutes is the time the doctor's weekly availability offers\nover the range.",
+                    "type": "integer",
+                    "example": 2400
+                },
+                "avgLeadTimeHours": {
+                    "description": "AvgLeadTimeHours is the average time from booking to visit of the\nappointments that were not cancelled.",
+                    "type": "number",
+                    "example": 52.5
+                },
+                "bookedMinutes": {
+                    "description": "BookedMinutes is the duration of the appointments that were not\ncancelled.",
+                    "type": "integer",
+                    "example": 1800
+                },
+                "cancelled": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "completed": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "doctorName": {
+                    "type": "string",
+                    "example": "Dr. Jane Smith"
+                },
+                "noShow": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "rank": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "recordsAuthored": {
+                    "type": "integer",
+                    "example": 48
+                },
+                "specialty": {
+                    "type": "string",
+                    "example": "Cardiology"
+                },
+                "utilization": {
+                    "description": "Utilization is booked over available minutes, 0 without availability.",
+                    "type": "number",
+                    "example": 0.75
+                }
+            }
+        },
+        "domain.DoctorUtilizationReport": {
+            "description": "Doctors ranked by utilization over a date range",
+            "type": "object",
+            "properties": {
+                "doctors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DoctorUtilization"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
+                "interval": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AnalyticsInterval"
+                        }
+                    ],
+                    "example": "day"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Jakarta"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-07-31"
+                }
+            }
+        },
+        "domain.DocumentKind": {
+            "type": "string",
+            "enum": [
+                "visit-summary",
+                "patient-history",
+                "medical-record",
+                "invoice"
+            ],
+            "x-enum-varnames": [
+                "DocumentVisitSummary",
+                "DocumentPatientHistory",
+                "DocumentMedicalRecord",
+                "DocumentInvoice"
+            ]
+        },
+        "domain.DocumentVerification": {
+            "description": "What the QR code on a printed document vouches for",
+            "type": "object",
+            "properties": {
+                "current": {
+                    "description": "Current is false when the record has changed since the document was\nprinted, so the printout may be out of date.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "documentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000090"
+                },
+                "issuedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "issuer": {
+                    "type": "string",
+                    "example": "HMS Hospital"
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.DocumentKind"
+                        }
+                    ],
+                    "example": "visit-summary"
+                },
+                "patient": {
+                    "type": "string",
+                    "example": "J*** D**"
+                },
+                "reference": {
+                    "description": "Reference is the appointment, patient or record ID, or the invoice\nnumber, the document was printed from.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Visit Summary"
+                }
+            }
+        },
+        "domain.EligibilityCheck": {
+            "description": "Result of an insurance eligibility check for an appointment",
+            "type": "object",
+            "properties": {
+                "checkedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "eligible": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "payerRefCode": {
+                    "type": "string",
+                    "example": "ELG-0001234567890"
+                },
+                "payerType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PayerType"
+                        }
+                    ],
+                    "example": "BPJS"
+                },
+                "policyId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Policy expired"
+                }
+            }
+        },
+        "domain.EnterLabResultsRequest": {
+            "description": "Request body for entering lab results",
+            "type": "object",
+            "required": [
+                "results"
+            ],
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/domain.LabResultRequest"
+                    }
+                }
+            }
+        },
+        "domain.Event": {
+            "description": "Domain event delivered over the event stream and to webhooks",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "entityId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "66a1f0c2e4b0a1b2c3d4e5f6"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "topic": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ActivityType"
+                        }
+                    ],
+                    "example": "APPOINTMENT"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.EventType"
+                        }
+                    ],
+                    "example": "appointment.created"
+                }
+            }
+        },
+        "domain.EventType": {
+            "type": "string",
+            "enum": [
+                "activity.created",
+                "appointment.created",
+                "appointment.updated",
+                "appointment.status_changed",
+                "appointment.cancelled",
+                "appointment.rescheduled",
+                "appointment.triaged",
+                "patient.created",
+                "patient.updated",
+                "patient.deleted",
+                "medical_record.created",
+                "medical_record.updated",
+                "medical_record.deleted",
+                "queue.ticket_issued",
+                "queue.ticket_called",
+                "queue.ticket_updated",
+                "queue.ticket_triaged",
+                "referral.created",
+                "referral.updated",
+                "referral.status_changed",
+                "roster.shift_assigned",
+                "roster.shift_cancelled",
+                "roster.swap_requested",
+                "roster.swap_decided",
+                "webhook.ping"
+            ],
+            "x-enum-varnames": [
+                "EventActivityCreated",
+                "EventAppointmentCreated",
+                "EventAppointmentUpdated",
+                "EventAppointmentStatusChanged",
+                "EventAppointmentCancelled",
+                "EventAppointmentRescheduled",
+                "EventAppointmentTriaged",
+                "EventPatientCreated",
+                "EventPatientUpdated",
+                "EventPatientDeleted",
+                "EventMedicalRecordCreated",
+                "EventMedicalRecordUpdated",
+                "EventMedicalRecordDeleted",
+                "EventQueueTicketIssued",
+                "EventQueueTicketCalled",
+                "EventQueueTicketUpdated",
+                "EventQueueTicketTriaged",
+                "EventReferralCreated",
+                "EventReferralUpdated",
+                "EventReferralStatusChanged",
+                "EventShiftAssigned",
+                "EventShiftCancelled",
+                "EventShiftSwapRequested",
+                "EventShiftSwapDecided",
+                "EventWebhookPing"
+            ]
+        },
+        "domain.GraphQLRequest": {
+            "description": "GraphQL operation, as sent by GraphQL clients",
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string",
+                    "example": "{ patient(id: \"60d0fe4f53115a001f000001\") { name appointments(limit: 5) { dateTime doctor { name } } } }"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "domain.GraphQLResponse": {
+            "description": "GraphQL result; fields the caller may not see are null with an error",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": {}
+                    }
+                }
+            }
+        },
+        "domain.HL7Attempt": {
+            "type": "object",
+            "properties": {
+                "ackCode": {
+                    "type": "string",
+                    "example": "AE"
+                },
+                "attemptedAt": {
+                    "type": "string"
+                },
+                "durationMs": {
+                    "type": "integer",
+                    "example": 35
+                },
+                "error": {
+                    "type": "string",
+                    "example": "connection refused"
+                }
+            }
+        },
+        "domain.HL7Direction": {
+            "type": "string",
+            "enum": [
+                "inbound",
+                "outbound"
+            ],
+            "x-enum-varnames": [
+                "HL7Inbound",
+                "HL7Outbound"
+            ]
+        },
+        "domain.HL7MessageEntity": {
+            "description": "HL7 v2 message received or sent over MLLP, with its acknowledgment",
+            "type": "object",
+            "properties": {
+                "ack": {
+                    "type": "string"
+                },
+                "ackCode": {
+                    "type": "string",
+                    "example": "AA"
+                },
+                "attemptCount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.HL7Attempt"
+                    }
+                },
+                "controlId": {
+                    "type": "string",
+                    "example": "MSG00001"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "direction": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.HL7Direction"
+                        }
+                    ],
+                    "example": "inbound"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "patient not found"
+                },
+                "eventId": {
+                    "type": "string",
+                    "example": "66a1f0c2e4b0a1b2c3d4e5f6"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
+                "messageType": {
+                    "type": "string",
+                    "example": "ADT^A08"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "peer": {
+                    "type": "string",
+                    "example": "10.0.0.12:53211"
+                },
+                "processedAt": {
+                    "type": "string"
+                },
+                "raw": {
+                    "type": "string"
+                },
+                "sendingApplication": {
+                    "type": "string",
+                    "example": "LIS"
+                },
+                "sendingFacility": {
+                    "type": "string",
+                    "example": "LAB"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.HL7MessageStatus"
+                        }
+                    ],
+                    "example": "Processed"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.HL7MessageStatus": {
+            "type": "string",
+            "enum": [
+                "Processed",
+                "Error",
+                "Rejected",
+                "Pending",
+                "Sent",
+                "Failed",
+                "DeadLetter"
+            ],
+            "x-enum-varnames": [
+                "HL7MessageProcessed",
+                "HL7MessageError",
+                "HL7MessageRejected",
+                "HL7MessagePending",
+                "HL7MessageSent",
+                "HL7MessageFailed",
+                "HL7MessageDeadLetter"
+            ]
+        },
+        "domain.ImportJobDTO": {
+            "description": "Bulk import job with its progress and the rows that were not imported",
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 1150
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "createdBy": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "dryRun": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "duplicates": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "error": {
+                    "type": "string",
+                    "example": "failed to save import progress: context deadline exceeded"
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "fileName": {
+                    "type": "string",
+                    "example": "patients.csv"
+                },
+                "finishedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:02:30Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000050"
+                },
+                "invalid": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ImportKind"
+                        }
+                    ],
+                    "example": "patients"
+                },
+                "problems": {
+                    "description": "Problems lists the rows that were not, or would not be, imported.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ImportRowResult"
+                    }
+                },
+                "processedRows": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "progress": {
+                    "description": "Progress is the percentage of rows processed.",
+                    "type": "integer",
+                    "example": 48
+                },
+                "startedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:01Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ImportJobStatus"
+                        }
+                    ],
+                    "example": "Running"
+                },
+                "totalRows": {
+                    "type": "integer",
+                    "example": 2500
+                },
+                "valid": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "domain.ImportJobStatus": {
+            "type": "string",
+            "enum": [
+                "Pending",
+                "Running",
+                "Completed",
+                "Failed"
+            ],
+            "x-enum-varnames": [
+                "ImportJobPending",
+                "ImportJobRunning",
+                "ImportJobCompleted",
+                "ImportJobFailed"
+            ]
+        },
+        "domain.ImportKind": {
+            "type": "string",
+            "enum": [
+                "patients",
+                "doctors"
+            ],
+            "x-enum-varnames": [
+                "ImportPatients",
+                "ImportDoctors"
+            ]
+        },
+        "domain.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "Phone"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "The 'Phone' field must be a valid E.164 formatted phone number."
+                }
+            }
+        },
+        "domain.ImportRowResult": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ImportRowError"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ImportRowStatus"
+                        }
+                    ],
+                    "example": "invalid"
+                }
+            }
+        },
+        "domain.ImportRowStatus": {
+            "type": "string",
+            "enum": [
+                "created",
+                "valid",
+                "invalid",
+                "duplicate",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "ImportRowCreated",
+                "ImportRowValid",
+                "ImportRowInvalid",
+                "ImportRowDuplicate",
+                "ImportRowFailed"
+            ]
+        },
+        "domain.InsurancePolicyDTO": {
+            "description": "Insurance policy data transfer object",
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "memberNumber": {
+                    "type": "string",
+                    "example": "0001234567890"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "payerName": {
+                    "type": "string",
+                    "example": "BPJS Kesehatan"
+                },
+                "payerType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PayerType"
+                        }
+                    ],
+                    "example": "BPJS"
+                },
+                "planClass": {
+                    "type": "string",
+                    "example": "Kelas 1"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "validFrom": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "validUntil": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                }
+            }
+        },
+        "domain.InsurancePolicyRequest": {
+            "description": "Request body for creating or updating an insurance policy",
+            "type": "object",
+            "required": [
+                "memberNumber",
+                "patientId",
+                "payerName",
+                "payerType",
+                "validFrom"
+            ],
+            "properties": {
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "memberNumber": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "0001234567890"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "payerName": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "BPJS Kesehatan"
+                },
+                "payerType": {
+                    "enum": [
+                        "BPJS",
+                        "Private"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PayerType"
+                        }
+                    ],
+                    "example": "BPJS"
+                },
+                "planClass": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Kelas 1"
+                },
+                "validFrom": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "validUntil": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                }
+            }
+        },
+        "domain.InvoiceDTO": {
+            "description": "Invoice data transfer object",
+            "type": "object",
+            "properties": {
+                "amountPaid": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "amountRefunded": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "balance": {
+                    "type": "integer",
+                    "example": 15000000
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "discountTotal": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "issuedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.InvoiceLineItem"
+                    }
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string",
+                    "example": "INV-20250717-1F000001"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.InvoiceStatus"
+                        }
+                    ],
+                    "example": "Unpaid"
+                },
+                "subtotal": {
+                    "type": "integer",
+                    "example": 15000000
+                },
+                "taxTotal": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 15000000
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "voidReason": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.InvoiceItemRequest": {
+            "description": "A line on a new invoice, either from the tariff catalogue or a free-text charge",
+            "type": "object",
+            "required": [
+                "quantity"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Wound dressing"
+                },
+                "discount": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "tariffId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "taxRate": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 0
+                },
+                "unitPrice": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5000000
+                }
+            }
+        },
+        "domain.InvoiceLineItem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "CONS-GP"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "General practitioner consultation"
+                },
+                "discount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "subtotal": {
+                    "type": "integer",
+                    "example": 15000000
+                },
+                "tariffId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "tax": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "taxRate": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 15000000
+                },
+                "unitPrice": {
+                    "type": "integer",
+                    "example": 15000000
+                }
+            }
+        },
+        "domain.InvoiceStatus": {
+            "type": "string",
+            "enum": [
+                "Unpaid",
+                "PartiallyPaid",
+                "Paid",
+                "Refunded",
+                "Void"
+            ],
+            "x-enum-varnames": [
+                "InvoiceStatusUnpaid",
+                "InvoiceStatusPartiallyPaid",
+                "InvoiceStatusPaid",
+                "InvoiceStatusRefunded",
+                "InvoiceStatusVoid"
+            ]
+        },
+        "domain.LabOrderDTO": {
+            "description": "Lab order data transfer object",
+            "type": "object",
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "clinicalNotes": {
+                    "type": "string",
+                    "example": "Suspected anemia"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "hasAbnormal": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "priority": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.LabOrderPriority"
+                        }
+                    ],
+                    "example": "routine"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.LabResult"
+                    }
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.LabOrderStatus"
+                        }
+                    ],
+                    "example": "Ordered"
+                },
+                "tests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.LabTest"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                }
+            }
+        },
+        "domain.LabOrderPriority": {
+            "type": "string",
+            "enum": [
+                "routine",
+                "urgent",
+                "stat"
+            ],
+            "x-enum-varnames": [
+                "LabOrderPriorityRoutine",
+                "LabOrderPriorityUrgent",
+                "LabOrderPriorityStat"
+            ]
+        },
+        "domain.LabOrderStatus": {
+            "type": "string",
+            "enum": [
+                "Ordered",
+                "InProgress",
+                "Completed",
+                "Cancelled"
+            ],
+            "x-enum-varnames": [
+                "LabOrderStatusOrdered",
+                "LabOrderStatusInProgress",
+                "LabOrderStatusCompleted",
+                "LabOrderStatusCancelled"
+            ]
+        },
+        "domain.LabResult": {
+            "type": "object",
+            "properties": {
+                "flag": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AbnormalFlag"
+                        }
+                    ],
+                    "example": "L"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "preliminary": {
+                    "description": "Preliminary results, sent by analyzers before they are verified, may\nstill be replaced by a final result.",
+                    "type": "boolean"
+                },
+                "referenceRange": {
+                    "$ref": "#/definitions/domain.ReferenceRange"
+                },
+                "resultedAt": {
+                    "type": "string"
+                },
+                "resultedBy": {
+                    "type": "string"
+                },
+                "testCode": {
+                    "type": "string",
+                    "example": "HB"
+                },
+                "testName": {
+                    "type": "string",
+                    "example": "Hemoglobin"
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "g/dL"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "10.5"
+                }
+            }
+        },
+        "domain.LabResultRequest": {
+            "description": "A single result entered by the lab",
+            "type": "object",
+            "required": [
+                "testCode",
+                "value"
+            ],
+            "properties": {
+                "flag": {
+                    "enum": [
+                        "N",
+                        "L",
+                        "H",
+                        "LL",
+                        "HH",
+                        "A"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AbnormalFlag"
+                        }
+                    ],
+                    "example": "L"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "referenceRange": {
+                    "$ref": "#/definitions/domain.ReferenceRange"
+                },
+                "testCode": {
+                    "type": "string",
+                    "example": "HB"
+                },
+                "unit": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "g/dL"
+                },
+                "value": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "10.5"
+                }
+            }
+        },
+        "domain.LabTest": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "HB"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Hemoglobin"
+                }
+            }
+        },
+        "domain.LabWorklistItem": {
+            "description": "Lab worklist entry with the patient name resolved",
+            "type": "object",
+            "properties": {
+                "order": {
+                    "$ref": "#/definitions/domain.LabOrderDTO"
+                },
+                "patientName": {
+                    "type": "string",
+                    "example": "John Doe"
+                }
+            }
+        },
+        "domain.LinkReferralAppointmentRequest": {
+            "description": "Request body for linking the appointment booked for a referral",
+            "type": "object",
+            "required": [
+                "appointmentId"
+            ],
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                }
+            }
+        },
+        "domain.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/domain.UserDTO"
+                }
+            }
+        },
+        "domain.MedicalRecordDTO": {
+            "description": "Medical record data transfer object",
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Patient presented with flu-like symptoms."
+                },
+                "diagnosis": {
+                    "type": "string",
+                    "example": "Influenza A"
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Advised patient to stay hydrated."
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "recordType": {
+                    "type": "string",
+                    "example": "checkup"
+                },
+                "treatment": {
+                    "type": "string",
+                    "example": "Prescribed Tamiflu and rest."
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                }
+            }
+        },
+        "domain.OnCallEntry": {
+            "description": "Staff member on call at a given time",
+            "type": "object",
+            "properties": {
+                "assignment": {
+                    "$ref": "#/definitions/domain.ShiftAssignmentEntity"
+                },
+                "userName": {
+                    "type": "string",
+                    "example": "Dr. Jane Smith"
+                }
+            }
+        },
+        "domain.OperatingHours": {
+            "type": "object",
+            "required": [
+                "close",
+                "open"
+            ],
+            "properties": {
+                "close": {
+                    "type": "string",
+                    "example": "16:00"
+                },
+                "dayOfWeek": {
+                    "description": "0-6 (Sunday-Saturday)",
+                    "type": "integer",
+                    "maximum": 6,
+                    "minimum": 0,
+                    "example": 1
+                },
+                "open": {
+                    "type": "string",
+                    "example": "08:00"
+                }
+            }
+        },
+        "domain.OutboxMessageEntity": {
+            "type": "object",
+            "properties": {
+                "activity": {
+                    "$ref": "#/definitions/domain.ActivityEntity"
+                },
+                "attempts": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "dispatchedAt": {
+                    "type": "string"
+                },
+                "entityId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "lastError": {
+                    "type": "string",
+                    "example": "failed to create activity: connection reset"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.OutboxStatus"
+                        }
+                    ],
+                    "example": "Dead"
+                },
+                "topic": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ActivityType"
+                        }
+                    ],
+                    "example": "APPOINTMENT"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.EventType"
+                        }
+                    ],
+                    "example": "appointment.created"
+                }
+            }
+        },
+        "domain.OutboxStatus": {
+            "type": "string",
+            "enum": [
+                "Pending",
+                "Dispatched",
+                "Dead"
+            ],
+            "x-enum-varnames": [
+                "OutboxStatusPending",
+                "OutboxStatusDispatched",
+                "OutboxStatusDead"
+            ]
+        },
+        "domain.PatientBalance": {
+            "description": "Outstanding balance of a patient across all invoices",
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "IDR"
+                },
+                "invoiceCount": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "outstanding": {
+                    "type": "integer",
+                    "example": 15000000
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "totalBilled": {
+                    "type": "integer",
+                    "example": 45000000
+                },
+                "totalPaid": {
+                    "type": "integer",
+                    "example": 30000000
+                },
+                "totalRefunded": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "domain.PatientDTO": {
+            "description": "Patient data transfer object",
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "123 Main St"
+                },
+                "age": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "jane.doe@example.com"
+                },
+                "gender": {
+                    "type": "string",
+                    "example": "Female"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "lastVisit": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "1234567890"
+                }
+            }
+        },
+        "domain.PatientDetailResponse": {
+            "description": "Detailed patient information including recent appointments and medical history",
+            "type": "object",
+            "properties": {
+                "labResults": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.LabOrderDTO"
+                    }
+                },
+                "medicalHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.MedicalRecordDTO"
+                    }
+                },
+                "patient": {
+                    "$ref": "#/definitions/domain.PatientDTO"
+                },
+                "recentAppointments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.AppointmentDTO"
+                    }
+                }
+            }
+        },
+        "domain.PatientMixPoint": {
+            "description": "Patients seen in one period, split by whether it was their first visit",
+            "type": "object",
+            "properties": {
+                "new": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2025-07"
+                },
+                "returning": {
+                    "type": "integer",
+                    "example": 85
+                }
+            }
+        },
+        "domain.PatientMixResponse": {
+            "description": "New and returning patients per period. A patient is new in the period of their first appointment.",
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
+                "interval": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AnalyticsInterval"
+                        }
+                    ],
+                    "example": "day"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PatientMixPoint"
+                    }
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Jakarta"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-07-31"
+                }
+            }
+        },
+        "domain.PayerType": {
+            "type": "string",
+            "enum": [
+                "BPJS",
+                "Private"
+            ],
+            "x-enum-varnames": [
+                "PayerTypeBPJS",
+                "PayerTypePrivate"
+            ]
+        },
+        "domain.PaymentDTO": {
+            "description": "Payment data transfer object",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 10000000
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "invoiceId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000005"
+                },
+                "method": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PaymentMethod"
+                        }
+                    ],
+                    "example": "Cash"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "receivedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "EDC-123456"
+                },
+                "refundOf": {
+                    "type": "string"
+                },
+                "refundedAmount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PaymentType"
+                        }
+                    ],
+                    "example": "Payment"
+                }
+            }
+        },
+        "domain.PaymentMethod": {
+            "type": "string",
+            "enum": [
+                "Cash",
+                "Card",
+                "Transfer",
+                "Insurance"
+            ],
+            "x-enum-varnames": [
+                "PaymentMethodCash",
+                "PaymentMethodCard",
+                "PaymentMethodTransfer",
+                "PaymentMethodInsurance"
+            ]
+        },
+        "domain.PaymentType": {
+            "type": "string",
+            "enum": [
+                "Payment",
+                "Refund"
+            ],
+            "x-enum-varnames": [
+                "PaymentTypePayment",
+                "PaymentTypeRefund"
+            ]
+        },
+        "domain.PharmacyItemDTO": {
+            "description": "Pharmacy item data transfer object",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "PCT500"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "form": {
+                    "type": "string",
+                    "example": "tablet"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Paracetamol"
+                },
+                "reorderLevel": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "strength": {
+                    "type": "string",
+                    "example": "500 mg"
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "tablet"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                }
+            }
+        },
+        "domain.PharmacyItemRequest": {
+            "description": "Request body for creating or updating a pharmacy item",
+            "type": "object",
+            "required": [
+                "code",
+                "form",
+                "name",
+                "unit"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "PCT500"
+                },
+                "form": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "tablet"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Paracetamol"
+                },
+                "reorderLevel": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100
+                },
+                "strength": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "500 mg"
+                },
+                "unit": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "tablet"
+                }
+            }
+        },
+        "domain.QueueBoardResponse": {
+            "description": "Waiting-room display board of a queue. Patient names are left out.",
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-07-17"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Dr. John Doe"
+                },
+                "nowServing": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.QueueBoardTicket"
+                    }
+                },
+                "queueId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "upNext": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.QueueBoardTicket"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "waitingCount": {
+                    "type": "integer",
+                    "example": 8
+                }
+            }
+        },
+        "domain.QueueBoardTicket": {
+            "description": "Ticket shown on a queue display board",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "W-012"
+                },
+                "counter": {
+                    "type": "string",
+                    "example": "Room 101"
+                },
+                "estimatedWaitMinutes": {
+                    "type": "integer",
+                    "example": 30
+                }
+            }
+        },
+        "domain.QueueDetailResponse": {
+            "description": "Queue with its tickets for the day",
+            "type": "object",
+            "properties": {
+                "averageDurationMinutes": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "queue": {
+                    "$ref": "#/definitions/domain.QueueEntity"
+                },
+                "tickets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.QueueTicketDTO"
+                    }
+                }
+            }
+        },
+        "domain.QueueEntity": {
+            "description": "Daily outpatient queue of a doctor or a polyclinic",
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-07-17"
+                },
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "lastNumber": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "polyclinic": {
+                    "type": "string",
+                    "example": "Poli Umum"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.QueueTicketDTO": {
+            "description": "Queue ticket with its position and estimated wait",
+            "type": "object",
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "calledAt": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "W-012"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "counter": {
+                    "type": "string",
+                    "example": "Room 101"
+                },
+                "duration": {
+                    "description": "expected service time in minutes",
+                    "type": "integer",
+                    "example": 15
+                },
+                "estimatedWaitMinutes": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000010"
+                },
+                "issuedAt": {
+                    "type": "string"
+                },
+                "issuedBy": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Fever since yesterday"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "patientName": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "queueId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "recallCount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "source": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.QueueTicketSource"
+                        }
+                    ],
+                    "example": "walk-in"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.QueueTicketStatus"
+                        }
+                    ],
+                    "example": "Waiting"
+                },
+                "triage": {
+                    "$ref": "#/definitions/domain.TriageAssessment"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.QueueTicketEntity": {
+            "description": "Queue ticket of a walk-in or checked-in patient",
+            "type": "object",
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "calledAt": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "W-012"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "counter": {
+                    "type": "string",
+                    "example": "Room 101"
+                },
+                "duration": {
+                    "description": "expected service time in minutes",
+                    "type": "integer",
+                    "example": 15
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000010"
+                },
+                "issuedAt": {
+                    "type": "string"
+                },
+                "issuedBy": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Fever since yesterday"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "patientName": {
+                    "type": "string",
+                    "example": "Jane Doe"
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "queueId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "recallCount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "source": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.QueueTicketSource"
+                        }
+                    ],
+                    "example": "walk-in"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.QueueTicketStatus"
+                        }
+                    ],
+                    "example": "Waiting"
+                },
+                "triage": {
+                    "$ref": "#/definitions/domain.TriageAssessment"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.QueueTicketSource": {
+            "type": "string",
+            "enum": [
+                "walk-in",
+                "appointment"
+            ],
+            "x-enum-varnames": [
+                "QueueTicketWalkIn",
+                "QueueTicketAppointment"
+            ]
+        },
+        "domain.QueueTicketStatus": {
+            "type": "string",
+            "enum": [
+                "Waiting",
+                "Called",
+                "Skipped",
+                "Completed"
+            ],
+            "x-enum-varnames": [
+                "QueueTicketWaiting",
+                "QueueTicketCalled",
+                "QueueTicketSkipped",
+                "QueueTicketCompleted"
+            ]
+        },
+        "domain.ReceiveStockRequest": {
+            "description": "Request body for receiving goods into stock",
+            "type": "object",
+            "required": [
+                "batchNumber",
+                "expiryDate",
+                "itemId",
                 "location",
-                "patientId",
-                "status",
-                "type"
+                "quantity"
+            ],
+            "properties": {
+                "batchNumber": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "B2025-07"
+                },
+                "expiryDate": {
+                    "type": "string",
+                    "example": "2026-07-31T00:00:00Z"
+                },
+                "itemId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "location": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "MAIN"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "reference": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "GRN-0001"
+                },
+                "supplier": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "PT Kimia Farma"
+                },
+                "unitCost": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 50000
+                }
+            }
+        },
+        "domain.ReferenceRange": {
+            "type": "object",
+            "properties": {
+                "criticalHigh": {
+                    "type": "number",
+                    "example": 20
+                },
+                "criticalLow": {
+                    "type": "number",
+                    "example": 7
+                },
+                "high": {
+                    "type": "number",
+                    "example": 16
+                },
+                "low": {
+                    "type": "number",
+                    "example": 12
+                },
+                "text": {
+                    "type": "string",
+                    "example": "12-16 g/dL"
+                }
+            }
+        },
+        "domain.ReferralEntity": {
+            "description": "Referral of a patient from one doctor to another doctor or to a specialty",
+            "type": "object",
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "fromDoctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000020"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Exertional chest pain, abnormal ECG"
+                },
+                "recordIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "respondedAt": {
+                    "type": "string"
+                },
+                "respondedBy": {
+                    "type": "string"
+                },
+                "responseNotes": {
+                    "type": "string",
+                    "example": "Please attach the latest echo"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReferralStatus"
+                        }
+                    ],
+                    "example": "Sent"
+                },
+                "toDoctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000005"
+                },
+                "toSpecialty": {
+                    "description": "ToSpecialty is always set; referrals to a doctor take the doctor's\nspecialty so they also show in the specialty inbox.",
+                    "type": "string",
+                    "example": "Cardiology"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                },
+                "urgency": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReferralUrgency"
+                        }
+                    ],
+                    "example": "urgent"
+                }
+            }
+        },
+        "domain.ReferralInboxItem": {
+            "description": "Referral inbox entry with the patient and referring doctor names resolved",
+            "type": "object",
+            "properties": {
+                "fromDoctorName": {
+                    "type": "string",
+                    "example": "Dr. Jane Smith"
+                },
+                "patientName": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "referral": {
+                    "$ref": "#/definitions/domain.ReferralEntity"
+                }
+            }
+        },
+        "domain.ReferralStatus": {
+            "type": "string",
+            "enum": [
+                "Sent",
+                "Accepted",
+                "Declined",
+                "Completed"
+            ],
+            "x-enum-varnames": [
+                "ReferralStatusSent",
+                "ReferralStatusAccepted",
+                "ReferralStatusDeclined",
+                "ReferralStatusCompleted"
+            ]
+        },
+        "domain.ReferralUrgency": {
+            "type": "string",
+            "enum": [
+                "routine",
+                "urgent",
+                "emergency"
+            ],
+            "x-enum-varnames": [
+                "ReferralUrgencyRoutine",
+                "ReferralUrgencyUrgent",
+                "ReferralUrgencyEmergency"
+            ]
+        },
+        "domain.RefundPaymentRequest": {
+            "description": "Request body for refunding part or all of a payment",
+            "type": "object",
+            "required": [
+                "amount",
+                "reason"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 5000000
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Procedure cancelled"
+                }
+            }
+        },
+        "domain.ReminderChannel": {
+            "type": "string",
+            "enum": [
+                "email",
+                "sms",
+                "whatsapp"
+            ],
+            "x-enum-varnames": [
+                "ReminderChannelEmail",
+                "ReminderChannelSMS",
+                "ReminderChannelWhatsApp"
+            ]
+        },
+        "domain.ReminderEntity": {
+            "description": "Appointment reminder sent, or attempted, to a patient",
+            "type": "object",
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "appointmentTime": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "channel": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReminderChannel"
+                        }
+                    ],
+                    "example": "sms"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReminderKind"
+                        }
+                    ],
+                    "example": "reminder"
+                },
+                "language": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReminderLanguage"
+                        }
+                    ],
+                    "example": "id"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "offsetMinutes": {
+                    "type": "integer",
+                    "example": 1440
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "recipient": {
+                    "type": "string",
+                    "example": "+6281234567890"
+                },
+                "sentAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReminderStatus"
+                        }
+                    ],
+                    "example": "Sent"
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "Pengingat janji temu"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ReminderKind": {
+            "type": "string",
+            "enum": [
+                "reminder",
+                "rescheduled"
+            ],
+            "x-enum-varnames": [
+                "ReminderKindReminder",
+                "ReminderKindRescheduled"
+            ]
+        },
+        "domain.ReminderLanguage": {
+            "type": "string",
+            "enum": [
+                "id",
+                "en"
+            ],
+            "x-enum-varnames": [
+                "ReminderLanguageIndonesian",
+                "ReminderLanguageEnglish"
+            ]
+        },
+        "domain.ReminderPreferenceEntity": {
+            "description": "Reminder preferences of a patient",
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ReminderChannel"
+                    },
+                    "example": [
+                        "sms"
+                    ]
+                },
+                "language": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReminderLanguage"
+                        }
+                    ],
+                    "example": "id"
+                },
+                "optOut": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ReminderPreferenceRequest": {
+            "description": "Request body for updating the reminder preferences of a patient",
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ReminderChannel"
+                    },
+                    "example": [
+                        "sms"
+                    ]
+                },
+                "language": {
+                    "enum": [
+                        "id",
+                        "en"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReminderLanguage"
+                        }
+                    ],
+                    "example": "id"
+                },
+                "optOut": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "domain.ReminderStatus": {
+            "type": "string",
+            "enum": [
+                "Pending",
+                "Sent",
+                "Failed",
+                "Skipped"
+            ],
+            "x-enum-varnames": [
+                "ReminderStatusPending",
+                "ReminderStatusSent",
+                "ReminderStatusFailed",
+                "ReminderStatusSkipped"
+            ]
+        },
+        "domain.RespondReferralRequest": {
+            "description": "Request body for accepting or declining a referral",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "doctorId": {
+                    "description": "DoctorID assigns a specialty referral to the accepting doctor.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000005"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Please attach the latest echo"
+                },
+                "status": {
+                    "enum": [
+                        "Accepted",
+                        "Declined"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ReferralStatus"
+                        }
+                    ],
+                    "example": "Accepted"
+                }
+            }
+        },
+        "domain.Role": {
+            "type": "string",
+            "enum": [
+                "Admin",
+                "Doctor",
+                "Nurse",
+                "Receptionist",
+                "Management",
+                "Lab"
+            ],
+            "x-enum-varnames": [
+                "RoleAdmin",
+                "RoleDoctor",
+                "RoleNurse",
+                "RoleReceptionist",
+                "RoleManagement",
+                "RoleLab"
+            ]
+        },
+        "domain.RoomDTO": {
+            "description": "Room data transfer object",
+            "type": "object",
+            "properties": {
+                "class": {
+                    "type": "string",
+                    "example": "VIP"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000011"
+                },
+                "number": {
+                    "type": "string",
+                    "example": "201"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "wardId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000010"
+                }
+            }
+        },
+        "domain.SatuSehatAttempt": {
+            "type": "object",
+            "properties": {
+                "attemptedAt": {
+                    "type": "string"
+                },
+                "durationMs": {
+                    "type": "integer",
+                    "example": 240
+                },
+                "error": {
+                    "type": "string",
+                    "example": "no SATUSEHAT ID for patient 60d0fe4f53115a001f000002"
+                },
+                "statusCode": {
+                    "type": "integer",
+                    "example": 400
+                }
+            }
+        },
+        "domain.SatuSehatBackfillResult": {
+            "description": "Result of queueing the records of a period for submission to SATUSEHAT",
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "encounters": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-07-31"
+                }
+            }
+        },
+        "domain.SatuSehatReconciliationCounts": {
+            "description": "Sync status counts of one resource type in a reconciliation report",
+            "type": "object",
+            "properties": {
+                "deadLetter": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "missing": {
+                    "description": "Missing counts the records that were never queued, e.g. because they\npredate the integration; a backfill queues them.",
+                    "type": "integer",
+                    "example": 2
+                },
+                "pending": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "synced": {
+                    "type": "integer",
+                    "example": 112
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "domain.SatuSehatReconciliationItem": {
+            "description": "Record in a reconciliation report that is not in SATUSEHAT",
+            "type": "object",
+            "properties": {
+                "attemptCount": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-07-17T10:00:00Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "no SATUSEHAT ID for patient 60d0fe4f53115a001f000002"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "sourceType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.SatuSehatSourceType"
+                        }
+                    ],
+                    "example": "medical_record"
+                },
+                "status": {
+                    "description": "Status is the sync status, or Missing when the record was never queued.",
+                    "type": "string",
+                    "example": "DeadLetter"
+                }
+            }
+        },
+        "domain.SatuSehatReconciliationReport": {
+            "description": "Comparison of the completed appointments and medical records in a period with what was submitted to SATUSEHAT",
+            "type": "object",
+            "properties": {
+                "conditions": {
+                    "$ref": "#/definitions/domain.SatuSehatReconciliationCounts"
+                },
+                "encounters": {
+                    "$ref": "#/definitions/domain.SatuSehatReconciliationCounts"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-07-31"
+                },
+                "unsynced": {
+                    "description": "Unsynced lists every record in the period that is not synced, oldest\nfirst.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SatuSehatReconciliationItem"
+                    }
+                }
+            }
+        },
+        "domain.SatuSehatReferenceEntity": {
+            "description": "SATUSEHAT ID of a patient, doctor or clinic room",
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000041"
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.SatuSehatReferenceKind"
+                        }
+                    ],
+                    "example": "patient"
+                },
+                "localId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "satusehatId": {
+                    "type": "string",
+                    "example": "P02478375538"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.SatuSehatReferenceKind": {
+            "type": "string",
+            "enum": [
+                "patient",
+                "practitioner",
+                "location"
+            ],
+            "x-enum-varnames": [
+                "SatuSehatReferencePatient",
+                "SatuSehatReferencePractitioner",
+                "SatuSehatReferenceLocation"
+            ]
+        },
+        "domain.SatuSehatReferenceRequest": {
+            "description": "Request to set the SATUSEHAT ID of a patient, doctor or clinic room",
+            "type": "object",
+            "required": [
+                "satusehatId"
+            ],
+            "properties": {
+                "satusehatId": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "P02478375538"
+                }
+            }
+        },
+        "domain.SatuSehatSourceType": {
+            "type": "string",
+            "enum": [
+                "appointment",
+                "medical_record"
+            ],
+            "x-enum-varnames": [
+                "SatuSehatSourceAppointment",
+                "SatuSehatSourceMedicalRecord"
+            ]
+        },
+        "domain.SatuSehatSyncEntity": {
+            "description": "Submission of an appointment or medical record to SATUSEHAT, with its sync status",
+            "type": "object",
+            "properties": {
+                "attemptCount": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SatuSehatAttempt"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deleted": {
+                    "description": "Deleted is set when the source was deleted after it was sent, so the\nresource is resent as entered in error.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000040"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "resourceType": {
+                    "type": "string",
+                    "example": "Encounter"
+                },
+                "revision": {
+                    "description": "Revision counts the changes to the source, so an attempt that raced a\nchange does not mark the change as sent.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "satusehatId": {
+                    "type": "string",
+                    "example": "2823ed1d-3e3e-434e-9a5b-9c579d192c3b"
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "sourceType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.SatuSehatSourceType"
+                        }
+                    ],
+                    "example": "appointment"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.SatuSehatSyncStatus"
+                        }
+                    ],
+                    "example": "Synced"
+                },
+                "syncedAt": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.SatuSehatSyncStatus": {
+            "type": "string",
+            "enum": [
+                "Pending",
+                "Synced",
+                "Failed",
+                "DeadLetter",
+                "Skipped"
             ],
+            "x-enum-varnames": [
+                "SatuSehatSyncPending",
+                "SatuSehatSyncSynced",
+                "SatuSehatSyncFailed",
+                "SatuSehatSyncDeadLetter",
+                "SatuSehatSyncSkipped"
+            ]
+        },
+        "domain.ShiftAssignmentEntity": {
+            "description": "Shift worked by a staff member on a day",
+            "type": "object",
             "properties": {
                 "createdAt": {
-                    "type": "string",
-                    "example": "2025-07-17T09:00:00Z"
+                    "type": "string"
                 },
-                "dateTime": {
-                    "type": "string",
-                    "example": "2025-07-17T10:00:00Z"
+                "createdBy": {
+                    "type": "string"
                 },
-                "doctorId": {
+                "date": {
                     "type": "string",
-                    "example": "60d0fe4f53115a001f000003"
-                },
-                "duration": {
-                    "type": "integer"
+                    "example": "2025-07-17"
                 },
-                "id": {
+                "end": {
                     "type": "string",
-                    "example": "60d0fe4f53115a001f000001"
+                    "example": "2025-07-17T14:00:00+07:00"
                 },
-                "location": {
+                "id": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Room 101"
+                    "example": "60d0fe4f53115a001f000041"
                 },
                 "notes": {
                     "type": "string",
-                    "maxLength": 500,
-                    "example": "Patient complained of headache"
+                    "example": "Covers ICU handover"
                 },
-                "patientHistory": {
-                    "type": "string",
-                    "maxLength": 1000,
-                    "example": "No significant medical history"
+                "onCall": {
+                    "description": "OnCall marks a standby shift: the staff member is reachable for\nemergencies but not rostered for regular work.",
+                    "type": "boolean",
+                    "example": false
                 },
-                "patientId": {
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Role"
+                        }
+                    ],
+                    "example": "Nurse"
+                },
+                "start": {
                     "type": "string",
-                    "example": "60d0fe4f53115a001f000002"
+                    "example": "2025-07-17T07:00:00+07:00"
                 },
                 "status": {
-                    "enum": [
-                        "Scheduled",
-                        "Confirmed",
-                        "Completed",
-                        "Cancelled"
-                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/domain.AppointmentStatus"
+                            "$ref": "#/definitions/domain.ShiftAssignmentStatus"
                         }
                     ],
                     "example": "Scheduled"
                 },
-                "type": {
+                "templateId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000040"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                }
+            }
+        },
+        "domain.ShiftAssignmentStatus": {
+            "type": "string",
+            "enum": [
+                "Scheduled",
+                "Cancelled"
+            ],
+            "x-enum-varnames": [
+                "ShiftAssignmentScheduled",
+                "ShiftAssignmentCancelled"
+            ]
+        },
+        "domain.ShiftCoverage": {
+            "type": "object",
+            "required": [
+                "count",
+                "role"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 3
+                },
+                "role": {
                     "enum": [
-                        "check-up",
-                        "follow-up",
-                        "consultation",
-                        "procedure",
-                        "emergency"
+                        "Admin",
+                        "Doctor",
+                        "Nurse",
+                        "Receptionist",
+                        "Management",
+                        "Lab"
                     ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/domain.AppointmentType"
+                            "$ref": "#/definitions/domain.Role"
                         }
                     ],
-                    "example": "check-up"
-                },
-                "updatedAt": {
-                    "type": "string",
-                    "example": "2025-07-17T09:00:00Z"
+                    "example": "Nurse"
                 }
             }
         },
-        "domain.AppointmentDetailResponse": {
-            "description": "Detailed appointment information",
+        "domain.ShiftSwapEntity": {
+            "description": "Request to hand a shift over to a colleague, or to trade it for one of theirs",
             "type": "object",
             "properties": {
-                "appointment": {
-                    "$ref": "#/definitions/domain.AppointmentDTO"
+                "assignmentId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000041"
                 },
-                "lastRecord": {
-                    "$ref": "#/definitions/domain.MedicalRecordDTO"
+                "createdAt": {
+                    "type": "string"
                 },
-                "patient": {
-                    "$ref": "#/definitions/domain.PatientDTO"
+                "decidedAt": {
+                    "type": "string"
+                },
+                "decidedBy": {
+                    "type": "string"
+                },
+                "decisionNotes": {
+                    "type": "string",
+                    "example": "Approved, coverage unchanged"
+                },
+                "fromUserId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000042"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Family event"
+                },
+                "requestedBy": {
+                    "type": "string"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ShiftSwapStatus"
+                        }
+                    ],
+                    "example": "Pending"
+                },
+                "targetAssignmentId": {
+                    "description": "TargetAssignmentID is the colleague's shift taken in exchange; without\nit the shift is simply handed over.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000043"
+                },
+                "toUserId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "domain.AppointmentStatus": {
+        "domain.ShiftSwapStatus": {
             "type": "string",
             "enum": [
-                "Scheduled",
-                "Confirmed",
-                "Completed",
+                "Pending",
+                "Approved",
+                "Rejected",
                 "Cancelled"
             ],
             "x-enum-varnames": [
-                "AppointmentStatusScheduled",
-                "AppointmentStatusConfirmed",
-                "AppointmentStatusCompleted",
-                "AppointmentStatusCancelled"
-            ]
-        },
-        "domain.AppointmentType": {
-            "type": "string",
-            "enum": [
-                "check-up",
-                "follow-up",
-                "consultation",
-                "procedure",
-                "emergency"
-            ],
-            "x-enum-varnames": [
-                "AppointmentTypeCheckUp",
-                "AppointmentTypeFollowUp",
-                "AppointmentTypeConsultation",
-                "AppointmentTypeProcedure",
-                "AppointmentTypeEmergency"
+                "ShiftSwapPending",
+                "ShiftSwapApproved",
+                "ShiftSwapRejected",
+                "ShiftSwapCancelled"
             ]
         },
-        "domain.ChangePasswordRequest": {
-            "description": "Request body for changing user password",
+        "domain.ShiftTemplateEntity": {
+            "description": "Reusable shift definition, e.g. the morning shift",
             "type": "object",
-            "required": [
-                "confirmPassword",
-                "newPassword"
-            ],
             "properties": {
-                "confirmPassword": {
+                "code": {
                     "type": "string",
-                    "example": "VeryStrongNewPassword123"
+                    "example": "AM"
                 },
-                "newPassword": {
+                "coverage": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ShiftCoverage"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "daysOfWeek": {
+                    "description": "DaysOfWeek limits the days the shift runs on, 0-6 (Sunday-Saturday).\nEmpty means every day.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "end": {
                     "type": "string",
-                    "minLength": 8,
-                    "example": "VeryStrongNewPassword123"
+                    "example": "14:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000040"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Morning"
+                },
+                "start": {
+                    "description": "Start and End are clock times in the hospital time zone; a shift whose\nend is not after its start runs into the next day.",
+                    "type": "string",
+                    "example": "07:00"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "type": "string"
                 }
             }
         },
-        "domain.CreateAppointmentRequest": {
-            "description": "Request body for creating a new appointment",
+        "domain.ShiftTemplateRequest": {
+            "description": "Request body for creating or updating a shift template",
             "type": "object",
             "required": [
-                "doctorId",
-                "duration",
-                "location",
-                "patientId",
-                "type"
+                "code",
+                "end",
+                "name",
+                "start"
             ],
             "properties": {
-                "dateTime": {
-                    "type": "string",
-                    "example": "2025-07-17T10:00:00Z"
-                },
-                "doctorId": {
+                "code": {
                     "type": "string",
-                    "example": "60d0fe4f53115a001f000003"
-                },
-                "duration": {
-                    "type": "integer"
+                    "maxLength": 10,
+                    "minLength": 1,
+                    "example": "AM"
                 },
-                "location": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Room 101"
+                "coverage": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ShiftCoverage"
+                    }
                 },
-                "notes": {
-                    "type": "string",
-                    "maxLength": 500,
-                    "example": "Patient complained of headache"
+                "daysOfWeek": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "patientHistory": {
+                "end": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "example": "No significant medical history"
+                    "example": "14:00"
                 },
-                "patientId": {
-                    "type": "string",
-                    "example": "60d0fe4f53115a001f000002"
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "type": {
-                    "enum": [
-                        "check-up",
-                        "follow-up",
-                        "consultation",
-                        "procedure",
-                        "emergency"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/domain.AppointmentType"
-                        }
-                    ],
-                    "example": "check-up"
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
+                    "example": "Morning"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "07:00"
                 }
             }
         },
-        "domain.CreateDoctorRequet": {
-            "description": "Request body for creating a new doctor",
+        "domain.StockAlert": {
+            "description": "Low-stock or near-expiry alert",
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "phone",
-                "specialty"
-            ],
             "properties": {
-                "email": {
+                "batchNumber": {
                     "type": "string",
-                    "example": "jane.smith@example.com"
+                    "example": "B2025-07"
                 },
-                "name": {
+                "code": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Dr. Jane Smith"
+                    "example": "PCT500"
                 },
-                "phone": {
+                "expiryDate": {
                     "type": "string",
-                    "example": "+1987654321"
+                    "example": "2025-08-31T00:00:00Z"
                 },
-                "specialty": {
+                "itemId": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Pediatrics"
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "MAIN"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Paracetamol at MAIN is below the reorder level (40/100)"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Paracetamol"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.StockAlertType"
+                        }
+                    ],
+                    "example": "LowStock"
                 }
             }
         },
-        "domain.CreateMedicalRecordRequest": {
-            "description": "Request body for creating a new medical record",
-            "type": "object",
-            "required": [
-                "description",
-                "diagnosis",
-                "doctorId",
-                "patientId",
-                "recordType",
-                "treatment"
+        "domain.StockAlertType": {
+            "type": "string",
+            "enum": [
+                "LowStock",
+                "NearExpiry"
             ],
+            "x-enum-varnames": [
+                "StockAlertLowStock",
+                "StockAlertNearExpiry"
+            ]
+        },
+        "domain.StockBatchEntity": {
+            "description": "Stock batch of a pharmacy item at a location",
+            "type": "object",
             "properties": {
-                "description": {
+                "batchNumber": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 10,
-                    "example": "Patient presented with flu-like symptoms."
+                    "example": "B2025-07"
                 },
-                "diagnosis": {
-                    "type": "string",
-                    "maxLength": 200,
-                    "minLength": 5,
-                    "example": "Influenza A"
+                "createdAt": {
+                    "type": "string"
                 },
-                "doctorId": {
+                "expiryAlertedAt": {
+                    "type": "string"
+                },
+                "expiryDate": {
                     "type": "string",
-                    "example": "60d0fe4f53115a001f000003"
+                    "example": "2026-07-31T00:00:00Z"
                 },
-                "notes": {
+                "id": {
                     "type": "string",
-                    "maxLength": 500,
-                    "example": "Advised patient to stay hydrated."
+                    "example": "60d0fe4f53115a001f000001"
                 },
-                "patientId": {
+                "itemId": {
                     "type": "string",
                     "example": "60d0fe4f53115a001f000002"
                 },
-                "recordType": {
+                "location": {
                     "type": "string",
-                    "enum": [
-                        "checkup",
-                        "followup",
-                        "procedure",
-                        "emergency"
-                    ],
-                    "example": "checkup"
+                    "example": "MAIN"
                 },
-                "treatment": {
+                "quantity": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "receivedAt": {
+                    "type": "string"
+                },
+                "supplier": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 5,
-                    "example": "Prescribed Tamiflu and rest."
+                    "example": "PT Kimia Farma"
+                },
+                "unitCost": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "domain.CreatePatientRequest": {
-            "description": "Request body for creating a new patient",
+        "domain.StockLevel": {
+            "description": "Stock level of an item at a location",
             "type": "object",
-            "required": [
-                "address",
-                "age",
-                "email",
-                "gender",
-                "name",
-                "phone"
-            ],
             "properties": {
-                "address": {
+                "code": {
                     "type": "string",
-                    "maxLength": 200,
-                    "minLength": 10,
-                    "example": "123 Main St, Anytown, USA"
+                    "example": "PCT500"
                 },
-                "age": {
-                    "type": "integer",
-                    "maximum": 120
+                "isLow": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "email": {
+                "itemId": {
                     "type": "string",
-                    "example": "john.doe@example.com"
+                    "example": "60d0fe4f53115a001f000002"
                 },
-                "gender": {
+                "location": {
                     "type": "string",
-                    "enum": [
-                        "Male",
-                        "Female",
-                        "Other"
-                    ],
-                    "example": "Male"
+                    "example": "MAIN"
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "John Doe"
+                    "example": "Paracetamol"
                 },
-                "phone": {
+                "nearestExpiry": {
                     "type": "string",
-                    "example": "+1234567890"
+                    "example": "2026-07-31T00:00:00Z"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 480
+                },
+                "reorderLevel": {
+                    "type": "integer",
+                    "example": 100
                 }
             }
         },
-        "domain.CreateUserRequest": {
-            "description": "Request body for creating a new user",
+        "domain.StockMovementEntity": {
+            "description": "Stock movement (ledger entry) object",
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password",
-                "role"
-            ],
             "properties": {
-                "email": {
+                "batchId": {
                     "type": "string",
-                    "example": "jane.doe@example.com"
+                    "example": "60d0fe4f53115a001f000005"
                 },
-                "name": {
+                "batchNumber": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
-                    "example": "Jane Doe"
+                    "example": "B2025-07"
                 },
-                "password": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string",
-                    "minLength": 8,
-                    "example": "StrongPassword123"
+                    "example": "60d0fe4f53115a001f000001"
                 },
-                "role": {
-                    "enum": [
-                        "Admin",
-                        "Doctor",
-                        "Nurse",
-                        "Receptionist",
-                        "Management"
-                    ],
+                "itemId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "location": {
+                    "type": "string",
+                    "example": "MAIN"
+                },
+                "medicalRecordId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000004"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": -10
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "RX-0001"
+                },
+                "type": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/domain.Role"
+                            "$ref": "#/definitions/domain.StockMovementType"
                         }
                     ],
-                    "example": "Receptionist"
+                    "example": "Dispensed"
                 }
             }
         },
-        "domain.DashboardResponse": {
-            "description": "Dashboard response containing statistics, recent activities, and upcoming appointments",
-            "type": "object",
-            "properties": {
-                "recentActivities": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.Activity"
-                    }
-                },
-                "stats": {
-                    "$ref": "#/definitions/domain.DashboardStats"
-                },
-                "upcomingAppointments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.UpcomingAppointment"
-                    }
-                }
-            }
+        "domain.StockMovementType": {
+            "type": "string",
+            "enum": [
+                "Received",
+                "Dispensed",
+                "Adjusted",
+                "Expired"
+            ],
+            "x-enum-varnames": [
+                "StockMovementReceived",
+                "StockMovementDispensed",
+                "StockMovementAdjusted",
+                "StockMovementExpired"
+            ]
         },
-        "domain.DashboardStats": {
-            "description": "Dashboard statistics",
-            "type": "object",
-            "properties": {
-                "appointmentsCount": {
-                    "type": "integer"
-                },
-                "doctorsCount": {
-                    "type": "integer"
-                },
-                "medicalRecordsCount": {
-                    "type": "integer"
-                },
-                "patientsCount": {
-                    "type": "integer"
-                }
-            }
+        "domain.TariffCategory": {
+            "type": "string",
+            "enum": [
+                "consultation",
+                "procedure",
+                "lab",
+                "room",
+                "pharmacy",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "TariffCategoryConsultation",
+                "TariffCategoryProcedure",
+                "TariffCategoryLab",
+                "TariffCategoryRoom",
+                "TariffCategoryPharmacy",
+                "TariffCategoryOther"
+            ]
         },
-        "domain.DoctorDTO": {
-            "description": "Doctor data transfer object",
+        "domain.TariffDTO": {
+            "description": "Tariff data transfer object",
             "type": "object",
             "properties": {
-                "availability": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.TimeSlot"
-                    }
+                "appointmentType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentType"
+                        }
+                    ],
+                    "example": "check-up"
+                },
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.TariffCategory"
+                        }
+                    ],
+                    "example": "consultation"
                 },
-                "createdAt": {
+                "code": {
                     "type": "string",
-                    "example": "2025-07-17T09:00:00Z"
+                    "example": "CONS-GP"
                 },
-                "email": {
+                "createdAt": {
                     "type": "string",
-                    "example": "john.doe@example.com"
+                    "example": "2025-07-17T09:00:00Z"
                 },
                 "id": {
                     "type": "string",
                     "example": "60d0fe4f53115a001f000001"
                 },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "name": {
                     "type": "string",
-                    "example": "Dr. John Doe"
+                    "example": "General practitioner consultation"
                 },
-                "phone": {
-                    "type": "string",
-                    "example": "1234567890"
+                "price": {
+                    "type": "integer",
+                    "example": 15000000
                 },
-                "specialty": {
-                    "type": "string",
-                    "example": "Cardiology"
+                "taxRate": {
+                    "type": "integer",
+                    "example": 0
                 },
                 "updatedAt": {
                     "type": "string",
@@ -2529,184 +16253,192 @@ const docTemplate = `{
                 }
             }
         },
-        "domain.DoctorDetailResponse": {
-            "description": "Detailed doctor information including recent patients",
-            "type": "object",
-            "properties": {
-                "doctor": {
-                    "$ref": "#/definitions/domain.DoctorDTO"
-                },
-                "recentPatients": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.PatientDTO"
-                    }
-                }
-            }
-        },
-        "domain.LoginRequest": {
+        "domain.TariffRequest": {
+            "description": "Request body for creating or updating a tariff",
             "type": "object",
             "required": [
-                "email",
-                "password"
+                "category",
+                "code",
+                "name"
             ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "appointmentType": {
+                    "enum": [
+                        "check-up",
+                        "follow-up",
+                        "consultation",
+                        "procedure",
+                        "emergency"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentType"
+                        }
+                    ],
+                    "example": "check-up"
                 },
-                "password": {
-                    "type": "string"
+                "category": {
+                    "enum": [
+                        "consultation",
+                        "procedure",
+                        "lab",
+                        "room",
+                        "pharmacy",
+                        "other"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.TariffCategory"
+                        }
+                    ],
+                    "example": "consultation"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "example": "CONS-GP"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "General practitioner consultation"
+                },
+                "price": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 15000000
+                },
+                "taxRate": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 0
                 }
             }
         },
-        "domain.LoginResponse": {
+        "domain.TimeSlot": {
             "type": "object",
             "properties": {
-                "token": {
+                "dayOfWeek": {
+                    "description": "0-6 (Sunday-Saturday)",
+                    "type": "integer"
+                },
+                "endTime": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/domain.UserDTO"
+                "startTime": {
+                    "type": "string"
                 }
             }
         },
-        "domain.MedicalRecordDTO": {
-            "description": "Medical record data transfer object",
+        "domain.TopDiagnosesResponse": {
+            "description": "Most recorded diagnoses in a date range",
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string",
-                    "example": "2025-07-17T09:00:00Z"
-                },
-                "date": {
-                    "type": "string",
-                    "example": "2025-07-17T10:00:00Z"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "Patient presented with flu-like symptoms."
-                },
-                "diagnosis": {
-                    "type": "string",
-                    "example": "Influenza A"
-                },
-                "doctorId": {
-                    "type": "string",
-                    "example": "60d0fe4f53115a001f000003"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "60d0fe4f53115a001f000001"
-                },
-                "notes": {
-                    "type": "string",
-                    "example": "Advised patient to stay hydrated."
+                "diagnoses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DiagnosisCount"
+                    }
                 },
-                "patientId": {
+                "from": {
                     "type": "string",
-                    "example": "60d0fe4f53115a001f000002"
+                    "example": "2025-07-01"
                 },
-                "recordType": {
-                    "type": "string",
-                    "example": "checkup"
+                "interval": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AnalyticsInterval"
+                        }
+                    ],
+                    "example": "day"
                 },
-                "treatment": {
+                "timezone": {
                     "type": "string",
-                    "example": "Prescribed Tamiflu and rest."
+                    "example": "Asia/Jakarta"
                 },
-                "updatedAt": {
+                "to": {
                     "type": "string",
-                    "example": "2025-07-17T09:00:00Z"
+                    "example": "2025-07-31"
                 }
             }
         },
-        "domain.PatientDTO": {
-            "description": "Patient data transfer object",
+        "domain.TransferPatientRequest": {
+            "description": "Request body for transferring an admitted patient to another bed",
             "type": "object",
+            "required": [
+                "bedId"
+            ],
             "properties": {
-                "address": {
+                "bedId": {
                     "type": "string",
-                    "example": "123 Main St"
-                },
-                "age": {
-                    "type": "integer"
+                    "example": "60d0fe4f53115a001f000014"
                 },
-                "email": {
+                "reason": {
                     "type": "string",
-                    "example": "jane.doe@example.com"
+                    "maxLength": 500,
+                    "example": "Moved to isolation"
+                }
+            }
+        },
+        "domain.TriageAssessment": {
+            "description": "Emergency Severity Index triage assessment",
+            "type": "object",
+            "properties": {
+                "assessedAt": {
+                    "type": "string"
                 },
-                "gender": {
-                    "type": "string",
-                    "example": "Female"
+                "assessedBy": {
+                    "type": "string"
                 },
-                "id": {
+                "chiefComplaint": {
                     "type": "string",
-                    "example": "60d0fe4f53115a001f000001"
+                    "example": "Chest pain radiating to left arm"
                 },
-                "lastVisit": {
-                    "type": "string",
-                    "example": "2025-07-17T10:00:00Z"
+                "esiLevel": {
+                    "type": "integer",
+                    "example": 2
                 },
-                "name": {
+                "notes": {
                     "type": "string",
-                    "example": "Jane Doe"
+                    "example": "Onset 30 minutes ago"
                 },
-                "phone": {
-                    "type": "string",
-                    "example": "1234567890"
+                "vitals": {
+                    "$ref": "#/definitions/domain.VitalSigns"
                 }
             }
         },
-        "domain.PatientDetailResponse": {
-            "description": "Detailed patient information including recent appointments and medical history",
+        "domain.TriageRequest": {
+            "description": "Request body for recording a triage assessment",
             "type": "object",
-            "properties": {
-                "medicalHistory": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.MedicalRecordDTO"
-                    }
-                },
-                "patient": {
-                    "$ref": "#/definitions/domain.PatientDTO"
-                },
-                "recentAppointments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.AppointmentDTO"
-                    }
-                }
-            }
-        },
-        "domain.Role": {
-            "type": "string",
-            "enum": [
-                "Admin",
-                "Doctor",
-                "Nurse",
-                "Receptionist",
-                "Management"
+            "required": [
+                "chiefComplaint",
+                "esiLevel"
             ],
-            "x-enum-varnames": [
-                "RoleAdmin",
-                "RoleDoctor",
-                "RoleNurse",
-                "RoleReceptionist",
-                "RoleManagement"
-            ]
-        },
-        "domain.TimeSlot": {
-            "type": "object",
             "properties": {
-                "dayOfWeek": {
-                    "description": "0-6 (Sunday-Saturday)",
-                    "type": "integer"
+                "chiefComplaint": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Chest pain radiating to left arm"
                 },
-                "endTime": {
-                    "type": "string"
+                "esiLevel": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 2
                 },
-                "startTime": {
-                    "type": "string"
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Onset 30 minutes ago"
+                },
+                "vitals": {
+                    "$ref": "#/definitions/domain.VitalSigns"
                 }
             }
         },
@@ -2762,6 +16494,11 @@ const docTemplate = `{
                     "type": "string",
                     "example": "No significant medical history"
                 },
+                "roomId": {
+                    "description": "RoomID moves the appointment to another clinic room.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000032"
+                },
                 "status": {
                     "enum": [
                         "Scheduled",
@@ -2802,16 +16539,76 @@ const docTemplate = `{
             "properties": {
                 "status": {
                     "enum": [
-                        "Scheduled",
-                        "Confirmed",
-                        "Completed",
-                        "Cancelled"
+                        "Scheduled",
+                        "Confirmed",
+                        "Completed",
+                        "Cancelled"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.AppointmentStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "domain.UpdateBedStatusRequest": {
+            "description": "Request body for changing the housekeeping status of a bed",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "enum": [
+                        "Available",
+                        "Cleaning",
+                        "Maintenance"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BedStatus"
+                        }
+                    ],
+                    "example": "Cleaning"
+                }
+            }
+        },
+        "domain.UpdateClaimStatusRequest": {
+            "description": "Request body for recording the payer's decision on a claim",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 15000000
+                },
+                "payerReference": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "SEP-0001"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Diagnosis code missing"
+                },
+                "status": {
+                    "enum": [
+                        "Draft",
+                        "Approved",
+                        "Rejected",
+                        "Paid"
                     ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/domain.AppointmentStatus"
+                            "$ref": "#/definitions/domain.ClaimStatus"
                         }
-                    ]
+                    ],
+                    "example": "Approved"
                 }
             }
         },
@@ -2825,6 +16622,11 @@ const docTemplate = `{
                 "specialty"
             ],
             "properties": {
+                "departmentId": {
+                    "description": "DepartmentID assigns the doctor to a department.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000030"
+                },
                 "email": {
                     "type": "string",
                     "example": "jane.smith@example.com"
@@ -2844,6 +16646,33 @@ const docTemplate = `{
                     "maxLength": 100,
                     "minLength": 3,
                     "example": "Pediatrics"
+                },
+                "userId": {
+                    "description": "UserID links the doctor to their login account.",
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                }
+            }
+        },
+        "domain.UpdateLabOrderStatusRequest": {
+            "description": "Request body for updating a lab order status",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "enum": [
+                        "Ordered",
+                        "InProgress",
+                        "Cancelled"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.LabOrderStatus"
+                        }
+                    ],
+                    "example": "InProgress"
                 }
             }
         },
@@ -2956,7 +16785,8 @@ const docTemplate = `{
                         "Doctor",
                         "Nurse",
                         "Receptionist",
-                        "Management"
+                        "Management",
+                        "Lab"
                     ],
                     "allOf": [
                         {
@@ -2987,6 +16817,373 @@ const docTemplate = `{
                 }
             }
         },
+        "domain.VitalSigns": {
+            "description": "Vital signs taken at triage",
+            "type": "object",
+            "properties": {
+                "diastolicBp": {
+                    "type": "integer",
+                    "maximum": 200,
+                    "minimum": 20,
+                    "example": 60
+                },
+                "gcs": {
+                    "type": "integer",
+                    "maximum": 15,
+                    "minimum": 3,
+                    "example": 15
+                },
+                "heartRate": {
+                    "type": "integer",
+                    "maximum": 250,
+                    "minimum": 20,
+                    "example": 118
+                },
+                "oxygenSaturation": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 50,
+                    "example": 93
+                },
+                "painScore": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 0,
+                    "example": 7
+                },
+                "respiratoryRate": {
+                    "type": "integer",
+                    "maximum": 60,
+                    "minimum": 4,
+                    "example": 24
+                },
+                "systolicBp": {
+                    "type": "integer",
+                    "maximum": 300,
+                    "minimum": 40,
+                    "example": 95
+                },
+                "temperature": {
+                    "type": "number",
+                    "maximum": 45,
+                    "minimum": 30,
+                    "example": 38.9
+                }
+            }
+        },
+        "domain.VoidInvoiceRequest": {
+            "description": "Request body for voiding an invoice",
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Created in error"
+                }
+            }
+        },
+        "domain.WalkInTicketRequest": {
+            "description": "Request body for issuing a walk-in ticket. Give either a doctor or a polyclinic.",
+            "type": "object",
+            "properties": {
+                "doctorId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000003"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Fever since yesterday"
+                },
+                "patientId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "patientName": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Jane Doe"
+                },
+                "polyclinic": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Poli Umum"
+                }
+            }
+        },
+        "domain.WardBoard": {
+            "description": "Bed occupancy board for a single ward",
+            "type": "object",
+            "properties": {
+                "beds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.BedBoardEntry"
+                    }
+                },
+                "stats": {
+                    "$ref": "#/definitions/domain.BedOccupancyStats"
+                },
+                "ward": {
+                    "$ref": "#/definitions/domain.WardDTO"
+                }
+            }
+        },
+        "domain.WardDTO": {
+            "description": "Ward data transfer object",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "MLT"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "floor": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000010"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Melati"
+                },
+                "specialty": {
+                    "type": "string",
+                    "example": "Internal Medicine"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                }
+            }
+        },
+        "domain.WardRequest": {
+            "description": "Request body for creating or updating a ward",
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "minLength": 2,
+                    "example": "MLT"
+                },
+                "floor": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Melati"
+                },
+                "specialty": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Internal Medicine"
+                }
+            }
+        },
+        "domain.WebhookAttempt": {
+            "type": "object",
+            "properties": {
+                "attemptedAt": {
+                    "type": "string"
+                },
+                "durationMs": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "error": {
+                    "type": "string",
+                    "example": "unexpected status 500"
+                },
+                "statusCode": {
+                    "type": "integer",
+                    "example": 500
+                }
+            }
+        },
+        "domain.WebhookDeliveryEntity": {
+            "description": "Webhook delivery with its attempt log",
+            "type": "object",
+            "properties": {
+                "attemptCount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.WebhookAttempt"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deliveredAt": {
+                    "type": "string"
+                },
+                "eventId": {
+                    "type": "string",
+                    "example": "66a1f0c2e4b0a1b2c3d4e5f6"
+                },
+                "eventType": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.EventType"
+                        }
+                    ],
+                    "example": "appointment.created"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000002"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.WebhookDeliveryStatus"
+                        }
+                    ],
+                    "example": "Pending"
+                },
+                "subscriptionId": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://vendor.example.com/hooks/hms"
+                }
+            }
+        },
+        "domain.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "Pending",
+                "Delivered",
+                "Failed",
+                "DeadLetter"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliveryDelivered",
+                "WebhookDeliveryFailed",
+                "WebhookDeliveryDeadLetter"
+            ]
+        },
+        "domain.WebhookSubscriptionDTO": {
+            "description": "Webhook subscription data transfer object",
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventType"
+                    },
+                    "example": [
+                        "appointment.created"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "60d0fe4f53115a001f000001"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "SMS vendor"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-07-17T09:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://vendor.example.com/hooks/hms"
+                }
+            }
+        },
+        "domain.WebhookSubscriptionRequest": {
+            "description": "Request body for creating or updating a webhook subscription",
+            "type": "object",
+            "required": [
+                "eventTypes",
+                "name",
+                "url"
+            ],
+            "properties": {
+                "eventTypes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/domain.EventType"
+                    },
+                    "example": [
+                        "appointment.created"
+                    ]
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "SMS vendor"
+                },
+                "secret": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16,
+                    "example": "a-long-shared-signing-secret"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://vendor.example.com/hooks/hms"
+                }
+            }
+        },
         "utils.ErrorResponse": {
             "type": "object",
             "properties": {
@@ -1,15 +1,18 @@
 package app
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 
+	"github.com/ekastn/hms-api/internal/domain"
 	"github.com/ekastn/hms-api/internal/env"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -21,9 +24,25 @@ type App struct {
 }
 
 type config struct {
-	addr      string
-	mongoCfg  mongoDbCfg
-	jwtSecret string
+	addr         string
+	location     *time.Location
+	mongoCfg     mongoDbCfg
+	jwtSecret    string
+	pharmacyCfg  pharmacyCfg
+	eventsCfg    eventsCfg
+	webhookCfg   webhookCfg
+	outboxCfg    outboxCfg
+	reminderCfg  reminderCfg
+	linkCfg      appointmentLinkCfg
+	queueCfg     queueCfg
+	rosterCfg    rosterCfg
+	documentCfg  documentCfg
+	importCfg    importCfg
+	fhirCfg      fhirCfg
+	hl7Cfg       hl7Cfg
+	satusehatCfg satusehatCfg
+	grpcCfg      grpcCfg
+	graphqlCfg   graphqlCfg
 }
 
 type mongoDbCfg struct {
@@ -31,11 +50,109 @@ type mongoDbCfg struct {
 	db   string
 }
 
+type eventsCfg struct {
+	historySize int
+}
+
+type outboxCfg struct {
+	dispatchInterval time.Duration
+	maxAttempts      int
+}
+
+type reminderCfg struct {
+	offsets      []time.Duration
+	channels     []domain.ReminderChannel
+	language     domain.ReminderLanguage
+	scanInterval time.Duration
+	outputFile   string
+}
+
+type rosterCfg struct {
+	availabilitySource domain.AvailabilitySource
+}
+
+type documentCfg struct {
+	hospitalName    string
+	hospitalAddress string
+	hospitalPhone   string
+	secret          string
+	verifyURL       string
+}
+
+type importCfg struct {
+	pollInterval time.Duration
+}
+
+type fhirCfg struct {
+	baseURL string
+}
+
+type hl7Cfg struct {
+	listenAddr           string
+	sendAddr             string
+	facility             string
+	receivingApplication string
+	receivingFacility    string
+	ackTimeout           time.Duration
+	maxAttempts          int
+	sendInterval         time.Duration
+}
+
+// satusehatCfg configures the SATUSEHAT integration, which is disabled when
+// no client ID is set.
+type satusehatCfg struct {
+	authURL        string
+	baseURL        string
+	clientID       string
+	clientSecret   string
+	organizationID string
+	locationID     string
+	timeout        time.Duration
+	maxAttempts    int
+	syncInterval   time.Duration
+}
+
+// grpcCfg configures the gRPC API, which is disabled when no address is set.
+type grpcCfg struct {
+	addr string
+}
+
+// graphqlCfg limits how much a GraphQL query may ask for; see graph.NewServer.
+type graphqlCfg struct {
+	complexityLimit int
+}
+
+type queueCfg struct {
+	defaultDuration int
+}
+
+type appointmentLinkCfg struct {
+	secret       string
+	baseURL      string
+	cancelCutoff time.Duration
+}
+
+type webhookCfg struct {
+	maxAttempts      int
+	timeout          time.Duration
+	dispatchInterval time.Duration
+}
+
+type pharmacyCfg struct {
+	nearExpiryDays     int
+	expiryScanInterval time.Duration
+}
+
 func (a *App) Run() {
 	a.loadConfig()
 	a.connectDb()
 	a.mount()
-	a.setupRoutes()
+
+	// Background workers run until the server shuts down.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a.setupRoutes(ctx)
 
 	go func() {
 		if err := a.f.Listen(a.cfg.addr); err != nil {
@@ -48,6 +165,7 @@ func (a *App) Run() {
 	<-quit
 
 	log.Println("shutting down server...")
+	cancel()
 	if err := a.f.Shutdown(); err != nil {
 		log.Fatal("error while shutting down server: ", err)
 	}
@@ -1,12 +1,12 @@
 package app
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/ekastn/hms-api/internal/domain"
 	"github.com/ekastn/hms-api/internal/utils"
 	"github.com/gofiber/fiber/v2"
-	"github.com/golang-jwt/jwt/v5"
 )
 
 func JWTMiddleware(secret string) fiber.Handler {
@@ -21,26 +21,32 @@ func JWTMiddleware(secret string) fiber.Handler {
 			return utils.ErrorResponseJSON(c, fiber.StatusUnauthorized, "Missing or malformed JWT", nil)
 		}
 
-		tokenString := parts[1]
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fiber.NewError(fiber.StatusUnauthorized, "unexpected signing method")
-			}
-			return []byte(secret), nil
-		})
-
-		if err != nil || !token.Valid {
+		token, err := utils.ParseToken(secret, parts[1])
+		if errors.Is(err, utils.ErrInvalidClaims) {
+			return utils.ErrorResponseJSON(c, fiber.StatusUnauthorized, "Invalid JWT claims", nil)
+		}
+		if err != nil {
 			return utils.ErrorResponseJSON(c, fiber.StatusUnauthorized, "Invalid or expired JWT", nil)
 		}
 
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			return utils.ErrorResponseJSON(c, fiber.StatusUnauthorized, "Invalid JWT claims", nil)
-		}
+		c.Locals("userID", token.UserID)
+		c.Locals("userRole", token.Role)
+		c.Locals("tokenExpiresAt", token.ExpiresAt)
 
-		c.Locals("userID", claims["sub"]) // subject is the user ID
-		c.Locals("userRole", claims["role"])
+		return c.Next()
+	}
+}
 
+// QueryTokenMiddleware lets clients that cannot set request headers, such as
+// the browser EventSource, pass the JWT in the access_token query parameter.
+// It must run before JWTMiddleware.
+func QueryTokenMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Get("Authorization") == "" {
+			if token := c.Query("access_token"); token != "" {
+				c.Request().Header.Set("Authorization", "Bearer "+token)
+			}
+		}
 		return c.Next()
 	}
 }
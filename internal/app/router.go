@@ -1,15 +1,26 @@
 package app
 
 import (
+	"context"
+	"net/http"
+
+	"github.com/ekastn/hms-api/internal/document"
 	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/events"
+	"github.com/ekastn/hms-api/internal/fhir"
+	"github.com/ekastn/hms-api/internal/graph"
 	"github.com/ekastn/hms-api/internal/handlers"
+	"github.com/ekastn/hms-api/internal/notify"
+	"github.com/ekastn/hms-api/internal/payer"
 	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/ekastn/hms-api/internal/rpc"
+	"github.com/ekastn/hms-api/internal/satusehat"
 	"github.com/ekastn/hms-api/internal/service"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/swagger"
 )
 
-func (a *App) setupRoutes() {
+func (a *App) setupRoutes(ctx context.Context) {
 	// Initialize repositories
 	patientRepo := repository.NewPatientRepository(a.db.Collection("patients"))
 	docRepo := repository.NewDoctorRepository(a.db.Collection("doctors"))
@@ -17,48 +28,366 @@ func (a *App) setupRoutes() {
 	medicalRecordRepo := repository.NewMedicalRecordRepository(a.db.Collection("medical_records"))
 	activityRepo := repository.NewActivityRepository(a.db.Collection("activities"))
 	userRepo := repository.NewUserRepository(a.db.Collection("users"))
+	labOrderRepo := repository.NewLabOrderRepository(a.db.Collection("lab_orders"))
+	wardRepo := repository.NewWardRepository(a.db.Collection("wards"))
+	roomRepo := repository.NewRoomRepository(a.db.Collection("rooms"))
+	bedRepo := repository.NewBedRepository(a.db.Collection("beds"))
+	admissionRepo := repository.NewAdmissionRepository(a.db.Collection("admissions"))
+	tariffRepo := repository.NewTariffRepository(a.db.Collection("tariffs"))
+	invoiceRepo := repository.NewInvoiceRepository(a.db.Collection("invoices"))
+	paymentRepo := repository.NewPaymentRepository(a.db.Collection("payments"))
+	insurancePolicyRepo := repository.NewInsurancePolicyRepository(a.db.Collection("insurance_policies"))
+	claimRepo := repository.NewClaimRepository(a.db.Collection("claims"))
+	pharmacyItemRepo := repository.NewPharmacyItemRepository(a.db.Collection("pharmacy_items"))
+	stockBatchRepo := repository.NewStockBatchRepository(a.db.Collection("stock_batches"))
+	stockMovementRepo := repository.NewStockMovementRepository(a.db.Collection("stock_movements"))
+	webhookSubscriptionRepo := repository.NewWebhookSubscriptionRepository(a.db.Collection("webhook_subscriptions"))
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(a.db.Collection("webhook_deliveries"))
+	outboxRepo := repository.NewOutboxRepository(a.db.Collection("outbox"))
+	reminderRepo := repository.NewReminderRepository(a.db.Collection("reminders"))
+	reminderPreferenceRepo := repository.NewReminderPreferenceRepository(a.db.Collection("reminder_preferences"))
+	queueRepo := repository.NewQueueRepository(a.db.Collection("queues"))
+	queueTicketRepo := repository.NewQueueTicketRepository(a.db.Collection("queue_tickets"))
+	referralRepo := repository.NewReferralRepository(a.db.Collection("referrals"))
+	appointmentLinkTokenRepo := repository.NewAppointmentLinkTokenRepository(a.db.Collection("appointment_link_tokens"))
+	departmentRepo := repository.NewDepartmentRepository(a.db.Collection("departments"))
+	clinicRepo := repository.NewClinicRepository(a.db.Collection("clinics"))
+	clinicRoomRepo := repository.NewClinicRoomRepository(a.db.Collection("clinic_rooms"))
+	shiftTemplateRepo := repository.NewShiftTemplateRepository(a.db.Collection("shift_templates"))
+	shiftAssignmentRepo := repository.NewShiftAssignmentRepository(a.db.Collection("shift_assignments"))
+	shiftSwapRepo := repository.NewShiftSwapRepository(a.db.Collection("shift_swaps"))
+	importJobRepo := repository.NewImportJobRepository(a.db.Collection("import_jobs"))
+	hl7MessageRepo := repository.NewHL7MessageRepository(a.db.Collection("hl7_messages"))
+	satusehatSyncRepo := repository.NewSatuSehatSyncRepository(a.db.Collection("satusehat_syncs"))
+	satusehatReferenceRepo := repository.NewSatuSehatReferenceRepository(a.db.Collection("satusehat_references"))
+
+	// Event bus for the real-time event stream, closed on shutdown so open
+	// streams end.
+	eventBus := events.NewBus(a.cfg.eventsCfg.historySize)
+	go func() {
+		<-ctx.Done()
+		eventBus.Close()
+	}()
 
 	// Initialize services
-	activityService := service.NewActivityService(activityRepo)
+	webhookService := service.NewWebhookService(
+		webhookSubscriptionRepo,
+		webhookDeliveryRepo,
+		&http.Client{Timeout: a.cfg.webhookCfg.timeout},
+		a.cfg.webhookCfg.maxAttempts,
+	)
+	activityService := service.NewActivityService(activityRepo, outboxRepo)
 	patientService := service.NewPatientService(
 		patientRepo,
 		appointmentRepo,
 		medicalRecordRepo,
+		labOrderRepo,
 		activityService,
+		a.db.Client(),
 	)
 	docService := service.NewDoctorService(
 		docRepo,
 		appointmentRepo,
 		patientRepo,
+		departmentRepo,
+		userRepo,
+		activityService,
+		a.db.Client(),
+	)
+	facilityService := service.NewFacilityService(
+		departmentRepo,
+		clinicRepo,
+		clinicRoomRepo,
+		appointmentRepo,
+		a.cfg.location,
+	)
+	rosterService := service.NewRosterService(
+		shiftTemplateRepo,
+		shiftAssignmentRepo,
+		shiftSwapRepo,
+		userRepo,
+		docRepo,
+		activityService,
+		a.db.Client(),
+		a.cfg.location,
+		a.cfg.rosterCfg.availabilitySource,
+	)
+	analyticsService := service.NewAnalyticsService(appointmentRepo, medicalRecordRepo, docRepo, a.cfg.location)
+	exportService := service.NewExportService(patientRepo, appointmentRepo, medicalRecordRepo, activityRepo, userRepo, activityService)
+	importService := service.NewImportService(
+		importJobRepo,
+		patientRepo,
+		userRepo,
+		patientService,
+		docService,
+		activityService,
+	)
+	billingService := service.NewBillingService(
+		tariffRepo,
+		invoiceRepo,
+		paymentRepo,
+		patientRepo,
+		appointmentRepo,
 		activityService,
+		a.db.Client(),
 	)
 	appointmentService := service.NewAppointmentService(
 		appointmentRepo,
 		patientRepo,
 		medicalRecordRepo,
+		docRepo,
 		activityService,
+		billingService,
+		facilityService,
+		rosterService,
 		a.db.Client(),
 	)
-	medicalRecordService := service.NewMedicalRecordService(medicalRecordRepo, activityService)
+	medicalRecordService := service.NewMedicalRecordService(medicalRecordRepo, activityService, a.db.Client())
 	dashboardService := service.NewDashboardService(
 		patientRepo,
 		docRepo,
 		appointmentRepo,
 		medicalRecordRepo,
 		activityRepo,
+		bedRepo,
+		analyticsService,
 	)
 	authService := service.NewAuthService(userRepo, a.cfg.jwtSecret)
 	userService := service.NewUserService(userRepo)
+	labService := service.NewLabService(labOrderRepo, patientRepo, appointmentRepo, activityService, a.db.Client())
+	wardService := service.NewWardService(wardRepo, roomRepo, bedRepo, admissionRepo, patientRepo, activityService)
+	insuranceService := service.NewInsuranceService(
+		insurancePolicyRepo,
+		claimRepo,
+		patientRepo,
+		appointmentRepo,
+		invoiceRepo,
+		billingService,
+		payer.NewFakeGateway(),
+		activityService,
+		a.db.Client(),
+	)
+	admissionService := service.NewAdmissionService(
+		admissionRepo,
+		bedRepo,
+		patientRepo,
+		docRepo,
+		medicalRecordRepo,
+		activityService,
+		a.db.Client(),
+	)
+	pharmacyService := service.NewPharmacyService(
+		pharmacyItemRepo,
+		stockBatchRepo,
+		stockMovementRepo,
+		patientRepo,
+		activityService,
+		a.db.Client(),
+		a.cfg.pharmacyCfg.nearExpiryDays,
+	)
+	queueService := service.NewQueueService(
+		queueRepo,
+		queueTicketRepo,
+		appointmentRepo,
+		patientRepo,
+		docRepo,
+		activityService,
+		a.db.Client(),
+		a.cfg.queueCfg.defaultDuration,
+		a.cfg.location,
+	)
+	triageService := service.NewTriageService(
+		appointmentRepo,
+		queueTicketRepo,
+		activityService,
+		a.db.Client(),
+	)
+	referralService := service.NewReferralService(
+		referralRepo,
+		patientRepo,
+		docRepo,
+		medicalRecordRepo,
+		appointmentRepo,
+		activityService,
+		a.db.Client(),
+	)
+	appointmentLinkService := service.NewAppointmentLinkService(
+		appointmentRepo,
+		appointmentLinkTokenRepo,
+		appointmentService,
+		a.cfg.linkCfg.secret,
+		a.cfg.linkCfg.baseURL,
+		a.cfg.linkCfg.cancelCutoff,
+		a.db.Client(),
+	)
+	documentService := service.NewDocumentService(
+		appointmentService,
+		patientService,
+		patientRepo,
+		docRepo,
+		medicalRecordRepo,
+		invoiceRepo,
+		stockMovementRepo,
+		pharmacyItemRepo,
+		userRepo,
+		activityService,
+		document.Hospital{
+			Name:    a.cfg.documentCfg.hospitalName,
+			Address: a.cfg.documentCfg.hospitalAddress,
+			Phone:   a.cfg.documentCfg.hospitalPhone,
+		},
+		a.cfg.location,
+		a.cfg.documentCfg.secret,
+		a.cfg.documentCfg.verifyURL,
+	)
+	fhirService := service.NewFHIRService(
+		fhir.NewMapper(a.cfg.fhirCfg.baseURL),
+		patientRepo,
+		docRepo,
+		appointmentRepo,
+		medicalRecordRepo,
+		patientService,
+		docService,
+		appointmentService,
+		medicalRecordService,
+	)
+
+	hl7Service := service.NewHL7Service(
+		hl7MessageRepo,
+		patientRepo,
+		wardRepo,
+		roomRepo,
+		bedRepo,
+		admissionRepo,
+		patientService,
+		admissionService,
+		labService,
+		service.HL7Settings{
+			ListenAddr:           a.cfg.hl7Cfg.listenAddr,
+			SendAddr:             a.cfg.hl7Cfg.sendAddr,
+			Facility:             a.cfg.hl7Cfg.facility,
+			ReceivingApplication: a.cfg.hl7Cfg.receivingApplication,
+			ReceivingFacility:    a.cfg.hl7Cfg.receivingFacility,
+			AckTimeout:           a.cfg.hl7Cfg.ackTimeout,
+			MaxAttempts:          a.cfg.hl7Cfg.maxAttempts,
+			Location:             a.cfg.location,
+		},
+	)
+
+	// Submission to SATUSEHAT is off until the facility's credentials are set.
+	var satusehatClient satusehat.Client
+	if a.cfg.satusehatCfg.clientID != "" {
+		satusehatClient = satusehat.NewHTTPClient(satusehat.Config{
+			AuthURL:      a.cfg.satusehatCfg.authURL,
+			BaseURL:      a.cfg.satusehatCfg.baseURL,
+			ClientID:     a.cfg.satusehatCfg.clientID,
+			ClientSecret: a.cfg.satusehatCfg.clientSecret,
+			Timeout:      a.cfg.satusehatCfg.timeout,
+		})
+	}
+	satusehatService := service.NewSatuSehatService(
+		satusehatSyncRepo,
+		satusehatReferenceRepo,
+		appointmentRepo,
+		medicalRecordRepo,
+		patientRepo,
+		docRepo,
+		satusehatClient,
+		service.SatuSehatSettings{
+			OrganizationID: a.cfg.satusehatCfg.organizationID,
+			LocationID:     a.cfg.satusehatCfg.locationID,
+			MaxAttempts:    a.cfg.satusehatCfg.maxAttempts,
+			Location:       a.cfg.location,
+		},
+	)
+	outboxDispatcher := service.NewOutboxDispatcher(outboxRepo, activityRepo, eventBus, webhookService, hl7Service, satusehatService, a.cfg.outboxCfg.maxAttempts)
+
+	// All reminder channels use the local file/log backend until real
+	// providers are configured.
+	reminderSender := notify.NewFileSender(a.cfg.reminderCfg.outputFile)
+	reminderService := service.NewReminderService(
+		reminderRepo,
+		reminderPreferenceRepo,
+		appointmentRepo,
+		patientRepo,
+		docRepo,
+		appointmentLinkService,
+		reminderSender,
+		reminderSender,
+		reminderSender,
+		service.ReminderSettings{
+			Offsets:  a.cfg.reminderCfg.offsets,
+			Channels: a.cfg.reminderCfg.channels,
+			Language: a.cfg.reminderCfg.language,
+			Location: a.cfg.location,
+		},
+	)
+
+	// The gRPC API serves the same services to internal callers.
+	grpcServer := rpc.NewServer(
+		rpc.Settings{
+			Addr:      a.cfg.grpcCfg.addr,
+			JWTSecret: a.cfg.jwtSecret,
+		},
+		patientService,
+		docService,
+		appointmentService,
+		medicalRecordService,
+		eventBus,
+	)
+
+	graphqlServer := graph.NewServer(
+		a.cfg.graphqlCfg.complexityLimit,
+		patientService,
+		docService,
+		appointmentService,
+		medicalRecordService,
+		activityService,
+	)
+
+	// Start background workers
+	go outboxDispatcher.Run(ctx, a.cfg.outboxCfg.dispatchInterval)
+	go pharmacyService.RunExpiryScanner(ctx, a.cfg.pharmacyCfg.expiryScanInterval)
+	go webhookService.RunDispatcher(ctx, a.cfg.webhookCfg.dispatchInterval)
+	go reminderService.RunScheduler(ctx, a.cfg.reminderCfg.scanInterval)
+	go importService.RunWorker(ctx, a.cfg.importCfg.pollInterval)
+	go hl7Service.Listen(ctx)
+	go hl7Service.RunSender(ctx, a.cfg.hl7Cfg.sendInterval)
+	go satusehatService.Run(ctx, a.cfg.satusehatCfg.syncInterval)
+	go grpcServer.Listen(ctx)
 
 	// Initialize handlers
-	patientHandler := handlers.NewPatientHandler(patientService)
+	patientHandler := handlers.NewPatientHandler(patientService, exportService)
 	docHandler := handlers.NewDoctorHandler(docService)
-	appointmentHandler := handlers.NewAppointmentHandler(appointmentService)
-	medicalRecordHandler := handlers.NewMedicalRecordHandler(medicalRecordService)
+	appointmentHandler := handlers.NewAppointmentHandler(appointmentService, exportService)
+	medicalRecordHandler := handlers.NewMedicalRecordHandler(medicalRecordService, exportService)
 	dashboardHandler := handlers.NewDashboardHandler(dashboardService)
 	authHandler := handlers.NewAuthHandler(authService)
 	userHandler := handlers.NewUserHandler(userService)
-	activityHandler := handlers.NewActivityHandler(activityService)
+	activityHandler := handlers.NewActivityHandler(activityService, exportService)
+	labHandler := handlers.NewLabHandler(labService)
+	wardHandler := handlers.NewWardHandler(wardService)
+	admissionHandler := handlers.NewAdmissionHandler(admissionService)
+	billingHandler := handlers.NewBillingHandler(billingService)
+	insuranceHandler := handlers.NewInsuranceHandler(insuranceService)
+	pharmacyHandler := handlers.NewPharmacyHandler(pharmacyService)
+	eventHandler := handlers.NewEventHandler(eventBus)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	outboxHandler := handlers.NewOutboxHandler(outboxDispatcher)
+	reminderHandler := handlers.NewReminderHandler(reminderService)
+	appointmentLinkHandler := handlers.NewAppointmentLinkHandler(appointmentLinkService)
+	documentHandler := handlers.NewDocumentHandler(documentService)
+	queueHandler := handlers.NewQueueHandler(queueService)
+	triageHandler := handlers.NewTriageHandler(triageService)
+	referralHandler := handlers.NewReferralHandler(referralService)
+	facilityHandler := handlers.NewFacilityHandler(facilityService)
+	rosterHandler := handlers.NewRosterHandler(rosterService)
+	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService, exportService)
+	importHandler := handlers.NewImportHandler(importService, exportService)
+	fhirHandler := handlers.NewFHIRHandler(fhirService)
+	hl7Handler := handlers.NewHL7Handler(hl7Service)
+	satusehatHandler := handlers.NewSatuSehatHandler(satusehatService)
+	graphqlHandler := handlers.NewGraphQLHandler(graphqlServer)
 
 	api := a.f.Group("/api")
 
@@ -66,6 +395,12 @@ func (a *App) setupRoutes() {
 	auth := api.Group("/auth")
 	auth.Post("/login", authHandler.Login)
 
+	// Public routes used by patients from reminder links
+	public := api.Group("/public")
+	public.Get("/appointments/respond", appointmentLinkHandler.Inspect)
+	public.Post("/appointments/respond", appointmentLinkHandler.Apply)
+	public.Get("/documents/verify", documentHandler.Verify)
+
 	// Middleware
 	jwt := JWTMiddleware(a.cfg.jwtSecret)
 
@@ -82,10 +417,23 @@ func (a *App) setupRoutes() {
 	dashboard := api.Group("/dashboard", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleManagement))
 	dashboard.Get("/", dashboardHandler.GetDashboardData)
 
+	// Analytics routes
+	analytics := api.Group("/analytics", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleManagement))
+	analytics.Get("/appointments", analyticsHandler.GetAppointmentTrend)
+	analytics.Get("/appointments/rates", analyticsHandler.GetAppointmentRates)
+	analytics.Get("/patients", analyticsHandler.GetPatientMix)
+	analytics.Get("/diagnoses", analyticsHandler.GetTopDiagnoses)
+	analytics.Get("/doctors", analyticsHandler.GetDoctorUtilization)
+	analytics.Get("/doctors/export", analyticsHandler.ExportDoctorUtilization)
+
 	patients := api.Group("/patients", jwt)
 	patients.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), patientHandler.GetAll)
 	patients.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), patientHandler.GetByID)
 	patients.Get("/:id/detail", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), patientHandler.GetPatientDetail) // New endpoint for detailed patient info
+	patients.Get("/:id/pdf", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), documentHandler.GetPatientHistory)
+	patients.Get("/:id/balance", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), billingHandler.GetPatientBalance)
+	patients.Get("/:id/reminder-preferences", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), reminderHandler.GetPreference)
+	patients.Put("/:id/reminder-preferences", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), reminderHandler.UpdatePreference)
 	patients.Post("/", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), patientHandler.Create)
 	patients.Put("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), patientHandler.Update)
 	patients.Delete("/:id", RBACMiddleware(domain.RoleAdmin), patientHandler.Delete)
@@ -98,28 +446,238 @@ func (a *App) setupRoutes() {
 	doctors.Put("/:id", RBACMiddleware(domain.RoleAdmin), docHandler.Update)
 	doctors.Delete("/:id", RBACMiddleware(domain.RoleAdmin), docHandler.Delete)
 
+	imports := api.Group("/import", jwt)
+	imports.Post("/patients", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), importHandler.ImportPatients)
+	imports.Post("/doctors", RBACMiddleware(domain.RoleAdmin), importHandler.ImportDoctors)
+	imports.Get("/jobs", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), importHandler.GetJobs)
+	imports.Get("/jobs/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), importHandler.GetJob)
+	imports.Get("/jobs/:id/result", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), importHandler.GetJobResult)
+
 	appointments := api.Group("/appointments", jwt)
 	appointments.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), appointmentHandler.GetAll)
 	appointments.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), appointmentHandler.GetByID)
 	appointments.Get("/:id/detail", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), appointmentHandler.GetAppointmentDetail) // New endpoint for detailed appointment info
+	appointments.Get("/:id/pdf", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), documentHandler.GetVisitSummary)
 	appointments.Post("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), appointmentHandler.Create)
 	appointments.Put("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), appointmentHandler.Update)
 	appointments.Put("/:id/status", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), appointmentHandler.HandleUpdateAppointmentStatus)
+	appointments.Put("/:id/eligibility", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), insuranceHandler.CheckEligibility)
+	appointments.Put("/:id/triage", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse), triageHandler.TriageAppointment)
 	appointments.Delete("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), appointmentHandler.Delete)
 
+	reminders := api.Group("/reminders", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement))
+	reminders.Get("/", reminderHandler.GetReminders)
+
+	queues := api.Group("/queues", jwt)
+	queues.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), queueHandler.GetQueues)
+	queues.Post("/tickets", RBACMiddleware(domain.RoleAdmin, domain.RoleNurse, domain.RoleReceptionist), queueHandler.IssueWalkIn)
+	queues.Post("/check-in", RBACMiddleware(domain.RoleAdmin, domain.RoleNurse, domain.RoleReceptionist), queueHandler.CheckIn)
+	queues.Put("/tickets/:id/skip", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), queueHandler.SkipTicket)
+	queues.Put("/tickets/:id/recall", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), queueHandler.RecallTicket)
+	queues.Put("/tickets/:id/complete", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse), queueHandler.CompleteTicket)
+	queues.Put("/tickets/:id/triage", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse), triageHandler.TriageTicket)
+	queues.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), queueHandler.GetQueueDetail)
+	queues.Get("/:id/board", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), queueHandler.GetBoard)
+	queues.Post("/:id/call-next", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse), queueHandler.CallNext)
+
+	referrals := api.Group("/referrals", jwt)
+	referrals.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), referralHandler.GetAll)
+	referrals.Get("/inbox", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), referralHandler.GetInbox)
+	referrals.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), referralHandler.GetByID)
+	referrals.Post("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor), referralHandler.Create)
+	referrals.Put("/:id/respond", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor), referralHandler.Respond)
+	referrals.Put("/:id/appointment", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleReceptionist), referralHandler.LinkAppointment)
+	referrals.Put("/:id/complete", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor), referralHandler.Complete)
+
+	departments := api.Group("/departments", jwt)
+	departments.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), facilityHandler.GetAllDepartments)
+	departments.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), facilityHandler.GetDepartmentByID)
+	departments.Post("/", RBACMiddleware(domain.RoleAdmin), facilityHandler.CreateDepartment)
+	departments.Put("/:id", RBACMiddleware(domain.RoleAdmin), facilityHandler.UpdateDepartment)
+
+	clinics := api.Group("/clinics", jwt)
+	clinics.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), facilityHandler.GetAllClinics)
+	clinics.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), facilityHandler.GetClinicByID)
+	clinics.Post("/", RBACMiddleware(domain.RoleAdmin), facilityHandler.CreateClinic)
+	clinics.Put("/:id", RBACMiddleware(domain.RoleAdmin), facilityHandler.UpdateClinic)
+
+	clinicRooms := api.Group("/clinic-rooms", jwt)
+	clinicRooms.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), facilityHandler.GetAllRooms)
+	clinicRooms.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), facilityHandler.GetRoomByID)
+	clinicRooms.Post("/", RBACMiddleware(domain.RoleAdmin), facilityHandler.CreateRoom)
+	clinicRooms.Put("/:id", RBACMiddleware(domain.RoleAdmin), facilityHandler.UpdateRoom)
+
+	roster := api.Group("/roster", jwt)
+	roster.Get("/templates", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement, domain.RoleLab), rosterHandler.GetAllTemplates)
+	roster.Get("/templates/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement, domain.RoleLab), rosterHandler.GetTemplateByID)
+	roster.Post("/templates", RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), rosterHandler.CreateTemplate)
+	roster.Put("/templates/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), rosterHandler.UpdateTemplate)
+	roster.Get("/assignments", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement, domain.RoleLab), rosterHandler.GetAssignments)
+	roster.Post("/assignments", RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), rosterHandler.CreateAssignment)
+	roster.Put("/assignments/:id/cancel", RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), rosterHandler.CancelAssignment)
+	roster.Get("/swaps", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement, domain.RoleLab), rosterHandler.GetSwaps)
+	roster.Post("/swaps", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement, domain.RoleLab), rosterHandler.RequestSwap)
+	roster.Put("/swaps/:id/decide", RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), rosterHandler.DecideSwap)
+	roster.Get("/coverage", RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), rosterHandler.GetCoverage)
+	roster.Get("/on-call", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement, domain.RoleLab), rosterHandler.GetOnCall)
+
 	records := api.Group("/records", jwt)
 	records.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), medicalRecordHandler.GetAll)
 	records.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), medicalRecordHandler.GetByID)
+	records.Get("/:id/pdf", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), documentHandler.GetMedicalRecord)
 	records.Post("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor), medicalRecordHandler.Create)
 	records.Put("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor), medicalRecordHandler.Update)
 	records.Delete("/:id", RBACMiddleware(domain.RoleAdmin), medicalRecordHandler.Delete)
 
+	labs := api.Group("/labs", jwt)
+	labs.Get("/worklist", RBACMiddleware(domain.RoleAdmin, domain.RoleLab), labHandler.GetWorklist)
+	labs.Get("/orders", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleLab), labHandler.GetAllOrders)
+	labs.Get("/orders/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleLab), labHandler.GetOrderByID)
+	labs.Get("/patients/:patientId", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleLab), labHandler.GetPatientOrders)
+	labs.Post("/orders", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor), labHandler.CreateOrder)
+	labs.Put("/orders/:id/status", RBACMiddleware(domain.RoleAdmin, domain.RoleLab), labHandler.UpdateOrderStatus)
+	labs.Put("/orders/:id/results", RBACMiddleware(domain.RoleLab), labHandler.EnterResults)
+
+	wards := api.Group("/wards", jwt)
+	wards.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), wardHandler.GetAllWards)
+	wards.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), wardHandler.GetWardByID)
+	wards.Get("/:id/rooms", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), wardHandler.GetWardRooms)
+	wards.Get("/:id/beds", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), wardHandler.GetWardBeds)
+	wards.Post("/", RBACMiddleware(domain.RoleAdmin), wardHandler.CreateWard)
+	wards.Put("/:id", RBACMiddleware(domain.RoleAdmin), wardHandler.UpdateWard)
+
+	rooms := api.Group("/rooms", jwt)
+	rooms.Post("/", RBACMiddleware(domain.RoleAdmin), wardHandler.CreateRoom)
+
+	beds := api.Group("/beds", jwt)
+	beds.Get("/board", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), wardHandler.GetOccupancyBoard)
+	beds.Post("/", RBACMiddleware(domain.RoleAdmin), wardHandler.CreateBed)
+	beds.Put("/:id/status", RBACMiddleware(domain.RoleAdmin, domain.RoleNurse), wardHandler.UpdateBedStatus)
+
+	admissions := api.Group("/admissions", jwt)
+	admissions.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), admissionHandler.GetAll)
+	admissions.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), admissionHandler.GetByID)
+	admissions.Post("/", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), admissionHandler.Admit)
+	admissions.Put("/:id/transfer", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse), admissionHandler.Transfer)
+	admissions.Put("/:id/discharge", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor), admissionHandler.Discharge)
+
+	tariffs := api.Group("/tariffs", jwt)
+	tariffs.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), billingHandler.GetAllTariffs)
+	tariffs.Post("/", RBACMiddleware(domain.RoleAdmin), billingHandler.CreateTariff)
+	tariffs.Put("/:id", RBACMiddleware(domain.RoleAdmin), billingHandler.UpdateTariff)
+
+	invoices := api.Group("/invoices", jwt)
+	invoices.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), billingHandler.GetAllInvoices)
+	invoices.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), billingHandler.GetInvoiceByID)
+	invoices.Get("/:id/pdf", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), documentHandler.GetInvoice)
+	invoices.Get("/:id/payments", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), billingHandler.GetInvoicePayments)
+	invoices.Post("/", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), billingHandler.CreateInvoice)
+	invoices.Put("/:id/void", RBACMiddleware(domain.RoleAdmin), billingHandler.VoidInvoice)
+
+	payments := api.Group("/payments", jwt)
+	payments.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), billingHandler.GetAllPayments)
+	payments.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), billingHandler.GetPaymentByID)
+	payments.Post("/", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), billingHandler.RecordPayment)
+	payments.Post("/:id/refund", RBACMiddleware(domain.RoleAdmin), billingHandler.RefundPayment)
+
+	insurance := api.Group("/insurance", jwt)
+	insurance.Get("/policies", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), insuranceHandler.GetPolicies)
+	insurance.Get("/policies/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), insuranceHandler.GetPolicyByID)
+	insurance.Post("/policies", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), insuranceHandler.CreatePolicy)
+	insurance.Put("/policies/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), insuranceHandler.UpdatePolicy)
+
+	claims := api.Group("/claims", jwt)
+	claims.Get("/", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), insuranceHandler.GetClaims)
+	claims.Get("/export", RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), insuranceHandler.ExportClaims)
+	claims.Get("/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist, domain.RoleManagement), insuranceHandler.GetClaimByID)
+	claims.Post("/", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), insuranceHandler.CreateClaim)
+	claims.Put("/:id/submit", RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), insuranceHandler.SubmitClaim)
+	claims.Put("/:id/status", RBACMiddleware(domain.RoleAdmin), insuranceHandler.UpdateClaimStatus)
+
+	pharmacy := api.Group("/pharmacy", jwt)
+	pharmacy.Get("/items", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), pharmacyHandler.GetAllItems)
+	pharmacy.Get("/items/:id", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), pharmacyHandler.GetItemByID)
+	pharmacy.Post("/items", RBACMiddleware(domain.RoleAdmin), pharmacyHandler.CreateItem)
+	pharmacy.Put("/items/:id", RBACMiddleware(domain.RoleAdmin), pharmacyHandler.UpdateItem)
+	pharmacy.Get("/stock", RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), pharmacyHandler.GetStockLevels)
+	pharmacy.Post("/stock/receive", RBACMiddleware(domain.RoleAdmin, domain.RoleNurse), pharmacyHandler.ReceiveStock)
+	pharmacy.Post("/stock/adjust", RBACMiddleware(domain.RoleAdmin), pharmacyHandler.AdjustStock)
+	pharmacy.Get("/batches", RBACMiddleware(domain.RoleAdmin, domain.RoleNurse, domain.RoleManagement), pharmacyHandler.GetBatches)
+	pharmacy.Post("/batches/:id/expire", RBACMiddleware(domain.RoleAdmin), pharmacyHandler.ExpireBatch)
+	pharmacy.Post("/dispense", RBACMiddleware(domain.RoleAdmin, domain.RoleNurse), pharmacyHandler.Dispense)
+	pharmacy.Get("/movements", RBACMiddleware(domain.RoleAdmin, domain.RoleNurse, domain.RoleManagement), pharmacyHandler.GetMovements)
+	pharmacy.Get("/alerts", RBACMiddleware(domain.RoleAdmin, domain.RoleNurse, domain.RoleManagement), pharmacyHandler.GetAlerts)
+
+	api.Get("/events", QueryTokenMiddleware(), jwt, eventHandler.Stream)
+
+	webhooks := api.Group("/webhooks", jwt, RBACMiddleware(domain.RoleAdmin))
+	webhooks.Get("/", webhookHandler.GetAllSubscriptions)
+	webhooks.Post("/", webhookHandler.CreateSubscription)
+	webhooks.Get("/deliveries", webhookHandler.GetDeliveries)
+	webhooks.Post("/deliveries/:id/retry", webhookHandler.RetryDelivery)
+	webhooks.Get("/:id", webhookHandler.GetSubscriptionByID)
+	webhooks.Put("/:id", webhookHandler.UpdateSubscription)
+	webhooks.Delete("/:id", webhookHandler.DeleteSubscription)
+	webhooks.Post("/:id/test", webhookHandler.SendTest)
+
+	// Events the outbox gave up on (Admin only)
+	outbox := api.Group("/outbox", jwt, RBACMiddleware(domain.RoleAdmin))
+	outbox.Get("/dead", outboxHandler.GetDead)
+	outbox.Post("/:id/requeue", outboxHandler.Requeue)
+
+	// HL7 v2 message log (Admin only)
+	hl7Messages := api.Group("/hl7/messages", jwt, RBACMiddleware(domain.RoleAdmin))
+	hl7Messages.Get("/", hl7Handler.GetMessages)
+	hl7Messages.Get("/:id", hl7Handler.GetMessageByID)
+	hl7Messages.Post("/:id/retry", hl7Handler.RetryMessage)
+
+	// SATUSEHAT sync log, reference mappings and reconciliation
+	satusehatAPI := api.Group("/satusehat", jwt)
+	satusehatAPI.Get("/reconciliation", RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), satusehatHandler.GetReconciliation)
+	satusehatAPI.Get("/syncs", RBACMiddleware(domain.RoleAdmin), satusehatHandler.GetSyncs)
+	satusehatAPI.Post("/syncs/backfill", RBACMiddleware(domain.RoleAdmin), satusehatHandler.Backfill)
+	satusehatAPI.Get("/syncs/:id", RBACMiddleware(domain.RoleAdmin), satusehatHandler.GetSyncByID)
+	satusehatAPI.Post("/syncs/:id/retry", RBACMiddleware(domain.RoleAdmin), satusehatHandler.RetrySync)
+	satusehatAPI.Get("/references", RBACMiddleware(domain.RoleAdmin), satusehatHandler.GetReferences)
+	satusehatAPI.Put("/references/:kind/:localId", RBACMiddleware(domain.RoleAdmin), satusehatHandler.SetReference)
+	satusehatAPI.Delete("/references/:kind/:localId", RBACMiddleware(domain.RoleAdmin), satusehatHandler.DeleteReference)
+
 	activities := api.Group("/activities", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleManagement))
 	activities.Get("/", activityHandler.HandleGetAllActivities)
 
+	// GraphQL checks each query and restricted field against the caller's role
+	api.Post("/graphql", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), graphqlHandler.HandleQuery)
+
 	api.Get("/docs/*", swagger.HandlerDefault)
 
 	api.Get("/health", healthCheck)
+
+	// The FHIR facade sits outside /api: it answers in FHIR JSON rather than
+	// the API's response envelope. Each resource type takes the roles of the
+	// REST resource it maps.
+	fhirAPI := a.f.Group("/fhir/R4", fhirHandler.Negotiate)
+	fhirAPI.Get("/metadata", fhirHandler.Metadata)
+
+	fhirAPI.Get("/Patient", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), fhirHandler.Search(fhir.TypePatient))
+	fhirAPI.Get("/Patient/:id", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), fhirHandler.Read(fhir.TypePatient))
+	fhirAPI.Post("/Patient", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleReceptionist), fhirHandler.Create(fhir.TypePatient))
+
+	fhirAPI.Get("/Practitioner", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), fhirHandler.Search(fhir.TypePractitioner))
+	fhirAPI.Get("/Practitioner/:id", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleManagement), fhirHandler.Read(fhir.TypePractitioner))
+	fhirAPI.Post("/Practitioner", jwt, RBACMiddleware(domain.RoleAdmin), fhirHandler.Create(fhir.TypePractitioner))
+
+	fhirAPI.Get("/Appointment", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), fhirHandler.Search(fhir.TypeAppointment))
+	fhirAPI.Get("/Appointment/:id", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement), fhirHandler.Read(fhir.TypeAppointment))
+	fhirAPI.Post("/Appointment", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist), fhirHandler.Create(fhir.TypeAppointment))
+
+	fhirAPI.Get("/Encounter", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), fhirHandler.Search(fhir.TypeEncounter))
+	fhirAPI.Get("/Encounter/:id", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), fhirHandler.Read(fhir.TypeEncounter))
+	fhirAPI.Post("/Encounter", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor), fhirHandler.Create(fhir.TypeEncounter))
+
+	fhirAPI.Get("/Condition", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), fhirHandler.Search(fhir.TypeCondition))
+	fhirAPI.Get("/Condition/:id", jwt, RBACMiddleware(domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement), fhirHandler.Read(fhir.TypeCondition))
+
+	fhirAPI.Use(fhirHandler.NotFound)
 }
 
 // @Summary		Health check endpoint
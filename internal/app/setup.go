@@ -2,11 +2,16 @@ package app
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/ekastn/hms-api/internal/domain"
 	"github.com/ekastn/hms-api/internal/env"
+	"github.com/ekastn/hms-api/internal/repository"
 	"github.com/ekastn/hms-api/internal/utils"
 	"github.com/joho/godotenv"
 	"go.mongodb.org/mongo-driver/bson"
@@ -32,9 +37,28 @@ func (a *App) connectDb() {
 
 	a.db = client.Database(a.cfg.mongoCfg.db)
 
+	a.ensureIndexes(ctx)
 	a.createInitialAdmin()
 }
 
+// ensureIndexes creates the indexes the repositories rely on for correctness.
+func (a *App) ensureIndexes(ctx context.Context) {
+	reminderRepo := repository.NewReminderRepository(a.db.Collection("reminders"))
+	if err := reminderRepo.EnsureIndexes(ctx); err != nil {
+		log.Fatalf("failed to create reminder indexes: %v", err)
+	}
+
+	queueRepo := repository.NewQueueRepository(a.db.Collection("queues"))
+	if err := queueRepo.EnsureIndexes(ctx); err != nil {
+		log.Fatalf("failed to create queue indexes: %v", err)
+	}
+
+	admissionRepo := repository.NewAdmissionRepository(a.db.Collection("admissions"))
+	if err := admissionRepo.EnsureIndexes(ctx); err != nil {
+		log.Fatalf("failed to create admission indexes: %v", err)
+	}
+}
+
 func (a *App) loadConfig() {
 	err := godotenv.Load()
 	if err != nil {
@@ -42,17 +66,151 @@ func (a *App) loadConfig() {
 	}
 
 	cfg := config{
-		addr: env.GetString("APP_ADDR", ":3000"),
+		addr:     env.GetString("APP_ADDR", ":3000"),
+		location: loadLocation(env.GetString("APP_TIMEZONE", "Asia/Jakarta")),
 		mongoCfg: mongoDbCfg{
 			addr: env.GetString("MONGO_ADDR", "mongodb://localhost:27017"),
 			db:   env.GetString("MONGO_DB", "hms"),
 		},
 		jwtSecret: env.GetString("JWT_SECRET", "your-secret-key"),
+		pharmacyCfg: pharmacyCfg{
+			nearExpiryDays:     env.GetInt("PHARMACY_NEAR_EXPIRY_DAYS", 90),
+			expiryScanInterval: time.Duration(env.GetInt("PHARMACY_EXPIRY_SCAN_INTERVAL_HOURS", 24)) * time.Hour,
+		},
+		eventsCfg: eventsCfg{
+			historySize: env.GetInt("EVENTS_HISTORY_SIZE", 1000),
+		},
+		outboxCfg: outboxCfg{
+			dispatchInterval: time.Duration(env.GetInt("OUTBOX_DISPATCH_INTERVAL_MS", 500)) * time.Millisecond,
+			maxAttempts:      env.GetInt("OUTBOX_MAX_ATTEMPTS", 10),
+		},
+		reminderCfg: reminderCfg{
+			offsets:      parseDurations(env.GetString("REMINDER_OFFSETS", "24h,2h")),
+			channels:     parseReminderChannels(env.GetString("REMINDER_CHANNELS", "email,sms")),
+			language:     domain.ReminderLanguage(env.GetString("REMINDER_DEFAULT_LANGUAGE", "id")),
+			scanInterval: time.Duration(env.GetInt("REMINDER_SCAN_INTERVAL_SECONDS", 60)) * time.Second,
+			outputFile:   env.GetString("REMINDER_OUTPUT_FILE", ""),
+		},
+		queueCfg: queueCfg{
+			defaultDuration: env.GetInt("QUEUE_DEFAULT_DURATION_MINUTES", 15),
+		},
+		rosterCfg: rosterCfg{
+			availabilitySource: domain.AvailabilitySource(env.GetString("DOCTOR_AVAILABILITY_SOURCE", string(domain.AvailabilityNone))),
+		},
+		linkCfg: appointmentLinkCfg{
+			secret:       env.GetString("APPOINTMENT_LINK_SECRET", ""),
+			baseURL:      env.GetString("APPOINTMENT_LINK_BASE_URL", "http://localhost:5173/appointments/respond"),
+			cancelCutoff: time.Duration(env.GetInt("APPOINTMENT_CANCEL_CUTOFF_HOURS", 4)) * time.Hour,
+		},
+		documentCfg: documentCfg{
+			hospitalName:    env.GetString("HOSPITAL_NAME", "HMS Hospital"),
+			hospitalAddress: env.GetString("HOSPITAL_ADDRESS", ""),
+			hospitalPhone:   env.GetString("HOSPITAL_PHONE", ""),
+			secret:          env.GetString("DOCUMENT_SIGNING_SECRET", ""),
+			verifyURL:       env.GetString("DOCUMENT_VERIFY_BASE_URL", "http://localhost:5173/documents/verify"),
+		},
+		importCfg: importCfg{
+			pollInterval: time.Duration(env.GetInt("IMPORT_POLL_INTERVAL_SECONDS", 5)) * time.Second,
+		},
+		fhirCfg: fhirCfg{
+			baseURL: env.GetString("FHIR_BASE_URL", "http://localhost:5021/fhir/R4"),
+		},
+		hl7Cfg: hl7Cfg{
+			listenAddr:           env.GetString("HL7_LISTEN_ADDR", ""),
+			sendAddr:             env.GetString("HL7_SEND_ADDR", ""),
+			facility:             env.GetString("HL7_FACILITY", "HMS"),
+			receivingApplication: env.GetString("HL7_RECEIVING_APPLICATION", ""),
+			receivingFacility:    env.GetString("HL7_RECEIVING_FACILITY", ""),
+			ackTimeout:           time.Duration(env.GetInt("HL7_ACK_TIMEOUT_SECONDS", 30)) * time.Second,
+			maxAttempts:          env.GetInt("HL7_MAX_ATTEMPTS", 8),
+			sendInterval:         time.Duration(env.GetInt("HL7_SEND_INTERVAL_SECONDS", 5)) * time.Second,
+		},
+		satusehatCfg: satusehatCfg{
+			authURL:        env.GetString("SATUSEHAT_AUTH_URL", "https://api-satusehat-stg.dto.kemkes.go.id/oauth2/v1"),
+			baseURL:        env.GetString("SATUSEHAT_BASE_URL", "https://api-satusehat-stg.dto.kemkes.go.id/fhir-r4/v1"),
+			clientID:       env.GetString("SATUSEHAT_CLIENT_ID", ""),
+			clientSecret:   env.GetString("SATUSEHAT_CLIENT_SECRET", ""),
+			organizationID: env.GetString("SATUSEHAT_ORGANIZATION_ID", ""),
+			locationID:     env.GetString("SATUSEHAT_LOCATION_ID", ""),
+			timeout:        time.Duration(env.GetInt("SATUSEHAT_TIMEOUT_SECONDS", 30)) * time.Second,
+			maxAttempts:    env.GetInt("SATUSEHAT_MAX_ATTEMPTS", 8),
+			syncInterval:   time.Duration(env.GetInt("SATUSEHAT_SYNC_INTERVAL_SECONDS", 30)) * time.Second,
+		},
+		grpcCfg: grpcCfg{
+			addr: env.GetString("GRPC_ADDR", ""),
+		},
+		graphqlCfg: graphqlCfg{
+			complexityLimit: env.GetInt("GRAPHQL_COMPLEXITY_LIMIT", 1000),
+		},
+		webhookCfg: webhookCfg{
+			maxAttempts:      env.GetInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			timeout:          time.Duration(env.GetInt("WEBHOOK_TIMEOUT_SECONDS", 10)) * time.Second,
+			dispatchInterval: time.Duration(env.GetInt("WEBHOOK_DISPATCH_INTERVAL_SECONDS", 5)) * time.Second,
+		},
+	}
+
+	// Link tokens must never be accepted as login tokens, so they are signed
+	// with their own key, derived from the JWT secret when none is set.
+	if cfg.linkCfg.secret == "" {
+		mac := hmac.New(sha256.New, []byte(cfg.jwtSecret))
+		mac.Write([]byte("appointment-links"))
+		cfg.linkCfg.secret = hex.EncodeToString(mac.Sum(nil))
+	}
+	if cfg.documentCfg.secret == "" {
+		mac := hmac.New(sha256.New, []byte(cfg.jwtSecret))
+		mac.Write([]byte("documents"))
+		cfg.documentCfg.secret = hex.EncodeToString(mac.Sum(nil))
+	}
+
+	if !cfg.rosterCfg.availabilitySource.IsValid() {
+		log.Printf("invalid DOCTOR_AVAILABILITY_SOURCE %q, falling back to %q", cfg.rosterCfg.availabilitySource, domain.AvailabilityNone)
+		cfg.rosterCfg.availabilitySource = domain.AvailabilityNone
 	}
 
 	a.cfg = cfg
 }
 
+// parseDurations parses a comma-separated list such as "24h,2h", skipping
+// invalid entries.
+func parseDurations(list string) []time.Duration {
+	var durations []time.Duration
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		d, err := time.ParseDuration(part)
+		if err != nil || d <= 0 {
+			log.Printf("ignoring invalid duration %q", part)
+			continue
+		}
+		durations = append(durations, d)
+	}
+	return durations
+}
+
+func parseReminderChannels(list string) []domain.ReminderChannel {
+	var channels []domain.ReminderChannel
+	for _, part := range strings.Split(list, ",") {
+		channel := domain.ReminderChannel(strings.TrimSpace(part))
+		if !channel.IsValid() {
+			log.Printf("ignoring invalid reminder channel %q", part)
+			continue
+		}
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+func loadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		log.Printf("unknown time zone %q, using local time: %v", name, err)
+		return time.Local
+	}
+	return loc
+}
+
 func (a *App) createInitialAdmin() {
 	initialAdminEmail := env.GetString("INITIAL_ADMIN_EMAIL", "")
 	initialAdminPassword := env.GetString("INITIAL_ADMIN_PASSWORD", "")
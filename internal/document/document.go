@@ -0,0 +1,160 @@
+// Package document renders printable patient documents as PDF. Every document
+// is a bundled template that writes a simple line-based layout, drawn below a
+// header with the hospital's name, the patient's identifiers and a QR code
+// linking to the document's verification page.
+//
+// Each layout line starts with a directive:
+//
+//	heading Text          section heading
+//	field Label|Value     label and value on one line
+//	text Text             wrapped paragraph
+//	note Text             small grey paragraph
+//	table Col:2|Col:1     starts a table, the numbers being relative widths
+//	row a|b               table row
+//	total Label|Value     right-aligned line below a table
+//
+// Values are passed through the cell function so they cannot break a line.
+package document
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/skip2/go-qrcode"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// Hospital is printed at the top of every page.
+type Hospital struct {
+	Name    string
+	Address string
+	Phone   string
+}
+
+// Patient identifies the patient on every page.
+type Patient struct {
+	ID     string
+	Name   string
+	Age    int
+	Gender string
+}
+
+// Document is a template together with the data to fill it with.
+type Document struct {
+	// Template is the name of a bundled template, e.g. "visit-summary".
+	Template  string
+	Title     string
+	Patient   Patient
+	IssuedAt  time.Time
+	VerifyURL string
+	Data      any
+}
+
+// Renderer renders documents with the bundled templates.
+type Renderer struct {
+	hospital  Hospital
+	location  *time.Location
+	templates *template.Template
+}
+
+// NewRenderer parses the bundled templates. Dates are printed in loc.
+func NewRenderer(hospital Hospital, loc *time.Location) *Renderer {
+	r := &Renderer{hospital: hospital, location: loc}
+	r.templates = template.Must(template.New("").Funcs(r.funcs()).ParseFS(templateFS, "templates/*.tmpl"))
+	return r
+}
+
+// Render writes the document to w as PDF.
+func (r *Renderer) Render(w io.Writer, doc *Document) error {
+	var layout bytes.Buffer
+	if err := r.templates.ExecuteTemplate(&layout, doc.Template+".tmpl", doc.Data); err != nil {
+		return fmt.Errorf("failed to fill %s template: %w", doc.Template, err)
+	}
+
+	qr, err := qrcode.Encode(doc.VerifyURL, qrcode.Medium, 256)
+	if err != nil {
+		return fmt.Errorf("failed to encode verification code: %w", err)
+	}
+
+	p := newPage(r.hospital, doc, qr, r.location)
+	for n, line := range strings.Split(layout.String(), "\n") {
+		if err := p.draw(strings.TrimSpace(line)); err != nil {
+			return fmt.Errorf("%s template line %d: %w", doc.Template, n+1, err)
+		}
+	}
+	return p.output(w)
+}
+
+func (r *Renderer) funcs() template.FuncMap {
+	return template.FuncMap{
+		"date": func(v any) string {
+			return r.formatTime(v, "02 Jan 2006")
+		},
+		"datetime": func(v any) string {
+			return r.formatTime(v, "02 Jan 2006 15:04")
+		},
+		"money": formatMoney,
+		"cell":  cell,
+	}
+}
+
+// formatTime formats a time.Time, *time.Time or RFC 3339 string in the
+// hospital's time zone, and anything missing as a dash.
+func (r *Renderer) formatTime(v any, layout string) string {
+	var t time.Time
+	switch v := v.(type) {
+	case time.Time:
+		t = v
+	case *time.Time:
+		if v != nil {
+			t = *v
+		}
+	case string:
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return cell(v)
+		}
+		t = parsed
+	}
+	if t.IsZero() {
+		return "-"
+	}
+	return t.In(r.location).Format(layout)
+}
+
+// formatMoney formats an amount in minor units, e.g. 15000000 IDR as
+// "IDR 150,000.00".
+func formatMoney(amount int64, currency string) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	whole := fmt.Sprint(amount / 100)
+	var grouped strings.Builder
+	for i, d := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(d)
+	}
+	return fmt.Sprintf("%s %s%s.%02d", currency, sign, grouped.String(), amount%100)
+}
+
+// cell makes a value safe to put in a layout line, printing missing values
+// as a dash.
+func cell(v any) string {
+	s := strings.TrimSpace(fmt.Sprint(v))
+	if v == nil || s == "" {
+		return "-"
+	}
+	s = strings.NewReplacer("|", "/", "\r\n", " ", "\n", " ", "\r", " ").Replace(s)
+	return s
+}
@@ -0,0 +1,271 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	fontFamily = "Helvetica"
+	qrSize     = 24.0
+	lineHeight = 4.5
+	labelWidth = 45.0
+	totalWidth = 45.0
+	cellPad    = 1.0
+)
+
+// page draws the layout lines of one document.
+type page struct {
+	pdf   *fpdf.Fpdf
+	tr    func(string) string
+	width float64 // between the margins
+	left  float64
+
+	// The table being drawn, repeated at the top of each page it spans.
+	columns []float64
+	header  []string
+}
+
+func newPage(hospital Hospital, doc *Document, qr []byte, loc *time.Location) *page {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 12, 15)
+	pdf.SetAutoPageBreak(true, 18)
+	pdf.SetTitle(doc.Title, true)
+	pdf.SetCreator(hospital.Name, true)
+	pdf.AliasNbPages("")
+	pdf.RegisterImageOptionsReader("qr", fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(qr))
+
+	pageWidth, _ := pdf.GetPageSize()
+	left, top, right, _ := pdf.GetMargins()
+	p := &page{
+		pdf:   pdf,
+		tr:    pdf.UnicodeTranslatorFromDescriptor(""),
+		width: pageWidth - left - right,
+		left:  left,
+	}
+
+	pdf.SetHeaderFunc(func() {
+		pdf.ImageOptions("qr", pageWidth-right-qrSize, top, qrSize, qrSize, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, doc.VerifyURL)
+
+		textWidth := p.width - qrSize - 4
+		pdf.SetXY(left, top+2)
+		pdf.SetFont(fontFamily, "B", 14)
+		pdf.CellFormat(textWidth, 7, p.tr(hospital.Name), "", 1, "L", false, 0, "")
+		pdf.SetFont(fontFamily, "", 9)
+		for _, line := range []string{hospital.Address, hospital.Phone} {
+			if line != "" {
+				pdf.CellFormat(textWidth, lineHeight, p.tr(line), "", 1, "L", false, 0, "")
+			}
+		}
+
+		pdf.SetY(top + qrSize + 2)
+		pdf.SetFont(fontFamily, "B", 12)
+		pdf.CellFormat(p.width, 7, p.tr(doc.Title), "B", 1, "L", false, 0, "")
+
+		pdf.SetFont(fontFamily, "", 9)
+		identity := fmt.Sprintf("Patient: %s    Patient ID: %s", doc.Patient.Name, doc.Patient.ID)
+		if doc.Patient.Age > 0 || doc.Patient.Gender != "" {
+			identity += fmt.Sprintf("    Age/Sex: %d / %s", doc.Patient.Age, doc.Patient.Gender)
+		}
+		pdf.CellFormat(p.width, 6, p.tr(identity), "B", 1, "L", false, 0, "")
+		pdf.Ln(3)
+	})
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-14)
+		pdf.SetFont(fontFamily, "I", 7.5)
+		pdf.SetTextColor(110, 110, 110)
+		issued := fmt.Sprintf("Issued %s. Scan the QR code to verify this document.", doc.IssuedAt.In(loc).Format("02 Jan 2006 15:04 MST"))
+		pdf.CellFormat(p.width-30, lineHeight, p.tr(issued), "T", 0, "L", false, 0, "")
+		pdf.CellFormat(30, lineHeight, fmt.Sprintf("Page %d/{nb}", pdf.PageNo()), "T", 0, "R", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	})
+
+	pdf.AddPage()
+	return p
+}
+
+// draw draws one layout line.
+func (p *page) draw(line string) error {
+	if line == "" {
+		return nil
+	}
+
+	directive, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+	switch directive {
+	case "heading":
+		p.columns = nil
+		p.pdf.Ln(2)
+		p.pdf.SetFont(fontFamily, "B", 10.5)
+		p.pdf.CellFormat(p.width, 6.5, p.tr(arg), "B", 1, "L", false, 0, "")
+		p.pdf.Ln(1.5)
+	case "field":
+		label, value, _ := strings.Cut(arg, "|")
+		p.field(label, value)
+	case "text":
+		p.pdf.SetFont(fontFamily, "", 9)
+		p.paragraph(arg)
+	case "note":
+		p.pdf.SetFont(fontFamily, "I", 8)
+		p.pdf.SetTextColor(110, 110, 110)
+		p.paragraph(arg)
+		p.pdf.SetTextColor(0, 0, 0)
+	case "table":
+		return p.table(arg)
+	case "row":
+		if p.columns == nil {
+			return fmt.Errorf("row outside a table")
+		}
+		p.pdf.SetFont(fontFamily, "", 8.5)
+		p.row(strings.Split(arg, "|"), false)
+	case "total":
+		label, value, _ := strings.Cut(arg, "|")
+		p.pdf.SetFont(fontFamily, "B", 9)
+		p.pdf.CellFormat(p.width-totalWidth, lineHeight+1, p.tr(label), "", 0, "R", false, 0, "")
+		p.pdf.CellFormat(totalWidth, lineHeight+1, p.tr(value), "", 1, "R", false, 0, "")
+	default:
+		return fmt.Errorf("unknown directive %q", directive)
+	}
+	return p.pdf.Error()
+}
+
+func (p *page) output(w io.Writer) error {
+	return p.pdf.Output(w)
+}
+
+func (p *page) field(label, value string) {
+	p.pdf.SetFont(fontFamily, "", 9)
+	lines := p.wrap(p.tr(value), p.width-labelWidth)
+
+	p.pdf.SetFont(fontFamily, "B", 9)
+	p.pdf.CellFormat(labelWidth, lineHeight, p.tr(label), "", 0, "L", false, 0, "")
+	p.pdf.SetFont(fontFamily, "", 9)
+	for i, line := range lines {
+		if i > 0 {
+			p.pdf.SetX(p.left + labelWidth)
+		}
+		p.pdf.CellFormat(p.width-labelWidth, lineHeight, line, "", 1, "L", false, 0, "")
+	}
+}
+
+func (p *page) paragraph(text string) {
+	for _, line := range p.wrap(p.tr(text), p.width) {
+		p.pdf.CellFormat(p.width, lineHeight, line, "", 1, "L", false, 0, "")
+	}
+	p.pdf.Ln(1)
+}
+
+// table starts a table from column specs such as "Date:2|Diagnosis:5".
+func (p *page) table(spec string) error {
+	var names []string
+	var weights []float64
+	var sum float64
+	for _, col := range strings.Split(spec, "|") {
+		name, weight, ok := strings.Cut(col, ":")
+		w, err := strconv.ParseFloat(weight, 64)
+		if !ok || err != nil || w <= 0 {
+			return fmt.Errorf("invalid table column %q", col)
+		}
+		names = append(names, name)
+		weights = append(weights, w)
+		sum += w
+	}
+
+	p.columns = make([]float64, len(weights))
+	for i, w := range weights {
+		p.columns[i] = p.width * w / sum
+	}
+	p.header = names
+
+	p.pdf.Ln(1)
+	p.row(p.header, true)
+	return nil
+}
+
+// row draws a table row with its cells wrapped to the column widths. A row
+// that does not fit goes to the next page, below a repeated header row.
+func (p *page) row(cells []string, header bool) {
+	style := ""
+	if header {
+		style = "B"
+	}
+	p.pdf.SetFont(fontFamily, style, 8.5)
+
+	lines := make([][]string, len(p.columns))
+	n := 1
+	for i, w := range p.columns {
+		text := ""
+		if i < len(cells) {
+			text = p.tr(strings.TrimSpace(cells[i]))
+		}
+		lines[i] = p.wrap(text, w-2*cellPad)
+		n = max(n, len(lines[i]))
+	}
+	height := float64(n)*lineHeight + cellPad
+
+	_, pageHeight := p.pdf.GetPageSize()
+	_, _, _, bottom := p.pdf.GetMargins()
+	if p.pdf.GetY()+height > pageHeight-bottom {
+		p.pdf.AddPage()
+		if !header {
+			p.row(p.header, true)
+			p.pdf.SetFont(fontFamily, style, 8.5)
+		}
+	}
+
+	x, y := p.left, p.pdf.GetY()
+	p.pdf.SetFillColor(235, 235, 235)
+	for i, w := range p.columns {
+		if header {
+			p.pdf.Rect(x, y, w, height, "FD")
+		} else {
+			p.pdf.Rect(x, y, w, height, "D")
+		}
+		for j, line := range lines[i] {
+			p.pdf.Text(x+cellPad, y+float64(j+1)*lineHeight-0.6, line)
+		}
+		x += w
+	}
+	p.pdf.SetXY(p.left, y+height)
+}
+
+// wrap breaks text, already translated to the font's code page, into lines
+// no wider than width in the current font. Words too long for a line are
+// broken.
+func (p *page) wrap(text string, width float64) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if p.pdf.GetStringWidth(candidate) <= width {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+		for p.pdf.GetStringWidth(word) > width && len(word) > 1 {
+			cut := len(word) - 1
+			for cut > 1 && p.pdf.GetStringWidth(word[:cut]) > width {
+				cut--
+			}
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		line = word
+	}
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
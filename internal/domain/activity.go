@@ -13,6 +13,17 @@ const (
 	ActivityTypeMedicalRecord ActivityType = "MEDICAL_RECORD"
 	ActivityTypePatient       ActivityType = "PATIENT"
 	ActivityTypeDoctor        ActivityType = "DOCTOR"
+	ActivityTypeLab           ActivityType = "LAB"
+	ActivityTypeAdmission     ActivityType = "ADMISSION"
+	ActivityTypeBilling       ActivityType = "BILLING"
+	ActivityTypeInsurance     ActivityType = "INSURANCE"
+	ActivityTypePharmacy      ActivityType = "PHARMACY"
+	ActivityTypeQueue         ActivityType = "QUEUE"
+	ActivityTypeReferral      ActivityType = "REFERRAL"
+	ActivityTypeRoster        ActivityType = "ROSTER"
+	ActivityTypeExport        ActivityType = "EXPORT"
+	ActivityTypeDocument      ActivityType = "DOCUMENT"
+	ActivityTypeImport        ActivityType = "IMPORT"
 )
 
 type ActivityEntity struct {
@@ -22,3 +33,13 @@ type ActivityEntity struct {
 	Description string             `bson:"description"`
 	Timestamp   time.Time          `bson:"timestamp"`
 }
+
+func (a *ActivityEntity) ToDTO() Activity {
+	return Activity{
+		ID:          a.ID.Hex(),
+		Type:        string(a.Type),
+		Title:       a.Title,
+		Description: a.Description,
+		Timestamp:   a.Timestamp,
+	}
+}
@@ -0,0 +1,177 @@
+package domain
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// AnalyticsDateFormat is the layout of analytics date range bounds.
+const AnalyticsDateFormat = "2006-01-02"
+
+// AnalyticsInterval is the bucket size of a time series.
+type AnalyticsInterval string
+
+const (
+	AnalyticsIntervalDay   AnalyticsInterval = "day"
+	AnalyticsIntervalWeek  AnalyticsInterval = "week"
+	AnalyticsIntervalMonth AnalyticsInterval = "month"
+)
+
+func (i AnalyticsInterval) IsValid() bool {
+	switch i {
+	case AnalyticsIntervalDay, AnalyticsIntervalWeek, AnalyticsIntervalMonth:
+		return true
+	}
+	return false
+}
+
+// PeriodFormat is the $dateToString format naming the bucket a time falls in:
+// 2025-07-17 for days, 2025-W29 (ISO week) for weeks and 2025-07 for months.
+func (i AnalyticsInterval) PeriodFormat() string {
+	switch i {
+	case AnalyticsIntervalWeek:
+		return "%G-W%V"
+	case AnalyticsIntervalMonth:
+		return "%Y-%m"
+	}
+	return "%Y-%m-%d"
+}
+
+// AppointmentGroupBy is what appointment counts are broken down by.
+type AppointmentGroupBy string
+
+const (
+	AppointmentGroupByNone      AppointmentGroupBy = ""
+	AppointmentGroupByStatus    AppointmentGroupBy = "status"
+	AppointmentGroupByType      AppointmentGroupBy = "type"
+	AppointmentGroupByDoctor    AppointmentGroupBy = "doctor"
+	AppointmentGroupBySpecialty AppointmentGroupBy = "specialty"
+)
+
+func (g AppointmentGroupBy) IsValid() bool {
+	switch g {
+	case AppointmentGroupByNone, AppointmentGroupByStatus, AppointmentGroupByType, AppointmentGroupByDoctor, AppointmentGroupBySpecialty:
+		return true
+	}
+	return false
+}
+
+// AnalyticsRange is the window an analytics result covers.
+type AnalyticsRange struct {
+	From     string            `json:"from" example:"2025-07-01"`
+	To       string            `json:"to" example:"2025-07-31"`
+	Timezone string            `json:"timezone" example:"Asia/Jakarta"`
+	Interval AnalyticsInterval `json:"interval,omitempty" example:"day"`
+}
+
+// @Description	Appointment count of one period, and of one group when broken down
+// @swagger:model
+type AppointmentCountPoint struct {
+	Period string `bson:"period" json:"period" example:"2025-07-17"`
+	// Group is the status, type, doctor ID or specialty counted.
+	Group string `bson:"group,omitempty" json:"group,omitempty" example:"Completed"`
+	// Label names the group when Group is an ID.
+	Label string `bson:"label,omitempty" json:"label,omitempty" example:"Dr. Jane Smith"`
+	Count int64  `bson:"count" json:"count" example:"12"`
+}
+
+// @Description	Appointments per period, optionally broken down by status, type, doctor or specialty
+// @swagger:model
+type AppointmentTrendResponse struct {
+	AnalyticsRange
+	GroupBy AppointmentGroupBy      `json:"groupBy,omitempty" example:"status"`
+	Points  []AppointmentCountPoint `json:"points"`
+}
+
+// @Description	Appointment outcomes of one period. No-shows are past appointments never completed or cancelled.
+// @swagger:model
+type AppointmentRatePoint struct {
+	Period    string `bson:"_id" json:"period" example:"2025-07-17"`
+	Total     int64  `bson:"total" json:"total" example:"20"`
+	Completed int64  `bson:"completed" json:"completed" example:"15"`
+	Cancelled int64  `bson:"cancelled" json:"cancelled" example:"3"`
+	NoShow    int64  `bson:"noShow" json:"noShow" example:"2"`
+	// CancellationRate is cancelled over all appointments.
+	CancellationRate float64 `bson:"-" json:"cancellationRate" example:"0.15"`
+	// NoShowRate is no-shows over the past appointments that were not
+	// cancelled.
+	NoShowRate float64 `bson:"-" json:"noShowRate" example:"0.1176"`
+}
+
+// Rates fills in the rates from the counts.
+func (p *AppointmentRatePoint) Rates() {
+	p.CancellationRate, p.NoShowRate = 0, 0
+	if p.Total > 0 {
+		p.CancellationRate = float64(p.Cancelled) / float64(p.Total)
+	}
+	if due := p.Completed + p.NoShow; due > 0 {
+		p.NoShowRate = float64(p.NoShow) / float64(due)
+	}
+}
+
+// @Description	No-show and cancellation rates per period and over the whole range
+// @swagger:model
+type AppointmentRatesResponse struct {
+	AnalyticsRange
+	Overall AppointmentRatePoint   `json:"overall"`
+	Points  []AppointmentRatePoint `json:"points"`
+}
+
+// @Description	Patients seen in one period, split by whether it was their first visit
+// @swagger:model
+type PatientMixPoint struct {
+	Period    string `bson:"_id" json:"period" example:"2025-07"`
+	New       int64  `bson:"new" json:"new" example:"40"`
+	Returning int64  `bson:"returning" json:"returning" example:"85"`
+}
+
+// @Description	New and returning patients per period. A patient is new in the period of their first appointment.
+// @swagger:model
+type PatientMixResponse struct {
+	AnalyticsRange
+	Points []PatientMixPoint `json:"points"`
+}
+
+// @Description	How often a diagnosis was recorded
+// @swagger:model
+type DiagnosisCount struct {
+	Diagnosis string `bson:"diagnosis" json:"diagnosis" example:"Influenza A"`
+	Count     int64  `bson:"count" json:"count" example:"31"`
+	Patients  int64  `bson:"patients" json:"patients" example:"29"`
+}
+
+// @Description	Most recorded diagnoses in a date range
+// @swagger:model
+type TopDiagnosesResponse struct {
+	AnalyticsRange
+	Diagnoses []DiagnosisCount `json:"diagnoses"`
+}
+
+// @Description	Utilization and productivity of a doctor over a date range
+// @swagger:model
+type DoctorUtilization struct {
+	Rank       int                `bson:"-" json:"rank" example:"1"`
+	DoctorID   primitive.ObjectID `bson:"_id" json:"doctorId" example:"60d0fe4f53115a001f000003"`
+	DoctorName string             `bson:"-" json:"doctorName" example:"Dr. Jane Smith"`
+	Specialty  string             `bson:"-" json:"specialty" example:"Cardiology"`
+	// AvailableMinutes is the time the doctor's weekly availability offers
+	// over the range.
+	AvailableMinutes int64 `bson:"-" json:"availableMinutes" example:"2400"`
+	// BookedMinutes is the duration of the appointments that were not
+	// cancelled.
+	BookedMinutes int64 `bson:"bookedMinutes" json:"bookedMinutes" example:"1800"`
+	// Utilization is booked over available minutes, 0 without availability.
+	Utilization  float64 `bson:"-" json:"utilization" example:"0.75"`
+	Appointments int64   `bson:"total" json:"appointments" example:"60"`
+	Completed    int64   `bson:"completed" json:"completed" example:"50"`
+	Cancelled    int64   `bson:"cancelled" json:"cancelled" example:"6"`
+	NoShow       int64   `bson:"noShow" json:"noShow" example:"4"`
+	// AvgLeadTimeHours is the average time from booking to visit of the
+	// appointments that were not cancelled.
+	AvgLeadTimeHours float64 `bson:"avgLeadTimeHours" json:"avgLeadTimeHours" example:"52.5"`
+	RecordsAuthored  int64   `bson:"-" json:"recordsAuthored" example:"48"`
+}
+
+// @Description	Doctors ranked by utilization over a date range
+// @swagger:model
+type DoctorUtilizationReport struct {
+	AnalyticsRange
+	Doctors []DoctorUtilization `json:"doctors"`
+}
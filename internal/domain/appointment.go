@@ -44,37 +44,51 @@ func (at AppointmentType) IsValid() bool {
 // @Description	Appointment object
 // @swagger:model
 type AppointmentEntity struct {
-	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
-	PatientID      primitive.ObjectID `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
-	DoctorID       primitive.ObjectID `bson:"doctorId" json:"doctorId" example:"60d0fe4f53115a001f000003"`
-	Type           AppointmentType    `bson:"type" json:"type" example:"check-up"`
-	DateTime       time.Time          `bson:"dateTime" json:"dateTime" example:"2025-07-17T10:00:00Z"`
-	Duration       int                `bson:"duration" json:"duration" example:30` // in minutes
-	Status         AppointmentStatus  `bson:"status" json:"status" example:"Scheduled"`
-	Location       string             `bson:"location" json:"location" example:"Room 101"`
-	Notes          string             `bson:"notes,omitempty" json:"notes,omitempty" example:"Patient complained of headache"`
-	PatientHistory string             `bson:"patientHistory,omitempty" json:"patientHistory,omitempty" example:"No significant medical history"`
-	CreatedBy      primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
-	UpdatedBy      primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
-	CreatedAt      time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt      time.Time          `bson:"updatedAt" json:"updatedAt"`
+	ID               primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	PatientID        primitive.ObjectID  `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	DoctorID         primitive.ObjectID  `bson:"doctorId" json:"doctorId" example:"60d0fe4f53115a001f000003"`
+	Type             AppointmentType     `bson:"type" json:"type" example:"check-up"`
+	DateTime         time.Time           `bson:"dateTime" json:"dateTime" example:"2025-07-17T10:00:00Z"`
+	Duration         int                 `bson:"duration" json:"duration" example:30` // in minutes
+	Status           AppointmentStatus   `bson:"status" json:"status" example:"Scheduled"`
+	Location         string              `bson:"location" json:"location" example:"Room 101"`
+	RoomID           *primitive.ObjectID `bson:"roomId,omitempty" json:"roomId,omitempty" example:"60d0fe4f53115a001f000032"`
+	DepartmentID     *primitive.ObjectID `bson:"departmentId,omitempty" json:"departmentId,omitempty" example:"60d0fe4f53115a001f000030"`
+	Notes            string              `bson:"notes,omitempty" json:"notes,omitempty" example:"Patient complained of headache"`
+	PatientHistory   string              `bson:"patientHistory,omitempty" json:"patientHistory,omitempty" example:"No significant medical history"`
+	Eligibility      *EligibilityCheck   `bson:"eligibility,omitempty" json:"eligibility,omitempty"`
+	Triage           *TriageAssessment   `bson:"triage,omitempty" json:"triage,omitempty"`
+	ConflictOverride *ConflictOverride   `bson:"conflictOverride,omitempty" json:"conflictOverride,omitempty"`
+	Bump             *AppointmentBump    `bson:"bump,omitempty" json:"bump,omitempty"`
+	CreatedBy        primitive.ObjectID  `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy        primitive.ObjectID  `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt        time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt        time.Time           `bson:"updatedAt" json:"updatedAt"`
 }
 
+
 type AppointmentDTO struct {
-	ID             string            `json:"id" example:"60d0fe4f53115a001f000001"`
-	PatientID      string            `json:"patientId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
-	DoctorID       string            `json:"doctorId" validate:"required,mongodb" example:"60d0fe4f53115a001f000003"`
-	Type           AppointmentType   `json:"type" validate:"required,oneof=check-up follow-up consultation procedure emergency" example:"check-up"`
-	DateTime       time.Time         `json:"dateTime" example:"2025-07-17T10:00:00Z"`
-	Duration       int               `json:"duration" validate:"required,gt=0" example:30`
-	Status         AppointmentStatus `json:"status" validate:"required,oneof=Scheduled Confirmed Completed Cancelled" example:"Scheduled"`
-	Location       string            `json:"location" validate:"required,min=3,max=100" example:"Room 101"`
-	Notes          string            `json:"notes,omitempty" validate:"max=500" example:"Patient complained of headache"`
-	PatientHistory string            `json:"patientHistory,omitempty" validate:"max=1000" example:"No significant medical history"`
-	CreatedAt      time.Time         `json:"createdAt" example:"2025-07-17T09:00:00Z"`
-	UpdatedAt      time.Time         `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+	ID               string            `json:"id" example:"60d0fe4f53115a001f000001"`
+	PatientID        string            `json:"patientId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	DoctorID         string            `json:"doctorId" validate:"required,mongodb" example:"60d0fe4f53115a001f000003"`
+	Type             AppointmentType   `json:"type" validate:"required,oneof=check-up follow-up consultation procedure emergency" example:"check-up"`
+	DateTime         time.Time         `json:"dateTime" example:"2025-07-17T10:00:00Z"`
+	Duration         int               `json:"duration" validate:"required,gt=0" example:30`
+	Status           AppointmentStatus `json:"status" validate:"required,oneof=Scheduled Confirmed Completed Cancelled" example:"Scheduled"`
+	Location         string            `json:"location" validate:"required,min=3,max=100" example:"Room 101"`
+	RoomID           string            `json:"roomId,omitempty" example:"60d0fe4f53115a001f000032"`
+	DepartmentID     string            `json:"departmentId,omitempty" example:"60d0fe4f53115a001f000030"`
+	Notes            string            `json:"notes,omitempty" validate:"max=500" example:"Patient complained of headache"`
+	PatientHistory   string            `json:"patientHistory,omitempty" validate:"max=1000" example:"No significant medical history"`
+	Eligibility      *EligibilityCheck `json:"eligibility,omitempty"`
+	Triage           *TriageAssessment `json:"triage,omitempty"`
+	ConflictOverride *ConflictOverride `json:"conflictOverride,omitempty"`
+	Bump             *AppointmentBump  `json:"bump,omitempty"`
+	CreatedAt        time.Time         `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt        time.Time         `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
 }
 
+
 // @Description	Detailed appointment information
 // @swagger:model
 type AppointmentDetailResponse struct {
@@ -104,8 +118,14 @@ func (a AppointmentDTO) ToEntity() (AppointmentEntity, error) {
 	entity.Duration = a.Duration
 	entity.Status = a.Status
 	entity.Location = a.Location
+	entity.RoomID = objectIDFromHexOrNil(a.RoomID)
+	entity.DepartmentID = objectIDFromHexOrNil(a.DepartmentID)
 	entity.Notes = a.Notes
 	entity.PatientHistory = a.PatientHistory
+	entity.Eligibility = a.Eligibility
+	entity.Triage = a.Triage
+	entity.ConflictOverride = a.ConflictOverride
+	entity.Bump = a.Bump
 	entity.CreatedAt = a.CreatedAt
 	entity.UpdatedAt = a.UpdatedAt
 
@@ -114,18 +134,24 @@ func (a AppointmentDTO) ToEntity() (AppointmentEntity, error) {
 
 func (a *AppointmentEntity) ToDTO() AppointmentDTO {
 	return AppointmentDTO{
-		ID:             a.ID.Hex(),
-		PatientID:      a.PatientID.Hex(),
-		DoctorID:       a.DoctorID.Hex(),
-		Type:           a.Type,
-		DateTime:       a.DateTime,
-		Duration:       a.Duration,
-		Status:         a.Status,
-		Location:       a.Location,
-		Notes:          a.Notes,
-		PatientHistory: a.PatientHistory,
-		CreatedAt:      a.CreatedAt,
-		UpdatedAt:      a.UpdatedAt,
+		ID:               a.ID.Hex(),
+		PatientID:        a.PatientID.Hex(),
+		DoctorID:         a.DoctorID.Hex(),
+		Type:             a.Type,
+		DateTime:         a.DateTime,
+		Duration:         a.Duration,
+		Status:           a.Status,
+		Location:         a.Location,
+		RoomID:           hexOrEmpty(a.RoomID),
+		DepartmentID:     hexOrEmpty(a.DepartmentID),
+		Notes:            a.Notes,
+		PatientHistory:   a.PatientHistory,
+		Eligibility:      a.Eligibility,
+		Triage:           a.Triage,
+		ConflictOverride: a.ConflictOverride,
+		Bump:             a.Bump,
+		CreatedAt:        a.CreatedAt,
+		UpdatedAt:        a.UpdatedAt,
 	}
 }
 
@@ -151,12 +177,14 @@ func (a *AppointmentEntity) ToDetailDTO(patient *PatientEntity, lastRecord *Medi
 // @Description	Request body for updating an existing appointment
 // @swagger:model
 type UpdateAppointmentRequest struct {
-	Type           *AppointmentType   `json:"type,omitempty" validate:"oneof=check-up follow-up consultation procedure emergency" example:"check-up"`
+	Type           *AppointmentType   `json:"type,omitempty" validate:"omitempty,oneof=check-up follow-up consultation procedure emergency" example:"check-up"`
 	DateTime       *time.Time         `json:"dateTime,omitempty" example:"2025-07-17T10:00:00Z"`
-	Duration       *int               `json:"duration,omitempty" validate:"gt=0" example:30`
-	Status         *AppointmentStatus `json:"status,omitempty" validate:"oneof=Scheduled Confirmed Completed Cancelled" example:"Scheduled"`
-	Location       *string            `json:"location,omitempty" validate:"min=3,max=100" example:"Room 101"`
-	Notes          *string            `json:"notes,omitempty" validate:"max=500" example:"Patient complained of headache"`
+	Duration       *int               `json:"duration,omitempty" validate:"omitempty,gt=0" example:30`
+	Status         *AppointmentStatus `json:"status,omitempty" validate:"omitempty,oneof=Scheduled Confirmed Completed Cancelled" example:"Scheduled"`
+	Location       *string            `json:"location,omitempty" validate:"omitempty,min=3,max=100" example:"Room 101"`
+	// RoomID moves the appointment to another clinic room.
+	RoomID         *string            `json:"roomId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000032"`
+	Notes          *string            `json:"notes,omitempty" validate:"omitempty,max=500" example:"Patient complained of headache"`
 	PatientHistory *string            `json:"patientHistory,omitempty" example:"No significant medical history"`
 }
 
@@ -213,7 +241,13 @@ type CreateAppointmentRequest struct {
 	Type           AppointmentType `json:"type" validate:"required,oneof=check-up follow-up consultation procedure emergency" example:"check-up"`
 	DateTime       time.Time       `json:"dateTime" validate:"-" example:"2025-07-17T10:00:00Z"`
 	Duration       int             `json:"duration" validate:"required,gt=0" example:30`
-	Location       string          `json:"location" validate:"required,min=3,max=100" example:"Room 101"`
+	Location       string          `json:"location" validate:"required_without=RoomID,omitempty,min=3,max=100" example:"Room 101"`
+	// RoomID books the appointment into a clinic room; the location is then
+	// taken from the room.
+	RoomID         string          `json:"roomId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000032"`
 	Notes          string          `json:"notes,omitempty" validate:"max=500" example:"Patient complained of headache"`
 	PatientHistory string          `json:"patientHistory,omitempty" validate:"max=1000" example:"No significant medical history"`
+	// OverrideReason lets an emergency booking take a slot the doctor is
+	// already booked for; the appointments in the way are moved back.
+	OverrideReason string `json:"overrideReason,omitempty" validate:"max=500" example:"Suspected myocardial infarction"`
 }
\ No newline at end of file
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AppointmentLinkAction is what a patient can do through a link sent in an
+// appointment reminder.
+type AppointmentLinkAction string
+
+const (
+	AppointmentLinkConfirm AppointmentLinkAction = "confirm"
+	AppointmentLinkCancel  AppointmentLinkAction = "cancel"
+)
+
+func (a AppointmentLinkAction) IsValid() bool {
+	switch a {
+	case AppointmentLinkConfirm, AppointmentLinkCancel:
+		return true
+	}
+	return false
+}
+
+// Status returns the appointment status the action moves the appointment to.
+func (a AppointmentLinkAction) Status() AppointmentStatus {
+	if a == AppointmentLinkCancel {
+		return AppointmentStatusCancelled
+	}
+	return AppointmentStatusConfirmed
+}
+
+// AppointmentLinkTokenEntity records a used link token so that it cannot be
+// replayed. The ID is the token ID.
+type AppointmentLinkTokenEntity struct {
+	ID            primitive.ObjectID    `bson:"_id"`
+	AppointmentID primitive.ObjectID    `bson:"appointmentId"`
+	Action        AppointmentLinkAction `bson:"action"`
+	UsedAt        time.Time             `bson:"usedAt"`
+}
+
+// @Description	Appointment and action behind a confirmation or cancellation link
+// @swagger:model
+type AppointmentLinkInfo struct {
+	Action        AppointmentLinkAction `json:"action" example:"confirm"`
+	AppointmentID string                `json:"appointmentId" example:"60d0fe4f53115a001f000001"`
+	DateTime      time.Time             `json:"dateTime" example:"2025-07-17T10:00:00Z"`
+	Location      string                `json:"location" example:"Room 101"`
+	Status        AppointmentStatus     `json:"status" example:"Scheduled"`
+}
+
+// @Description	Request body for using a confirmation or cancellation link
+// @swagger:model
+type AppointmentLinkRequest struct {
+	Token string `json:"token" validate:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
@@ -0,0 +1,376 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// All monetary amounts in billing are integers in the currency's minor unit
+// (e.g. sen for IDR). Tax rates are expressed in basis points, 1100 = 11%.
+
+const DefaultCurrency = "IDR"
+
+type TariffCategory string
+
+const (
+	TariffCategoryConsultation TariffCategory = "consultation"
+	TariffCategoryProcedure    TariffCategory = "procedure"
+	TariffCategoryLab          TariffCategory = "lab"
+	TariffCategoryRoom         TariffCategory = "room"
+	TariffCategoryPharmacy     TariffCategory = "pharmacy"
+	TariffCategoryOther        TariffCategory = "other"
+)
+
+type InvoiceStatus string
+
+const (
+	InvoiceStatusUnpaid        InvoiceStatus = "Unpaid"
+	InvoiceStatusPartiallyPaid InvoiceStatus = "PartiallyPaid"
+	InvoiceStatusPaid          InvoiceStatus = "Paid"
+	InvoiceStatusRefunded      InvoiceStatus = "Refunded"
+	InvoiceStatusVoid          InvoiceStatus = "Void"
+)
+
+func (is InvoiceStatus) IsValid() bool {
+	switch is {
+	case InvoiceStatusUnpaid, InvoiceStatusPartiallyPaid, InvoiceStatusPaid, InvoiceStatusRefunded, InvoiceStatusVoid:
+		return true
+	}
+	return false
+}
+
+type PaymentType string
+
+const (
+	PaymentTypePayment PaymentType = "Payment"
+	PaymentTypeRefund  PaymentType = "Refund"
+)
+
+type PaymentMethod string
+
+const (
+	PaymentMethodCash      PaymentMethod = "Cash"
+	PaymentMethodCard      PaymentMethod = "Card"
+	PaymentMethodTransfer  PaymentMethod = "Transfer"
+	PaymentMethodInsurance PaymentMethod = "Insurance"
+)
+
+// applyRate returns amount * bps / 10000 rounded half up.
+func applyRate(amount int64, bps int) int64 {
+	return (amount*int64(bps) + 5000) / 10000
+}
+
+// @Description	Tariff (service price) object
+// @swagger:model
+type TariffEntity struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	Code            string             `bson:"code" json:"code" example:"CONS-GP"`
+	Name            string             `bson:"name" json:"name" example:"General practitioner consultation"`
+	Category        TariffCategory     `bson:"category" json:"category" example:"consultation"`
+	AppointmentType AppointmentType    `bson:"appointmentType,omitempty" json:"appointmentType,omitempty" example:"check-up"`
+	Price           int64              `bson:"price" json:"price" example:"15000000"`
+	TaxRate         int                `bson:"taxRate" json:"taxRate" example:"0"`
+	IsActive        bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy       primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy       primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt       time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt       time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Tariff data transfer object
+// @swagger:model
+type TariffDTO struct {
+	ID              string          `json:"id" example:"60d0fe4f53115a001f000001"`
+	Code            string          `json:"code" example:"CONS-GP"`
+	Name            string          `json:"name" example:"General practitioner consultation"`
+	Category        TariffCategory  `json:"category" example:"consultation"`
+	AppointmentType AppointmentType `json:"appointmentType,omitempty" example:"check-up"`
+	Price           int64           `json:"price" example:"15000000"`
+	TaxRate         int             `json:"taxRate" example:"0"`
+	IsActive        bool            `json:"isActive" example:"true"`
+	CreatedAt       time.Time       `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt       time.Time       `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (t *TariffEntity) ToDTO() TariffDTO {
+	return TariffDTO{
+		ID:              t.ID.Hex(),
+		Code:            t.Code,
+		Name:            t.Name,
+		Category:        t.Category,
+		AppointmentType: t.AppointmentType,
+		Price:           t.Price,
+		TaxRate:         t.TaxRate,
+		IsActive:        t.IsActive,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
+
+// @Description	Request body for creating or updating a tariff
+// @swagger:model
+type TariffRequest struct {
+	Code            string          `json:"code" validate:"required,max=30" example:"CONS-GP"`
+	Name            string          `json:"name" validate:"required,min=3,max=100" example:"General practitioner consultation"`
+	Category        TariffCategory  `json:"category" validate:"required,oneof=consultation procedure lab room pharmacy other" example:"consultation"`
+	AppointmentType AppointmentType `json:"appointmentType,omitempty" validate:"omitempty,oneof=check-up follow-up consultation procedure emergency" example:"check-up"`
+	Price           int64           `json:"price" validate:"gte=0" example:"15000000"`
+	TaxRate         int             `json:"taxRate" validate:"gte=0,lte=10000" example:"0"`
+	IsActive        *bool           `json:"isActive,omitempty" example:"true"`
+}
+
+type InvoiceLineItem struct {
+	TariffID    *primitive.ObjectID `bson:"tariffId,omitempty" json:"tariffId,omitempty" example:"60d0fe4f53115a001f000001"`
+	Code        string              `bson:"code,omitempty" json:"code,omitempty" example:"CONS-GP"`
+	Description string              `bson:"description" json:"description" example:"General practitioner consultation"`
+	Quantity    int                 `bson:"quantity" json:"quantity" example:"1"`
+	UnitPrice   int64               `bson:"unitPrice" json:"unitPrice" example:"15000000"`
+	Discount    int64               `bson:"discount" json:"discount" example:"0"`
+	TaxRate     int                 `bson:"taxRate" json:"taxRate" example:"0"`
+	Subtotal    int64               `bson:"subtotal" json:"subtotal" example:"15000000"`
+	Tax         int64               `bson:"tax" json:"tax" example:"0"`
+	Total       int64               `bson:"total" json:"total" example:"15000000"`
+}
+
+// Calculate fills in the computed amounts of the line. The discount is
+// applied before tax.
+func (li *InvoiceLineItem) Calculate() {
+	li.Subtotal = li.UnitPrice * int64(li.Quantity)
+	taxable := li.Subtotal - li.Discount
+	if taxable < 0 {
+		taxable = 0
+	}
+	li.Tax = applyRate(taxable, li.TaxRate)
+	li.Total = taxable + li.Tax
+}
+
+// @Description	Invoice object
+// @swagger:model
+type InvoiceEntity struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	Number         string              `bson:"number" json:"number" example:"INV-20250717-1F000001"`
+	PatientID      primitive.ObjectID  `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	AppointmentID  *primitive.ObjectID `bson:"appointmentId,omitempty" json:"appointmentId,omitempty" example:"60d0fe4f53115a001f000004"`
+	Currency       string              `bson:"currency" json:"currency" example:"IDR"`
+	Items          []InvoiceLineItem   `bson:"items" json:"items"`
+	Subtotal       int64               `bson:"subtotal" json:"subtotal" example:"15000000"`
+	DiscountTotal  int64               `bson:"discountTotal" json:"discountTotal" example:"0"`
+	TaxTotal       int64               `bson:"taxTotal" json:"taxTotal" example:"0"`
+	Total          int64               `bson:"total" json:"total" example:"15000000"`
+	AmountPaid     int64               `bson:"amountPaid" json:"amountPaid" example:"0"`
+	AmountRefunded int64               `bson:"amountRefunded" json:"amountRefunded" example:"0"`
+	Status         InvoiceStatus       `bson:"status" json:"status" example:"Unpaid"`
+	Notes          string              `bson:"notes,omitempty" json:"notes,omitempty"`
+	VoidReason     string              `bson:"voidReason,omitempty" json:"voidReason,omitempty"`
+	IssuedAt       time.Time           `bson:"issuedAt" json:"issuedAt"`
+	CreatedBy      primitive.ObjectID  `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy      primitive.ObjectID  `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt      time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// Recalculate recomputes line items and invoice totals.
+func (i *InvoiceEntity) Recalculate() {
+	i.Subtotal, i.DiscountTotal, i.TaxTotal, i.Total = 0, 0, 0, 0
+	for idx := range i.Items {
+		i.Items[idx].Calculate()
+		i.Subtotal += i.Items[idx].Subtotal
+		i.DiscountTotal += i.Items[idx].Discount
+		i.TaxTotal += i.Items[idx].Tax
+		i.Total += i.Items[idx].Total
+	}
+}
+
+// NetPaid is the amount received minus the amount refunded.
+func (i *InvoiceEntity) NetPaid() int64 {
+	return i.AmountPaid - i.AmountRefunded
+}
+
+// Balance is the amount the patient still owes on the invoice.
+func (i *InvoiceEntity) Balance() int64 {
+	if i.Status == InvoiceStatusVoid {
+		return 0
+	}
+	return i.Total - i.NetPaid()
+}
+
+// RefreshStatus derives the payment status from the paid and refunded amounts.
+// Void invoices keep their status.
+func (i *InvoiceEntity) RefreshStatus() {
+	if i.Status == InvoiceStatusVoid {
+		return
+	}
+	switch {
+	case i.NetPaid() >= i.Total && i.Total > 0:
+		i.Status = InvoiceStatusPaid
+	case i.NetPaid() > 0:
+		i.Status = InvoiceStatusPartiallyPaid
+	case i.AmountRefunded > 0:
+		i.Status = InvoiceStatusRefunded
+	default:
+		i.Status = InvoiceStatusUnpaid
+	}
+}
+
+// @Description	Invoice data transfer object
+// @swagger:model
+type InvoiceDTO struct {
+	ID             string            `json:"id" example:"60d0fe4f53115a001f000001"`
+	Number         string            `json:"number" example:"INV-20250717-1F000001"`
+	PatientID      string            `json:"patientId" example:"60d0fe4f53115a001f000002"`
+	AppointmentID  string            `json:"appointmentId,omitempty" example:"60d0fe4f53115a001f000004"`
+	Currency       string            `json:"currency" example:"IDR"`
+	Items          []InvoiceLineItem `json:"items"`
+	Subtotal       int64             `json:"subtotal" example:"15000000"`
+	DiscountTotal  int64             `json:"discountTotal" example:"0"`
+	TaxTotal       int64             `json:"taxTotal" example:"0"`
+	Total          int64             `json:"total" example:"15000000"`
+	AmountPaid     int64             `json:"amountPaid" example:"0"`
+	AmountRefunded int64             `json:"amountRefunded" example:"0"`
+	Balance        int64             `json:"balance" example:"15000000"`
+	Status         InvoiceStatus     `json:"status" example:"Unpaid"`
+	Notes          string            `json:"notes,omitempty"`
+	VoidReason     string            `json:"voidReason,omitempty"`
+	IssuedAt       time.Time         `json:"issuedAt" example:"2025-07-17T09:00:00Z"`
+	CreatedAt      time.Time         `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt      time.Time         `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (i *InvoiceEntity) ToDTO() InvoiceDTO {
+	dto := InvoiceDTO{
+		ID:             i.ID.Hex(),
+		Number:         i.Number,
+		PatientID:      i.PatientID.Hex(),
+		Currency:       i.Currency,
+		Items:          i.Items,
+		Subtotal:       i.Subtotal,
+		DiscountTotal:  i.DiscountTotal,
+		TaxTotal:       i.TaxTotal,
+		Total:          i.Total,
+		AmountPaid:     i.AmountPaid,
+		AmountRefunded: i.AmountRefunded,
+		Balance:        i.Balance(),
+		Status:         i.Status,
+		Notes:          i.Notes,
+		VoidReason:     i.VoidReason,
+		IssuedAt:       i.IssuedAt,
+		CreatedAt:      i.CreatedAt,
+		UpdatedAt:      i.UpdatedAt,
+	}
+	if i.AppointmentID != nil {
+		dto.AppointmentID = i.AppointmentID.Hex()
+	}
+	return dto
+}
+
+// @Description	A line on a new invoice, either from the tariff catalogue or a free-text charge
+// @swagger:model
+type InvoiceItemRequest struct {
+	TariffID    string `json:"tariffId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000001"`
+	Description string `json:"description,omitempty" validate:"required_without=TariffID,max=200" example:"Wound dressing"`
+	Quantity    int    `json:"quantity" validate:"required,gt=0" example:"1"`
+	UnitPrice   int64  `json:"unitPrice,omitempty" validate:"gte=0" example:"5000000"`
+	Discount    int64  `json:"discount,omitempty" validate:"gte=0" example:"0"`
+	TaxRate     int    `json:"taxRate,omitempty" validate:"gte=0,lte=10000" example:"0"`
+}
+
+// @Description	Request body for creating an invoice manually
+// @swagger:model
+type CreateInvoiceRequest struct {
+	PatientID     string               `json:"patientId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	AppointmentID string               `json:"appointmentId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000004"`
+	Items         []InvoiceItemRequest `json:"items" validate:"required,min=1,dive"`
+	Notes         string               `json:"notes,omitempty" validate:"max=500"`
+}
+
+// @Description	Request body for voiding an invoice
+// @swagger:model
+type VoidInvoiceRequest struct {
+	Reason string `json:"reason" validate:"required,max=500" example:"Created in error"`
+}
+
+// @Description	Payment or refund object
+// @swagger:model
+type PaymentEntity struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	InvoiceID      primitive.ObjectID  `bson:"invoiceId" json:"invoiceId" example:"60d0fe4f53115a001f000005"`
+	PatientID      primitive.ObjectID  `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	Type           PaymentType         `bson:"type" json:"type" example:"Payment"`
+	Method         PaymentMethod       `bson:"method" json:"method" example:"Cash"`
+	Amount         int64               `bson:"amount" json:"amount" example:"10000000"`
+	RefundedAmount int64               `bson:"refundedAmount" json:"refundedAmount" example:"0"`
+	RefundOf       *primitive.ObjectID `bson:"refundOf,omitempty" json:"refundOf,omitempty"`
+	Reference      string              `bson:"reference,omitempty" json:"reference,omitempty" example:"EDC-123456"`
+	Notes          string              `bson:"notes,omitempty" json:"notes,omitempty"`
+	ReceivedAt     time.Time           `bson:"receivedAt" json:"receivedAt"`
+	CreatedBy      primitive.ObjectID  `bson:"createdBy" json:"createdBy,omitempty"`
+	CreatedAt      time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Payment data transfer object
+// @swagger:model
+type PaymentDTO struct {
+	ID             string        `json:"id" example:"60d0fe4f53115a001f000001"`
+	InvoiceID      string        `json:"invoiceId" example:"60d0fe4f53115a001f000005"`
+	PatientID      string        `json:"patientId" example:"60d0fe4f53115a001f000002"`
+	Type           PaymentType   `json:"type" example:"Payment"`
+	Method         PaymentMethod `json:"method" example:"Cash"`
+	Amount         int64         `json:"amount" example:"10000000"`
+	RefundedAmount int64         `json:"refundedAmount" example:"0"`
+	RefundOf       string        `json:"refundOf,omitempty"`
+	Reference      string        `json:"reference,omitempty" example:"EDC-123456"`
+	Notes          string        `json:"notes,omitempty"`
+	ReceivedAt     time.Time     `json:"receivedAt" example:"2025-07-17T09:00:00Z"`
+	CreatedAt      time.Time     `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (p *PaymentEntity) ToDTO() PaymentDTO {
+	dto := PaymentDTO{
+		ID:             p.ID.Hex(),
+		InvoiceID:      p.InvoiceID.Hex(),
+		PatientID:      p.PatientID.Hex(),
+		Type:           p.Type,
+		Method:         p.Method,
+		Amount:         p.Amount,
+		RefundedAmount: p.RefundedAmount,
+		Reference:      p.Reference,
+		Notes:          p.Notes,
+		ReceivedAt:     p.ReceivedAt,
+		CreatedAt:      p.CreatedAt,
+	}
+	if p.RefundOf != nil {
+		dto.RefundOf = p.RefundOf.Hex()
+	}
+	return dto
+}
+
+// @Description	Request body for recording a payment against an invoice
+// @swagger:model
+type CreatePaymentRequest struct {
+	InvoiceID string        `json:"invoiceId" validate:"required,mongodb" example:"60d0fe4f53115a001f000005"`
+	Method    PaymentMethod `json:"method" validate:"required,oneof=Cash Card Transfer Insurance" example:"Cash"`
+	Amount    int64         `json:"amount" validate:"required,gt=0" example:"10000000"`
+	Reference string        `json:"reference,omitempty" validate:"max=100" example:"EDC-123456"`
+	Notes     string        `json:"notes,omitempty" validate:"max=500"`
+}
+
+// @Description	Request body for refunding part or all of a payment
+// @swagger:model
+type RefundPaymentRequest struct {
+	Amount int64  `json:"amount" validate:"required,gt=0" example:"5000000"`
+	Reason string `json:"reason" validate:"required,max=500" example:"Procedure cancelled"`
+}
+
+// @Description	Outstanding balance of a patient across all invoices
+// @swagger:model
+type PatientBalance struct {
+	PatientID     string `json:"patientId" example:"60d0fe4f53115a001f000002"`
+	Currency      string `json:"currency" example:"IDR"`
+	InvoiceCount  int64  `json:"invoiceCount" example:"3"`
+	TotalBilled   int64  `json:"totalBilled" example:"45000000"`
+	TotalPaid     int64  `json:"totalPaid" example:"30000000"`
+	TotalRefunded int64  `json:"totalRefunded" example:"0"`
+	Outstanding   int64  `json:"outstanding" example:"15000000"`
+}
@@ -35,6 +35,9 @@ type UpcomingAppointment struct {
 // @swagger:model
 type DashboardResponse struct {
 	Stats                DashboardStats        `json:"stats"`
+	BedOccupancy         BedOccupancyStats     `json:"bedOccupancy"`
 	RecentActivities     []Activity            `json:"recentActivities"`
 	UpcomingAppointments []UpcomingAppointment `json:"upcomingAppointments"`
+	// DoctorUtilization ranks the doctors by utilization over the last 30 days.
+	DoctorUtilization []DoctorUtilization `json:"doctorUtilization"`
 }
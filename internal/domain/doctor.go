@@ -9,18 +9,22 @@ import (
 // @Description	Doctor object
 // @swagger:model
 type DoctorEntity struct {
-	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
-	Name         string             `bson:"name" json:"name" example:"Dr. John Doe"`
-	Specialty    string             `bson:"specialty" json:"specialty" example:"Cardiology"`
-	Phone        string             `bson:"phone" json:"phone" example:"1234567890"`
-	Email        string             `bson:"email" json:"email" example:"john.doe@example.com"`
-	Availability []TimeSlot         `bson:"availability" json:"availability"`
-	CreatedBy    primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
-	UpdatedBy    primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
-	IsDeleted    bool               `bson:"isDeleted" json:"isDeleted" example:false`
-	DeletedAt    *time.Time         `bson:"deletedAt,omitempty" json:"deletedAt,omitempty"`
-	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+	ID           primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	Name         string              `bson:"name" json:"name" example:"Dr. John Doe"`
+	Specialty    string              `bson:"specialty" json:"specialty" example:"Cardiology"`
+	DepartmentID *primitive.ObjectID `bson:"departmentId,omitempty" json:"departmentId,omitempty" example:"60d0fe4f53115a001f000030"`
+	// UserID is the doctor's login account, whose roster shifts make the
+	// doctor available when availability comes from the roster.
+	UserID       *primitive.ObjectID `bson:"userId,omitempty" json:"userId,omitempty" example:"60d0fe4f53115a001f000001"`
+	Phone        string              `bson:"phone" json:"phone" example:"1234567890"`
+	Email        string              `bson:"email" json:"email" example:"john.doe@example.com"`
+	Availability []TimeSlot          `bson:"availability" json:"availability"`
+	CreatedBy    primitive.ObjectID  `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy    primitive.ObjectID  `bson:"updatedBy" json:"updatedBy,omitempty"`
+	IsDeleted    bool                `bson:"isDeleted" json:"isDeleted" example:false`
+	DeletedAt    *time.Time          `bson:"deletedAt,omitempty" json:"deletedAt,omitempty"`
+	CreatedAt    time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time           `bson:"updatedAt" json:"updatedAt"`
 }
 
 type TimeSlot struct {
@@ -35,6 +39,8 @@ type DoctorDTO struct {
 	ID           string     `json:"id" example:"60d0fe4f53115a001f000001"`
 	Name         string     `json:"name" example:"Dr. John Doe"`
 	Specialty    string     `json:"specialty" example:"Cardiology"`
+	DepartmentID string     `json:"departmentId,omitempty" example:"60d0fe4f53115a001f000030"`
+	UserID       string     `json:"userId,omitempty" example:"60d0fe4f53115a001f000001"`
 	Phone        string     `json:"phone" example:"1234567890"`
 	Email        string     `json:"email" example:"john.doe@example.com"`
 	Availability []TimeSlot `json:"availability"`
@@ -48,6 +54,8 @@ func (d DoctorDTO) ToEntity() DoctorEntity {
 		ID:           id,
 		Name:         d.Name,
 		Specialty:    d.Specialty,
+		DepartmentID: objectIDFromHexOrNil(d.DepartmentID),
+		UserID:       objectIDFromHexOrNil(d.UserID),
 		Phone:        d.Phone,
 		Email:        d.Email,
 		Availability: d.Availability,
@@ -61,6 +69,8 @@ func (d DoctorEntity) ToDTO() DoctorDTO {
 		ID:           d.ID.Hex(),
 		Name:         d.Name,
 		Specialty:    d.Specialty,
+		DepartmentID: hexOrEmpty(d.DepartmentID),
+		UserID:       hexOrEmpty(d.UserID),
 		Phone:        d.Phone,
 		Email:        d.Email,
 		Availability: d.Availability,
@@ -76,6 +86,10 @@ type CreateDoctorRequet struct {
 	Specialty string `json:"specialty" validate:"required,min=3,max=100" example:"Pediatrics"`
 	Phone     string `json:"phone" validate:"required,e164" example:"+1987654321"`
 	Email     string `json:"email" validate:"required,email" example:"jane.smith@example.com"`
+	// DepartmentID assigns the doctor to a department.
+	DepartmentID string `json:"departmentId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000030"`
+	// UserID links the doctor to their login account.
+	UserID string `json:"userId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000001"`
 }
 
 // @Description	Request body for updating an existing doctor
@@ -85,6 +99,10 @@ type UpdateDoctorRequet struct {
 	Specialty string `json:"specialty" validate:"required,min=3,max=100" example:"Pediatrics"`
 	Phone     string `json:"phone" validate:"required,e164" example:"+1987654321"`
 	Email     string `json:"email" validate:"required,email" example:"jane.smith@example.com"`
+	// DepartmentID assigns the doctor to a department.
+	DepartmentID string `json:"departmentId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000030"`
+	// UserID links the doctor to their login account.
+	UserID string `json:"userId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000001"`
 }
 
 // @Description	Detailed doctor information including recent patients
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// DocumentKind is a kind of printable patient document.
+type DocumentKind string
+
+const (
+	DocumentVisitSummary   DocumentKind = "visit-summary"
+	DocumentPatientHistory DocumentKind = "patient-history"
+	DocumentMedicalRecord  DocumentKind = "medical-record"
+	DocumentInvoice        DocumentKind = "invoice"
+)
+
+func (k DocumentKind) IsValid() bool {
+	switch k {
+	case DocumentVisitSummary, DocumentPatientHistory, DocumentMedicalRecord, DocumentInvoice:
+		return true
+	}
+	return false
+}
+
+// Title is the heading printed on the document.
+func (k DocumentKind) Title() string {
+	switch k {
+	case DocumentVisitSummary:
+		return "Visit Summary"
+	case DocumentPatientHistory:
+		return "Patient History"
+	case DocumentMedicalRecord:
+		return "Medical Record"
+	case DocumentInvoice:
+		return "Invoice"
+	}
+	return string(k)
+}
+
+// @Description	What the QR code on a printed document vouches for
+// @swagger:model
+type DocumentVerification struct {
+	DocumentID string       `json:"documentId" example:"60d0fe4f53115a001f000090"`
+	Kind       DocumentKind `json:"kind" example:"visit-summary"`
+	Title      string       `json:"title" example:"Visit Summary"`
+	// Reference is the appointment, patient or record ID, or the invoice
+	// number, the document was printed from.
+	Reference string    `json:"reference" example:"60d0fe4f53115a001f000001"`
+	Patient   string    `json:"patient" example:"J*** D**"`
+	Issuer    string    `json:"issuer" example:"HMS Hospital"`
+	IssuedAt  time.Time `json:"issuedAt" example:"2025-07-17T10:00:00Z"`
+	// Current is false when the record has changed since the document was
+	// printed, so the printout may be out of date.
+	Current bool `json:"current" example:"true"`
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventType names a domain event, e.g. appointment.created.
+type EventType string
+
+const (
+	EventActivityCreated EventType = "activity.created"
+
+	EventAppointmentCreated       EventType = "appointment.created"
+	EventAppointmentUpdated       EventType = "appointment.updated"
+	EventAppointmentStatusChanged EventType = "appointment.status_changed"
+	EventAppointmentCancelled     EventType = "appointment.cancelled"
+	EventAppointmentRescheduled   EventType = "appointment.rescheduled"
+	EventAppointmentTriaged       EventType = "appointment.triaged"
+
+	EventPatientCreated EventType = "patient.created"
+	EventPatientUpdated EventType = "patient.updated"
+	EventPatientDeleted EventType = "patient.deleted"
+
+	EventMedicalRecordCreated EventType = "medical_record.created"
+	EventMedicalRecordUpdated EventType = "medical_record.updated"
+	EventMedicalRecordDeleted EventType = "medical_record.deleted"
+
+	EventQueueTicketIssued  EventType = "queue.ticket_issued"
+	EventQueueTicketCalled  EventType = "queue.ticket_called"
+	EventQueueTicketUpdated EventType = "queue.ticket_updated"
+	EventQueueTicketTriaged EventType = "queue.ticket_triaged"
+
+	EventReferralCreated       EventType = "referral.created"
+	EventReferralUpdated       EventType = "referral.updated"
+	EventReferralStatusChanged EventType = "referral.status_changed"
+
+	EventShiftAssigned      EventType = "roster.shift_assigned"
+	EventShiftCancelled     EventType = "roster.shift_cancelled"
+	EventShiftSwapRequested EventType = "roster.swap_requested"
+	EventShiftSwapDecided   EventType = "roster.swap_decided"
+
+	// EventWebhookPing is only sent to test a webhook subscription.
+	EventWebhookPing EventType = "webhook.ping"
+)
+
+// EventTypes lists every event type services publish.
+var EventTypes = []EventType{
+	EventActivityCreated,
+	EventAppointmentCreated,
+	EventAppointmentUpdated,
+	EventAppointmentStatusChanged,
+	EventAppointmentCancelled,
+	EventAppointmentRescheduled,
+	EventAppointmentTriaged,
+	EventPatientCreated,
+	EventPatientUpdated,
+	EventPatientDeleted,
+	EventMedicalRecordCreated,
+	EventMedicalRecordUpdated,
+	EventMedicalRecordDeleted,
+	EventQueueTicketIssued,
+	EventQueueTicketCalled,
+	EventQueueTicketUpdated,
+	EventQueueTicketTriaged,
+	EventReferralCreated,
+	EventReferralUpdated,
+	EventReferralStatusChanged,
+	EventShiftAssigned,
+	EventShiftCancelled,
+	EventShiftSwapRequested,
+	EventShiftSwapDecided,
+}
+
+func (t EventType) IsValid() bool {
+	for _, eventType := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// @Description	Domain event delivered over the event stream and to webhooks
+// @swagger:model
+type Event struct {
+	ID        string       `json:"id" example:"66a1f0c2e4b0a1b2c3d4e5f6"`
+	Type      EventType    `json:"type" example:"appointment.created"`
+	Topic     ActivityType `json:"topic" example:"APPOINTMENT"`
+	EntityID  string       `json:"entityId,omitempty" example:"60d0fe4f53115a001f000001"`
+	Data      interface{}  `json:"data,omitempty" swaggertype:"object"`
+	Timestamp time.Time    `json:"timestamp" example:"2025-07-17T09:00:00Z"`
+	// Sequence is the position of the event in the event bus, set when it is
+	// published. The event stream uses it as the SSE event ID.
+	Sequence uint64 `json:"-"`
+}
+
+// topicsByRole lists the event topics each role may subscribe to. Admin and
+// Management see everything.
+var topicsByRole = map[Role][]ActivityType{
+	RoleDoctor:       {ActivityTypeAppointment, ActivityTypeMedicalRecord, ActivityTypePatient, ActivityTypeLab, ActivityTypeAdmission, ActivityTypePharmacy, ActivityTypeQueue, ActivityTypeReferral, ActivityTypeRoster},
+	RoleNurse:        {ActivityTypeAppointment, ActivityTypeMedicalRecord, ActivityTypePatient, ActivityTypeLab, ActivityTypeAdmission, ActivityTypePharmacy, ActivityTypeQueue, ActivityTypeReferral, ActivityTypeRoster},
+	RoleReceptionist: {ActivityTypeAppointment, ActivityTypePatient, ActivityTypeDoctor, ActivityTypeAdmission, ActivityTypeBilling, ActivityTypeInsurance, ActivityTypeQueue, ActivityTypeReferral},
+	RoleLab:          {ActivityTypeLab},
+}
+
+// AllEventTopics lists every topic events are published under.
+var AllEventTopics = []ActivityType{
+	ActivityTypeAppointment,
+	ActivityTypeMedicalRecord,
+	ActivityTypePatient,
+	ActivityTypeDoctor,
+	ActivityTypeLab,
+	ActivityTypeAdmission,
+	ActivityTypeBilling,
+	ActivityTypeInsurance,
+	ActivityTypePharmacy,
+	ActivityTypeQueue,
+	ActivityTypeReferral,
+	ActivityTypeRoster,
+}
+
+// TopicsForRole returns the event topics a role is allowed to receive.
+func TopicsForRole(role Role) []ActivityType {
+	if role == RoleAdmin || role == RoleManagement {
+		return AllEventTopics
+	}
+	return topicsByRole[role]
+}
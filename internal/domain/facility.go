@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OperatingHoursFormat is the clock format of opening and closing times.
+const OperatingHoursFormat = "15:04"
+
+// OperatingHours is the opening window of a clinic or room on one weekday,
+// in the hospital time zone.
+type OperatingHours struct {
+	DayOfWeek int    `bson:"dayOfWeek" json:"dayOfWeek" validate:"min=0,max=6" example:"1"` // 0-6 (Sunday-Saturday)
+	Open      string `bson:"open" json:"open" validate:"required,datetime=15:04" example:"08:00"`
+	Close     string `bson:"close" json:"close" validate:"required,datetime=15:04" example:"16:00"`
+}
+
+// OpenDuring reports whether [start, end) falls inside one of the windows.
+// The times must already be in the hospital time zone. No hours at all
+// means open around the clock.
+func OpenDuring(hours []OperatingHours, start, end time.Time) bool {
+	if len(hours) == 0 {
+		return true
+	}
+
+	midnight := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	for _, h := range hours {
+		if h.DayOfWeek != int(start.Weekday()) {
+			continue
+		}
+		opening, err := time.Parse(OperatingHoursFormat, h.Open)
+		if err != nil {
+			continue
+		}
+		closing, err := time.Parse(OperatingHoursFormat, h.Close)
+		if err != nil {
+			continue
+		}
+
+		from := midnight.Add(time.Duration(opening.Hour())*time.Hour + time.Duration(opening.Minute())*time.Minute)
+		until := midnight.Add(time.Duration(closing.Hour())*time.Hour + time.Duration(closing.Minute())*time.Minute)
+		if !start.Before(from) && !end.After(until) {
+			return true
+		}
+	}
+	return false
+}
+
+// @Description	Hospital department, e.g. Internal Medicine
+// @swagger:model
+type DepartmentEntity struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000030"`
+	Name        string             `bson:"name" json:"name" example:"Cardiology"`
+	Code        string             `bson:"code" json:"code" example:"CARD"`
+	Description string             `bson:"description,omitempty" json:"description,omitempty" example:"Heart and blood vessel care"`
+	IsActive    bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy   primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy   primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt   time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Outpatient clinic (polyclinic) of a department
+// @swagger:model
+type ClinicEntity struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000031"`
+	DepartmentID   primitive.ObjectID `bson:"departmentId" json:"departmentId" example:"60d0fe4f53115a001f000030"`
+	Name           string             `bson:"name" json:"name" example:"Poli Jantung"`
+	Code           string             `bson:"code" json:"code" example:"PJ"`
+	Location       string             `bson:"location,omitempty" json:"location,omitempty" example:"Building A, 2nd floor"`
+	OperatingHours []OperatingHours   `bson:"operatingHours,omitempty" json:"operatingHours,omitempty"`
+	IsActive       bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy      primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy      primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt      time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Consultation or procedure room of a clinic that appointments are booked into
+// @swagger:model
+type ClinicRoomEntity struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000032"`
+	ClinicID     primitive.ObjectID `bson:"clinicId" json:"clinicId" example:"60d0fe4f53115a001f000031"`
+	DepartmentID primitive.ObjectID `bson:"departmentId" json:"departmentId" example:"60d0fe4f53115a001f000030"`
+	Name         string             `bson:"name" json:"name" example:"Room 101"`
+	// Capacity is how many appointments the room takes at the same time.
+	Capacity int `bson:"capacity" json:"capacity" example:"1"`
+	// OperatingHours override the clinic's hours when set.
+	OperatingHours []OperatingHours   `bson:"operatingHours,omitempty" json:"operatingHours,omitempty"`
+	IsActive       bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy      primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy      primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt      time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Request body for creating or updating a department
+// @swagger:model
+type DepartmentRequest struct {
+	Name        string `json:"name" validate:"required,min=2,max=100" example:"Cardiology"`
+	Code        string `json:"code" validate:"required,min=2,max=10" example:"CARD"`
+	Description string `json:"description,omitempty" validate:"max=500" example:"Heart and blood vessel care"`
+	IsActive    *bool  `json:"isActive,omitempty" example:"true"`
+}
+
+// @Description	Request body for creating or updating a clinic
+// @swagger:model
+type ClinicRequest struct {
+	DepartmentID   string           `json:"departmentId" validate:"required,mongodb" example:"60d0fe4f53115a001f000030"`
+	Name           string           `json:"name" validate:"required,min=2,max=100" example:"Poli Jantung"`
+	Code           string           `json:"code" validate:"required,min=2,max=10" example:"PJ"`
+	Location       string           `json:"location,omitempty" validate:"max=100" example:"Building A, 2nd floor"`
+	OperatingHours []OperatingHours `json:"operatingHours,omitempty" validate:"omitempty,dive"`
+	IsActive       *bool            `json:"isActive,omitempty" example:"true"`
+}
+
+// @Description	Request body for creating or updating a clinic room
+// @swagger:model
+type ClinicRoomRequest struct {
+	ClinicID       string           `json:"clinicId" validate:"required,mongodb" example:"60d0fe4f53115a001f000031"`
+	Name           string           `json:"name" validate:"required,min=1,max=50" example:"Room 101"`
+	Capacity       int              `json:"capacity" validate:"required,min=1,max=50" example:"1"`
+	OperatingHours []OperatingHours `json:"operatingHours,omitempty" validate:"omitempty,dive"`
+	IsActive       *bool            `json:"isActive,omitempty" example:"true"`
+}
+
+// hexOrEmpty returns the hex form of an optional ID, or "" when it is unset.
+func hexOrEmpty(id *primitive.ObjectID) string {
+	if id == nil {
+		return ""
+	}
+	return id.Hex()
+}
+
+// objectIDFromHexOrNil parses an optional ID, returning nil when it is empty
+// or malformed.
+func objectIDFromHexOrNil(hex string) *primitive.ObjectID {
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
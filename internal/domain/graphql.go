@@ -0,0 +1,16 @@
+package domain
+
+// @Description	GraphQL operation, as sent by GraphQL clients
+// @swagger:model
+type GraphQLRequest struct {
+	Query         string         `json:"query" validate:"required" example:"{ patient(id: \"60d0fe4f53115a001f000001\") { name appointments(limit: 5) { dateTime doctor { name } } } }"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// @Description	GraphQL result; fields the caller may not see are null with an error
+// @swagger:model
+type GraphQLResponse struct {
+	Data   map[string]any   `json:"data,omitempty"`
+	Errors []map[string]any `json:"errors,omitempty"`
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type HL7Direction string
+
+const (
+	HL7Inbound  HL7Direction = "inbound"
+	HL7Outbound HL7Direction = "outbound"
+)
+
+func (d HL7Direction) IsValid() bool {
+	switch d {
+	case HL7Inbound, HL7Outbound:
+		return true
+	}
+	return false
+}
+
+// HL7MessageStatus is Processed, Error or Rejected for inbound messages, after
+// the ACK code they were answered with, and Pending, Sent, Failed or
+// DeadLetter for outbound ones.
+type HL7MessageStatus string
+
+const (
+	HL7MessageProcessed  HL7MessageStatus = "Processed"
+	HL7MessageError      HL7MessageStatus = "Error"
+	HL7MessageRejected   HL7MessageStatus = "Rejected"
+	HL7MessagePending    HL7MessageStatus = "Pending"
+	HL7MessageSent       HL7MessageStatus = "Sent"
+	HL7MessageFailed     HL7MessageStatus = "Failed"
+	HL7MessageDeadLetter HL7MessageStatus = "DeadLetter"
+)
+
+func (s HL7MessageStatus) IsValid() bool {
+	switch s {
+	case HL7MessageProcessed, HL7MessageError, HL7MessageRejected,
+		HL7MessagePending, HL7MessageSent, HL7MessageFailed, HL7MessageDeadLetter:
+		return true
+	}
+	return false
+}
+
+// HL7Attempt is one attempt at sending an outbound message.
+type HL7Attempt struct {
+	AttemptedAt time.Time `bson:"attemptedAt" json:"attemptedAt"`
+	AckCode     string    `bson:"ackCode,omitempty" json:"ackCode,omitempty" example:"AE"`
+	Error       string    `bson:"error,omitempty" json:"error,omitempty" example:"connection refused"`
+	DurationMs  int64     `bson:"durationMs" json:"durationMs" example:"35"`
+}
+
+// @Description	HL7 v2 message received or sent over MLLP, with its acknowledgment
+// @swagger:model
+type HL7MessageEntity struct {
+	ID                 primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000030"`
+	Direction          HL7Direction       `bson:"direction" json:"direction" example:"inbound"`
+	Status             HL7MessageStatus   `bson:"status" json:"status" example:"Processed"`
+	MessageType        string             `bson:"messageType" json:"messageType" example:"ADT^A08"`
+	ControlID          string             `bson:"controlId" json:"controlId" example:"MSG00001"`
+	SendingApplication string             `bson:"sendingApplication" json:"sendingApplication" example:"LIS"`
+	SendingFacility    string             `bson:"sendingFacility" json:"sendingFacility" example:"LAB"`
+	Peer               string             `bson:"peer" json:"peer" example:"10.0.0.12:53211"`
+	EventID            string             `bson:"eventId,omitempty" json:"eventId,omitempty" example:"66a1f0c2e4b0a1b2c3d4e5f6"`
+	PatientID          string             `bson:"patientId,omitempty" json:"patientId,omitempty" example:"60d0fe4f53115a001f000002"`
+	Raw                string             `bson:"raw" json:"raw"`
+	Ack                string             `bson:"ack,omitempty" json:"ack,omitempty"`
+	AckCode            string             `bson:"ackCode,omitempty" json:"ackCode,omitempty" example:"AA"`
+	Error              string             `bson:"error,omitempty" json:"error,omitempty" example:"patient not found"`
+	AttemptCount       int                `bson:"attemptCount" json:"attemptCount" example:"0"`
+	NextAttemptAt      *time.Time         `bson:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty"`
+	Attempts           []HL7Attempt       `bson:"attempts,omitempty" json:"attempts,omitempty"`
+	ProcessedAt        *time.Time         `bson:"processedAt,omitempty" json:"processedAt,omitempty"`
+	CreatedAt          time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt          time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ImportKind is what a bulk import creates.
+type ImportKind string
+
+const (
+	ImportPatients ImportKind = "patients"
+	ImportDoctors  ImportKind = "doctors"
+)
+
+func (k ImportKind) IsValid() bool {
+	switch k {
+	case ImportPatients, ImportDoctors:
+		return true
+	}
+	return false
+}
+
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "Pending"
+	ImportJobRunning   ImportJobStatus = "Running"
+	ImportJobCompleted ImportJobStatus = "Completed"
+	ImportJobFailed    ImportJobStatus = "Failed"
+)
+
+// ImportRowStatus is the outcome of one row of an import file.
+type ImportRowStatus string
+
+const (
+	// ImportRowCreated rows were imported; ImportRowValid rows would have
+	// been, had the job not been a dry run.
+	ImportRowCreated   ImportRowStatus = "created"
+	ImportRowValid     ImportRowStatus = "valid"
+	ImportRowInvalid   ImportRowStatus = "invalid"
+	ImportRowDuplicate ImportRowStatus = "duplicate"
+	ImportRowFailed    ImportRowStatus = "failed"
+)
+
+// ImportRowError is a problem with one field of a row, or with the whole row
+// when Field is empty.
+type ImportRowError struct {
+	Field   string `bson:"field,omitempty" json:"field,omitempty" example:"Phone"`
+	Message string `bson:"message" json:"message" example:"The 'Phone' field must be a valid E.164 formatted phone number."`
+}
+
+// ImportRowResult is the outcome of one row. Row counts the file's records
+// from the header as row 1, so it matches a spreadsheet without blank rows.
+type ImportRowResult struct {
+	Row    int              `bson:"row" json:"row" example:"2"`
+	Status ImportRowStatus  `bson:"status" json:"status" example:"invalid"`
+	ID     string           `bson:"id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	Errors []ImportRowError `bson:"errors,omitempty" json:"errors,omitempty"`
+}
+
+// ImportJobEntity is an uploaded CSV file and the progress of importing it.
+// The worker saves the row results in batches, so a job picked up again
+// after a crash resumes after the last saved row.
+type ImportJobEntity struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	Kind     ImportKind         `bson:"kind"`
+	DryRun   bool               `bson:"dryRun"`
+	FileName string             `bson:"fileName"`
+	Content  string             `bson:"content"`
+	Status   ImportJobStatus    `bson:"status"`
+	// LeaseUntil is when a running job may be picked up by another worker.
+	LeaseUntil    time.Time          `bson:"leaseUntil"`
+	TotalRows     int                `bson:"totalRows"`
+	ProcessedRows int                `bson:"processedRows"`
+	Results       []ImportRowResult  `bson:"results"`
+	Error         string             `bson:"error,omitempty"`
+	CreatedBy     primitive.ObjectID `bson:"createdBy"`
+	StartedAt     *time.Time         `bson:"startedAt,omitempty"`
+	FinishedAt    *time.Time         `bson:"finishedAt,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt"`
+	UpdatedAt     time.Time          `bson:"updatedAt"`
+}
+
+// Count returns how many of the processed rows ended with the status.
+func (j *ImportJobEntity) Count(status ImportRowStatus) int {
+	n := 0
+	for _, r := range j.Results {
+		if r.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// @Description	Bulk import job with its progress and the rows that were not imported
+// @swagger:model
+type ImportJobDTO struct {
+	ID            string          `json:"id" example:"60d0fe4f53115a001f000050"`
+	Kind          ImportKind      `json:"kind" example:"patients"`
+	DryRun        bool            `json:"dryRun" example:"false"`
+	FileName      string          `json:"fileName" example:"patients.csv"`
+	Status        ImportJobStatus `json:"status" example:"Running"`
+	TotalRows     int             `json:"totalRows" example:"2500"`
+	ProcessedRows int             `json:"processedRows" example:"1200"`
+	// Progress is the percentage of rows processed.
+	Progress   int    `json:"progress" example:"48"`
+	Created    int    `json:"created" example:"1150"`
+	Valid      int    `json:"valid" example:"0"`
+	Invalid    int    `json:"invalid" example:"30"`
+	Duplicates int    `json:"duplicates" example:"20"`
+	Failed     int    `json:"failed" example:"0"`
+	Error      string `json:"error,omitempty" example:"failed to save import progress: context deadline exceeded"`
+	// Problems lists the rows that were not, or would not be, imported.
+	Problems   []ImportRowResult `json:"problems,omitempty"`
+	CreatedBy  string            `json:"createdBy" example:"60d0fe4f53115a001f000001"`
+	StartedAt  *time.Time        `json:"startedAt,omitempty" example:"2025-07-17T09:00:01Z"`
+	FinishedAt *time.Time        `json:"finishedAt,omitempty" example:"2025-07-17T09:02:30Z"`
+	CreatedAt  time.Time         `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+}
+
+// ToDTO returns the job without its file. Problems are only filled in when
+// withProblems is set, so job lists stay small.
+func (j *ImportJobEntity) ToDTO(withProblems bool) ImportJobDTO {
+	dto := ImportJobDTO{
+		ID:            j.ID.Hex(),
+		Kind:          j.Kind,
+		DryRun:        j.DryRun,
+		FileName:      j.FileName,
+		Status:        j.Status,
+		TotalRows:     j.TotalRows,
+		ProcessedRows: j.ProcessedRows,
+		Created:       j.Count(ImportRowCreated),
+		Valid:         j.Count(ImportRowValid),
+		Invalid:       j.Count(ImportRowInvalid),
+		Duplicates:    j.Count(ImportRowDuplicate),
+		Failed:        j.Count(ImportRowFailed),
+		Error:         j.Error,
+		CreatedBy:     j.CreatedBy.Hex(),
+		StartedAt:     j.StartedAt,
+		FinishedAt:    j.FinishedAt,
+		CreatedAt:     j.CreatedAt,
+	}
+	if j.TotalRows > 0 {
+		dto.Progress = j.ProcessedRows * 100 / j.TotalRows
+	}
+	if withProblems {
+		for _, r := range j.Results {
+			if r.Status != ImportRowCreated && r.Status != ImportRowValid {
+				dto.Problems = append(dto.Problems, r)
+			}
+		}
+	}
+	return dto
+}
@@ -0,0 +1,326 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type BedStatus string
+
+const (
+	BedStatusAvailable   BedStatus = "Available"
+	BedStatusOccupied    BedStatus = "Occupied"
+	BedStatusCleaning    BedStatus = "Cleaning"
+	BedStatusMaintenance BedStatus = "Maintenance"
+)
+
+func (bs BedStatus) IsValid() bool {
+	switch bs {
+	case BedStatusAvailable, BedStatusOccupied, BedStatusCleaning, BedStatusMaintenance:
+		return true
+	}
+	return false
+}
+
+type AdmissionStatus string
+
+const (
+	AdmissionStatusAdmitted   AdmissionStatus = "Admitted"
+	AdmissionStatusDischarged AdmissionStatus = "Discharged"
+)
+
+// @Description	Ward object
+// @swagger:model
+type WardEntity struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000010"`
+	Name      string             `bson:"name" json:"name" example:"Melati"`
+	Code      string             `bson:"code" json:"code" example:"MLT"`
+	Floor     int                `bson:"floor" json:"floor" example:"2"`
+	Specialty string             `bson:"specialty,omitempty" json:"specialty,omitempty" example:"Internal Medicine"`
+	IsActive  bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Ward data transfer object
+// @swagger:model
+type WardDTO struct {
+	ID        string    `json:"id" example:"60d0fe4f53115a001f000010"`
+	Name      string    `json:"name" example:"Melati"`
+	Code      string    `json:"code" example:"MLT"`
+	Floor     int       `json:"floor" example:"2"`
+	Specialty string    `json:"specialty,omitempty" example:"Internal Medicine"`
+	IsActive  bool      `json:"isActive" example:"true"`
+	CreatedAt time.Time `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt time.Time `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (w *WardEntity) ToDTO() WardDTO {
+	return WardDTO{
+		ID:        w.ID.Hex(),
+		Name:      w.Name,
+		Code:      w.Code,
+		Floor:     w.Floor,
+		Specialty: w.Specialty,
+		IsActive:  w.IsActive,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// @Description	Request body for creating or updating a ward
+// @swagger:model
+type WardRequest struct {
+	Name      string `json:"name" validate:"required,min=2,max=100" example:"Melati"`
+	Code      string `json:"code" validate:"required,min=2,max=10" example:"MLT"`
+	Floor     int    `json:"floor" validate:"gte=0" example:"2"`
+	Specialty string `json:"specialty,omitempty" validate:"max=100" example:"Internal Medicine"`
+	IsActive  *bool  `json:"isActive,omitempty" example:"true"`
+}
+
+// @Description	Room object
+// @swagger:model
+type RoomEntity struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000011"`
+	WardID    primitive.ObjectID `bson:"wardId" json:"wardId" example:"60d0fe4f53115a001f000010"`
+	Number    string             `bson:"number" json:"number" example:"201"`
+	Class     string             `bson:"class,omitempty" json:"class,omitempty" example:"VIP"`
+	CreatedBy primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Room data transfer object
+// @swagger:model
+type RoomDTO struct {
+	ID        string    `json:"id" example:"60d0fe4f53115a001f000011"`
+	WardID    string    `json:"wardId" example:"60d0fe4f53115a001f000010"`
+	Number    string    `json:"number" example:"201"`
+	Class     string    `json:"class,omitempty" example:"VIP"`
+	CreatedAt time.Time `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt time.Time `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (r *RoomEntity) ToDTO() RoomDTO {
+	return RoomDTO{
+		ID:        r.ID.Hex(),
+		WardID:    r.WardID.Hex(),
+		Number:    r.Number,
+		Class:     r.Class,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// @Description	Request body for creating a room
+// @swagger:model
+type CreateRoomRequest struct {
+	WardID string `json:"wardId" validate:"required,mongodb" example:"60d0fe4f53115a001f000010"`
+	Number string `json:"number" validate:"required,min=1,max=20" example:"201"`
+	Class  string `json:"class,omitempty" validate:"max=50" example:"VIP"`
+}
+
+// @Description	Bed object
+// @swagger:model
+type BedEntity struct {
+	ID                 primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000012"`
+	WardID             primitive.ObjectID  `bson:"wardId" json:"wardId" example:"60d0fe4f53115a001f000010"`
+	RoomID             primitive.ObjectID  `bson:"roomId" json:"roomId" example:"60d0fe4f53115a001f000011"`
+	Label              string              `bson:"label" json:"label" example:"201-A"`
+	Status             BedStatus           `bson:"status" json:"status" example:"Available"`
+	CurrentAdmissionID *primitive.ObjectID `bson:"currentAdmissionId" json:"currentAdmissionId,omitempty"`
+	CreatedBy          primitive.ObjectID  `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy          primitive.ObjectID  `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt          time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt          time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Bed data transfer object
+// @swagger:model
+type BedDTO struct {
+	ID                 string    `json:"id" example:"60d0fe4f53115a001f000012"`
+	WardID             string    `json:"wardId" example:"60d0fe4f53115a001f000010"`
+	RoomID             string    `json:"roomId" example:"60d0fe4f53115a001f000011"`
+	Label              string    `json:"label" example:"201-A"`
+	Status             BedStatus `json:"status" example:"Available"`
+	CurrentAdmissionID string    `json:"currentAdmissionId,omitempty" example:"60d0fe4f53115a001f000013"`
+	CreatedAt          time.Time `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt          time.Time `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (b *BedEntity) ToDTO() BedDTO {
+	dto := BedDTO{
+		ID:        b.ID.Hex(),
+		WardID:    b.WardID.Hex(),
+		RoomID:    b.RoomID.Hex(),
+		Label:     b.Label,
+		Status:    b.Status,
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
+	}
+	if b.CurrentAdmissionID != nil {
+		dto.CurrentAdmissionID = b.CurrentAdmissionID.Hex()
+	}
+	return dto
+}
+
+// @Description	Request body for creating a bed
+// @swagger:model
+type CreateBedRequest struct {
+	RoomID string `json:"roomId" validate:"required,mongodb" example:"60d0fe4f53115a001f000011"`
+	Label  string `json:"label" validate:"required,min=1,max=20" example:"201-A"`
+}
+
+// @Description	Request body for changing the housekeeping status of a bed
+// @swagger:model
+type UpdateBedStatusRequest struct {
+	Status BedStatus `json:"status" validate:"required,oneof=Available Cleaning Maintenance" example:"Cleaning"`
+}
+
+// BedTransfer records a bed move during an admission.
+type BedTransfer struct {
+	FromBedID     primitive.ObjectID `bson:"fromBedId" json:"fromBedId"`
+	ToBedID       primitive.ObjectID `bson:"toBedId" json:"toBedId"`
+	Reason        string             `bson:"reason,omitempty" json:"reason,omitempty" example:"Moved to isolation"`
+	TransferredBy primitive.ObjectID `bson:"transferredBy" json:"transferredBy,omitempty"`
+	TransferredAt time.Time          `bson:"transferredAt" json:"transferredAt"`
+}
+
+// @Description	Discharge summary built from the medical records written during the stay
+// @swagger:model
+type DischargeSummary struct {
+	AdmittedAt      time.Time          `bson:"admittedAt" json:"admittedAt"`
+	DischargedAt    time.Time          `bson:"dischargedAt" json:"dischargedAt"`
+	LengthOfStay    int                `bson:"lengthOfStay" json:"lengthOfStay" example:"3"` // in days
+	AdmissionReason string             `bson:"admissionReason" json:"admissionReason" example:"Dengue fever"`
+	Diagnoses       []string           `bson:"diagnoses" json:"diagnoses"`
+	Treatments      []string           `bson:"treatments" json:"treatments"`
+	Records         []MedicalRecordDTO `bson:"records" json:"records"`
+	Disposition     string             `bson:"disposition" json:"disposition" example:"Home"`
+	Instructions    string             `bson:"instructions,omitempty" json:"instructions,omitempty" example:"Oral rehydration, control in 7 days"`
+}
+
+// @Description	Inpatient admission object
+// @swagger:model
+type AdmissionEntity struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000013"`
+	PatientID        primitive.ObjectID `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	DoctorID         primitive.ObjectID `bson:"doctorId" json:"doctorId" example:"60d0fe4f53115a001f000003"`
+	WardID           primitive.ObjectID `bson:"wardId" json:"wardId" example:"60d0fe4f53115a001f000010"`
+	BedID            primitive.ObjectID `bson:"bedId" json:"bedId" example:"60d0fe4f53115a001f000012"`
+	Status           AdmissionStatus    `bson:"status" json:"status" example:"Admitted"`
+	Reason           string             `bson:"reason" json:"reason" example:"Dengue fever"`
+	AdmittedAt       time.Time          `bson:"admittedAt" json:"admittedAt"`
+	DischargedAt     *time.Time         `bson:"dischargedAt,omitempty" json:"dischargedAt,omitempty"`
+	Transfers        []BedTransfer      `bson:"transfers,omitempty" json:"transfers,omitempty"`
+	DischargeSummary *DischargeSummary  `bson:"dischargeSummary,omitempty" json:"dischargeSummary,omitempty"`
+	CreatedBy        primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy        primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt        time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt        time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Admission data transfer object
+// @swagger:model
+type AdmissionDTO struct {
+	ID               string            `json:"id" example:"60d0fe4f53115a001f000013"`
+	PatientID        string            `json:"patientId" example:"60d0fe4f53115a001f000002"`
+	DoctorID         string            `json:"doctorId" example:"60d0fe4f53115a001f000003"`
+	WardID           string            `json:"wardId" example:"60d0fe4f53115a001f000010"`
+	BedID            string            `json:"bedId" example:"60d0fe4f53115a001f000012"`
+	Status           AdmissionStatus   `json:"status" example:"Admitted"`
+	Reason           string            `json:"reason" example:"Dengue fever"`
+	AdmittedAt       time.Time         `json:"admittedAt" example:"2025-07-17T09:00:00Z"`
+	DischargedAt     *time.Time        `json:"dischargedAt,omitempty"`
+	Transfers        []BedTransfer     `json:"transfers,omitempty"`
+	DischargeSummary *DischargeSummary `json:"dischargeSummary,omitempty"`
+	CreatedAt        time.Time         `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt        time.Time         `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (a *AdmissionEntity) ToDTO() AdmissionDTO {
+	return AdmissionDTO{
+		ID:               a.ID.Hex(),
+		PatientID:        a.PatientID.Hex(),
+		DoctorID:         a.DoctorID.Hex(),
+		WardID:           a.WardID.Hex(),
+		BedID:            a.BedID.Hex(),
+		Status:           a.Status,
+		Reason:           a.Reason,
+		AdmittedAt:       a.AdmittedAt,
+		DischargedAt:     a.DischargedAt,
+		Transfers:        a.Transfers,
+		DischargeSummary: a.DischargeSummary,
+		CreatedAt:        a.CreatedAt,
+		UpdatedAt:        a.UpdatedAt,
+	}
+}
+
+// @Description	Request body for admitting a patient
+// @swagger:model
+type AdmitPatientRequest struct {
+	PatientID string `json:"patientId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	DoctorID  string `json:"doctorId" validate:"required,mongodb" example:"60d0fe4f53115a001f000003"`
+	BedID     string `json:"bedId" validate:"required,mongodb" example:"60d0fe4f53115a001f000012"`
+	Reason    string `json:"reason" validate:"required,min=3,max=500" example:"Dengue fever"`
+}
+
+// @Description	Request body for transferring an admitted patient to another bed
+// @swagger:model
+type TransferPatientRequest struct {
+	BedID  string `json:"bedId" validate:"required,mongodb" example:"60d0fe4f53115a001f000014"`
+	Reason string `json:"reason,omitempty" validate:"max=500" example:"Moved to isolation"`
+}
+
+// @Description	Request body for discharging a patient
+// @swagger:model
+type DischargePatientRequest struct {
+	Disposition  string `json:"disposition" validate:"required,oneof=Home Referred AMA Deceased" example:"Home"`
+	Instructions string `json:"instructions,omitempty" validate:"max=1000" example:"Oral rehydration, control in 7 days"`
+}
+
+// @Description	Bed occupancy statistics
+// @swagger:model
+type BedOccupancyStats struct {
+	TotalBeds       int64   `json:"totalBeds" example:"40"`
+	OccupiedBeds    int64   `json:"occupiedBeds" example:"31"`
+	AvailableBeds   int64   `json:"availableBeds" example:"6"`
+	UnavailableBeds int64   `json:"unavailableBeds" example:"3"`  // cleaning or maintenance
+	OccupancyRate   float64 `json:"occupancyRate" example:"77.5"` // percentage of total beds
+}
+
+// NewBedOccupancyStats computes the derived counts from per-status bed counts.
+func NewBedOccupancyStats(counts map[BedStatus]int64) BedOccupancyStats {
+	stats := BedOccupancyStats{
+		OccupiedBeds:    counts[BedStatusOccupied],
+		AvailableBeds:   counts[BedStatusAvailable],
+		UnavailableBeds: counts[BedStatusCleaning] + counts[BedStatusMaintenance],
+	}
+	stats.TotalBeds = stats.OccupiedBeds + stats.AvailableBeds + stats.UnavailableBeds
+	if stats.TotalBeds > 0 {
+		stats.OccupancyRate = float64(stats.OccupiedBeds) / float64(stats.TotalBeds) * 100
+	}
+	return stats
+}
+
+// @Description	A bed on the occupancy board
+// @swagger:model
+type BedBoardEntry struct {
+	Bed         BedDTO     `json:"bed"`
+	RoomNumber  string     `json:"roomNumber" example:"201"`
+	PatientID   string     `json:"patientId,omitempty" example:"60d0fe4f53115a001f000002"`
+	PatientName string     `json:"patientName,omitempty" example:"John Doe"`
+	AdmittedAt  *time.Time `json:"admittedAt,omitempty"`
+}
+
+// @Description	Bed occupancy board for a single ward
+// @swagger:model
+type WardBoard struct {
+	Ward  WardDTO           `json:"ward"`
+	Stats BedOccupancyStats `json:"stats"`
+	Beds  []BedBoardEntry   `json:"beds"`
+}
@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PayerType string
+
+const (
+	PayerTypeBPJS    PayerType = "BPJS"
+	PayerTypePrivate PayerType = "Private"
+)
+
+type ClaimStatus string
+
+const (
+	ClaimStatusDraft     ClaimStatus = "Draft"
+	ClaimStatusSubmitted ClaimStatus = "Submitted"
+	ClaimStatusApproved  ClaimStatus = "Approved"
+	ClaimStatusRejected  ClaimStatus = "Rejected"
+	ClaimStatusPaid      ClaimStatus = "Paid"
+)
+
+func (cs ClaimStatus) IsValid() bool {
+	switch cs {
+	case ClaimStatusDraft, ClaimStatusSubmitted, ClaimStatusApproved, ClaimStatusRejected, ClaimStatusPaid:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo reports whether a claim may move from cs to next.
+func (cs ClaimStatus) CanTransitionTo(next ClaimStatus) bool {
+	switch cs {
+	case ClaimStatusDraft:
+		return next == ClaimStatusSubmitted
+	case ClaimStatusSubmitted:
+		return next == ClaimStatusApproved || next == ClaimStatusRejected
+	case ClaimStatusApproved:
+		return next == ClaimStatusPaid
+	case ClaimStatusRejected:
+		// A rejected claim can be corrected and sent again.
+		return next == ClaimStatusDraft
+	}
+	return false
+}
+
+// @Description	Insurance policy of a patient
+// @swagger:model
+type InsurancePolicyEntity struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	PatientID    primitive.ObjectID `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	PayerType    PayerType          `bson:"payerType" json:"payerType" example:"BPJS"`
+	PayerName    string             `bson:"payerName" json:"payerName" example:"BPJS Kesehatan"`
+	MemberNumber string             `bson:"memberNumber" json:"memberNumber" example:"0001234567890"`
+	PlanClass    string             `bson:"planClass,omitempty" json:"planClass,omitempty" example:"Kelas 1"`
+	ValidFrom    time.Time          `bson:"validFrom" json:"validFrom" example:"2025-01-01T00:00:00Z"`
+	ValidUntil   *time.Time         `bson:"validUntil,omitempty" json:"validUntil,omitempty" example:"2025-12-31T23:59:59Z"`
+	IsActive     bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy    primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy    primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// CoversDate reports whether the policy is active and valid on the given date.
+func (p *InsurancePolicyEntity) CoversDate(t time.Time) bool {
+	if !p.IsActive || t.Before(p.ValidFrom) {
+		return false
+	}
+	return p.ValidUntil == nil || !t.After(*p.ValidUntil)
+}
+
+// @Description	Insurance policy data transfer object
+// @swagger:model
+type InsurancePolicyDTO struct {
+	ID           string     `json:"id" example:"60d0fe4f53115a001f000001"`
+	PatientID    string     `json:"patientId" example:"60d0fe4f53115a001f000002"`
+	PayerType    PayerType  `json:"payerType" example:"BPJS"`
+	PayerName    string     `json:"payerName" example:"BPJS Kesehatan"`
+	MemberNumber string     `json:"memberNumber" example:"0001234567890"`
+	PlanClass    string     `json:"planClass,omitempty" example:"Kelas 1"`
+	ValidFrom    time.Time  `json:"validFrom" example:"2025-01-01T00:00:00Z"`
+	ValidUntil   *time.Time `json:"validUntil,omitempty" example:"2025-12-31T23:59:59Z"`
+	IsActive     bool       `json:"isActive" example:"true"`
+	CreatedAt    time.Time  `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt    time.Time  `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (p *InsurancePolicyEntity) ToDTO() InsurancePolicyDTO {
+	return InsurancePolicyDTO{
+		ID:           p.ID.Hex(),
+		PatientID:    p.PatientID.Hex(),
+		PayerType:    p.PayerType,
+		PayerName:    p.PayerName,
+		MemberNumber: p.MemberNumber,
+		PlanClass:    p.PlanClass,
+		ValidFrom:    p.ValidFrom,
+		ValidUntil:   p.ValidUntil,
+		IsActive:     p.IsActive,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+// @Description	Request body for creating or updating an insurance policy
+// @swagger:model
+type InsurancePolicyRequest struct {
+	PatientID    string     `json:"patientId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	PayerType    PayerType  `json:"payerType" validate:"required,oneof=BPJS Private" example:"BPJS"`
+	PayerName    string     `json:"payerName" validate:"required,max=100" example:"BPJS Kesehatan"`
+	MemberNumber string     `json:"memberNumber" validate:"required,max=30" example:"0001234567890"`
+	PlanClass    string     `json:"planClass,omitempty" validate:"max=50" example:"Kelas 1"`
+	ValidFrom    time.Time  `json:"validFrom" validate:"required" example:"2025-01-01T00:00:00Z"`
+	ValidUntil   *time.Time `json:"validUntil,omitempty" example:"2025-12-31T23:59:59Z"`
+	IsActive     *bool      `json:"isActive,omitempty" example:"true"`
+}
+
+// @Description	Result of an insurance eligibility check for an appointment
+// @swagger:model
+type EligibilityCheck struct {
+	PolicyID     *primitive.ObjectID `bson:"policyId,omitempty" json:"policyId,omitempty" example:"60d0fe4f53115a001f000001"`
+	PayerType    PayerType           `bson:"payerType,omitempty" json:"payerType,omitempty" example:"BPJS"`
+	Eligible     bool                `bson:"eligible" json:"eligible" example:"true"`
+	Reason       string              `bson:"reason,omitempty" json:"reason,omitempty" example:"Policy expired"`
+	PayerRefCode string              `bson:"payerRefCode,omitempty" json:"payerRefCode,omitempty" example:"ELG-0001234567890"`
+	CheckedAt    time.Time           `bson:"checkedAt" json:"checkedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+type ClaimStatusChange struct {
+	Status    ClaimStatus        `bson:"status" json:"status" example:"Submitted"`
+	Reason    string             `bson:"reason,omitempty" json:"reason,omitempty"`
+	ChangedBy primitive.ObjectID `bson:"changedBy" json:"changedBy,omitempty"`
+	ChangedAt time.Time          `bson:"changedAt" json:"changedAt"`
+}
+
+// @Description	Insurance claim object
+// @swagger:model
+type ClaimEntity struct {
+	ID              primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	Number          string              `bson:"number" json:"number" example:"CLM-20250717-1F000001"`
+	InvoiceID       primitive.ObjectID  `bson:"invoiceId" json:"invoiceId" example:"60d0fe4f53115a001f000005"`
+	InvoiceNumber   string              `bson:"invoiceNumber" json:"invoiceNumber" example:"INV-20250717-1F000001"`
+	PatientID       primitive.ObjectID  `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	AppointmentID   *primitive.ObjectID `bson:"appointmentId,omitempty" json:"appointmentId,omitempty" example:"60d0fe4f53115a001f000004"`
+	PolicyID        primitive.ObjectID  `bson:"policyId" json:"policyId" example:"60d0fe4f53115a001f000006"`
+	PayerType       PayerType           `bson:"payerType" json:"payerType" example:"BPJS"`
+	PayerName       string              `bson:"payerName" json:"payerName" example:"BPJS Kesehatan"`
+	MemberNumber    string              `bson:"memberNumber" json:"memberNumber" example:"0001234567890"`
+	ServiceDate     time.Time           `bson:"serviceDate" json:"serviceDate" example:"2025-07-17T10:00:00Z"`
+	Items           []InvoiceLineItem   `bson:"items" json:"items"`
+	Currency        string              `bson:"currency" json:"currency" example:"IDR"`
+	ClaimedAmount   int64               `bson:"claimedAmount" json:"claimedAmount" example:"15000000"`
+	ApprovedAmount  int64               `bson:"approvedAmount" json:"approvedAmount" example:"0"`
+	PaidAmount      int64               `bson:"paidAmount" json:"paidAmount" example:"0"`
+	Status          ClaimStatus         `bson:"status" json:"status" example:"Draft"`
+	PayerReference  string              `bson:"payerReference,omitempty" json:"payerReference,omitempty" example:"FAKE-CLM-20250717-1F000001"`
+	RejectionReason string              `bson:"rejectionReason,omitempty" json:"rejectionReason,omitempty"`
+	History         []ClaimStatusChange `bson:"history" json:"history"`
+	SubmittedAt     *time.Time          `bson:"submittedAt,omitempty" json:"submittedAt,omitempty"`
+	CreatedBy       primitive.ObjectID  `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy       primitive.ObjectID  `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt       time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt       time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Insurance claim data transfer object
+// @swagger:model
+type ClaimDTO struct {
+	ID              string              `json:"id" example:"60d0fe4f53115a001f000001"`
+	Number          string              `json:"number" example:"CLM-20250717-1F000001"`
+	InvoiceID       string              `json:"invoiceId" example:"60d0fe4f53115a001f000005"`
+	InvoiceNumber   string              `json:"invoiceNumber" example:"INV-20250717-1F000001"`
+	PatientID       string              `json:"patientId" example:"60d0fe4f53115a001f000002"`
+	AppointmentID   string              `json:"appointmentId,omitempty" example:"60d0fe4f53115a001f000004"`
+	PolicyID        string              `json:"policyId" example:"60d0fe4f53115a001f000006"`
+	PayerType       PayerType           `json:"payerType" example:"BPJS"`
+	PayerName       string              `json:"payerName" example:"BPJS Kesehatan"`
+	MemberNumber    string              `json:"memberNumber" example:"0001234567890"`
+	ServiceDate     time.Time           `json:"serviceDate" example:"2025-07-17T10:00:00Z"`
+	Items           []InvoiceLineItem   `json:"items"`
+	Currency        string              `json:"currency" example:"IDR"`
+	ClaimedAmount   int64               `json:"claimedAmount" example:"15000000"`
+	ApprovedAmount  int64               `json:"approvedAmount" example:"0"`
+	PaidAmount      int64               `json:"paidAmount" example:"0"`
+	Status          ClaimStatus         `json:"status" example:"Draft"`
+	PayerReference  string              `json:"payerReference,omitempty" example:"FAKE-CLM-20250717-1F000001"`
+	RejectionReason string              `json:"rejectionReason,omitempty"`
+	History         []ClaimStatusChange `json:"history"`
+	SubmittedAt     *time.Time          `json:"submittedAt,omitempty"`
+	CreatedAt       time.Time           `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt       time.Time           `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (c *ClaimEntity) ToDTO() ClaimDTO {
+	dto := ClaimDTO{
+		ID:              c.ID.Hex(),
+		Number:          c.Number,
+		InvoiceID:       c.InvoiceID.Hex(),
+		InvoiceNumber:   c.InvoiceNumber,
+		PatientID:       c.PatientID.Hex(),
+		PolicyID:        c.PolicyID.Hex(),
+		PayerType:       c.PayerType,
+		PayerName:       c.PayerName,
+		MemberNumber:    c.MemberNumber,
+		ServiceDate:     c.ServiceDate,
+		Items:           c.Items,
+		Currency:        c.Currency,
+		ClaimedAmount:   c.ClaimedAmount,
+		ApprovedAmount:  c.ApprovedAmount,
+		PaidAmount:      c.PaidAmount,
+		Status:          c.Status,
+		PayerReference:  c.PayerReference,
+		RejectionReason: c.RejectionReason,
+		History:         c.History,
+		SubmittedAt:     c.SubmittedAt,
+		CreatedAt:       c.CreatedAt,
+		UpdatedAt:       c.UpdatedAt,
+	}
+	if c.AppointmentID != nil {
+		dto.AppointmentID = c.AppointmentID.Hex()
+	}
+	return dto
+}
+
+// @Description	Request body for generating a claim from an invoice
+// @swagger:model
+type CreateClaimRequest struct {
+	InvoiceID string `json:"invoiceId" validate:"required,mongodb" example:"60d0fe4f53115a001f000005"`
+	PolicyID  string `json:"policyId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000006"`
+}
+
+// @Description	Request body for recording the payer's decision on a claim
+// @swagger:model
+type UpdateClaimStatusRequest struct {
+	Status         ClaimStatus `json:"status" validate:"required,oneof=Draft Approved Rejected Paid" example:"Approved"`
+	Amount         int64       `json:"amount,omitempty" validate:"gte=0" example:"15000000"`
+	PayerReference string      `json:"payerReference,omitempty" validate:"max=100" example:"SEP-0001"`
+	Reason         string      `json:"reason,omitempty" validate:"max=500" example:"Diagnosis code missing"`
+}
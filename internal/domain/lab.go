@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type LabOrderStatus string
+
+const (
+	LabOrderStatusOrdered    LabOrderStatus = "Ordered"
+	LabOrderStatusInProgress LabOrderStatus = "InProgress"
+	LabOrderStatusCompleted  LabOrderStatus = "Completed"
+	LabOrderStatusCancelled  LabOrderStatus = "Cancelled"
+)
+
+func (ls LabOrderStatus) IsValid() bool {
+	switch ls {
+	case LabOrderStatusOrdered, LabOrderStatusInProgress, LabOrderStatusCompleted, LabOrderStatusCancelled:
+		return true
+	}
+	return false
+}
+
+type LabOrderPriority string
+
+const (
+	LabOrderPriorityRoutine LabOrderPriority = "routine"
+	LabOrderPriorityUrgent  LabOrderPriority = "urgent"
+	LabOrderPriorityStat    LabOrderPriority = "stat"
+)
+
+// Rank returns the worklist ordering of a priority, lower values are processed first.
+func (lp LabOrderPriority) Rank() int {
+	switch lp {
+	case LabOrderPriorityStat:
+		return 0
+	case LabOrderPriorityUrgent:
+		return 1
+	}
+	return 2
+}
+
+// AbnormalFlag follows the HL7 interpretation codes used by most analyzers.
+type AbnormalFlag string
+
+const (
+	AbnormalFlagNormal       AbnormalFlag = "N"
+	AbnormalFlagLow          AbnormalFlag = "L"
+	AbnormalFlagHigh         AbnormalFlag = "H"
+	AbnormalFlagCriticalLow  AbnormalFlag = "LL"
+	AbnormalFlagCriticalHigh AbnormalFlag = "HH"
+	AbnormalFlagAbnormal     AbnormalFlag = "A"
+)
+
+func (af AbnormalFlag) IsValid() bool {
+	switch af {
+	case AbnormalFlagNormal, AbnormalFlagLow, AbnormalFlagHigh, AbnormalFlagCriticalLow, AbnormalFlagCriticalHigh, AbnormalFlagAbnormal:
+		return true
+	}
+	return false
+}
+
+// IsAbnormal reports whether the flag is anything other than normal.
+func (af AbnormalFlag) IsAbnormal() bool {
+	return af != "" && af != AbnormalFlagNormal
+}
+
+type LabTest struct {
+	Code string `bson:"code" json:"code" validate:"required,max=20" example:"HB"`
+	Name string `bson:"name" json:"name" validate:"required,max=100" example:"Hemoglobin"`
+}
+
+type ReferenceRange struct {
+	Low          *float64 `bson:"low,omitempty" json:"low,omitempty" example:"12"`
+	High         *float64 `bson:"high,omitempty" json:"high,omitempty" example:"16"`
+	CriticalLow  *float64 `bson:"criticalLow,omitempty" json:"criticalLow,omitempty" example:"7"`
+	CriticalHigh *float64 `bson:"criticalHigh,omitempty" json:"criticalHigh,omitempty" example:"20"`
+	Text         string   `bson:"text,omitempty" json:"text,omitempty" example:"12-16 g/dL"`
+}
+
+// Evaluate derives the abnormal flag for a numeric value against the range.
+// It returns an empty flag when the range has no numeric bounds.
+func (r ReferenceRange) Evaluate(value float64) AbnormalFlag {
+	if r.Low == nil && r.High == nil && r.CriticalLow == nil && r.CriticalHigh == nil {
+		return ""
+	}
+	switch {
+	case r.CriticalLow != nil && value < *r.CriticalLow:
+		return AbnormalFlagCriticalLow
+	case r.CriticalHigh != nil && value > *r.CriticalHigh:
+		return AbnormalFlagCriticalHigh
+	case r.Low != nil && value < *r.Low:
+		return AbnormalFlagLow
+	case r.High != nil && value > *r.High:
+		return AbnormalFlagHigh
+	}
+	return AbnormalFlagNormal
+}
+
+type LabResult struct {
+	TestCode       string             `bson:"testCode" json:"testCode" example:"HB"`
+	TestName       string             `bson:"testName" json:"testName" example:"Hemoglobin"`
+	Value          string             `bson:"value" json:"value" example:"10.5"`
+	Unit           string             `bson:"unit,omitempty" json:"unit,omitempty" example:"g/dL"`
+	ReferenceRange ReferenceRange     `bson:"referenceRange" json:"referenceRange"`
+	Flag           AbnormalFlag       `bson:"flag" json:"flag" example:"L"`
+	Notes          string             `bson:"notes,omitempty" json:"notes,omitempty"`
+	ResultedBy     primitive.ObjectID `bson:"resultedBy" json:"resultedBy,omitempty"`
+	ResultedAt     time.Time          `bson:"resultedAt" json:"resultedAt"`
+	// Preliminary results, sent by analyzers before they are verified, may
+	// still be replaced by a final result.
+	Preliminary bool `bson:"preliminary,omitempty" json:"preliminary,omitempty"`
+}
+
+// @Description	Lab order object
+// @swagger:model
+type LabOrderEntity struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	PatientID     primitive.ObjectID `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	AppointmentID primitive.ObjectID `bson:"appointmentId" json:"appointmentId" example:"60d0fe4f53115a001f000004"`
+	DoctorID      primitive.ObjectID `bson:"doctorId" json:"doctorId" example:"60d0fe4f53115a001f000003"`
+	Tests         []LabTest          `bson:"tests" json:"tests"`
+	Priority      LabOrderPriority   `bson:"priority" json:"priority" example:"routine"`
+	Status        LabOrderStatus     `bson:"status" json:"status" example:"Ordered"`
+	ClinicalNotes string             `bson:"clinicalNotes,omitempty" json:"clinicalNotes,omitempty" example:"Suspected anemia"`
+	Results       []LabResult        `bson:"results,omitempty" json:"results,omitempty"`
+	CompletedAt   *time.Time         `bson:"completedAt,omitempty" json:"completedAt,omitempty"`
+	CreatedBy     primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy     primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt     time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// HasAbnormalResults reports whether any result on the order is flagged.
+func (l *LabOrderEntity) HasAbnormalResults() bool {
+	for _, r := range l.Results {
+		if r.Flag.IsAbnormal() {
+			return true
+		}
+	}
+	return false
+}
+
+// @Description	Lab order data transfer object
+// @swagger:model
+type LabOrderDTO struct {
+	ID            string           `json:"id" example:"60d0fe4f53115a001f000001"`
+	PatientID     string           `json:"patientId" example:"60d0fe4f53115a001f000002"`
+	AppointmentID string           `json:"appointmentId" example:"60d0fe4f53115a001f000004"`
+	DoctorID      string           `json:"doctorId" example:"60d0fe4f53115a001f000003"`
+	Tests         []LabTest        `json:"tests"`
+	Priority      LabOrderPriority `json:"priority" example:"routine"`
+	Status        LabOrderStatus   `json:"status" example:"Ordered"`
+	ClinicalNotes string           `json:"clinicalNotes,omitempty" example:"Suspected anemia"`
+	Results       []LabResult      `json:"results,omitempty"`
+	HasAbnormal   bool             `json:"hasAbnormal" example:"false"`
+	CompletedAt   *time.Time       `json:"completedAt,omitempty"`
+	CreatedAt     time.Time        `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt     time.Time        `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (l *LabOrderEntity) ToDTO() LabOrderDTO {
+	return LabOrderDTO{
+		ID:            l.ID.Hex(),
+		PatientID:     l.PatientID.Hex(),
+		AppointmentID: l.AppointmentID.Hex(),
+		DoctorID:      l.DoctorID.Hex(),
+		Tests:         l.Tests,
+		Priority:      l.Priority,
+		Status:        l.Status,
+		ClinicalNotes: l.ClinicalNotes,
+		Results:       l.Results,
+		HasAbnormal:   l.HasAbnormalResults(),
+		CompletedAt:   l.CompletedAt,
+		CreatedAt:     l.CreatedAt,
+		UpdatedAt:     l.UpdatedAt,
+	}
+}
+
+// @Description	Lab worklist entry with the patient name resolved
+// @swagger:model
+type LabWorklistItem struct {
+	Order       LabOrderDTO `json:"order"`
+	PatientName string      `json:"patientName" example:"John Doe"`
+}
+
+// @Description	Request body for placing a new lab order
+// @swagger:model
+type CreateLabOrderRequest struct {
+	PatientID     string           `json:"patientId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	AppointmentID string           `json:"appointmentId" validate:"required,mongodb" example:"60d0fe4f53115a001f000004"`
+	Tests         []LabTest        `json:"tests" validate:"required,min=1,dive"`
+	Priority      LabOrderPriority `json:"priority" validate:"required,oneof=routine urgent stat" example:"routine"`
+	ClinicalNotes string           `json:"clinicalNotes,omitempty" validate:"max=500" example:"Suspected anemia"`
+}
+
+// @Description	A single result entered by the lab
+// @swagger:model
+type LabResultRequest struct {
+	TestCode       string         `json:"testCode" validate:"required" example:"HB"`
+	Value          string         `json:"value" validate:"required,max=100" example:"10.5"`
+	Unit           string         `json:"unit,omitempty" validate:"max=20" example:"g/dL"`
+	ReferenceRange ReferenceRange `json:"referenceRange"`
+	Flag           AbnormalFlag   `json:"flag,omitempty" validate:"omitempty,oneof=N L H LL HH A" example:"L"`
+	Notes          string         `json:"notes,omitempty" validate:"max=500"`
+}
+
+// @Description	Request body for entering lab results
+// @swagger:model
+type EnterLabResultsRequest struct {
+	Results []LabResultRequest `json:"results" validate:"required,min=1,dive"`
+}
+
+// @Description	Request body for updating a lab order status
+// @swagger:model
+type UpdateLabOrderStatusRequest struct {
+	Status LabOrderStatus `json:"status" validate:"required,oneof=Ordered InProgress Cancelled" example:"InProgress"`
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending    OutboxStatus = "Pending"
+	OutboxStatusDispatched OutboxStatus = "Dispatched"
+	// OutboxStatusDead marks a message that failed too many times. It is no
+	// longer retried until an admin requeues it.
+	OutboxStatusDead OutboxStatus = "Dead"
+)
+
+// OutboxMessageEntity is an event waiting to be dispatched. It is written in
+// the same Mongo session as the business change that raised it, so an event
+// exists if and only if the change was committed. Its ID doubles as the event
+// ID, which lets consumers de-duplicate redeliveries.
+type OutboxMessageEntity struct {
+	ID            primitive.ObjectID `bson:"_id" json:"id" example:"60d0fe4f53115a001f000001"`
+	Type          EventType          `bson:"type" json:"type" example:"appointment.created"`
+	Topic         ActivityType       `bson:"topic" json:"topic" example:"APPOINTMENT"`
+	EntityID      string             `bson:"entityId,omitempty" json:"entityId,omitempty" example:"60d0fe4f53115a001f000002"`
+	Payload       string             `bson:"payload,omitempty" json:"payload,omitempty"`
+	Activity      *ActivityEntity    `bson:"activity,omitempty" json:"activity,omitempty"`
+	OccurredAt    time.Time          `bson:"occurredAt" json:"occurredAt"`
+	Status        OutboxStatus       `bson:"status" json:"status" example:"Dead"`
+	Attempts      int                `bson:"attempts" json:"attempts" example:"10"`
+	NextAttemptAt time.Time          `bson:"nextAttemptAt" json:"nextAttemptAt"`
+	LastError     string             `bson:"lastError,omitempty" json:"lastError,omitempty" example:"failed to create activity: connection reset"`
+	DispatchedAt  *time.Time         `bson:"dispatchedAt,omitempty" json:"dispatchedAt,omitempty"`
+}
+
+// ToEvent rebuilds the event the message was created for.
+func (m *OutboxMessageEntity) ToEvent() Event {
+	event := Event{
+		ID:        m.ID.Hex(),
+		Type:      m.Type,
+		Topic:     m.Topic,
+		EntityID:  m.EntityID,
+		Timestamp: m.OccurredAt,
+	}
+	if m.Payload != "" {
+		event.Data = json.RawMessage(m.Payload)
+	}
+	return event
+}
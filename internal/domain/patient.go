@@ -93,4 +93,5 @@ type PatientDetailResponse struct {
 	Patient            PatientDTO         `json:"patient"`
 	RecentAppointments []AppointmentDTO   `json:"recentAppointments"`
 	MedicalHistory     []MedicalRecordDTO `json:"medicalHistory"`
+	LabResults         []LabOrderDTO      `json:"labResults"`
 }
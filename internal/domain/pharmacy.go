@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type StockMovementType string
+
+const (
+	StockMovementReceived  StockMovementType = "Received"
+	StockMovementDispensed StockMovementType = "Dispensed"
+	StockMovementAdjusted  StockMovementType = "Adjusted"
+	StockMovementExpired   StockMovementType = "Expired"
+)
+
+func (mt StockMovementType) IsValid() bool {
+	switch mt {
+	case StockMovementReceived, StockMovementDispensed, StockMovementAdjusted, StockMovementExpired:
+		return true
+	}
+	return false
+}
+
+type StockAlertType string
+
+const (
+	StockAlertLowStock   StockAlertType = "LowStock"
+	StockAlertNearExpiry StockAlertType = "NearExpiry"
+)
+
+// @Description	Pharmacy item (drug or consumable) object
+// @swagger:model
+type PharmacyItemEntity struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	Code         string             `bson:"code" json:"code" example:"PCT500"`
+	Name         string             `bson:"name" json:"name" example:"Paracetamol"`
+	Form         string             `bson:"form" json:"form" example:"tablet"`
+	Strength     string             `bson:"strength,omitempty" json:"strength,omitempty" example:"500 mg"`
+	Unit         string             `bson:"unit" json:"unit" example:"tablet"`
+	ReorderLevel int                `bson:"reorderLevel" json:"reorderLevel" example:"100"`
+	IsActive     bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy    primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy    primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt    time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Pharmacy item data transfer object
+// @swagger:model
+type PharmacyItemDTO struct {
+	ID           string    `json:"id" example:"60d0fe4f53115a001f000001"`
+	Code         string    `json:"code" example:"PCT500"`
+	Name         string    `json:"name" example:"Paracetamol"`
+	Form         string    `json:"form" example:"tablet"`
+	Strength     string    `json:"strength,omitempty" example:"500 mg"`
+	Unit         string    `json:"unit" example:"tablet"`
+	ReorderLevel int       `json:"reorderLevel" example:"100"`
+	IsActive     bool      `json:"isActive" example:"true"`
+	CreatedAt    time.Time `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt    time.Time `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (p *PharmacyItemEntity) ToDTO() PharmacyItemDTO {
+	return PharmacyItemDTO{
+		ID:           p.ID.Hex(),
+		Code:         p.Code,
+		Name:         p.Name,
+		Form:         p.Form,
+		Strength:     p.Strength,
+		Unit:         p.Unit,
+		ReorderLevel: p.ReorderLevel,
+		IsActive:     p.IsActive,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+// @Description	Request body for creating or updating a pharmacy item
+// @swagger:model
+type PharmacyItemRequest struct {
+	Code         string `json:"code" validate:"required,max=30" example:"PCT500"`
+	Name         string `json:"name" validate:"required,min=2,max=100" example:"Paracetamol"`
+	Form         string `json:"form" validate:"required,max=30" example:"tablet"`
+	Strength     string `json:"strength,omitempty" validate:"max=30" example:"500 mg"`
+	Unit         string `json:"unit" validate:"required,max=20" example:"tablet"`
+	ReorderLevel int    `json:"reorderLevel" validate:"gte=0" example:"100"`
+	IsActive     *bool  `json:"isActive,omitempty" example:"true"`
+}
+
+// @Description	Stock batch of a pharmacy item at a location
+// @swagger:model
+type StockBatchEntity struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	ItemID          primitive.ObjectID `bson:"itemId" json:"itemId" example:"60d0fe4f53115a001f000002"`
+	BatchNumber     string             `bson:"batchNumber" json:"batchNumber" example:"B2025-07"`
+	Location        string             `bson:"location" json:"location" example:"MAIN"`
+	ExpiryDate      time.Time          `bson:"expiryDate" json:"expiryDate" example:"2026-07-31T00:00:00Z"`
+	Quantity        int                `bson:"quantity" json:"quantity" example:"500"`
+	UnitCost        int64              `bson:"unitCost" json:"unitCost" example:"50000"`
+	Supplier        string             `bson:"supplier,omitempty" json:"supplier,omitempty" example:"PT Kimia Farma"`
+	ReceivedAt      time.Time          `bson:"receivedAt" json:"receivedAt"`
+	ExpiryAlertedAt *time.Time         `bson:"expiryAlertedAt,omitempty" json:"expiryAlertedAt,omitempty"`
+	CreatedAt       time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt       time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// IsExpired reports whether the batch is past its expiry date at t.
+func (b *StockBatchEntity) IsExpired(t time.Time) bool {
+	return !t.Before(b.ExpiryDate)
+}
+
+// @Description	Request body for receiving goods into stock
+// @swagger:model
+type ReceiveStockRequest struct {
+	ItemID      string    `json:"itemId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	BatchNumber string    `json:"batchNumber" validate:"required,max=50" example:"B2025-07"`
+	Location    string    `json:"location" validate:"required,max=30" example:"MAIN"`
+	ExpiryDate  time.Time `json:"expiryDate" validate:"required" example:"2026-07-31T00:00:00Z"`
+	Quantity    int       `json:"quantity" validate:"required,gt=0" example:"500"`
+	UnitCost    int64     `json:"unitCost" validate:"gte=0" example:"50000"`
+	Supplier    string    `json:"supplier,omitempty" validate:"max=100" example:"PT Kimia Farma"`
+	Reference   string    `json:"reference,omitempty" validate:"max=100" example:"GRN-0001"`
+}
+
+// @Description	A single item to dispense
+// @swagger:model
+type DispenseItemRequest struct {
+	ItemID   string `json:"itemId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	Quantity int    `json:"quantity" validate:"required,gt=0" example:"10"`
+}
+
+// @Description	Request body for dispensing items to a patient
+// @swagger:model
+type DispenseRequest struct {
+	PatientID       string                `json:"patientId" validate:"required,mongodb" example:"60d0fe4f53115a001f000003"`
+	MedicalRecordID string                `json:"medicalRecordId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000004"`
+	Location        string                `json:"location" validate:"required,max=30" example:"MAIN"`
+	Reference       string                `json:"reference,omitempty" validate:"max=100" example:"RX-0001"`
+	Items           []DispenseItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+// @Description	Request body for a manual stock adjustment
+// @swagger:model
+type AdjustStockRequest struct {
+	BatchID string `json:"batchId" validate:"required,mongodb" example:"60d0fe4f53115a001f000001"`
+	Delta   int    `json:"delta" validate:"required,ne=0" example:"-2"`
+	Reason  string `json:"reason" validate:"required,max=500" example:"Stock count correction"`
+}
+
+// @Description	Stock movement (ledger entry) object
+// @swagger:model
+type StockMovementEntity struct {
+	ID              primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	ItemID          primitive.ObjectID  `bson:"itemId" json:"itemId" example:"60d0fe4f53115a001f000002"`
+	BatchID         primitive.ObjectID  `bson:"batchId" json:"batchId" example:"60d0fe4f53115a001f000005"`
+	BatchNumber     string              `bson:"batchNumber" json:"batchNumber" example:"B2025-07"`
+	Location        string              `bson:"location" json:"location" example:"MAIN"`
+	Type            StockMovementType   `bson:"type" json:"type" example:"Dispensed"`
+	Quantity        int                 `bson:"quantity" json:"quantity" example:"-10"`
+	PatientID       *primitive.ObjectID `bson:"patientId,omitempty" json:"patientId,omitempty" example:"60d0fe4f53115a001f000003"`
+	MedicalRecordID *primitive.ObjectID `bson:"medicalRecordId,omitempty" json:"medicalRecordId,omitempty" example:"60d0fe4f53115a001f000004"`
+	Reference       string              `bson:"reference,omitempty" json:"reference,omitempty" example:"RX-0001"`
+	Reason          string              `bson:"reason,omitempty" json:"reason,omitempty"`
+	CreatedBy       primitive.ObjectID  `bson:"createdBy" json:"createdBy,omitempty"`
+	CreatedAt       time.Time           `bson:"createdAt" json:"createdAt"`
+}
+
+// @Description	Stock level of an item at a location
+// @swagger:model
+type StockLevel struct {
+	ItemID        string     `json:"itemId" example:"60d0fe4f53115a001f000002"`
+	Code          string     `json:"code" example:"PCT500"`
+	Name          string     `json:"name" example:"Paracetamol"`
+	Location      string     `json:"location" example:"MAIN"`
+	Quantity      int        `json:"quantity" example:"480"`
+	ReorderLevel  int        `json:"reorderLevel" example:"100"`
+	IsLow         bool       `json:"isLow" example:"false"`
+	NearestExpiry *time.Time `json:"nearestExpiry,omitempty" example:"2026-07-31T00:00:00Z"`
+}
+
+// @Description	Low-stock or near-expiry alert
+// @swagger:model
+type StockAlert struct {
+	Type        StockAlertType `json:"type" example:"LowStock"`
+	ItemID      string         `json:"itemId" example:"60d0fe4f53115a001f000002"`
+	Code        string         `json:"code" example:"PCT500"`
+	Name        string         `json:"name" example:"Paracetamol"`
+	Location    string         `json:"location" example:"MAIN"`
+	BatchNumber string         `json:"batchNumber,omitempty" example:"B2025-07"`
+	Quantity    int            `json:"quantity" example:"40"`
+	ExpiryDate  *time.Time     `json:"expiryDate,omitempty" example:"2025-08-31T00:00:00Z"`
+	Message     string         `json:"message" example:"Paracetamol at MAIN is below the reorder level (40/100)"`
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// QueueDateFormat is the layout of QueueEntity.Date.
+const QueueDateFormat = "2006-01-02"
+
+type QueueTicketStatus string
+
+const (
+	QueueTicketWaiting   QueueTicketStatus = "Waiting"
+	QueueTicketCalled    QueueTicketStatus = "Called"
+	QueueTicketSkipped   QueueTicketStatus = "Skipped"
+	QueueTicketCompleted QueueTicketStatus = "Completed"
+)
+
+func (s QueueTicketStatus) IsValid() bool {
+	switch s {
+	case QueueTicketWaiting, QueueTicketCalled, QueueTicketSkipped, QueueTicketCompleted:
+		return true
+	}
+	return false
+}
+
+type QueueTicketSource string
+
+const (
+	QueueTicketWalkIn      QueueTicketSource = "walk-in"
+	QueueTicketAppointment QueueTicketSource = "appointment"
+)
+
+// Prefix returns the letter ticket codes of the source start with.
+func (s QueueTicketSource) Prefix() string {
+	if s == QueueTicketAppointment {
+		return "A"
+	}
+	return "W"
+}
+
+// @Description	Daily outpatient queue of a doctor or a polyclinic
+// @swagger:model
+type QueueEntity struct {
+	ID         primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	Date       string              `bson:"date" json:"date" example:"2025-07-17"`
+	DoctorID   *primitive.ObjectID `bson:"doctorId,omitempty" json:"doctorId,omitempty" example:"60d0fe4f53115a001f000003"`
+	Polyclinic string              `bson:"polyclinic,omitempty" json:"polyclinic,omitempty" example:"Poli Umum"`
+	LastNumber int                 `bson:"lastNumber" json:"lastNumber" example:"12"`
+	CreatedAt  time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Queue ticket of a walk-in or checked-in patient
+// @swagger:model
+type QueueTicketEntity struct {
+	ID            primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000010"`
+	QueueID       primitive.ObjectID  `bson:"queueId" json:"queueId" example:"60d0fe4f53115a001f000001"`
+	Number        int                 `bson:"number" json:"number" example:"12"`
+	Code          string              `bson:"code" json:"code" example:"W-012"`
+	Source        QueueTicketSource   `bson:"source" json:"source" example:"walk-in"`
+	PatientID     *primitive.ObjectID `bson:"patientId,omitempty" json:"patientId,omitempty" example:"60d0fe4f53115a001f000002"`
+	PatientName   string              `bson:"patientName,omitempty" json:"patientName,omitempty" example:"Jane Doe"`
+	AppointmentID *primitive.ObjectID `bson:"appointmentId,omitempty" json:"appointmentId,omitempty" example:"60d0fe4f53115a001f000004"`
+	Priority      int                 `bson:"priority" json:"priority" example:"0"`
+	Triage        *TriageAssessment   `bson:"triage,omitempty" json:"triage,omitempty"`
+	Duration      int                 `bson:"duration" json:"duration" example:"15"` // expected service time in minutes
+	Status        QueueTicketStatus   `bson:"status" json:"status" example:"Waiting"`
+	Counter       string              `bson:"counter,omitempty" json:"counter,omitempty" example:"Room 101"`
+	Notes         string              `bson:"notes,omitempty" json:"notes,omitempty" example:"Fever since yesterday"`
+	RecallCount   int                 `bson:"recallCount" json:"recallCount" example:"0"`
+	IssuedAt      time.Time           `bson:"issuedAt" json:"issuedAt"`
+	CalledAt      *time.Time          `bson:"calledAt,omitempty" json:"calledAt,omitempty"`
+	CompletedAt   *time.Time          `bson:"completedAt,omitempty" json:"completedAt,omitempty"`
+	IssuedBy      primitive.ObjectID  `bson:"issuedBy" json:"issuedBy,omitempty"`
+	UpdatedBy     primitive.ObjectID  `bson:"updatedBy" json:"updatedBy,omitempty"`
+	UpdatedAt     time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Queue ticket with its position and estimated wait
+// @swagger:model
+type QueueTicketDTO struct {
+	QueueTicketEntity
+	Position             int `json:"position,omitempty" example:"3"`
+	EstimatedWaitMinutes int `json:"estimatedWaitMinutes,omitempty" example:"30"`
+}
+
+// @Description	Queue with its tickets for the day
+// @swagger:model
+type QueueDetailResponse struct {
+	Queue                  QueueEntity      `json:"queue"`
+	Tickets                []QueueTicketDTO `json:"tickets"`
+	AverageDurationMinutes int              `json:"averageDurationMinutes" example:"15"`
+}
+
+// @Description	Ticket shown on a queue display board
+// @swagger:model
+type QueueBoardTicket struct {
+	Code                 string `json:"code" example:"W-012"`
+	Counter              string `json:"counter,omitempty" example:"Room 101"`
+	EstimatedWaitMinutes int    `json:"estimatedWaitMinutes,omitempty" example:"30"`
+}
+
+// @Description	Waiting-room display board of a queue. Patient names are left out.
+// @swagger:model
+type QueueBoardResponse struct {
+	QueueID      string             `json:"queueId" example:"60d0fe4f53115a001f000001"`
+	Date         string             `json:"date" example:"2025-07-17"`
+	Name         string             `json:"name" example:"Dr. John Doe"`
+	NowServing   []QueueBoardTicket `json:"nowServing"`
+	UpNext       []QueueBoardTicket `json:"upNext"`
+	WaitingCount int                `json:"waitingCount" example:"8"`
+	UpdatedAt    time.Time          `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+// @Description	Request body for issuing a walk-in ticket. Give either a doctor or a polyclinic.
+// @swagger:model
+type WalkInTicketRequest struct {
+	DoctorID    string `json:"doctorId,omitempty" validate:"required_without=Polyclinic,omitempty,mongodb" example:"60d0fe4f53115a001f000003"`
+	Polyclinic  string `json:"polyclinic,omitempty" validate:"required_without=DoctorID,omitempty,max=100" example:"Poli Umum"`
+	PatientID   string `json:"patientId,omitempty" validate:"required_without=PatientName,omitempty,mongodb" example:"60d0fe4f53115a001f000002"`
+	PatientName string `json:"patientName,omitempty" validate:"required_without=PatientID,omitempty,max=100" example:"Jane Doe"`
+	Notes       string `json:"notes,omitempty" validate:"max=500" example:"Fever since yesterday"`
+}
+
+// @Description	Request body for checking in a scheduled appointment
+// @swagger:model
+type CheckInRequest struct {
+	AppointmentID string `json:"appointmentId" validate:"required,mongodb" example:"60d0fe4f53115a001f000004"`
+}
+
+// @Description	Request body for calling the next ticket
+// @swagger:model
+type CallNextRequest struct {
+	Counter string `json:"counter,omitempty" validate:"max=50" example:"Room 101"`
+}
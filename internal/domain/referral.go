@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReferralStatus string
+
+const (
+	ReferralStatusSent      ReferralStatus = "Sent"
+	ReferralStatusAccepted  ReferralStatus = "Accepted"
+	ReferralStatusDeclined  ReferralStatus = "Declined"
+	ReferralStatusCompleted ReferralStatus = "Completed"
+)
+
+func (rs ReferralStatus) IsValid() bool {
+	switch rs {
+	case ReferralStatusSent, ReferralStatusAccepted, ReferralStatusDeclined, ReferralStatusCompleted:
+		return true
+	}
+	return false
+}
+
+type ReferralUrgency string
+
+const (
+	ReferralUrgencyRoutine   ReferralUrgency = "routine"
+	ReferralUrgencyUrgent    ReferralUrgency = "urgent"
+	ReferralUrgencyEmergency ReferralUrgency = "emergency"
+)
+
+// Rank returns the inbox ordering of an urgency, lower values come first.
+func (ru ReferralUrgency) Rank() int {
+	switch ru {
+	case ReferralUrgencyEmergency:
+		return 0
+	case ReferralUrgencyUrgent:
+		return 1
+	}
+	return 2
+}
+
+// @Description	Referral of a patient from one doctor to another doctor or to a specialty
+// @swagger:model
+type ReferralEntity struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000020"`
+	PatientID    primitive.ObjectID  `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	FromDoctorID primitive.ObjectID  `bson:"fromDoctorId" json:"fromDoctorId" example:"60d0fe4f53115a001f000003"`
+	ToDoctorID   *primitive.ObjectID `bson:"toDoctorId,omitempty" json:"toDoctorId,omitempty" example:"60d0fe4f53115a001f000005"`
+	// ToSpecialty is always set; referrals to a doctor take the doctor's
+	// specialty so they also show in the specialty inbox.
+	ToSpecialty   string               `bson:"toSpecialty" json:"toSpecialty" example:"Cardiology"`
+	Reason        string               `bson:"reason" json:"reason" example:"Exertional chest pain, abnormal ECG"`
+	Urgency       ReferralUrgency      `bson:"urgency" json:"urgency" example:"urgent"`
+	RecordIDs     []primitive.ObjectID `bson:"recordIds,omitempty" json:"recordIds,omitempty"`
+	Status        ReferralStatus       `bson:"status" json:"status" example:"Sent"`
+	ResponseNotes string               `bson:"responseNotes,omitempty" json:"responseNotes,omitempty" example:"Please attach the latest echo"`
+	RespondedBy   primitive.ObjectID   `bson:"respondedBy,omitempty" json:"respondedBy,omitempty"`
+	RespondedAt   *time.Time           `bson:"respondedAt,omitempty" json:"respondedAt,omitempty"`
+	AppointmentID *primitive.ObjectID  `bson:"appointmentId,omitempty" json:"appointmentId,omitempty" example:"60d0fe4f53115a001f000004"`
+	CompletedAt   *time.Time           `bson:"completedAt,omitempty" json:"completedAt,omitempty"`
+	CreatedBy     primitive.ObjectID   `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy     primitive.ObjectID   `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt     time.Time            `bson:"createdAt" json:"createdAt"`
+	UpdatedAt     time.Time            `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Referral inbox entry with the patient and referring doctor names resolved
+// @swagger:model
+type ReferralInboxItem struct {
+	Referral       ReferralEntity `json:"referral"`
+	PatientName    string         `json:"patientName" example:"John Doe"`
+	FromDoctorName string         `json:"fromDoctorName" example:"Dr. Jane Smith"`
+}
+
+// @Description	Request body for referring a patient. Give either a doctor or a specialty.
+// @swagger:model
+type CreateReferralRequest struct {
+	PatientID    string          `json:"patientId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	FromDoctorID string          `json:"fromDoctorId" validate:"required,mongodb" example:"60d0fe4f53115a001f000003"`
+	ToDoctorID   string          `json:"toDoctorId,omitempty" validate:"required_without=ToSpecialty,omitempty,mongodb" example:"60d0fe4f53115a001f000005"`
+	ToSpecialty  string          `json:"toSpecialty,omitempty" validate:"required_without=ToDoctorID,omitempty,max=100" example:"Cardiology"`
+	Reason       string          `json:"reason" validate:"required,max=1000" example:"Exertional chest pain, abnormal ECG"`
+	Urgency      ReferralUrgency `json:"urgency" validate:"required,oneof=routine urgent emergency" example:"urgent"`
+	RecordIDs    []string        `json:"recordIds,omitempty" validate:"omitempty,dive,mongodb"`
+}
+
+// @Description	Request body for accepting or declining a referral
+// @swagger:model
+type RespondReferralRequest struct {
+	Status ReferralStatus `json:"status" validate:"required,oneof=Accepted Declined" example:"Accepted"`
+	// DoctorID assigns a specialty referral to the accepting doctor.
+	DoctorID string `json:"doctorId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000005"`
+	Notes    string `json:"notes,omitempty" validate:"required_if=Status Declined,max=500" example:"Please attach the latest echo"`
+}
+
+// @Description	Request body for linking the appointment booked for a referral
+// @swagger:model
+type LinkReferralAppointmentRequest struct {
+	AppointmentID string `json:"appointmentId" validate:"required,mongodb" example:"60d0fe4f53115a001f000004"`
+}
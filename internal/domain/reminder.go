@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReminderChannel string
+
+const (
+	ReminderChannelEmail    ReminderChannel = "email"
+	ReminderChannelSMS      ReminderChannel = "sms"
+	ReminderChannelWhatsApp ReminderChannel = "whatsapp"
+)
+
+func (c ReminderChannel) IsValid() bool {
+	switch c {
+	case ReminderChannelEmail, ReminderChannelSMS, ReminderChannelWhatsApp:
+		return true
+	}
+	return false
+}
+
+type ReminderLanguage string
+
+const (
+	ReminderLanguageIndonesian ReminderLanguage = "id"
+	ReminderLanguageEnglish    ReminderLanguage = "en"
+)
+
+func (l ReminderLanguage) IsValid() bool {
+	switch l {
+	case ReminderLanguageIndonesian, ReminderLanguageEnglish:
+		return true
+	}
+	return false
+}
+
+// ReminderKind tells a reminder ahead of an appointment apart from the
+// notice sent when an appointment was moved for an emergency.
+type ReminderKind string
+
+const (
+	ReminderKindReminder    ReminderKind = "reminder"
+	ReminderKindRescheduled ReminderKind = "rescheduled"
+)
+
+type ReminderStatus string
+
+const (
+	ReminderStatusPending ReminderStatus = "Pending"
+	ReminderStatusSent    ReminderStatus = "Sent"
+	ReminderStatusFailed  ReminderStatus = "Failed"
+	ReminderStatusSkipped ReminderStatus = "Skipped"
+)
+
+func (s ReminderStatus) IsValid() bool {
+	switch s {
+	case ReminderStatusPending, ReminderStatusSent, ReminderStatusFailed, ReminderStatusSkipped:
+		return true
+	}
+	return false
+}
+
+// @Description	Appointment reminder sent, or attempted, to a patient
+// @swagger:model
+type ReminderEntity struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	AppointmentID   primitive.ObjectID `bson:"appointmentId" json:"appointmentId" example:"60d0fe4f53115a001f000002"`
+	PatientID       primitive.ObjectID `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000003"`
+	Kind            ReminderKind       `bson:"kind" json:"kind" example:"reminder"`
+	AppointmentTime time.Time          `bson:"appointmentTime" json:"appointmentTime" example:"2025-07-17T10:00:00Z"`
+	OffsetMinutes   int                `bson:"offsetMinutes" json:"offsetMinutes" example:"1440"`
+	Channel         ReminderChannel    `bson:"channel" json:"channel" example:"sms"`
+	Language        ReminderLanguage   `bson:"language" json:"language" example:"id"`
+	Recipient       string             `bson:"recipient,omitempty" json:"recipient,omitempty" example:"+6281234567890"`
+	Subject         string             `bson:"subject,omitempty" json:"subject,omitempty" example:"Pengingat janji temu"`
+	Message         string             `bson:"message,omitempty" json:"message,omitempty"`
+	Status          ReminderStatus     `bson:"status" json:"status" example:"Sent"`
+	Error           string             `bson:"error,omitempty" json:"error,omitempty"`
+	SentAt          *time.Time         `bson:"sentAt,omitempty" json:"sentAt,omitempty"`
+	CreatedAt       time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt       time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Reminder preferences of a patient
+// @swagger:model
+type ReminderPreferenceEntity struct {
+	PatientID primitive.ObjectID `bson:"_id" json:"patientId" example:"60d0fe4f53115a001f000003"`
+	OptOut    bool               `bson:"optOut" json:"optOut" example:"false"`
+	Language  ReminderLanguage   `bson:"language,omitempty" json:"language,omitempty" example:"id"`
+	Channels  []ReminderChannel  `bson:"channels,omitempty" json:"channels,omitempty" example:"sms"`
+	UpdatedBy primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	UpdatedAt time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Request body for updating the reminder preferences of a patient
+// @swagger:model
+type ReminderPreferenceRequest struct {
+	OptOut   bool              `json:"optOut" example:"false"`
+	Language ReminderLanguage  `json:"language,omitempty" validate:"omitempty,oneof=id en" example:"id"`
+	Channels []ReminderChannel `json:"channels,omitempty" validate:"omitempty,dive,oneof=email sms whatsapp" example:"sms"`
+}
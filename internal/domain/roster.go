@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RosterDateFormat is the layout of ShiftAssignmentEntity.Date.
+const RosterDateFormat = "2006-01-02"
+
+// AvailabilitySource says what decides whether a doctor can be booked.
+type AvailabilitySource string
+
+const (
+	// AvailabilityNone books doctors at any time.
+	AvailabilityNone AvailabilitySource = "none"
+	// AvailabilityStatic books doctors within DoctorEntity.Availability.
+	AvailabilityStatic AvailabilitySource = "static"
+	// AvailabilityRoster books doctors during their rostered shifts.
+	AvailabilityRoster AvailabilitySource = "roster"
+)
+
+func (s AvailabilitySource) IsValid() bool {
+	switch s {
+	case AvailabilityNone, AvailabilityStatic, AvailabilityRoster:
+		return true
+	}
+	return false
+}
+
+type ShiftAssignmentStatus string
+
+const (
+	ShiftAssignmentScheduled ShiftAssignmentStatus = "Scheduled"
+	ShiftAssignmentCancelled ShiftAssignmentStatus = "Cancelled"
+)
+
+type ShiftSwapStatus string
+
+const (
+	ShiftSwapPending   ShiftSwapStatus = "Pending"
+	ShiftSwapApproved  ShiftSwapStatus = "Approved"
+	ShiftSwapRejected  ShiftSwapStatus = "Rejected"
+	ShiftSwapCancelled ShiftSwapStatus = "Cancelled"
+)
+
+func (s ShiftSwapStatus) IsValid() bool {
+	switch s {
+	case ShiftSwapPending, ShiftSwapApproved, ShiftSwapRejected, ShiftSwapCancelled:
+		return true
+	}
+	return false
+}
+
+// ShiftCoverage is how many staff of a role a shift needs.
+type ShiftCoverage struct {
+	Role  Role `bson:"role" json:"role" validate:"required,oneof=Admin Doctor Nurse Receptionist Management Lab" example:"Nurse"`
+	Count int  `bson:"count" json:"count" validate:"required,min=1,max=100" example:"3"`
+}
+
+// @Description	Reusable shift definition, e.g. the morning shift
+// @swagger:model
+type ShiftTemplateEntity struct {
+	ID   primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000040"`
+	Name string             `bson:"name" json:"name" example:"Morning"`
+	Code string             `bson:"code" json:"code" example:"AM"`
+	// Start and End are clock times in the hospital time zone; a shift whose
+	// end is not after its start runs into the next day.
+	Start string `bson:"start" json:"start" example:"07:00"`
+	End   string `bson:"end" json:"end" example:"14:00"`
+	// DaysOfWeek limits the days the shift runs on, 0-6 (Sunday-Saturday).
+	// Empty means every day.
+	DaysOfWeek []int              `bson:"daysOfWeek,omitempty" json:"daysOfWeek,omitempty"`
+	Coverage   []ShiftCoverage    `bson:"coverage,omitempty" json:"coverage,omitempty"`
+	IsActive   bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy  primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy  primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt  time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// RunsOn reports whether the shift runs on the weekday.
+func (t *ShiftTemplateEntity) RunsOn(day time.Weekday) bool {
+	if len(t.DaysOfWeek) == 0 {
+		return true
+	}
+	for _, d := range t.DaysOfWeek {
+		if d == int(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// Window returns when the shift starts and ends on the date, which must be
+// midnight in the hospital time zone.
+func (t *ShiftTemplateEntity) Window(date time.Time) (time.Time, time.Time, error) {
+	start, err := time.Parse(OperatingHoursFormat, t.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := time.Parse(OperatingHoursFormat, t.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	from := date.Add(time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute)
+	until := date.Add(time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute)
+	if !until.After(from) {
+		until = until.AddDate(0, 0, 1)
+	}
+	return from, until, nil
+}
+
+// @Description	Shift worked by a staff member on a day
+// @swagger:model
+type ShiftAssignmentEntity struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000041"`
+	UserID     primitive.ObjectID `bson:"userId" json:"userId" example:"60d0fe4f53115a001f000001"`
+	Role       Role               `bson:"role" json:"role" example:"Nurse"`
+	TemplateID primitive.ObjectID `bson:"templateId" json:"templateId" example:"60d0fe4f53115a001f000040"`
+	Date       string             `bson:"date" json:"date" example:"2025-07-17"`
+	Start      time.Time          `bson:"start" json:"start" example:"2025-07-17T07:00:00+07:00"`
+	End        time.Time          `bson:"end" json:"end" example:"2025-07-17T14:00:00+07:00"`
+	// OnCall marks a standby shift: the staff member is reachable for
+	// emergencies but not rostered for regular work.
+	OnCall    bool                  `bson:"onCall" json:"onCall" example:"false"`
+	Status    ShiftAssignmentStatus `bson:"status" json:"status" example:"Scheduled"`
+	Notes     string                `bson:"notes,omitempty" json:"notes,omitempty" example:"Covers ICU handover"`
+	CreatedBy primitive.ObjectID    `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy primitive.ObjectID    `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt time.Time             `bson:"createdAt" json:"createdAt"`
+	UpdatedAt time.Time             `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Request to hand a shift over to a colleague, or to trade it for one of theirs
+// @swagger:model
+type ShiftSwapEntity struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000042"`
+	AssignmentID primitive.ObjectID `bson:"assignmentId" json:"assignmentId" example:"60d0fe4f53115a001f000041"`
+	FromUserID   primitive.ObjectID `bson:"fromUserId" json:"fromUserId" example:"60d0fe4f53115a001f000001"`
+	ToUserID     primitive.ObjectID `bson:"toUserId" json:"toUserId" example:"60d0fe4f53115a001f000002"`
+	// TargetAssignmentID is the colleague's shift taken in exchange; without
+	// it the shift is simply handed over.
+	TargetAssignmentID *primitive.ObjectID `bson:"targetAssignmentId,omitempty" json:"targetAssignmentId,omitempty" example:"60d0fe4f53115a001f000043"`
+	Reason             string              `bson:"reason,omitempty" json:"reason,omitempty" example:"Family event"`
+	Status             ShiftSwapStatus     `bson:"status" json:"status" example:"Pending"`
+	DecisionNotes      string              `bson:"decisionNotes,omitempty" json:"decisionNotes,omitempty" example:"Approved, coverage unchanged"`
+	DecidedBy          primitive.ObjectID  `bson:"decidedBy,omitempty" json:"decidedBy,omitempty"`
+	DecidedAt          *time.Time          `bson:"decidedAt,omitempty" json:"decidedAt,omitempty"`
+	RequestedBy        primitive.ObjectID  `bson:"requestedBy" json:"requestedBy,omitempty"`
+	CreatedAt          time.Time           `bson:"createdAt" json:"createdAt"`
+	UpdatedAt          time.Time           `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Staffing of one shift for one role on a day, against what the shift needs
+// @swagger:model
+type CoverageSlot struct {
+	Date         string   `json:"date" example:"2025-07-17"`
+	TemplateID   string   `json:"templateId" example:"60d0fe4f53115a001f000040"`
+	TemplateName string   `json:"templateName" example:"Morning"`
+	Role         Role     `json:"role" example:"Nurse"`
+	Required     int      `json:"required" example:"3"`
+	Assigned     int      `json:"assigned" example:"2"`
+	Shortfall    int      `json:"shortfall" example:"1"`
+	UserIDs      []string `json:"userIds,omitempty"`
+}
+
+// @Description	Staff member on call at a given time
+// @swagger:model
+type OnCallEntry struct {
+	Assignment ShiftAssignmentEntity `json:"assignment"`
+	UserName   string                `json:"userName" example:"Dr. Jane Smith"`
+}
+
+// @Description	Request body for creating or updating a shift template
+// @swagger:model
+type ShiftTemplateRequest struct {
+	Name       string          `json:"name" validate:"required,min=2,max=50" example:"Morning"`
+	Code       string          `json:"code" validate:"required,min=1,max=10" example:"AM"`
+	Start      string          `json:"start" validate:"required,datetime=15:04" example:"07:00"`
+	End        string          `json:"end" validate:"required,datetime=15:04" example:"14:00"`
+	DaysOfWeek []int           `json:"daysOfWeek,omitempty" validate:"omitempty,dive,min=0,max=6"`
+	Coverage   []ShiftCoverage `json:"coverage,omitempty" validate:"omitempty,dive"`
+	IsActive   *bool           `json:"isActive,omitempty" example:"true"`
+}
+
+// @Description	Request body for rostering a staff member on a shift
+// @swagger:model
+type CreateShiftAssignmentRequest struct {
+	UserID     string `json:"userId" validate:"required,mongodb" example:"60d0fe4f53115a001f000001"`
+	TemplateID string `json:"templateId" validate:"required,mongodb" example:"60d0fe4f53115a001f000040"`
+	Date       string `json:"date" validate:"required,datetime=2006-01-02" example:"2025-07-17"`
+	OnCall     bool   `json:"onCall,omitempty" example:"false"`
+	Notes      string `json:"notes,omitempty" validate:"max=500" example:"Covers ICU handover"`
+}
+
+// @Description	Request body for asking to hand over or trade a shift
+// @swagger:model
+type CreateShiftSwapRequest struct {
+	AssignmentID       string `json:"assignmentId" validate:"required,mongodb" example:"60d0fe4f53115a001f000041"`
+	ToUserID           string `json:"toUserId" validate:"required,mongodb" example:"60d0fe4f53115a001f000002"`
+	TargetAssignmentID string `json:"targetAssignmentId,omitempty" validate:"omitempty,mongodb" example:"60d0fe4f53115a001f000043"`
+	Reason             string `json:"reason,omitempty" validate:"max=500" example:"Family event"`
+}
+
+// @Description	Request body for approving or rejecting a shift swap
+// @swagger:model
+type DecideShiftSwapRequest struct {
+	Status ShiftSwapStatus `json:"status" validate:"required,oneof=Approved Rejected" example:"Approved"`
+	Notes  string          `json:"notes,omitempty" validate:"max=500" example:"Approved, coverage unchanged"`
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SatuSehatDateFormat is the format of the dates bounding a backfill or a
+// reconciliation report.
+const SatuSehatDateFormat = "2006-01-02"
+
+// SatuSehatSourceType is the kind of record a SATUSEHAT sync entry submits.
+type SatuSehatSourceType string
+
+const (
+	// SatuSehatSourceAppointment is a completed appointment, sent as an Encounter.
+	SatuSehatSourceAppointment SatuSehatSourceType = "appointment"
+	// SatuSehatSourceMedicalRecord is the diagnosis of a medical record, sent
+	// as a Condition.
+	SatuSehatSourceMedicalRecord SatuSehatSourceType = "medical_record"
+)
+
+func (t SatuSehatSourceType) IsValid() bool {
+	switch t {
+	case SatuSehatSourceAppointment, SatuSehatSourceMedicalRecord:
+		return true
+	}
+	return false
+}
+
+type SatuSehatSyncStatus string
+
+const (
+	SatuSehatSyncPending    SatuSehatSyncStatus = "Pending"
+	SatuSehatSyncSynced     SatuSehatSyncStatus = "Synced"
+	SatuSehatSyncFailed     SatuSehatSyncStatus = "Failed"
+	SatuSehatSyncDeadLetter SatuSehatSyncStatus = "DeadLetter"
+	// SatuSehatSyncSkipped means there was nothing to send, e.g. because the
+	// record was deleted before it was ever sent.
+	SatuSehatSyncSkipped SatuSehatSyncStatus = "Skipped"
+)
+
+func (s SatuSehatSyncStatus) IsValid() bool {
+	switch s {
+	case SatuSehatSyncPending, SatuSehatSyncSynced, SatuSehatSyncFailed, SatuSehatSyncDeadLetter, SatuSehatSyncSkipped:
+		return true
+	}
+	return false
+}
+
+// SatuSehatAttempt is one attempt at submitting a record.
+type SatuSehatAttempt struct {
+	AttemptedAt time.Time `bson:"attemptedAt" json:"attemptedAt"`
+	StatusCode  int       `bson:"statusCode,omitempty" json:"statusCode,omitempty" example:"400"`
+	Error       string    `bson:"error,omitempty" json:"error,omitempty" example:"no SATUSEHAT ID for patient 60d0fe4f53115a001f000002"`
+	DurationMs  int64     `bson:"durationMs" json:"durationMs" example:"240"`
+}
+
+// @Description	Submission of an appointment or medical record to SATUSEHAT, with its sync status
+// @swagger:model
+type SatuSehatSyncEntity struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000040"`
+	SourceType   SatuSehatSourceType `bson:"sourceType" json:"sourceType" example:"appointment"`
+	SourceID     primitive.ObjectID  `bson:"sourceId" json:"sourceId" example:"60d0fe4f53115a001f000001"`
+	ResourceType string              `bson:"resourceType" json:"resourceType" example:"Encounter"`
+	PatientID    primitive.ObjectID  `bson:"patientId" json:"patientId" example:"60d0fe4f53115a001f000002"`
+	Status       SatuSehatSyncStatus `bson:"status" json:"status" example:"Synced"`
+	// Deleted is set when the source was deleted after it was sent, so the
+	// resource is resent as entered in error.
+	Deleted bool `bson:"deleted,omitempty" json:"deleted,omitempty" example:"false"`
+	// Revision counts the changes to the source, so an attempt that raced a
+	// change does not mark the change as sent.
+	Revision      int                `bson:"revision" json:"revision" example:"1"`
+	SatuSehatID   string             `bson:"satusehatId,omitempty" json:"satusehatId,omitempty" example:"2823ed1d-3e3e-434e-9a5b-9c579d192c3b"`
+	Payload       string             `bson:"payload,omitempty" json:"payload,omitempty"`
+	Error         string             `bson:"error,omitempty" json:"error,omitempty"`
+	AttemptCount  int                `bson:"attemptCount" json:"attemptCount" example:"1"`
+	NextAttemptAt *time.Time         `bson:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty"`
+	Attempts      []SatuSehatAttempt `bson:"attempts,omitempty" json:"attempts,omitempty"`
+	SyncedAt      *time.Time         `bson:"syncedAt,omitempty" json:"syncedAt,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt     time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// SatuSehatReferenceKind is the kind of hospital entity a SATUSEHAT reference
+// maps: patients to their IHS numbers, doctors to their practitioner IDs and
+// clinic rooms to the locations registered for them.
+type SatuSehatReferenceKind string
+
+const (
+	SatuSehatReferencePatient      SatuSehatReferenceKind = "patient"
+	SatuSehatReferencePractitioner SatuSehatReferenceKind = "practitioner"
+	SatuSehatReferenceLocation     SatuSehatReferenceKind = "location"
+)
+
+func (k SatuSehatReferenceKind) IsValid() bool {
+	switch k {
+	case SatuSehatReferencePatient, SatuSehatReferencePractitioner, SatuSehatReferenceLocation:
+		return true
+	}
+	return false
+}
+
+// @Description	SATUSEHAT ID of a patient, doctor or clinic room
+// @swagger:model
+type SatuSehatReferenceEntity struct {
+	ID          primitive.ObjectID     `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000041"`
+	Kind        SatuSehatReferenceKind `bson:"kind" json:"kind" example:"patient"`
+	LocalID     primitive.ObjectID     `bson:"localId" json:"localId" example:"60d0fe4f53115a001f000002"`
+	SatuSehatID string                 `bson:"satusehatId" json:"satusehatId" example:"P02478375538"`
+	UpdatedBy   primitive.ObjectID     `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt   time.Time              `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time              `bson:"updatedAt" json:"updatedAt"`
+}
+
+// @Description	Request to set the SATUSEHAT ID of a patient, doctor or clinic room
+// @swagger:model
+type SatuSehatReferenceRequest struct {
+	SatuSehatID string `json:"satusehatId" validate:"required,max=64" example:"P02478375538"`
+}
+
+// @Description	Sync status counts of one resource type in a reconciliation report
+// @swagger:model
+type SatuSehatReconciliationCounts struct {
+	Total      int `json:"total" example:"120"`
+	Synced     int `json:"synced" example:"112"`
+	Pending    int `json:"pending" example:"3"`
+	Failed     int `json:"failed" example:"1"`
+	DeadLetter int `json:"deadLetter" example:"2"`
+	Skipped    int `json:"skipped" example:"0"`
+	// Missing counts the records that were never queued, e.g. because they
+	// predate the integration; a backfill queues them.
+	Missing int `json:"missing" example:"2"`
+}
+
+// @Description	Record in a reconciliation report that is not in SATUSEHAT
+// @swagger:model
+type SatuSehatReconciliationItem struct {
+	SourceType SatuSehatSourceType `json:"sourceType" example:"medical_record"`
+	SourceID   string              `json:"sourceId" example:"60d0fe4f53115a001f000001"`
+	PatientID  string              `json:"patientId" example:"60d0fe4f53115a001f000002"`
+	Date       time.Time           `json:"date" example:"2025-07-17T10:00:00Z"`
+	// Status is the sync status, or Missing when the record was never queued.
+	Status       string `json:"status" example:"DeadLetter"`
+	Error        string `json:"error,omitempty" example:"no SATUSEHAT ID for patient 60d0fe4f53115a001f000002"`
+	AttemptCount int    `json:"attemptCount" example:"8"`
+}
+
+// @Description	Comparison of the completed appointments and medical records in a period with what was submitted to SATUSEHAT
+// @swagger:model
+type SatuSehatReconciliationReport struct {
+	From       string                        `json:"from" example:"2025-07-01"`
+	To         string                        `json:"to" example:"2025-07-31"`
+	Encounters SatuSehatReconciliationCounts `json:"encounters"`
+	Conditions SatuSehatReconciliationCounts `json:"conditions"`
+	// Unsynced lists every record in the period that is not synced, oldest
+	// first.
+	Unsynced []SatuSehatReconciliationItem `json:"unsynced"`
+}
+
+// @Description	Result of queueing the records of a period for submission to SATUSEHAT
+// @swagger:model
+type SatuSehatBackfillResult struct {
+	From       string `json:"from" example:"2025-07-01"`
+	To         string `json:"to" example:"2025-07-31"`
+	Encounters int    `json:"encounters" example:"8"`
+	Conditions int    `json:"conditions" example:"6"`
+}
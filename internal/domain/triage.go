@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// @Description	Vital signs taken at triage
+// @swagger:model
+type VitalSigns struct {
+	HeartRate        int     `bson:"heartRate,omitempty" json:"heartRate,omitempty" validate:"omitempty,min=20,max=250" example:"118"`
+	RespiratoryRate  int     `bson:"respiratoryRate,omitempty" json:"respiratoryRate,omitempty" validate:"omitempty,min=4,max=60" example:"24"`
+	SystolicBP       int     `bson:"systolicBp,omitempty" json:"systolicBp,omitempty" validate:"omitempty,min=40,max=300" example:"95"`
+	DiastolicBP      int     `bson:"diastolicBp,omitempty" json:"diastolicBp,omitempty" validate:"omitempty,min=20,max=200" example:"60"`
+	Temperature      float64 `bson:"temperature,omitempty" json:"temperature,omitempty" validate:"omitempty,min=30,max=45" example:"38.9"`
+	OxygenSaturation int     `bson:"oxygenSaturation,omitempty" json:"oxygenSaturation,omitempty" validate:"omitempty,min=50,max=100" example:"93"`
+	PainScore        *int    `bson:"painScore,omitempty" json:"painScore,omitempty" validate:"omitempty,min=0,max=10" example:"7"`
+	GCS              int     `bson:"gcs,omitempty" json:"gcs,omitempty" validate:"omitempty,min=3,max=15" example:"15"`
+}
+
+// @Description	Emergency Severity Index triage assessment
+// @swagger:model
+type TriageAssessment struct {
+	ESILevel       int                `bson:"esiLevel" json:"esiLevel" example:"2"`
+	ChiefComplaint string             `bson:"chiefComplaint" json:"chiefComplaint" example:"Chest pain radiating to left arm"`
+	Vitals         VitalSigns         `bson:"vitals" json:"vitals"`
+	Notes          string             `bson:"notes,omitempty" json:"notes,omitempty" example:"Onset 30 minutes ago"`
+	AssessedBy     primitive.ObjectID `bson:"assessedBy" json:"assessedBy"`
+	AssessedAt     time.Time          `bson:"assessedAt" json:"assessedAt"`
+}
+
+// Priority converts the ESI level to a queue priority. ESI 1 (resuscitation)
+// is the most urgent and gets the highest priority; untriaged tickets have 0.
+func (t *TriageAssessment) Priority() int {
+	if t == nil || t.ESILevel < 1 || t.ESILevel > 5 {
+		return 0
+	}
+	return 6 - t.ESILevel
+}
+
+// @Description	Request body for recording a triage assessment
+// @swagger:model
+type TriageRequest struct {
+	ESILevel       int        `json:"esiLevel" validate:"required,min=1,max=5" example:"2"`
+	ChiefComplaint string     `json:"chiefComplaint" validate:"required,max=200" example:"Chest pain radiating to left arm"`
+	Vitals         VitalSigns `json:"vitals"`
+	Notes          string     `json:"notes,omitempty" validate:"max=500" example:"Onset 30 minutes ago"`
+}
+
+// ConflictOverride records why an emergency booking was allowed to override
+// the doctor's schedule and which appointments it displaced.
+type ConflictOverride struct {
+	Reason    string               `bson:"reason" json:"reason" example:"Suspected myocardial infarction"`
+	Displaced []primitive.ObjectID `bson:"displaced" json:"displaced"`
+	By        primitive.ObjectID   `bson:"by" json:"by"`
+	At        time.Time            `bson:"at" json:"at"`
+}
+
+// AppointmentBump records that an appointment was moved to make room for an
+// emergency. NotifiedAt is set once the patient has been told.
+type AppointmentBump struct {
+	PreviousDateTime time.Time          `bson:"previousDateTime" json:"previousDateTime"`
+	ByAppointmentID  primitive.ObjectID `bson:"byAppointmentId" json:"byAppointmentId"`
+	Reason           string             `bson:"reason" json:"reason"`
+	At               time.Time          `bson:"at" json:"at"`
+	NotifiedAt       *time.Time         `bson:"notifiedAt,omitempty" json:"notifiedAt,omitempty"`
+}
@@ -15,6 +15,7 @@ const (
 	RoleNurse        Role = "Nurse"
 	RoleReceptionist Role = "Receptionist"
 	RoleManagement   Role = "Management"
+	RoleLab          Role = "Lab"
 )
 
 // @Description	User object
@@ -58,7 +59,7 @@ type CreateUserRequest struct {
 	Name     string `json:"name" validate:"required,min=3,max=100" example:"Jane Doe"`
 	Email    string `json:"email" validate:"required,email" example:"jane.doe@example.com"`
 	Password string `json:"password" validate:"required,min=8" example:"StrongPassword123"`
-	Role     Role   `json:"role" validate:"required,oneof=Admin Doctor Nurse Receptionist Management" example:"Receptionist"`
+	Role     Role   `json:"role" validate:"required,oneof=Admin Doctor Nurse Receptionist Management Lab" example:"Receptionist"`
 }
 
 // @Description	Request body for updating an existing user
@@ -66,7 +67,7 @@ type CreateUserRequest struct {
 type UpdateUserRequest struct {
 	Name     string `json:"name,omitempty" validate:"min=3,max=100" example:"Jane Doe"`
 	Email    string `json:"email,omitempty" validate:"email" example:"jane.doe@example.com"`
-	Role     Role   `json:"role,omitempty" validate:"oneof=Admin Doctor Nurse Receptionist Management" example:"Receptionist"`
+	Role     Role   `json:"role,omitempty" validate:"oneof=Admin Doctor Nurse Receptionist Management Lab" example:"Receptionist"`
 	IsActive *bool  `json:"isActive,omitempty" example:true`
 }
 
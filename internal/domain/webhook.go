@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending    WebhookDeliveryStatus = "Pending"
+	WebhookDeliveryDelivered  WebhookDeliveryStatus = "Delivered"
+	WebhookDeliveryFailed     WebhookDeliveryStatus = "Failed"
+	WebhookDeliveryDeadLetter WebhookDeliveryStatus = "DeadLetter"
+)
+
+func (s WebhookDeliveryStatus) IsValid() bool {
+	switch s {
+	case WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryFailed, WebhookDeliveryDeadLetter:
+		return true
+	}
+	return false
+}
+
+// @Description	Webhook subscription object
+// @swagger:model
+type WebhookSubscriptionEntity struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000001"`
+	Name       string             `bson:"name" json:"name" example:"SMS vendor"`
+	URL        string             `bson:"url" json:"url" example:"https://vendor.example.com/hooks/hms"`
+	Secret     string             `bson:"secret" json:"-"`
+	EventTypes []EventType        `bson:"eventTypes" json:"eventTypes" example:"appointment.created"`
+	IsActive   bool               `bson:"isActive" json:"isActive" example:"true"`
+	CreatedBy  primitive.ObjectID `bson:"createdBy" json:"createdBy,omitempty"`
+	UpdatedBy  primitive.ObjectID `bson:"updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt  time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// Matches reports whether the subscription wants events of the given type.
+func (w *WebhookSubscriptionEntity) Matches(eventType EventType) bool {
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// @Description	Webhook subscription data transfer object
+// @swagger:model
+type WebhookSubscriptionDTO struct {
+	ID         string      `json:"id" example:"60d0fe4f53115a001f000001"`
+	Name       string      `json:"name" example:"SMS vendor"`
+	URL        string      `json:"url" example:"https://vendor.example.com/hooks/hms"`
+	EventTypes []EventType `json:"eventTypes" example:"appointment.created"`
+	IsActive   bool        `json:"isActive" example:"true"`
+	CreatedAt  time.Time   `json:"createdAt" example:"2025-07-17T09:00:00Z"`
+	UpdatedAt  time.Time   `json:"updatedAt" example:"2025-07-17T09:00:00Z"`
+}
+
+func (w *WebhookSubscriptionEntity) ToDTO() WebhookSubscriptionDTO {
+	return WebhookSubscriptionDTO{
+		ID:         w.ID.Hex(),
+		Name:       w.Name,
+		URL:        w.URL,
+		EventTypes: w.EventTypes,
+		IsActive:   w.IsActive,
+		CreatedAt:  w.CreatedAt,
+		UpdatedAt:  w.UpdatedAt,
+	}
+}
+
+// @Description	Request body for creating or updating a webhook subscription
+// @swagger:model
+type WebhookSubscriptionRequest struct {
+	Name       string      `json:"name" validate:"required,max=100" example:"SMS vendor"`
+	URL        string      `json:"url" validate:"required,url,max=500" example:"https://vendor.example.com/hooks/hms"`
+	Secret     string      `json:"secret,omitempty" validate:"omitempty,min=16,max=128" example:"a-long-shared-signing-secret"`
+	EventTypes []EventType `json:"eventTypes" validate:"required,min=1" example:"appointment.created"`
+	IsActive   *bool       `json:"isActive,omitempty" example:"true"`
+}
+
+// WebhookAttempt is one entry of a delivery's log.
+type WebhookAttempt struct {
+	AttemptedAt time.Time `bson:"attemptedAt" json:"attemptedAt"`
+	StatusCode  int       `bson:"statusCode,omitempty" json:"statusCode,omitempty" example:"500"`
+	Error       string    `bson:"error,omitempty" json:"error,omitempty" example:"unexpected status 500"`
+	DurationMs  int64     `bson:"durationMs" json:"durationMs" example:"120"`
+}
+
+// @Description	Webhook delivery with its attempt log
+// @swagger:model
+type WebhookDeliveryEntity struct {
+	ID             primitive.ObjectID    `bson:"_id,omitempty" json:"id,omitempty" example:"60d0fe4f53115a001f000002"`
+	SubscriptionID primitive.ObjectID    `bson:"subscriptionId" json:"subscriptionId" example:"60d0fe4f53115a001f000001"`
+	EventID        string                `bson:"eventId" json:"eventId" example:"66a1f0c2e4b0a1b2c3d4e5f6"`
+	EventType      EventType             `bson:"eventType" json:"eventType" example:"appointment.created"`
+	URL            string                `bson:"url" json:"url" example:"https://vendor.example.com/hooks/hms"`
+	Payload        string                `bson:"payload" json:"payload"`
+	Status         WebhookDeliveryStatus `bson:"status" json:"status" example:"Pending"`
+	AttemptCount   int                   `bson:"attemptCount" json:"attemptCount" example:"0"`
+	NextAttemptAt  time.Time             `bson:"nextAttemptAt" json:"nextAttemptAt"`
+	Attempts       []WebhookAttempt      `bson:"attempts" json:"attempts"`
+	DeliveredAt    *time.Time            `bson:"deliveredAt,omitempty" json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time             `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      time.Time             `bson:"updatedAt" json:"updatedAt"`
+}
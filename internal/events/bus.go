@@ -0,0 +1,174 @@
+// Package events provides the in-process event bus that services publish
+// domain events to and the event stream endpoint fans out to clients.
+package events
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it
+// is dropped. Dropped clients reconnect with their last event ID.
+const subscriberBuffer = 64
+
+// Bus fans published events out to subscribers and keeps a bounded history so
+// reconnecting clients can catch up from their last event ID. Every published
+// event gets the next sequence number; replay follows the sequence rather
+// than the event IDs, because the outbox may publish an older event late when
+// it is retried.
+type Bus struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+	history     []domain.Event
+	historySize int
+	seq         uint64
+	closed      bool
+}
+
+// Subscription receives the events of the topics it subscribed to on C. C is
+// closed when the subscription is closed, dropped for being too slow, or the
+// bus shuts down.
+type Subscription struct {
+	C      chan domain.Event
+	topics map[domain.ActivityType]bool
+	bus    *Bus
+}
+
+func NewBus(historySize int) *Bus {
+	return &Bus{
+		subscribers: make(map[*Subscription]struct{}),
+		historySize: historySize,
+	}
+}
+
+// Publish delivers the event to every matching subscriber without blocking.
+// Events without an ID or timestamp get one, and every event gets the next
+// sequence number.
+func (b *Bus) Publish(event domain.Event) {
+	if b == nil {
+		return
+	}
+
+	if event.ID == "" {
+		event.ID = primitive.NewObjectID().Hex()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.seq++
+	event.Sequence = b.seq
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for sub := range b.subscribers {
+		if !sub.topics[event.Topic] {
+			continue
+		}
+		select {
+		case sub.C <- event:
+		default:
+			b.remove(sub)
+		}
+	}
+}
+
+// Subscribe registers a subscriber for the given topics. When lastEventID is
+// set, the buffered events published after it are returned for replay; the
+// subscription only receives events published after those. lastEventID is
+// either a sequence number or, for clients that track event IDs, the ID of a
+// buffered event. When it cannot be placed, e.g. it is from before a restart
+// or no longer buffered, the whole history is replayed.
+func (b *Bus) Subscribe(topics []domain.ActivityType, lastEventID string) (*Subscription, []domain.Event) {
+	sub := &Subscription{
+		C:      make(chan domain.Event, subscriberBuffer),
+		topics: make(map[domain.ActivityType]bool, len(topics)),
+		bus:    b,
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(sub.C)
+		return sub, nil
+	}
+
+	var replay []domain.Event
+	if lastEventID != "" {
+		after := b.sequenceOf(lastEventID)
+		for _, event := range b.history {
+			if event.Sequence > after && sub.topics[event.Topic] {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	b.subscribers[sub] = struct{}{}
+	return sub, replay
+}
+
+// Close unsubscribes and closes all subscriptions. Publishing after Close is a
+// no-op.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+// Close unsubscribes the subscription. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.remove(s)
+}
+
+// sequenceOf returns the sequence number to replay after, or 0 to replay
+// everything. It must be called with b.mu held.
+func (b *Bus) sequenceOf(lastEventID string) uint64 {
+	if seq, err := strconv.ParseUint(lastEventID, 10, 64); err == nil {
+		if seq > b.seq {
+			// From before a restart
+			return 0
+		}
+		return seq
+	}
+
+	for _, event := range b.history {
+		if event.ID == lastEventID {
+			return event.Sequence
+		}
+	}
+	return 0
+}
+
+// remove must be called with b.mu held.
+func (b *Bus) remove(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.C)
+}
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSubscribeReplaysInPublishOrder(t *testing.T) {
+	bus := NewBus(10)
+	topics := []domain.ActivityType{domain.ActivityTypeAppointment}
+
+	older := primitive.NewObjectID().Hex()
+	newer := primitive.NewObjectID().Hex()
+
+	sub, _ := bus.Subscribe(topics, "")
+	defer sub.Close()
+	bus.Publish(domain.Event{ID: newer, Topic: domain.ActivityTypeAppointment})
+	first := <-sub.C
+
+	// A retried outbox message publishes an older ID after a newer one.
+	bus.Publish(domain.Event{ID: older, Topic: domain.ActivityTypeAppointment})
+
+	for _, lastEventID := range []string{strconv.FormatUint(first.Sequence, 10), newer} {
+		resumed, replay := bus.Subscribe(topics, lastEventID)
+		resumed.Close()
+		if len(replay) != 1 || replay[0].ID != older {
+			t.Fatalf("replay after %s = %v, want the late event %s", lastEventID, replay, older)
+		}
+	}
+}
+
+func TestSubscribeReplaysEverythingForUnknownID(t *testing.T) {
+	bus := NewBus(10)
+	topics := []domain.ActivityType{domain.ActivityTypeAppointment, domain.ActivityTypePatient}
+
+	bus.Publish(domain.Event{Topic: domain.ActivityTypeAppointment})
+	bus.Publish(domain.Event{Topic: domain.ActivityTypePatient})
+	bus.Publish(domain.Event{Topic: domain.ActivityTypeLab})
+
+	for _, lastEventID := range []string{"999", primitive.NewObjectID().Hex()} {
+		sub, replay := bus.Subscribe(topics, lastEventID)
+		sub.Close()
+		if len(replay) != 2 {
+			t.Fatalf("replay after %s has %d events, want the 2 subscribed ones", lastEventID, len(replay))
+		}
+		if replay[0].Sequence != 1 || replay[1].Sequence != 2 {
+			t.Errorf("replay after %s = %v, want sequences 1 and 2", lastEventID, replay)
+		}
+	}
+}
+
+func TestSubscribeWithoutLastEventIDReplaysNothing(t *testing.T) {
+	bus := NewBus(10)
+	bus.Publish(domain.Event{Topic: domain.ActivityTypeAppointment})
+
+	sub, replay := bus.Subscribe([]domain.ActivityType{domain.ActivityTypeAppointment}, "")
+	defer sub.Close()
+	if len(replay) != 0 {
+		t.Fatalf("replay = %v, want none", replay)
+	}
+}
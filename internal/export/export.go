@@ -0,0 +1,70 @@
+// Package export writes tabular data as downloadable files. Rows are written
+// as they are produced, so a writer never holds more than one row in memory.
+package export
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Format is a file format rows can be exported in.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+func (f Format) IsValid() bool {
+	switch f {
+	case FormatCSV, FormatXLSX:
+		return true
+	}
+	return false
+}
+
+// ContentType is the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+// RowWriter writes the rows of one table. Close must be called once all rows
+// are written to complete the file.
+type RowWriter interface {
+	Write(row []string) error
+	Close() error
+}
+
+// NewRowWriter returns a writer of the format writing to w. The sheet name is
+// used by formats that name their tables.
+func NewRowWriter(format Format, w io.Writer, sheet string) (RowWriter, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w), nil
+	case FormatXLSX:
+		return newXLSXWriter(w, sheet)
+	}
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+// neutralizeCell prefixes cells a spreadsheet would evaluate as a formula with
+// a quote so they are shown as text. Plain numbers such as negative amounts are
+// left alone since they cannot carry a formula.
+func neutralizeCell(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return cell
+		}
+		return "'" + cell
+	}
+	return cell
+}
@@ -0,0 +1,92 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestNeutralizeCell(t *testing.T) {
+	tests := []struct {
+		cell string
+		want string
+	}{
+		{"", ""},
+		{"Budi", "Budi"},
+		{"=HYPERLINK(\"http://x\")", "'=HYPERLINK(\"http://x\")"},
+		{"+1+2", "'+1+2"},
+		{"-2+3", "'-2+3"},
+		{"@SUM(A1)", "'@SUM(A1)"},
+		{"\tcmd", "'\tcmd"},
+		{"\rcmd", "'\rcmd"},
+		{"-150000", "-150000"},
+		{"+62.5", "+62.5"},
+		{"a=b", "a=b"},
+	}
+	for _, tt := range tests {
+		if got := neutralizeCell(tt.cell); got != tt.want {
+			t.Errorf("neutralizeCell(%q) = %q, want %q", tt.cell, got, tt.want)
+		}
+	}
+}
+
+func TestCSVWriterNeutralizesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter(FormatCSV, &buf, "patients")
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := []string{"=1+1", "-5"}
+	if err := w.Write(row); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "'=1+1,-5\n"; got != want {
+		t.Errorf("csv = %q, want %q", got, want)
+	}
+	if row[0] != "=1+1" {
+		t.Errorf("caller's row was modified: %q", row[0])
+	}
+}
+
+func TestXLSXWriterNeutralizesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewRowWriter(FormatXLSX, &buf, "patients")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]string{"@cmd", "ok"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range zr.File {
+		if f.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sheet, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(sheet), `<t xml:space="preserve">&#39;@cmd</t>`) {
+			t.Errorf("sheet does not contain neutralized cell: %s", sheet)
+		}
+		return
+	}
+	t.Fatal("worksheet not found in workbook")
+}
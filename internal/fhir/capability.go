@@ -0,0 +1,148 @@
+package fhir
+
+import "time"
+
+type CapabilitySoftware struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type CapabilityImplementation struct {
+	Description string `json:"description"`
+	URL         string `json:"url,omitempty"`
+}
+
+type CapabilityInteraction struct {
+	Code string `json:"code"`
+}
+
+type CapabilitySearchParam struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Documentation string `json:"documentation,omitempty"`
+}
+
+type CapabilityResource struct {
+	Type        string                  `json:"type"`
+	Interaction []CapabilityInteraction `json:"interaction"`
+	SearchParam []CapabilitySearchParam `json:"searchParam,omitempty"`
+}
+
+type CapabilitySecurity struct {
+	Description string `json:"description"`
+}
+
+type CapabilityRest struct {
+	Mode     string               `json:"mode"`
+	Security *CapabilitySecurity  `json:"security,omitempty"`
+	Resource []CapabilityResource `json:"resource"`
+}
+
+type CapabilityStatement struct {
+	ResourceType   string                   `json:"resourceType"`
+	Status         string                   `json:"status"`
+	Date           string                   `json:"date"`
+	Kind           string                   `json:"kind"`
+	Software       CapabilitySoftware       `json:"software"`
+	Implementation CapabilityImplementation `json:"implementation"`
+	FHIRVersion    string                   `json:"fhirVersion"`
+	Format         []string                 `json:"format"`
+	Rest           []CapabilityRest         `json:"rest"`
+}
+
+var (
+	idParam         = CapabilitySearchParam{Name: "_id", Type: "token"}
+	identifierParam = CapabilitySearchParam{Name: "identifier", Type: "token", Documentation: "The resource ID, optionally with the system {base}/sid/{type}"}
+	nameParam       = CapabilitySearchParam{Name: "name", Type: "string", Documentation: "Part of the name, ignoring case"}
+	patientParam    = CapabilitySearchParam{Name: "patient", Type: "reference"}
+	subjectParam    = CapabilitySearchParam{Name: "subject", Type: "reference", Documentation: "A Patient reference"}
+)
+
+// resources lists what the facade serves of each resource type. Only types
+// with create can be written.
+var resources = []CapabilityResource{
+	{
+		Type:        TypePatient,
+		Interaction: interactions("read", "search-type", "create"),
+		SearchParam: []CapabilitySearchParam{idParam, identifierParam, nameParam},
+	},
+	{
+		Type:        TypePractitioner,
+		Interaction: interactions("read", "search-type", "create"),
+		SearchParam: []CapabilitySearchParam{idParam, identifierParam, nameParam},
+	},
+	{
+		Type:        TypeAppointment,
+		Interaction: interactions("read", "search-type", "create"),
+		SearchParam: []CapabilitySearchParam{
+			idParam, identifierParam, patientParam,
+			{Name: "practitioner", Type: "reference"},
+			{Name: "date", Type: "date", Documentation: "The start, with the prefixes eq, ge, gt, le and lt"},
+		},
+	},
+	{
+		Type:        TypeEncounter,
+		Interaction: interactions("read", "search-type", "create"),
+		SearchParam: []CapabilitySearchParam{
+			idParam, identifierParam, patientParam, subjectParam,
+			{Name: "date", Type: "date", Documentation: "The start, with the prefixes eq, ge, gt, le and lt"},
+		},
+	},
+	{
+		Type:        TypeCondition,
+		Interaction: interactions("read", "search-type"),
+		SearchParam: []CapabilitySearchParam{
+			idParam, identifierParam, patientParam, subjectParam,
+			{Name: "recorded-date", Type: "date", Documentation: "With the prefixes eq, ge, gt, le and lt"},
+		},
+	},
+}
+
+func interactions(codes ...string) []CapabilityInteraction {
+	list := make([]CapabilityInteraction, 0, len(codes))
+	for _, code := range codes {
+		list = append(list, CapabilityInteraction{Code: code})
+	}
+	return list
+}
+
+// Supports reports whether the facade serves the interaction on the
+// resource type.
+func Supports(resourceType, interaction string) bool {
+	for _, r := range resources {
+		if r.Type != resourceType {
+			continue
+		}
+		for _, i := range r.Interaction {
+			if i.Code == interaction {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CapabilityStatement describes the facade. date is when the server started,
+// so the statement only changes with the software.
+func (m *Mapper) CapabilityStatement(date time.Time) *CapabilityStatement {
+	return &CapabilityStatement{
+		ResourceType: TypeCapabilityStatement,
+		Status:       "active",
+		Date:         formatInstant(date),
+		Kind:         "instance",
+		Software:     CapabilitySoftware{Name: "HMS API"},
+		Implementation: CapabilityImplementation{
+			Description: "FHIR R4 facade over the hospital's patients, doctors, appointments and medical records",
+			URL:         m.baseURL,
+		},
+		FHIRVersion: Version,
+		Format:      []string{"json"},
+		Rest: []CapabilityRest{{
+			Mode: "server",
+			Security: &CapabilitySecurity{
+				Description: "Send the token from POST /api/auth/login as a Bearer token. Access to each resource type follows the role permissions of the REST API.",
+			},
+			Resource: resources,
+		}},
+	}
+}
@@ -0,0 +1,42 @@
+package fhir
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Error is a request the facade cannot serve, reported to the client as an
+// OperationOutcome with the HTTP status.
+type Error struct {
+	Status      int
+	Code        string
+	Diagnostics string
+}
+
+func (e *Error) Error() string {
+	return e.Diagnostics
+}
+
+// Outcome returns the OperationOutcome reporting the error.
+func (e *Error) Outcome() *OperationOutcome {
+	return NewOutcome("error", e.Code, e.Diagnostics)
+}
+
+func NewOutcome(severity, code, diagnostics string) *OperationOutcome {
+	return &OperationOutcome{
+		ResourceType: TypeOperationOutcome,
+		Issue:        []OperationOutcomeIssue{{Severity: severity, Code: code, Diagnostics: diagnostics}},
+	}
+}
+
+func NotFound(resourceType, id string) *Error {
+	return &Error{Status: http.StatusNotFound, Code: "not-found", Diagnostics: fmt.Sprintf("%s/%s is not known", resourceType, id)}
+}
+
+func Invalid(format string, args ...any) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: "invalid", Diagnostics: fmt.Sprintf(format, args...)}
+}
+
+func NotSupported(format string, args ...any) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: "not-supported", Diagnostics: fmt.Sprintf(format, args...)}
+}
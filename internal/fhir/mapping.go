@@ -0,0 +1,483 @@
+package fhir
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Mapper maps the hospital's entities to FHIR resources and FHIR resources
+// sent by clients to the requests the REST API takes. Identifier systems,
+// code systems and extensions the hospital defines itself live under the
+// server's base URL.
+type Mapper struct {
+	baseURL string
+}
+
+func NewMapper(baseURL string) *Mapper {
+	return &Mapper{baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// BaseURL is the server's base URL, e.g. https://hms.example.com/fhir/R4.
+func (m *Mapper) BaseURL() string {
+	return m.baseURL
+}
+
+// IdentifierSystem is the system of the identifiers of a resource type,
+// whose values are the entity IDs.
+func (m *Mapper) IdentifierSystem(resourceType string) string {
+	return m.baseURL + "/sid/" + strings.ToLower(resourceType)
+}
+
+func (m *Mapper) codeSystem(name string) string {
+	return m.baseURL + "/CodeSystem/" + name
+}
+
+func (m *Mapper) extension(name string) string {
+	return m.baseURL + "/StructureDefinition/" + name
+}
+
+func (m *Mapper) identifier(resourceType string, id primitive.ObjectID) []Identifier {
+	return []Identifier{{Use: "usual", System: m.IdentifierSystem(resourceType), Value: id.Hex()}}
+}
+
+// Patient maps a patient. FHIR has no age, only a birth date, so the age is
+// kept in an extension.
+func (m *Mapper) Patient(p *domain.PatientEntity) *Patient {
+	age := p.Age
+	active := !p.IsDeleted
+	patient := &Patient{
+		ResourceType: TypePatient,
+		ID:           p.ID.Hex(),
+		Meta:         meta(p.UpdatedAt),
+		Extension:    []Extension{{URL: m.extension("patient-age"), ValueInteger: &age}},
+		Identifier:   m.identifier(TypePatient, p.ID),
+		Active:       &active,
+		Name:         []HumanName{humanName(p.Name)},
+		Telecom:      telecom(p.Phone, p.Email),
+		Gender:       strings.ToLower(p.Gender),
+	}
+	if p.Address != "" {
+		patient.Address = []Address{{Text: p.Address}}
+	}
+	return patient
+}
+
+// PatientRequest maps a patient sent by a client. Without the age extension
+// the age is worked out from the birth date.
+func (m *Mapper) PatientRequest(p *Patient, now time.Time) domain.CreatePatientRequest {
+	req := domain.CreatePatientRequest{
+		Name:  nameText(p.Name),
+		Phone: contact(p.Telecom, "phone"),
+		Email: contact(p.Telecom, "email"),
+	}
+
+	switch p.Gender {
+	case "male":
+		req.Gender = "Male"
+	case "female":
+		req.Gender = "Female"
+	case "other", "unknown":
+		req.Gender = "Other"
+	}
+
+	if age := m.extensionInteger(p.Extension, "patient-age"); age != nil {
+		req.Age = *age
+	} else if birthDate, err := time.Parse("2006-01-02", p.BirthDate); err == nil {
+		req.Age = now.Year() - birthDate.Year()
+		if now.YearDay() < birthDate.YearDay() {
+			req.Age--
+		}
+	}
+
+	for _, a := range p.Address {
+		if a.Text != "" {
+			req.Address = a.Text
+			break
+		}
+	}
+	return req
+}
+
+// Practitioner maps a doctor, whose specialty becomes a qualification.
+func (m *Mapper) Practitioner(d *domain.DoctorEntity) *Practitioner {
+	active := !d.IsDeleted
+	practitioner := &Practitioner{
+		ResourceType: TypePractitioner,
+		ID:           d.ID.Hex(),
+		Meta:         meta(d.UpdatedAt),
+		Identifier:   m.identifier(TypePractitioner, d.ID),
+		Active:       &active,
+		Name:         []HumanName{humanName(d.Name)},
+		Telecom:      telecom(d.Phone, d.Email),
+	}
+	if d.Specialty != "" {
+		practitioner.Qualification = []PractitionerQualification{{Code: CodeableConcept{Text: d.Specialty}}}
+	}
+	return practitioner
+}
+
+func (m *Mapper) PractitionerRequest(p *Practitioner) domain.CreateDoctorRequet {
+	req := domain.CreateDoctorRequet{
+		Name:  nameText(p.Name),
+		Phone: contact(p.Telecom, "phone"),
+		Email: contact(p.Telecom, "email"),
+	}
+	for _, q := range p.Qualification {
+		if specialty := conceptText(q.Code); specialty != "" {
+			req.Specialty = specialty
+			break
+		}
+	}
+	return req
+}
+
+var appointmentStatuses = map[domain.AppointmentStatus]string{
+	domain.AppointmentStatusScheduled: "pending",
+	domain.AppointmentStatusConfirmed: "booked",
+	domain.AppointmentStatusCompleted: "fulfilled",
+	domain.AppointmentStatusCancelled: "cancelled",
+}
+
+// HL7 v2 appointment reason codes for the appointment types that have one.
+var appointmentReasons = map[domain.AppointmentType]string{
+	domain.AppointmentTypeCheckUp:      "CHECKUP",
+	domain.AppointmentTypeFollowUp:     "FOLLOWUP",
+	domain.AppointmentTypeConsultation: "ROUTINE",
+	domain.AppointmentTypeEmergency:    "EMERGENCY",
+}
+
+// Appointment maps an appointment. The patient, doctor and location are
+// participants; a clinic room is a Location reference.
+func (m *Mapper) Appointment(a *domain.AppointmentEntity) *Appointment {
+	appointment := &Appointment{
+		ResourceType:    TypeAppointment,
+		ID:              a.ID.Hex(),
+		Meta:            meta(a.UpdatedAt),
+		Identifier:      m.identifier(TypeAppointment, a.ID),
+		Status:          appointmentStatuses[a.Status],
+		AppointmentType: m.appointmentType(a.Type),
+		Start:           formatInstant(a.DateTime),
+		End:             formatInstant(a.DateTime.Add(time.Duration(a.Duration) * time.Minute)),
+		MinutesDuration: a.Duration,
+		Comment:         a.Notes,
+		Participant: []AppointmentParticipant{
+			{Actor: &Reference{Reference: reference(TypePatient, a.PatientID)}, Required: "required", Status: "accepted"},
+			{Actor: &Reference{Reference: reference(TypePractitioner, a.DoctorID)}, Required: "required", Status: "accepted"},
+		},
+	}
+
+	if a.Location != "" || a.RoomID != nil {
+		location := &Reference{Display: a.Location}
+		if a.RoomID != nil {
+			location.Reference = "Location/" + a.RoomID.Hex()
+		}
+		appointment.Participant = append(appointment.Participant, AppointmentParticipant{Actor: location, Required: "information-only", Status: "accepted"})
+	}
+	return appointment
+}
+
+func (m *Mapper) appointmentType(t domain.AppointmentType) *CodeableConcept {
+	concept := &CodeableConcept{Coding: []Coding{{System: m.codeSystem("appointment-type"), Code: string(t)}}}
+	if code, ok := appointmentReasons[t]; ok {
+		concept.Coding = append(concept.Coding, Coding{System: appointmentReasonSystem, Code: code})
+	}
+	return concept
+}
+
+// AppointmentRequest maps an appointment sent by a client. Its status is
+// ignored: new appointments are always scheduled.
+func (m *Mapper) AppointmentRequest(a *Appointment) (domain.CreateAppointmentRequest, error) {
+	req := domain.CreateAppointmentRequest{
+		Duration: a.MinutesDuration,
+		Notes:    a.Comment,
+	}
+
+	if a.Start != "" {
+		start, err := parseDateTime(a.Start)
+		if err != nil {
+			return req, fmt.Errorf("invalid start: %w", err)
+		}
+		req.DateTime = start
+		if req.Duration == 0 && a.End != "" {
+			end, err := parseDateTime(a.End)
+			if err != nil {
+				return req, fmt.Errorf("invalid end: %w", err)
+			}
+			req.Duration = int(end.Sub(start).Minutes())
+		}
+	}
+
+	if a.AppointmentType != nil {
+		req.Type = m.appointmentTypeOf(a.AppointmentType)
+	}
+
+	for _, p := range a.Participant {
+		if p.Actor == nil {
+			continue
+		}
+		resourceType, id, _ := strings.Cut(p.Actor.Reference, "/")
+		switch resourceType {
+		case TypePatient:
+			req.PatientID = id
+		case TypePractitioner:
+			req.DoctorID = id
+		case "Location":
+			req.RoomID = id
+			req.Location = p.Actor.Display
+		case "":
+			req.Location = p.Actor.Display
+		}
+	}
+	return req, nil
+}
+
+func (m *Mapper) appointmentTypeOf(concept *CodeableConcept) domain.AppointmentType {
+	for _, c := range concept.Coding {
+		if c.System == m.codeSystem("appointment-type") {
+			return domain.AppointmentType(c.Code)
+		}
+	}
+	for _, c := range concept.Coding {
+		if c.System != appointmentReasonSystem {
+			continue
+		}
+		for t, code := range appointmentReasons {
+			if code == c.Code {
+				return t
+			}
+		}
+	}
+	return ""
+}
+
+// Encounter maps a medical record as the visit it records. The diagnosis is
+// a reference to the Condition mapped from the same record, and the
+// treatment and notes, which FHIR has no place for on an encounter, are
+// extensions.
+func (m *Mapper) Encounter(r *domain.MedicalRecordEntity) *Encounter {
+	class := Coding{System: actCodeSystem, Code: "AMB", Display: "ambulatory"}
+	if r.RecordType == domain.RecordTypeEmergency {
+		class = Coding{System: actCodeSystem, Code: "EMER", Display: "emergency"}
+	}
+
+	encounter := &Encounter{
+		ResourceType: TypeEncounter,
+		ID:           r.ID.Hex(),
+		Meta:         meta(r.UpdatedAt),
+		Identifier:   m.identifier(TypeEncounter, r.ID),
+		Status:       "finished",
+		Class:        class,
+		Type:         []CodeableConcept{{Coding: []Coding{{System: m.codeSystem("medical-record-type"), Code: string(r.RecordType)}}}},
+		Subject:      &Reference{Reference: reference(TypePatient, r.PatientID)},
+		Participant:  []EncounterParticipant{{Individual: &Reference{Reference: reference(TypePractitioner, r.DoctorID)}}},
+		Period:       &Period{Start: formatInstant(r.Date)},
+	}
+	if r.Description != "" {
+		encounter.ReasonCode = []CodeableConcept{{Text: r.Description}}
+	}
+	if r.Diagnosis != "" {
+		encounter.Diagnosis = []EncounterDiagnosis{{
+			Condition: Reference{Reference: reference(TypeCondition, r.ID), Display: r.Diagnosis},
+			Use:       &CodeableConcept{Coding: []Coding{{System: encounterDiagnosisSystem, Code: "DD", Display: "Discharge diagnosis"}}},
+		}}
+	}
+	if r.Treatment != "" {
+		encounter.Extension = append(encounter.Extension, Extension{URL: m.extension("medical-record-treatment"), ValueString: r.Treatment})
+	}
+	if r.Notes != "" {
+		encounter.Extension = append(encounter.Extension, Extension{URL: m.extension("medical-record-notes"), ValueString: r.Notes})
+	}
+	return encounter
+}
+
+// EncounterRequest maps an encounter sent by a client to a medical record and
+// the time of the visit, which is zero when the encounter has no start.
+func (m *Mapper) EncounterRequest(e *Encounter) (domain.CreateMedicalRecordRequest, time.Time, error) {
+	req := domain.CreateMedicalRecordRequest{
+		Treatment: m.extensionString(e.Extension, "medical-record-treatment"),
+		Notes:     m.extensionString(e.Extension, "medical-record-notes"),
+	}
+	if e.Subject != nil {
+		req.PatientID = referenceID(e.Subject.Reference, TypePatient)
+	}
+	for _, p := range e.Participant {
+		if p.Individual != nil {
+			if id := referenceID(p.Individual.Reference, TypePractitioner); id != "" {
+				req.DoctorID = id
+				break
+			}
+		}
+	}
+	for _, t := range e.Type {
+		for _, c := range t.Coding {
+			if c.System == m.codeSystem("medical-record-type") {
+				req.RecordType = c.Code
+			}
+		}
+	}
+	if req.RecordType == "" && e.Class.Code == "EMER" {
+		req.RecordType = string(domain.RecordTypeEmergency)
+	}
+	for _, r := range e.ReasonCode {
+		if text := conceptText(r); text != "" {
+			req.Description = text
+			break
+		}
+	}
+	for _, d := range e.Diagnosis {
+		if d.Condition.Display != "" {
+			req.Diagnosis = d.Condition.Display
+			break
+		}
+	}
+
+	var date time.Time
+	if e.Period != nil && e.Period.Start != "" {
+		start, err := parseDateTime(e.Period.Start)
+		if err != nil {
+			return req, date, fmt.Errorf("invalid period.start: %w", err)
+		}
+		date = start
+	}
+	return req, date, nil
+}
+
+// Condition maps the diagnosis of a medical record. It shares the record's
+// ID with the Encounter it was made in.
+func (m *Mapper) Condition(r *domain.MedicalRecordEntity) *Condition {
+	return &Condition{
+		ResourceType: TypeCondition,
+		ID:           r.ID.Hex(),
+		Meta:         meta(r.UpdatedAt),
+		Identifier:   m.identifier(TypeCondition, r.ID),
+		VerificationStatus: &CodeableConcept{Coding: []Coding{
+			{System: conditionVerifySystem, Code: "confirmed"},
+		}},
+		Category: []CodeableConcept{{Coding: []Coding{
+			{System: conditionCategorySystem, Code: "encounter-diagnosis", Display: "Encounter Diagnosis"},
+		}}},
+		Code:         &CodeableConcept{Text: r.Diagnosis},
+		Subject:      Reference{Reference: reference(TypePatient, r.PatientID)},
+		Encounter:    &Reference{Reference: reference(TypeEncounter, r.ID)},
+		RecordedDate: formatInstant(r.Date),
+		Recorder:     &Reference{Reference: reference(TypePractitioner, r.DoctorID)},
+	}
+}
+
+func (m *Mapper) extensionInteger(extensions []Extension, name string) *int {
+	for _, e := range extensions {
+		if e.URL == m.extension(name) {
+			return e.ValueInteger
+		}
+	}
+	return nil
+}
+
+func (m *Mapper) extensionString(extensions []Extension, name string) string {
+	for _, e := range extensions {
+		if e.URL == m.extension(name) {
+			return e.ValueString
+		}
+	}
+	return ""
+}
+
+func meta(updatedAt time.Time) *Meta {
+	if updatedAt.IsZero() {
+		return nil
+	}
+	return &Meta{LastUpdated: formatInstant(updatedAt)}
+}
+
+func reference(resourceType string, id primitive.ObjectID) string {
+	return resourceType + "/" + id.Hex()
+}
+
+// referenceID returns the ID of a reference to a resource of the type, given
+// as "Type/id" or as an absolute URL ending in it.
+func referenceID(ref, resourceType string) string {
+	i := strings.LastIndex(ref, resourceType+"/")
+	if i < 0 || (i > 0 && ref[i-1] != '/') {
+		return ""
+	}
+	return strings.TrimPrefix(ref[i:], resourceType+"/")
+}
+
+// humanName splits a name on its last space into given names and a family
+// name, keeping the whole name as the text.
+func humanName(name string) HumanName {
+	n := HumanName{Use: "official", Text: name}
+	fields := strings.Fields(name)
+	if len(fields) > 0 && strings.HasSuffix(fields[0], ".") {
+		n.Prefix = fields[:1]
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		n.Family = fields[len(fields)-1]
+		n.Given = fields[:len(fields)-1]
+	}
+	return n
+}
+
+// nameText returns the first name's text, or its parts put together.
+func nameText(names []HumanName) string {
+	for _, n := range names {
+		if n.Text != "" {
+			return n.Text
+		}
+		parts := append(append(append([]string{}, n.Prefix...), n.Given...), n.Family)
+		if name := strings.TrimSpace(strings.Join(parts, " ")); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+func telecom(phone, email string) []ContactPoint {
+	var points []ContactPoint
+	if phone != "" {
+		points = append(points, ContactPoint{System: "phone", Value: phone, Use: "mobile"})
+	}
+	if email != "" {
+		points = append(points, ContactPoint{System: "email", Value: email})
+	}
+	return points
+}
+
+func contact(points []ContactPoint, system string) string {
+	for _, p := range points {
+		if p.System == system && p.Value != "" {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+func conceptText(c CodeableConcept) string {
+	if c.Text != "" {
+		return c.Text
+	}
+	for _, coding := range c.Coding {
+		if coding.Display != "" {
+			return coding.Display
+		}
+	}
+	return ""
+}
+
+func formatInstant(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseDateTime parses a FHIR dateTime or instant, which must have a time
+// and a time zone here.
+func parseDateTime(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339, s)
+}
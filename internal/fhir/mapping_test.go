@@ -0,0 +1,255 @@
+package fhir
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const testBaseURL = "https://hms.example.com/fhir/R4"
+
+// roundTrip sends a resource through JSON, as it would travel to a client and
+// back.
+func roundTrip[T any](t *testing.T, resource *T) *T {
+	t.Helper()
+	body, err := json.Marshal(resource)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded T
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return &decoded
+}
+
+func TestPatientRoundTrip(t *testing.T) {
+	m := NewMapper(testBaseURL + "/")
+	p := &domain.PatientEntity{
+		ID:        primitive.NewObjectID(),
+		Name:      "Siti Rahma",
+		Age:       34,
+		Gender:    "Female",
+		Phone:     "081234567890",
+		Email:     "siti@example.com",
+		Address:   "Jl. Merdeka 1",
+		UpdatedAt: time.Date(2025, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	patient := roundTrip(t, m.Patient(p))
+	if patient.ID != p.ID.Hex() || patient.Gender != "female" {
+		t.Errorf("patient = %+v", patient)
+	}
+	if got := patient.Identifier[0].System; got != testBaseURL+"/sid/patient" {
+		t.Errorf("identifier system = %q", got)
+	}
+	if got := patient.Name[0]; got.Family != "Rahma" || len(got.Given) != 1 || got.Given[0] != "Siti" {
+		t.Errorf("name = %+v", got)
+	}
+	if patient.Meta == nil || patient.Meta.LastUpdated != "2025-07-01T08:00:00Z" {
+		t.Errorf("meta = %+v", patient.Meta)
+	}
+
+	req := m.PatientRequest(patient, time.Now())
+	want := domain.CreatePatientRequest{
+		Name:    p.Name,
+		Age:     p.Age,
+		Gender:  p.Gender,
+		Phone:   p.Phone,
+		Email:   p.Email,
+		Address: p.Address,
+	}
+	if req != want {
+		t.Errorf("request = %+v, want %+v", req, want)
+	}
+}
+
+func TestPatientRequestAgeFromBirthDate(t *testing.T) {
+	m := NewMapper(testBaseURL)
+	now := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		birthDate string
+		want      int
+	}{
+		{"1990-06-30", 35},
+		{"1990-07-02", 34},
+	}
+	for _, tt := range tests {
+		req := m.PatientRequest(&Patient{BirthDate: tt.birthDate, Gender: "unknown"}, now)
+		if req.Age != tt.want || req.Gender != "Other" {
+			t.Errorf("birth date %s: age %d gender %q, want %d Other", tt.birthDate, req.Age, req.Gender, tt.want)
+		}
+	}
+}
+
+func TestPractitionerRoundTrip(t *testing.T) {
+	m := NewMapper(testBaseURL)
+	d := &domain.DoctorEntity{
+		ID:        primitive.NewObjectID(),
+		Name:      "Dr. Budi Santoso",
+		Specialty: "Cardiology",
+		Phone:     "0811111111",
+		Email:     "budi@example.com",
+	}
+
+	practitioner := roundTrip(t, m.Practitioner(d))
+	if got := practitioner.Name[0]; len(got.Prefix) != 1 || got.Prefix[0] != "Dr." || got.Family != "Santoso" {
+		t.Errorf("name = %+v", got)
+	}
+	if practitioner.Meta != nil {
+		t.Errorf("meta = %+v, want none without an update time", practitioner.Meta)
+	}
+
+	req := m.PractitionerRequest(practitioner)
+	want := domain.CreateDoctorRequet{Name: d.Name, Specialty: d.Specialty, Phone: d.Phone, Email: d.Email}
+	if req.Name != want.Name || req.Specialty != want.Specialty || req.Phone != want.Phone || req.Email != want.Email {
+		t.Errorf("request = %+v, want %+v", req, want)
+	}
+}
+
+func TestAppointmentRoundTrip(t *testing.T) {
+	m := NewMapper(testBaseURL)
+	roomID := primitive.NewObjectID()
+	a := &domain.AppointmentEntity{
+		ID:        primitive.NewObjectID(),
+		PatientID: primitive.NewObjectID(),
+		DoctorID:  primitive.NewObjectID(),
+		Type:      domain.AppointmentTypeFollowUp,
+		DateTime:  time.Date(2025, 7, 17, 10, 0, 0, 0, time.UTC),
+		Duration:  30,
+		Status:    domain.AppointmentStatusConfirmed,
+		Location:  "Room 101",
+		RoomID:    &roomID,
+		Notes:     "Bring previous results",
+	}
+
+	appointment := roundTrip(t, m.Appointment(a))
+	if appointment.Status != "booked" || appointment.End != "2025-07-17T10:30:00Z" {
+		t.Errorf("appointment = %+v", appointment)
+	}
+	if len(appointment.Participant) != 3 {
+		t.Fatalf("participants = %+v", appointment.Participant)
+	}
+
+	req, err := m.AppointmentRequest(appointment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := domain.CreateAppointmentRequest{
+		PatientID: a.PatientID.Hex(),
+		DoctorID:  a.DoctorID.Hex(),
+		Type:      a.Type,
+		DateTime:  a.DateTime,
+		Duration:  a.Duration,
+		Location:  a.Location,
+		RoomID:    roomID.Hex(),
+		Notes:     a.Notes,
+	}
+	if req != want {
+		t.Errorf("request = %+v, want %+v", req, want)
+	}
+}
+
+func TestAppointmentRequest(t *testing.T) {
+	m := NewMapper(testBaseURL)
+
+	t.Run("duration from end and type from reason code", func(t *testing.T) {
+		req, err := m.AppointmentRequest(&Appointment{
+			Start:           "2025-07-17T10:00:00+07:00",
+			End:             "2025-07-17T10:45:00+07:00",
+			AppointmentType: &CodeableConcept{Coding: []Coding{{System: appointmentReasonSystem, Code: "EMERGENCY"}}},
+			Participant:     []AppointmentParticipant{{Actor: &Reference{Display: "ER"}}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if req.Duration != 45 || req.Type != domain.AppointmentTypeEmergency || req.Location != "ER" {
+			t.Errorf("request = %+v", req)
+		}
+		if !req.DateTime.Equal(time.Date(2025, 7, 17, 3, 0, 0, 0, time.UTC)) {
+			t.Errorf("dateTime = %v", req.DateTime)
+		}
+	})
+
+	t.Run("start without a time zone", func(t *testing.T) {
+		if _, err := m.AppointmentRequest(&Appointment{Start: "2025-07-17T10:00:00"}); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestEncounterAndConditionRoundTrip(t *testing.T) {
+	m := NewMapper(testBaseURL)
+	r := &domain.MedicalRecordEntity{
+		ID:          primitive.NewObjectID(),
+		PatientID:   primitive.NewObjectID(),
+		DoctorID:    primitive.NewObjectID(),
+		Date:        time.Date(2025, 7, 17, 10, 0, 0, 0, time.UTC),
+		RecordType:  domain.RecordTypeEmergency,
+		Description: "Chest pain since this morning",
+		Diagnosis:   "Unstable angina",
+		Treatment:   "Aspirin and observation",
+		Notes:       "Refer to cardiology",
+	}
+
+	encounter := roundTrip(t, m.Encounter(r))
+	if encounter.Class.Code != "EMER" {
+		t.Errorf("class = %+v", encounter.Class)
+	}
+	if got := encounter.Diagnosis[0].Condition.Reference; got != "Condition/"+r.ID.Hex() {
+		t.Errorf("diagnosis reference = %q", got)
+	}
+
+	req, date, err := m.EncounterRequest(encounter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := domain.CreateMedicalRecordRequest{
+		PatientID:   r.PatientID.Hex(),
+		DoctorID:    r.DoctorID.Hex(),
+		RecordType:  string(r.RecordType),
+		Description: r.Description,
+		Diagnosis:   r.Diagnosis,
+		Treatment:   r.Treatment,
+		Notes:       r.Notes,
+	}
+	if req != want {
+		t.Errorf("request = %+v, want %+v", req, want)
+	}
+	if !date.Equal(r.Date) {
+		t.Errorf("date = %v, want %v", date, r.Date)
+	}
+
+	condition := roundTrip(t, m.Condition(r))
+	if condition.ID != r.ID.Hex() || condition.Code.Text != r.Diagnosis {
+		t.Errorf("condition = %+v", condition)
+	}
+	if condition.Encounter.Reference != "Encounter/"+r.ID.Hex() || condition.Subject.Reference != "Patient/"+r.PatientID.Hex() {
+		t.Errorf("condition references = %+v %+v", condition.Encounter, condition.Subject)
+	}
+}
+
+func TestEncounterRequestAbsoluteReferences(t *testing.T) {
+	m := NewMapper(testBaseURL)
+	patientID := primitive.NewObjectID().Hex()
+	req, date, err := m.EncounterRequest(&Encounter{
+		Class:   Coding{Code: "EMER"},
+		Subject: &Reference{Reference: testBaseURL + "/Patient/" + patientID},
+		Participant: []EncounterParticipant{
+			{Individual: &Reference{Reference: "RelatedPerson/1"}},
+			{Individual: &Reference{Reference: "Practitioner/abc"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.PatientID != patientID || req.DoctorID != "abc" || req.RecordType != "emergency" {
+		t.Errorf("request = %+v", req)
+	}
+	if !date.IsZero() {
+		t.Errorf("date = %v, want zero without a period", date)
+	}
+}
@@ -0,0 +1,118 @@
+package fhir
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	DefaultCount = 50
+	MaxCount     = 200
+)
+
+// SearchSet returns a search result bundle with self as its self link.
+func (m *Mapper) SearchSet(self string, entries []BundleEntry) *Bundle {
+	return &Bundle{
+		ResourceType: TypeBundle,
+		Type:         "searchset",
+		Link:         []BundleLink{{Relation: "self", URL: self}},
+		Entry:        entries,
+	}
+}
+
+// Entry returns a search result bundle entry for the resource.
+func (m *Mapper) Entry(resourceType, id string, resource any) BundleEntry {
+	return BundleEntry{
+		FullURL:  m.baseURL + "/" + resourceType + "/" + id,
+		Resource: resource,
+		Search:   &BundleEntrySearch{Mode: "match"},
+	}
+}
+
+// ParseIdentifier parses an identifier search parameter, "[system]|value" or
+// "value", and returns the entity ID it asks for. ok is false when the
+// parameter names another system, so nothing can match.
+func (m *Mapper) ParseIdentifier(resourceType, param string) (id string, ok bool) {
+	system, value, hasSystem := strings.Cut(param, "|")
+	if !hasSystem {
+		return param, true
+	}
+	if system != "" && system != m.IdentifierSystem(resourceType) {
+		return "", false
+	}
+	return value, true
+}
+
+// ParseReference parses a reference search parameter, "Type/id", an absolute
+// URL ending in it, or a bare id, and returns the id.
+func ParseReference(resourceType, param string) string {
+	if id := referenceID(param, resourceType); id != "" {
+		return id
+	}
+	if strings.Contains(param, "/") {
+		return ""
+	}
+	return param
+}
+
+// ParseDateRange parses the values of a date search parameter, such as
+// "ge2025-07-01" and "lt2025-08", into the range [from, to) every value
+// matches. A zero from or to leaves that end open. A value without a prefix
+// matches the whole period of its precision: a year, month, day or second.
+func ParseDateRange(values []string) (from, to time.Time, err error) {
+	for _, value := range values {
+		prefix := "eq"
+		if len(value) > 2 && value[0] >= 'a' && value[0] <= 'z' {
+			prefix, value = value[:2], value[2:]
+		}
+
+		start, end, err := parsePeriod(value)
+		if err != nil {
+			return from, to, err
+		}
+
+		var lo, hi time.Time
+		switch prefix {
+		case "eq":
+			lo, hi = start, end
+		case "ge":
+			lo = start
+		case "gt":
+			lo = end
+		case "le":
+			hi = end
+		case "lt":
+			hi = start
+		default:
+			return from, to, NotSupported("date prefix %q is not supported", prefix)
+		}
+
+		if !lo.IsZero() && (from.IsZero() || lo.After(from)) {
+			from = lo
+		}
+		if !hi.IsZero() && (to.IsZero() || hi.Before(to)) {
+			to = hi
+		}
+	}
+	return from, to, nil
+}
+
+// parsePeriod returns the period a date or dateTime stands for. Dates
+// without a time zone are taken to be UTC.
+func parsePeriod(value string) (start, end time.Time, err error) {
+	layouts := []struct {
+		layout string
+		next   func(time.Time) time.Time
+	}{
+		{"2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }},
+		{"2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }},
+		{"2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }},
+		{time.RFC3339, func(t time.Time) time.Time { return t.Add(time.Second) }},
+	}
+	for _, l := range layouts {
+		if t, err := time.Parse(l.layout, value); err == nil {
+			return t, l.next(t), nil
+		}
+	}
+	return start, end, Invalid("%q is not a valid date", value)
+}
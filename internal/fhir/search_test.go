@@ -0,0 +1,92 @@
+package fhir
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseIdentifier(t *testing.T) {
+	m := NewMapper(testBaseURL)
+	tests := []struct {
+		param  string
+		wantID string
+		wantOK bool
+	}{
+		{"abc", "abc", true},
+		{"|abc", "abc", true},
+		{testBaseURL + "/sid/patient|abc", "abc", true},
+		{"http://other.example.com/nik|abc", "", false},
+	}
+	for _, tt := range tests {
+		id, ok := m.ParseIdentifier(TypePatient, tt.param)
+		if id != tt.wantID || ok != tt.wantOK {
+			t.Errorf("ParseIdentifier(%q) = %q, %v, want %q, %v", tt.param, id, ok, tt.wantID, tt.wantOK)
+		}
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		param string
+		want  string
+	}{
+		{"abc", "abc"},
+		{"Patient/abc", "abc"},
+		{testBaseURL + "/Patient/abc", "abc"},
+		{"Practitioner/abc", ""},
+		{"SomePatient/abc", ""},
+	}
+	for _, tt := range tests {
+		if got := ParseReference(TypePatient, tt.param); got != tt.want {
+			t.Errorf("ParseReference(%q) = %q, want %q", tt.param, got, tt.want)
+		}
+	}
+}
+
+func TestParseDateRange(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name     string
+		values   []string
+		from, to time.Time
+	}{
+		{"none", nil, time.Time{}, time.Time{}},
+		{"year", []string{"2025"}, day(2025, 1, 1), day(2026, 1, 1)},
+		{"month", []string{"2025-07"}, day(2025, 7, 1), day(2025, 8, 1)},
+		{"day", []string{"eq2025-07-17"}, day(2025, 7, 17), day(2025, 7, 18)},
+		{"second", []string{"2025-07-17T10:00:00Z"}, time.Date(2025, 7, 17, 10, 0, 0, 0, time.UTC), time.Date(2025, 7, 17, 10, 0, 1, 0, time.UTC)},
+		{"ge and lt", []string{"ge2025-07-01", "lt2025-08"}, day(2025, 7, 1), day(2025, 8, 1)},
+		{"gt and le", []string{"gt2025-07-01", "le2025-07-31"}, day(2025, 7, 2), day(2025, 8, 1)},
+		{"narrowest wins", []string{"ge2025-01", "ge2025-03", "lt2025-12", "lt2025-06"}, day(2025, 3, 1), day(2025, 6, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := ParseDateRange(tt.values)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !from.Equal(tt.from) || !to.Equal(tt.to) {
+				t.Errorf("range = [%v, %v), want [%v, %v)", from, to, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+func TestParseDateRangeErrors(t *testing.T) {
+	tests := []struct {
+		value string
+		code  string
+	}{
+		{"2025-13", "invalid"},
+		{"yesterday", "invalid"},
+		{"sa2025-07-01", "not-supported"},
+	}
+	for _, tt := range tests {
+		_, _, err := ParseDateRange([]string{tt.value})
+		var fhirErr *Error
+		if !errors.As(err, &fhirErr) || fhirErr.Code != tt.code {
+			t.Errorf("ParseDateRange(%q) error = %v, want code %q", tt.value, err, tt.code)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"context"
+	"slices"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type roleKey struct{}
+
+func roleFrom(ctx context.Context) domain.Role {
+	role, _ := ctx.Value(roleKey{}).(domain.Role)
+	return role
+}
+
+// hasRole resolves the field only for the listed roles. For the others the
+// field is null, with an error at its path.
+func hasRole(ctx context.Context, obj any, next graphql.Resolver, roles []Role) (any, error) {
+	if slices.Contains(roles, Role(roleFrom(ctx))) {
+		return next(ctx)
+	}
+	return nil, &gqlerror.Error{
+		Message:    "Access denied",
+		Extensions: map[string]any{"code": "FORBIDDEN"},
+	}
+}
@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/vikstrous/dataloadgen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type loadersKey struct{}
+
+// loaders batch the lookups made while resolving one request: the keys
+// asked for while a batch is open are fetched together.
+type loaders struct {
+	patientByID            *dataloadgen.Loader[primitive.ObjectID, *domain.PatientEntity]
+	doctorByID             *dataloadgen.Loader[primitive.ObjectID, *domain.DoctorEntity]
+	appointmentsByPatient  *dataloadgen.Loader[primitive.ObjectID, []*domain.AppointmentEntity]
+	recordsByPatient       *dataloadgen.Loader[primitive.ObjectID, []*domain.MedicalRecordEntity]
+	recentPatientsByDoctor *dataloadgen.Loader[primitive.ObjectID, []primitive.ObjectID]
+}
+
+func (r *Resolver) newLoaders() *loaders {
+	return &loaders{
+		patientByID:            dataloadgen.NewMappedLoader(r.patientService.GetByIDs),
+		doctorByID:             dataloadgen.NewMappedLoader(r.docService.GetByIDs),
+		appointmentsByPatient:  dataloadgen.NewMappedLoader(r.appointmentService.GetByPatientIDs),
+		recordsByPatient:       dataloadgen.NewMappedLoader(r.recordService.GetByPatientIDs),
+		recentPatientsByDoctor: dataloadgen.NewMappedLoader(r.docService.GetRecentPatientIDs),
+	}
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// load loads the value for key, with the zero value for a key that has none,
+// such as a deleted patient or a patient without records.
+func load[K comparable, V any](ctx context.Context, loader *dataloadgen.Loader[K, V], key K) (V, error) {
+	value, err := loader.Load(ctx, key)
+	if errors.Is(err, dataloadgen.ErrNotFound) {
+		var zero V
+		return zero, nil
+	}
+	return value, err
+}
+
+// loadAll loads the values for keys in order, leaving out the keys that have
+// none.
+func loadAll[K comparable, V any](ctx context.Context, loader *dataloadgen.Loader[K, V], keys []K) ([]V, error) {
+	thunks := make([]func() (V, error), len(keys))
+	for i, key := range keys {
+		thunks[i] = loader.LoadThunk(ctx, key)
+	}
+
+	values := make([]V, 0, len(keys))
+	for _, thunk := range thunks {
+		value, err := thunk()
+		if errors.Is(err, dataloadgen.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// first returns up to limit items, and an empty list rather than nil for the
+// non-null lists.
+func first[T any](items []T, limit *int) ([]T, error) {
+	if limit != nil && *limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative")
+	}
+	if limit != nil && len(items) > *limit {
+		items = items[:*limit]
+	}
+	if items == nil {
+		items = []T{}
+	}
+	return items, nil
+}
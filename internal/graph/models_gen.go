@@ -0,0 +1,76 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type Query struct {
+}
+
+type Role string
+
+const (
+	RoleAdmin        Role = "Admin"
+	RoleDoctor       Role = "Doctor"
+	RoleNurse        Role = "Nurse"
+	RoleReceptionist Role = "Receptionist"
+	RoleManagement   Role = "Management"
+	RoleLab          Role = "Lab"
+)
+
+var AllRole = []Role{
+	RoleAdmin,
+	RoleDoctor,
+	RoleNurse,
+	RoleReceptionist,
+	RoleManagement,
+	RoleLab,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleAdmin, RoleDoctor, RoleNurse, RoleReceptionist, RoleManagement, RoleLab:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
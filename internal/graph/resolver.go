@@ -0,0 +1,14 @@
+package graph
+
+import "github.com/ekastn/hms-api/internal/service"
+
+// Resolver resolves the queries with the services behind the REST routes.
+// Edges between entities go through the request's loaders, so a list of
+// appointments loads its patients and doctors in one query each.
+type Resolver struct {
+	patientService     *service.PatientService
+	docService         *service.DoctorService
+	appointmentService *service.AppointmentService
+	recordService      *service.MedicalRecordService
+	activityService    *service.ActivityService
+}
@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MarshalObjectID and UnmarshalObjectID let the ID scalar stand for the
+// entities' ObjectIDs.
+func MarshalObjectID(id primitive.ObjectID) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(id.Hex()))
+	})
+}
+
+func UnmarshalObjectID(v any) (primitive.ObjectID, error) {
+	s, ok := v.(string)
+	if !ok {
+		return primitive.NilObjectID, fmt.Errorf("ID must be a string")
+	}
+	id, err := primitive.ObjectIDFromHex(s)
+	if err != nil {
+		return primitive.NilObjectID, fmt.Errorf("invalid ID %q", s)
+	}
+	return id, nil
+}
+
+func hexOrEmpty(id *primitive.ObjectID) string {
+	if id == nil {
+		return ""
+	}
+	return id.Hex()
+}
@@ -0,0 +1,147 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Type is the resolver for the type field.
+func (r *appointmentResolver) Type(ctx context.Context, obj *domain.AppointmentEntity) (string, error) {
+	return string(obj.Type), nil
+}
+
+// Status is the resolver for the status field.
+func (r *appointmentResolver) Status(ctx context.Context, obj *domain.AppointmentEntity) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Patient is the resolver for the patient field.
+func (r *appointmentResolver) Patient(ctx context.Context, obj *domain.AppointmentEntity) (*domain.PatientEntity, error) {
+	return load(ctx, loadersFrom(ctx).patientByID, obj.PatientID)
+}
+
+// Doctor is the resolver for the doctor field.
+func (r *appointmentResolver) Doctor(ctx context.Context, obj *domain.AppointmentEntity) (*domain.DoctorEntity, error) {
+	return load(ctx, loadersFrom(ctx).doctorByID, obj.DoctorID)
+}
+
+// RecentPatients is the resolver for the recentPatients field.
+func (r *doctorResolver) RecentPatients(ctx context.Context, obj *domain.DoctorEntity) ([]*domain.PatientEntity, error) {
+	patientIDs, err := load(ctx, loadersFrom(ctx).recentPatientsByDoctor, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return loadAll(ctx, loadersFrom(ctx).patientByID, patientIDs)
+}
+
+// RecordType is the resolver for the recordType field.
+func (r *medicalRecordResolver) RecordType(ctx context.Context, obj *domain.MedicalRecordEntity) (string, error) {
+	return string(obj.RecordType), nil
+}
+
+// Patient is the resolver for the patient field.
+func (r *medicalRecordResolver) Patient(ctx context.Context, obj *domain.MedicalRecordEntity) (*domain.PatientEntity, error) {
+	return load(ctx, loadersFrom(ctx).patientByID, obj.PatientID)
+}
+
+// Doctor is the resolver for the doctor field.
+func (r *medicalRecordResolver) Doctor(ctx context.Context, obj *domain.MedicalRecordEntity) (*domain.DoctorEntity, error) {
+	return load(ctx, loadersFrom(ctx).doctorByID, obj.DoctorID)
+}
+
+// Appointments is the resolver for the appointments field.
+func (r *patientResolver) Appointments(ctx context.Context, obj *domain.PatientEntity, limit *int) ([]*domain.AppointmentEntity, error) {
+	appointments, err := load(ctx, loadersFrom(ctx).appointmentsByPatient, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return first(appointments, limit)
+}
+
+// MedicalRecords is the resolver for the medicalRecords field.
+func (r *patientResolver) MedicalRecords(ctx context.Context, obj *domain.PatientEntity, limit *int) ([]*domain.MedicalRecordEntity, error) {
+	records, err := load(ctx, loadersFrom(ctx).recordsByPatient, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return first(records, limit)
+}
+
+// Patients is the resolver for the patients field.
+func (r *queryResolver) Patients(ctx context.Context) ([]*domain.PatientEntity, error) {
+	return r.patientService.GetAll(ctx)
+}
+
+// Patient is the resolver for the patient field.
+func (r *queryResolver) Patient(ctx context.Context, id primitive.ObjectID) (*domain.PatientEntity, error) {
+	return load(ctx, loadersFrom(ctx).patientByID, id)
+}
+
+// Doctors is the resolver for the doctors field.
+func (r *queryResolver) Doctors(ctx context.Context, departmentID *primitive.ObjectID) ([]*domain.DoctorEntity, error) {
+	return r.docService.GetAll(ctx, hexOrEmpty(departmentID))
+}
+
+// Doctor is the resolver for the doctor field.
+func (r *queryResolver) Doctor(ctx context.Context, id primitive.ObjectID) (*domain.DoctorEntity, error) {
+	return load(ctx, loadersFrom(ctx).doctorByID, id)
+}
+
+// Appointments is the resolver for the appointments field.
+func (r *queryResolver) Appointments(ctx context.Context, departmentID *primitive.ObjectID) ([]*domain.AppointmentEntity, error) {
+	return r.appointmentService.GetAll(ctx, hexOrEmpty(departmentID))
+}
+
+// Appointment is the resolver for the appointment field.
+func (r *queryResolver) Appointment(ctx context.Context, id primitive.ObjectID) (*domain.AppointmentEntity, error) {
+	return r.appointmentService.GetByID(ctx, id.Hex())
+}
+
+// MedicalRecords is the resolver for the medicalRecords field.
+func (r *queryResolver) MedicalRecords(ctx context.Context, patientID *primitive.ObjectID) ([]*domain.MedicalRecordEntity, error) {
+	if patientID != nil {
+		return r.recordService.GetByPatientID(ctx, patientID.Hex())
+	}
+	return r.recordService.GetAll(ctx)
+}
+
+// MedicalRecord is the resolver for the medicalRecord field.
+func (r *queryResolver) MedicalRecord(ctx context.Context, id primitive.ObjectID) (*domain.MedicalRecordEntity, error) {
+	return r.recordService.GetByID(ctx, id.Hex())
+}
+
+// Activities is the resolver for the activities field.
+func (r *queryResolver) Activities(ctx context.Context, limit *int) ([]*domain.Activity, error) {
+	if limit == nil || *limit < 1 {
+		return nil, fmt.Errorf("limit must be positive")
+	}
+	return r.activityService.GetRecentActivities(ctx, *limit)
+}
+
+// Appointment returns AppointmentResolver implementation.
+func (r *Resolver) Appointment() AppointmentResolver { return &appointmentResolver{r} }
+
+// Doctor returns DoctorResolver implementation.
+func (r *Resolver) Doctor() DoctorResolver { return &doctorResolver{r} }
+
+// MedicalRecord returns MedicalRecordResolver implementation.
+func (r *Resolver) MedicalRecord() MedicalRecordResolver { return &medicalRecordResolver{r} }
+
+// Patient returns PatientResolver implementation.
+func (r *Resolver) Patient() PatientResolver { return &patientResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type appointmentResolver struct{ *Resolver }
+type doctorResolver struct{ *Resolver }
+type medicalRecordResolver struct{ *Resolver }
+type patientResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
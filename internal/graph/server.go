@@ -0,0 +1,98 @@
+// Package graph serves the read-only GraphQL API at /api/graphql. The schema
+// is in schema.graphqls; run `go run github.com/99designs/gqlgen generate`
+// after changing it.
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// listWeight is the number of items counted for the lists with no limit
+	// when rating a query's complexity.
+	listWeight = 25
+
+	// recentPatientWeight is the most recent patients a doctor has.
+	recentPatientWeight = 10
+)
+
+type Server struct {
+	resolver *Resolver
+	exec     *executor.Executor
+}
+
+// NewServer returns a server refusing queries rated above complexityLimit:
+// each field counts one, and a list counts its fields once per item.
+func NewServer(
+	complexityLimit int,
+	patientService *service.PatientService,
+	docService *service.DoctorService,
+	appointmentService *service.AppointmentService,
+	recordService *service.MedicalRecordService,
+	activityService *service.ActivityService,
+) *Server {
+	resolver := &Resolver{
+		patientService:     patientService,
+		docService:         docService,
+		appointmentService: appointmentService,
+		recordService:      recordService,
+		activityService:    activityService,
+	}
+
+	cfg := Config{Resolvers: resolver}
+	cfg.Directives.HasRole = hasRole
+
+	cfg.Complexity.Query.Patients = func(childComplexity int) int {
+		return 1 + listWeight*childComplexity
+	}
+	cfg.Complexity.Query.Doctors = func(childComplexity int, _ *primitive.ObjectID) int {
+		return 1 + listWeight*childComplexity
+	}
+	cfg.Complexity.Query.Appointments = func(childComplexity int, _ *primitive.ObjectID) int {
+		return 1 + listWeight*childComplexity
+	}
+	cfg.Complexity.Query.MedicalRecords = func(childComplexity int, _ *primitive.ObjectID) int {
+		return 1 + listWeight*childComplexity
+	}
+	cfg.Complexity.Query.Activities = limitedComplexity
+	cfg.Complexity.Patient.Appointments = limitedComplexity
+	cfg.Complexity.Patient.MedicalRecords = limitedComplexity
+	cfg.Complexity.Doctor.RecentPatients = func(childComplexity int) int {
+		return 1 + recentPatientWeight*childComplexity
+	}
+
+	exec := executor.New(NewExecutableSchema(cfg))
+	exec.Use(extension.Introspection{})
+	exec.Use(extension.FixedComplexityLimit(complexityLimit))
+
+	return &Server{resolver: resolver, exec: exec}
+}
+
+func limitedComplexity(childComplexity int, limit *int) int {
+	if limit == nil || *limit < 0 {
+		return 1 + listWeight*childComplexity
+	}
+	return 1 + *limit*childComplexity
+}
+
+// Execute runs the operation for a user with the given role.
+func (s *Server) Execute(ctx context.Context, role domain.Role, params *graphql.RawParams) *graphql.Response {
+	ctx = context.WithValue(ctx, roleKey{}, role)
+	ctx = context.WithValue(ctx, loadersKey{}, s.resolver.newLoaders())
+	ctx = graphql.StartOperationTrace(ctx)
+
+	opCtx, errs := s.exec.CreateOperationContext(ctx, params)
+	if errs != nil {
+		return s.exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), errs)
+	}
+
+	responses, ctx := s.exec.DispatchOperation(ctx, opCtx)
+	return responses(ctx)
+}
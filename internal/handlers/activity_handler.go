@@ -10,24 +10,35 @@ import (
 
 type ActivityHandler struct {
 	activityService *service.ActivityService
+	exportService   *service.ExportService
 }
 
-func NewActivityHandler(activityService *service.ActivityService) *ActivityHandler {
-	return &ActivityHandler{activityService: activityService}
+func NewActivityHandler(activityService *service.ActivityService, exportService *service.ExportService) *ActivityHandler {
+	return &ActivityHandler{activityService: activityService, exportService: exportService}
 }
 
 // HandleGetAllActivities handles the request to get all activities.
 //
 //	@Summary		Get all activities
-//	@Description	Get a list of all recorded activities. Admin access required.
+//	@Description	Get a list of all recorded activities, or download it as CSV or Excel. Admin access required.
 //	@Tags			Activities
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.Activity}	"Activities retrieved successfully"
-//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve activities"
+//	@Param			format	query		string											false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.Activity}	"Activities retrieved successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid export format"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to retrieve activities"
 //	@Router			/activities [get]
 func (h *ActivityHandler) HandleGetAllActivities(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		return sendExport(c, h.exportService, h.exportService.ActivityExport(), format)
+	}
+
 	activities, err := h.activityService.GetAllActivities(c.Context())
 	if err != nil {
 		log.Printf("Error getting activities: %v", err)
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AdmissionHandler struct {
+	admissionService *service.AdmissionService
+}
+
+func NewAdmissionHandler(admissionService *service.AdmissionService) *AdmissionHandler {
+	return &AdmissionHandler{
+		admissionService: admissionService,
+	}
+}
+
+// GetAll handles the request to get admissions.
+//
+//	@Summary		Get all admissions
+//	@Description	Retrieve inpatient admissions, optionally filtered by status.
+//	@Tags			Admissions
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			status	query		string												false	"Admission status (Admitted or Discharged)"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.AdmissionDTO}	"List of admissions"
+//	@Failure		500		{object}	utils.ErrorResponse									"Failed to retrieve admissions"
+//	@Router			/admissions [get]
+func (h *AdmissionHandler) GetAll(c *fiber.Ctx) error {
+	status := domain.AdmissionStatus(c.Query("status"))
+
+	admissions, err := h.admissionService.GetAll(c.Context(), status)
+	if err != nil {
+		log.Printf("Error getting admissions: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve admissions", err.Error())
+	}
+
+	var admissionDTOs []domain.AdmissionDTO
+	for _, admission := range admissions {
+		admissionDTOs = append(admissionDTOs, admission.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of admissions", admissionDTOs)
+}
+
+// GetByID handles the request to get an admission by ID.
+//
+//	@Summary		Get admission by ID
+//	@Description	Retrieve a single admission, including transfers and the discharge summary when discharged.
+//	@Tags			Admissions
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Admission ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.AdmissionDTO}	"Admission retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse								"Admission not found"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve admission"
+//	@Router			/admissions/{id} [get]
+func (h *AdmissionHandler) GetByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	admission, err := h.admissionService.GetByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting admission %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve admission", err.Error())
+	}
+
+	if admission == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Admission not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Admission retrieved successfully", admission.ToDTO())
+}
+
+// Admit handles the request to admit a patient.
+//
+//	@Summary		Admit a patient
+//	@Description	Admit a patient into an available bed.
+//	@Tags			Admissions
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			admission	body		domain.AdmitPatientRequest						true	"Admission details"
+//	@Success		201			{object}	utils.SuccessResponse{data=object{id=string}}	"Patient admitted successfully"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to admit patient"
+//	@Router			/admissions [post]
+func (h *AdmissionHandler) Admit(c *fiber.Ctx) error {
+	var req domain.AdmitPatientRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.admissionService.Admit(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error admitting patient: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Patient admitted successfully", fiber.Map{"id": id})
+}
+
+// Transfer handles the request to transfer an admitted patient to another bed.
+//
+//	@Summary		Transfer a patient
+//	@Description	Move an admitted patient to another available bed.
+//	@Tags			Admissions
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string							true	"Admission ID"
+//	@Param			transfer	body		domain.TransferPatientRequest	true	"Transfer details"
+//	@Success		204			{object}	utils.SuccessResponse			"Patient transferred successfully"
+//	@Failure		400			{object}	utils.ErrorResponse				"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse				"Failed to transfer patient"
+//	@Router			/admissions/{id}/transfer [put]
+func (h *AdmissionHandler) Transfer(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.TransferPatientRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.admissionService.Transfer(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error transferring patient: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Patient transferred successfully", nil)
+}
+
+// Discharge handles the request to discharge a patient.
+//
+//	@Summary		Discharge a patient
+//	@Description	Discharge an admitted patient, free the bed and generate the discharge summary from the records written during the stay.
+//	@Tags			Admissions
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string												true	"Admission ID"
+//	@Param			discharge	body		domain.DischargePatientRequest						true	"Discharge details"
+//	@Success		200			{object}	utils.SuccessResponse{data=domain.DischargeSummary}	"Patient discharged successfully"
+//	@Failure		400			{object}	utils.ErrorResponse									"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse									"Failed to discharge patient"
+//	@Router			/admissions/{id}/discharge [put]
+func (h *AdmissionHandler) Discharge(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.DischargePatientRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	summary, err := h.admissionService.Discharge(c.Context(), id, &req, updaterID)
+	if err != nil {
+		log.Printf("Error discharging patient: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Patient discharged successfully", summary)
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/export"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AnalyticsHandler struct {
+	analyticsService *service.AnalyticsService
+	exportService    *service.ExportService
+}
+
+func NewAnalyticsHandler(analyticsService *service.AnalyticsService, exportService *service.ExportService) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+		exportService:    exportService,
+	}
+}
+
+// analyticsQuery reads the query parameters shared by the analytics endpoints.
+func analyticsQuery(c *fiber.Ctx) service.AnalyticsQuery {
+	return service.AnalyticsQuery{
+		From:         c.Query("from"),
+		To:           c.Query("to"),
+		Timezone:     c.Query("timezone"),
+		Interval:     domain.AnalyticsInterval(c.Query("interval")),
+		DepartmentID: c.Query("departmentId"),
+	}
+}
+
+// GetAppointmentTrend handles the request to get appointments over time.
+//
+//	@Summary		Get appointment trend
+//	@Description	Count appointments per day, week or month, optionally broken down by status, type, doctor or specialty. The range defaults to the last 30 days and the time zone to the hospital's.
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		ApiKeyAuth
+//	@Param			format			query		string														false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Param			from			query		string														false	"First date (YYYY-MM-DD)"
+//	@Param			to				query		string														false	"Last date (YYYY-MM-DD)"
+//	@Param			timezone		query		string														false	"IANA time zone, e.g. Asia/Jakarta"
+//	@Param			interval		query		string														false	"Bucket size (day, week, month)"
+//	@Param			groupBy			query		string														false	"Breakdown (status, type, doctor, specialty)"
+//	@Param			departmentId	query		string														false	"Department ID"
+//	@Success		200				{object}	utils.SuccessResponse{data=domain.AppointmentTrendResponse}	"Appointment trend"
+//	@Failure		400				{object}	utils.ErrorResponse											"Invalid parameters"
+//	@Router			/analytics/appointments [get]
+func (h *AnalyticsHandler) GetAppointmentTrend(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		e, err := h.analyticsService.ExportAppointmentTrend(c.Context(), analyticsQuery(c), domain.AppointmentGroupBy(c.Query("groupBy")))
+		if err != nil {
+			log.Printf("Error exporting appointment trend: %v", err)
+			return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to export appointment trend", err.Error())
+		}
+		return sendExport(c, h.exportService, e, format)
+	}
+
+	groupBy := domain.AppointmentGroupBy(c.Query("groupBy"))
+
+	trend, err := h.analyticsService.GetAppointmentTrend(c.Context(), analyticsQuery(c), groupBy)
+	if err != nil {
+		log.Printf("Error getting appointment trend: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve appointment trend", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Appointment trend", trend)
+}
+
+// GetAppointmentRates handles the request to get no-show and cancellation rates.
+//
+//	@Summary		Get appointment no-show and cancellation rates
+//	@Description	Count completed, cancelled and no-show appointments per day, week or month and over the whole range. A no-show is a past appointment that was never completed or cancelled.
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		ApiKeyAuth
+//	@Param			format			query		string														false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Param			from			query		string														false	"First date (YYYY-MM-DD)"
+//	@Param			to				query		string														false	"Last date (YYYY-MM-DD)"
+//	@Param			timezone		query		string														false	"IANA time zone, e.g. Asia/Jakarta"
+//	@Param			interval		query		string														false	"Bucket size (day, week, month)"
+//	@Param			departmentId	query		string														false	"Department ID"
+//	@Success		200				{object}	utils.SuccessResponse{data=domain.AppointmentRatesResponse}	"Appointment rates"
+//	@Failure		400				{object}	utils.ErrorResponse											"Invalid parameters"
+//	@Router			/analytics/appointments/rates [get]
+func (h *AnalyticsHandler) GetAppointmentRates(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		e, err := h.analyticsService.ExportAppointmentRates(c.Context(), analyticsQuery(c))
+		if err != nil {
+			log.Printf("Error exporting appointment rates: %v", err)
+			return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to export appointment rates", err.Error())
+		}
+		return sendExport(c, h.exportService, e, format)
+	}
+
+	rates, err := h.analyticsService.GetAppointmentRates(c.Context(), analyticsQuery(c))
+	if err != nil {
+		log.Printf("Error getting appointment rates: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve appointment rates", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Appointment rates", rates)
+}
+
+// GetPatientMix handles the request to get new and returning patients.
+//
+//	@Summary		Get new vs returning patients
+//	@Description	Count the patients seen per day, week or month, split into those on their first visit and those who had been before. Cancelled appointments are not visits.
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		ApiKeyAuth
+//	@Param			format			query		string													false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Param			from			query		string													false	"First date (YYYY-MM-DD)"
+//	@Param			to				query		string													false	"Last date (YYYY-MM-DD)"
+//	@Param			timezone		query		string													false	"IANA time zone, e.g. Asia/Jakarta"
+//	@Param			interval		query		string													false	"Bucket size (day, week, month)"
+//	@Param			departmentId	query		string													false	"Department ID"
+//	@Success		200				{object}	utils.SuccessResponse{data=domain.PatientMixResponse}	"New and returning patients"
+//	@Failure		400				{object}	utils.ErrorResponse										"Invalid parameters"
+//	@Router			/analytics/patients [get]
+func (h *AnalyticsHandler) GetPatientMix(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		e, err := h.analyticsService.ExportPatientMix(c.Context(), analyticsQuery(c))
+		if err != nil {
+			log.Printf("Error exporting patient mix: %v", err)
+			return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to export new and returning patients", err.Error())
+		}
+		return sendExport(c, h.exportService, e, format)
+	}
+
+	mix, err := h.analyticsService.GetPatientMix(c.Context(), analyticsQuery(c))
+	if err != nil {
+		log.Printf("Error getting patient mix: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve new and returning patients", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "New and returning patients", mix)
+}
+
+// GetTopDiagnoses handles the request to get the most recorded diagnoses.
+//
+//	@Summary		Get top diagnoses
+//	@Description	Rank the diagnoses recorded in medical records in the range, with how many distinct patients had each.
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		ApiKeyAuth
+//	@Param			format		query		string													false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Param			from		query		string													false	"First date (YYYY-MM-DD)"
+//	@Param			to			query		string													false	"Last date (YYYY-MM-DD)"
+//	@Param			timezone	query		string													false	"IANA time zone, e.g. Asia/Jakarta"
+//	@Param			limit		query		int														false	"Number of diagnoses, up to 100 (default 10)"
+//	@Success		200			{object}	utils.SuccessResponse{data=domain.TopDiagnosesResponse}	"Top diagnoses"
+//	@Failure		400			{object}	utils.ErrorResponse										"Invalid parameters"
+//	@Router			/analytics/diagnoses [get]
+func (h *AnalyticsHandler) GetTopDiagnoses(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		e, err := h.analyticsService.ExportTopDiagnoses(c.Context(), analyticsQuery(c), c.QueryInt("limit"))
+		if err != nil {
+			log.Printf("Error exporting top diagnoses: %v", err)
+			return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to export top diagnoses", err.Error())
+		}
+		return sendExport(c, h.exportService, e, format)
+	}
+
+	diagnoses, err := h.analyticsService.GetTopDiagnoses(c.Context(), analyticsQuery(c), c.QueryInt("limit"))
+	if err != nil {
+		log.Printf("Error getting top diagnoses: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve top diagnoses", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Top diagnoses", diagnoses)
+}
+
+// GetDoctorUtilization handles the request to get the doctor utilization report.
+//
+//	@Summary		Get doctor utilization
+//	@Description	Rank the active doctors by booked over available minutes, where available minutes come from each doctor's weekly availability. Includes completed, cancelled and no-show appointments, average lead time from booking to visit and medical records written.
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		ApiKeyAuth
+//	@Param			format			query		string														false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Param			from			query		string														false	"First date (YYYY-MM-DD)"
+//	@Param			to				query		string														false	"Last date (YYYY-MM-DD)"
+//	@Param			timezone		query		string														false	"IANA time zone, e.g. Asia/Jakarta"
+//	@Param			departmentId	query		string														false	"Department ID"
+//	@Success		200				{object}	utils.SuccessResponse{data=domain.DoctorUtilizationReport}	"Doctor utilization"
+//	@Failure		400				{object}	utils.ErrorResponse											"Invalid parameters"
+//	@Router			/analytics/doctors [get]
+func (h *AnalyticsHandler) GetDoctorUtilization(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		e, err := h.analyticsService.ExportDoctorUtilization(c.Context(), analyticsQuery(c))
+		if err != nil {
+			log.Printf("Error exporting doctor utilization: %v", err)
+			return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to export doctor utilization", err.Error())
+		}
+		return sendExport(c, h.exportService, e, format)
+	}
+
+	report, err := h.analyticsService.GetDoctorUtilization(c.Context(), analyticsQuery(c))
+	if err != nil {
+		log.Printf("Error getting doctor utilization: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve doctor utilization", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Doctor utilization", report)
+}
+
+// ExportDoctorUtilization handles the request to download the doctor utilization report.
+//
+//	@Summary		Export doctor utilization
+//	@Description	Download the doctor utilization report as CSV or Excel, one row per doctor in rank order.
+//	@Tags			Analytics
+//	@Produce		text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		ApiKeyAuth
+//	@Param			format			query		string				false	"File format, csv (default) or xlsx"
+//	@Param			from			query		string				false	"First date (YYYY-MM-DD)"
+//	@Param			to				query		string				false	"Last date (YYYY-MM-DD)"
+//	@Param			timezone		query		string				false	"IANA time zone, e.g. Asia/Jakarta"
+//	@Param			departmentId	query		string				false	"Department ID"
+//	@Success		200				{file}		file				"Doctor utilization report"
+//	@Failure		400				{object}	utils.ErrorResponse	"Invalid parameters"
+//	@Router			/analytics/doctors/export [get]
+func (h *AnalyticsHandler) ExportDoctorUtilization(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if !asFile {
+		format = export.FormatCSV
+	}
+
+	e, err := h.analyticsService.ExportDoctorUtilization(c.Context(), analyticsQuery(c))
+	if err != nil {
+		log.Printf("Error exporting doctor utilization: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to export doctor utilization", err.Error())
+	}
+
+	return sendExport(c, h.exportService, e, format)
+}
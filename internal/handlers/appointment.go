@@ -12,27 +12,44 @@ import (
 
 type AppointmentHandler struct {
 	appointmentService *service.AppointmentService
+	exportService      *service.ExportService
 }
 
-func NewAppointmentHandler(appointmentService *service.AppointmentService) *AppointmentHandler {
+func NewAppointmentHandler(appointmentService *service.AppointmentService, exportService *service.ExportService) *AppointmentHandler {
 	return &AppointmentHandler{
 		appointmentService: appointmentService,
+		exportService:      exportService,
 	}
 }
 
 // GetAll handles the request to get all appointments.
 //
 //	@Summary		Get all appointments
-//	@Description	Retrieve a list of all appointments.
+//	@Description	Retrieve a list of all appointments, optionally only those of a department, or download it as CSV or Excel.
 //	@Tags			Appointments
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.AppointmentDTO}	"List of appointments"
-//	@Failure		500	{object}	utils.ErrorResponse									"Failed to retrieve appointments"
+//	@Param			format			query		string												false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Param			departmentId	query		string												false	"Department ID"
+//	@Success		200				{object}	utils.SuccessResponse{data=[]domain.AppointmentDTO}	"List of appointments"
+//	@Failure		400				{object}	utils.ErrorResponse									"Invalid export format"
+//	@Failure		500				{object}	utils.ErrorResponse									"Failed to retrieve appointments"
 //	@Router			/appointments [get]
 func (h *AppointmentHandler) GetAll(c *fiber.Ctx) error {
-	appointments, err := h.appointmentService.GetAll(c.Context())
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		e, err := h.exportService.AppointmentExport(c.Query("departmentId"))
+		if err != nil {
+			return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to export appointments", err.Error())
+		}
+		return sendExport(c, h.exportService, e, format)
+	}
+
+	appointments, err := h.appointmentService.GetAll(c.Context(), c.Query("departmentId"))
 	if err != nil {
 		log.Printf("Error getting appointments: %v", err)
 		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve appointments", err.Error())
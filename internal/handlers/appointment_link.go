@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// AppointmentLinkHandler serves the public, unauthenticated endpoints behind
+// the confirmation and cancellation links in appointment reminders.
+type AppointmentLinkHandler struct {
+	linkService *service.AppointmentLinkService
+}
+
+func NewAppointmentLinkHandler(linkService *service.AppointmentLinkService) *AppointmentLinkHandler {
+	return &AppointmentLinkHandler{
+		linkService: linkService,
+	}
+}
+
+// Inspect handles the request to look up a confirmation or cancellation link.
+//
+//	@Summary		Look up an appointment link
+//	@Description	Show the appointment and action behind a confirmation or cancellation link without using it. Link previews and scanners may fetch links, so this endpoint never changes anything.
+//	@Tags			Public
+//	@Accept			json
+//	@Produce		json
+//	@Param			token	query		string													true	"Link token"
+//	@Success		200		{object}	utils.SuccessResponse{data=domain.AppointmentLinkInfo}	"Appointment link is valid"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid, expired or no longer applicable link"
+//	@Router			/public/appointments/respond [get]
+func (h *AppointmentLinkHandler) Inspect(c *fiber.Ctx) error {
+	info, err := h.linkService.Inspect(c.Context(), c.Query("token"))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Appointment link is valid", info)
+}
+
+// Apply handles the request to use a confirmation or cancellation link.
+//
+//	@Summary		Confirm or cancel an appointment
+//	@Description	Use a link token from an appointment reminder to move the appointment to Confirmed or Cancelled. Each token works once; cancellation is only possible up to the configured cut-off before the appointment.
+//	@Tags			Public
+//	@Accept			json
+//	@Produce		json
+//	@Param			link	body		domain.AppointmentLinkRequest							true	"Link token"
+//	@Success		200		{object}	utils.SuccessResponse{data=domain.AppointmentLinkInfo}	"Appointment updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid, expired, used or no longer applicable link"
+//	@Router			/public/appointments/respond [post]
+func (h *AppointmentLinkHandler) Apply(c *fiber.Ctx) error {
+	var req domain.AppointmentLinkRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	info, err := h.linkService.Apply(c.Context(), req.Token)
+	if err != nil {
+		log.Printf("Error applying appointment link: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Appointment updated successfully", info)
+}
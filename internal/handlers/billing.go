@@ -0,0 +1,451 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type BillingHandler struct {
+	billingService *service.BillingService
+}
+
+func NewBillingHandler(billingService *service.BillingService) *BillingHandler {
+	return &BillingHandler{
+		billingService: billingService,
+	}
+}
+
+// GetAllTariffs handles the request to get the tariff catalogue.
+//
+//	@Summary		Get all tariffs
+//	@Description	Retrieve the service tariff catalogue. Prices are in minor currency units.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.TariffDTO}	"List of tariffs"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve tariffs"
+//	@Router			/tariffs [get]
+func (h *BillingHandler) GetAllTariffs(c *fiber.Ctx) error {
+	tariffs, err := h.billingService.GetAllTariffs(c.Context())
+	if err != nil {
+		log.Printf("Error getting tariffs: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve tariffs", err.Error())
+	}
+
+	var tariffDTOs []domain.TariffDTO
+	for _, tariff := range tariffs {
+		tariffDTOs = append(tariffDTOs, tariff.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of tariffs", tariffDTOs)
+}
+
+// CreateTariff handles the request to create a tariff.
+//
+//	@Summary		Create a new tariff
+//	@Description	Add a service to the tariff catalogue. A tariff linked to an appointment type is billed automatically when such an appointment is completed.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			tariff	body		domain.TariffRequest							true	"Tariff object to be created"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Tariff created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create tariff"
+//	@Router			/tariffs [post]
+func (h *BillingHandler) CreateTariff(c *fiber.Ctx) error {
+	var req domain.TariffRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.billingService.CreateTariff(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating tariff: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Tariff created successfully", fiber.Map{"id": id})
+}
+
+// UpdateTariff handles the request to update a tariff.
+//
+//	@Summary		Update an existing tariff
+//	@Description	Update a tariff. Existing invoices keep the price they were issued with.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string					true	"Tariff ID"
+//	@Param			tariff	body		domain.TariffRequest	true	"Tariff object with updated fields"
+//	@Success		204		{object}	utils.SuccessResponse	"Tariff updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse		"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse		"Failed to update tariff"
+//	@Router			/tariffs/{id} [put]
+func (h *BillingHandler) UpdateTariff(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.TariffRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.billingService.UpdateTariff(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating tariff: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Tariff updated successfully", nil)
+}
+
+// GetAllInvoices handles the request to get invoices.
+//
+//	@Summary		Get all invoices
+//	@Description	Retrieve invoices, optionally filtered by patient and status.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			patientId	query		string											false	"Patient ID"
+//	@Param			status		query		string											false	"Invoice status (Unpaid, PartiallyPaid, Paid, Refunded, Void)"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.InvoiceDTO}	"List of invoices"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid invoice status"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to retrieve invoices"
+//	@Router			/invoices [get]
+func (h *BillingHandler) GetAllInvoices(c *fiber.Ctx) error {
+	status := domain.InvoiceStatus(c.Query("status"))
+	if status != "" && !status.IsValid() {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid invoice status", nil)
+	}
+
+	invoices, err := h.billingService.GetAllInvoices(c.Context(), c.Query("patientId"), status)
+	if err != nil {
+		log.Printf("Error getting invoices: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve invoices", err.Error())
+	}
+
+	var invoiceDTOs []domain.InvoiceDTO
+	for _, invoice := range invoices {
+		invoiceDTOs = append(invoiceDTOs, invoice.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of invoices", invoiceDTOs)
+}
+
+// GetInvoiceByID handles the request to get an invoice by ID.
+//
+//	@Summary		Get invoice by ID
+//	@Description	Retrieve a single invoice with its line items and balance.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string										true	"Invoice ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.InvoiceDTO}	"Invoice retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse							"Invoice not found"
+//	@Failure		500	{object}	utils.ErrorResponse							"Failed to retrieve invoice"
+//	@Router			/invoices/{id} [get]
+func (h *BillingHandler) GetInvoiceByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	invoice, err := h.billingService.GetInvoiceByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting invoice %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve invoice", err.Error())
+	}
+
+	if invoice == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Invoice not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Invoice retrieved successfully", invoice.ToDTO())
+}
+
+// GetInvoicePayments handles the request to get the payments of an invoice.
+//
+//	@Summary		Get invoice payments
+//	@Description	Retrieve the payments and refunds recorded against an invoice.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Invoice ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.PaymentDTO}	"List of payments"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve payments"
+//	@Router			/invoices/{id}/payments [get]
+func (h *BillingHandler) GetInvoicePayments(c *fiber.Ctx) error {
+	return h.listPayments(c, c.Params("id"))
+}
+
+// CreateInvoice handles the request to create an invoice.
+//
+//	@Summary		Create a new invoice
+//	@Description	Raise an invoice manually from tariff items or free-text charges.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			invoice	body		domain.CreateInvoiceRequest						true	"Invoice object to be created"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Invoice created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create invoice"
+//	@Router			/invoices [post]
+func (h *BillingHandler) CreateInvoice(c *fiber.Ctx) error {
+	var req domain.CreateInvoiceRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.billingService.CreateInvoice(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating invoice: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Invoice created successfully", fiber.Map{"id": id})
+}
+
+// VoidInvoice handles the request to void an invoice.
+//
+//	@Summary		Void an invoice
+//	@Description	Void an invoice that has no outstanding payments.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string						true	"Invoice ID"
+//	@Param			void	body		domain.VoidInvoiceRequest	true	"Reason for voiding"
+//	@Success		204		{object}	utils.SuccessResponse		"Invoice voided successfully"
+//	@Failure		400		{object}	utils.ErrorResponse			"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse			"Failed to void invoice"
+//	@Router			/invoices/{id}/void [put]
+func (h *BillingHandler) VoidInvoice(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.VoidInvoiceRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.billingService.VoidInvoice(c.Context(), id, req.Reason, updaterID); err != nil {
+		log.Printf("Error voiding invoice: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Invoice voided successfully", nil)
+}
+
+// GetAllPayments handles the request to get payments.
+//
+//	@Summary		Get all payments
+//	@Description	Retrieve payments and refunds, optionally filtered by invoice.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			invoiceId	query		string											false	"Invoice ID"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.PaymentDTO}	"List of payments"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to retrieve payments"
+//	@Router			/payments [get]
+func (h *BillingHandler) GetAllPayments(c *fiber.Ctx) error {
+	return h.listPayments(c, c.Query("invoiceId"))
+}
+
+func (h *BillingHandler) listPayments(c *fiber.Ctx, invoiceID string) error {
+	payments, err := h.billingService.GetAllPayments(c.Context(), invoiceID)
+	if err != nil {
+		log.Printf("Error getting payments: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve payments", err.Error())
+	}
+
+	var paymentDTOs []domain.PaymentDTO
+	for _, payment := range payments {
+		paymentDTOs = append(paymentDTOs, payment.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of payments", paymentDTOs)
+}
+
+// GetPaymentByID handles the request to get a payment by ID.
+//
+//	@Summary		Get payment by ID
+//	@Description	Retrieve a single payment or refund.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string										true	"Payment ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.PaymentDTO}	"Payment retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse							"Payment not found"
+//	@Failure		500	{object}	utils.ErrorResponse							"Failed to retrieve payment"
+//	@Router			/payments/{id} [get]
+func (h *BillingHandler) GetPaymentByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	payment, err := h.billingService.GetPaymentByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting payment %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve payment", err.Error())
+	}
+
+	if payment == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Payment not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Payment retrieved successfully", payment.ToDTO())
+}
+
+// RecordPayment handles the request to record a payment.
+//
+//	@Summary		Record a payment
+//	@Description	Record a full or partial payment against an invoice. Amounts are in minor currency units and may not exceed the outstanding balance.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			payment	body		domain.CreatePaymentRequest						true	"Payment details"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Payment recorded successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to record payment"
+//	@Router			/payments [post]
+func (h *BillingHandler) RecordPayment(c *fiber.Ctx) error {
+	var req domain.CreatePaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.billingService.RecordPayment(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error recording payment: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Payment recorded successfully", fiber.Map{"id": id})
+}
+
+// RefundPayment handles the request to refund a payment.
+//
+//	@Summary		Refund a payment
+//	@Description	Refund part or all of a payment. The refund is recorded as a separate payment entry.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string											true	"Payment ID"
+//	@Param			refund	body		domain.RefundPaymentRequest						true	"Refund details"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Payment refunded successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to refund payment"
+//	@Router			/payments/{id}/refund [post]
+func (h *BillingHandler) RefundPayment(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.RefundPaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	refundID, err := h.billingService.RefundPayment(c.Context(), id, &req, creatorID)
+	if err != nil {
+		log.Printf("Error refunding payment: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Payment refunded successfully", fiber.Map{"id": refundID})
+}
+
+// GetPatientBalance handles the request to get the outstanding balance of a patient.
+//
+//	@Summary		Get patient balance
+//	@Description	Retrieve the billed, paid, refunded and outstanding totals of a patient across all non-void invoices.
+//	@Tags			Billing
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Patient ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.PatientBalance}	"Patient balance retrieved successfully"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve patient balance"
+//	@Router			/patients/{id}/balance [get]
+func (h *BillingHandler) GetPatientBalance(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	balance, err := h.billingService.GetPatientBalance(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting balance for patient %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve patient balance", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Patient balance retrieved successfully", balance)
+}
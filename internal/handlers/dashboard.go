@@ -19,16 +19,17 @@ func NewDashboardHandler(dashboardService *service.DashboardService) *DashboardH
 // GetDashboardData handles the request to get dashboard data.
 //
 //	@Summary		Get dashboard data
-//	@Description	Retrieve various statistics and recent activities for the dashboard. Admin or Management access required.
+//	@Description	Retrieve various statistics and recent activities for the dashboard. Admin or Management access required. With a department, the patient, doctor and appointment figures only cover that department. The top five doctors by utilization over the last 30 days are included.
 //	@Tags			Dashboard
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	domain.DashboardResponse	"Dashboard data retrieved successfully"
-//	@Failure		500	{object}	utils.ErrorResponse			"Failed to get dashboard data"
+//	@Param			departmentId	query		string						false	"Department ID"
+//	@Success		200				{object}	domain.DashboardResponse	"Dashboard data retrieved successfully"
+//	@Failure		500				{object}	utils.ErrorResponse			"Failed to get dashboard data"
 //	@Router			/dashboard [get]
 func (h *DashboardHandler) GetDashboardData(c *fiber.Ctx) error {
-	data, err := h.dashboardService.GetDashboardData(c.Context())
+	data, err := h.dashboardService.GetDashboardData(c.Context(), c.Query("departmentId"))
 	if err != nil {
 		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to get dashboard data", nil)
 	}
@@ -24,16 +24,17 @@ func NewDoctorHandler(docService *service.DoctorService) *DoctorHandler {
 // GetAll handles the request to get all doctors.
 //
 //	@Summary		Get all doctors
-//	@Description	Retrieve a list of all registered doctors.
+//	@Description	Retrieve a list of all registered doctors, optionally only those of a department.
 //	@Tags			Doctors
 //	@Accept			json
 //	@Produce		json
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.DoctorDTO}	"List of doctors"
-//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve doctors"
+//	@Param			departmentId	query		string											false	"Department ID"
+//	@Success		200				{object}	utils.SuccessResponse{data=[]domain.DoctorDTO}	"List of doctors"
+//	@Failure		500				{object}	utils.ErrorResponse								"Failed to retrieve doctors"
 //	@Router			/doctors [get]
 func (h *DoctorHandler) GetAll(c *fiber.Ctx) error {
-	doctors, err := h.docService.GetAll(c.Context())
+	doctors, err := h.docService.GetAll(c.Context(), c.Query("departmentId"))
 	if err != nil {
 		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
 	}
@@ -142,6 +143,14 @@ func (h *DoctorHandler) Create(c *fiber.Ctx) error {
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
+	if req.DepartmentID != "" {
+		departmentID, _ := primitive.ObjectIDFromHex(req.DepartmentID) // validated above
+		docEntity.DepartmentID = &departmentID
+	}
+	if req.UserID != "" {
+		userID, _ := primitive.ObjectIDFromHex(req.UserID) // validated above
+		docEntity.UserID = &userID
+	}
 
 	id, err := h.docService.Create(c.Context(), &docEntity, creatorID)
 	if err != nil {
@@ -201,6 +210,14 @@ func (h *DoctorHandler) Update(c *fiber.Ctx) error {
 		CreatedAt:    existingDoc.CreatedAt,    // Preserve created at
 		UpdatedAt:    time.Now(),               // Update updated at
 	}
+	if req.DepartmentID != "" {
+		departmentID, _ := primitive.ObjectIDFromHex(req.DepartmentID) // validated above
+		docEntity.DepartmentID = &departmentID
+	}
+	if req.UserID != "" {
+		userID, _ := primitive.ObjectIDFromHex(req.UserID) // validated above
+		docEntity.UserID = &userID
+	}
 
 	err = h.docService.Update(c.Context(), id, &docEntity, updaterID)
 	if err != nil {
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DocumentHandler struct {
+	documentService *service.DocumentService
+}
+
+func NewDocumentHandler(documentService *service.DocumentService) *DocumentHandler {
+	return &DocumentHandler{
+		documentService: documentService,
+	}
+}
+
+// GetVisitSummary handles the request to print the visit summary of an appointment.
+//
+//	@Summary		Print visit summary
+//	@Description	Render the summary of an appointment, with triage and the patient's latest medical record, as a PDF for the patient. The QR code on each page links to the public verification endpoint.
+//	@Tags			Documents
+//	@Produce		application/pdf
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string				true	"Appointment ID"
+//	@Success		200	{file}		file				"Visit summary PDF"
+//	@Failure		404	{object}	utils.ErrorResponse	"Appointment not found"
+//	@Failure		500	{object}	utils.ErrorResponse	"Failed to print visit summary"
+//	@Router			/appointments/{id}/pdf [get]
+func (h *DocumentHandler) GetVisitSummary(c *fiber.Ctx) error {
+	return h.send(c, domain.DocumentVisitSummary, "Appointment not found")
+}
+
+// GetPatientHistory handles the request to print the history of a patient.
+//
+//	@Summary		Print patient history
+//	@Description	Render the patient's details, medical records, recent appointments and lab results as a PDF.
+//	@Tags			Documents
+//	@Produce		application/pdf
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string				true	"Patient ID"
+//	@Success		200	{file}		file				"Patient history PDF"
+//	@Failure		404	{object}	utils.ErrorResponse	"Patient not found"
+//	@Failure		500	{object}	utils.ErrorResponse	"Failed to print patient history"
+//	@Router			/patients/{id}/pdf [get]
+func (h *DocumentHandler) GetPatientHistory(c *fiber.Ctx) error {
+	return h.send(c, domain.DocumentPatientHistory, "Patient not found")
+}
+
+// GetMedicalRecord handles the request to print a medical record.
+//
+//	@Summary		Print medical record
+//	@Description	Render a medical record, with the medication dispensed against it, as a PDF.
+//	@Tags			Documents
+//	@Produce		application/pdf
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string				true	"Medical Record ID"
+//	@Success		200	{file}		file				"Medical record PDF"
+//	@Failure		404	{object}	utils.ErrorResponse	"Medical record not found"
+//	@Failure		500	{object}	utils.ErrorResponse	"Failed to print medical record"
+//	@Router			/records/{id}/pdf [get]
+func (h *DocumentHandler) GetMedicalRecord(c *fiber.Ctx) error {
+	return h.send(c, domain.DocumentMedicalRecord, "Medical record not found")
+}
+
+// GetInvoice handles the request to print an invoice.
+//
+//	@Summary		Print invoice
+//	@Description	Render an invoice with its line items, totals and balance as a PDF.
+//	@Tags			Documents
+//	@Produce		application/pdf
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string				true	"Invoice ID"
+//	@Success		200	{file}		file				"Invoice PDF"
+//	@Failure		404	{object}	utils.ErrorResponse	"Invoice not found"
+//	@Failure		500	{object}	utils.ErrorResponse	"Failed to print invoice"
+//	@Router			/invoices/{id}/pdf [get]
+func (h *DocumentHandler) GetInvoice(c *fiber.Ctx) error {
+	return h.send(c, domain.DocumentInvoice, "Invoice not found")
+}
+
+// Verify handles the request to check the QR code of a printed document.
+//
+//	@Summary		Verify a printed document
+//	@Description	Check the token from the QR code of a printed document. Tells whether the hospital issued it and whether the record has changed since. The patient's name is masked.
+//	@Tags			Public
+//	@Accept			json
+//	@Produce		json
+//	@Param			token	query		string													true	"Document token"
+//	@Success		200		{object}	utils.SuccessResponse{data=domain.DocumentVerification}	"Document is genuine"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid document code"
+//	@Router			/public/documents/verify [get]
+func (h *DocumentHandler) Verify(c *fiber.Ctx) error {
+	verification, err := h.documentService.Verify(c.Context(), c.Query("token"))
+	if err != nil {
+		log.Printf("Error verifying document: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Document is genuine", verification)
+}
+
+func (h *DocumentHandler) send(c *fiber.Ctx, kind domain.DocumentKind, notFound string) error {
+	userID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	doc, err := h.documentService.Render(c.Context(), kind, c.Params("id"), userID)
+	if err != nil {
+		log.Printf("Error printing %s: %v", kind, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to print document", err.Error())
+	}
+	if doc == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, notFound, nil)
+	}
+
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s"`, doc.FileName))
+	return c.Send(doc.Content)
+}
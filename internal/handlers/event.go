@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/events"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// heartbeatInterval keeps idle streams open through proxies and lets the
+// server notice clients that went away.
+const heartbeatInterval = 15 * time.Second
+
+type EventHandler struct {
+	eventBus *events.Bus
+}
+
+func NewEventHandler(eventBus *events.Bus) *EventHandler {
+	return &EventHandler{
+		eventBus: eventBus,
+	}
+}
+
+// Stream handles the Server-Sent Events stream of domain events.
+//
+//	@Summary		Stream domain events
+//	@Description	Open a Server-Sent Events stream of domain events (activity entries and entity changes). Events are filtered to the topics the caller's role may see, optionally narrowed with the topics parameter. Clients that cannot set headers, such as EventSource, may pass the JWT as access_token. On reconnect, send the Last-Event-ID header (or lastEventId parameter) to replay buffered events published since. The stream is closed when the JWT expires; reconnect with a fresh token.
+//	@Tags			Events
+//	@Produce		text/event-stream
+//	@Security		ApiKeyAuth
+//	@Param			topics			query		string				false	"Comma-separated topics, e.g. APPOINTMENT,PATIENT"
+//	@Param			lastEventId		query		string				false	"ID of the last event received"
+//	@Param			Last-Event-ID	header		string				false	"ID of the last event received"
+//	@Param			access_token	query		string				false	"JWT, for clients that cannot set the Authorization header"
+//	@Success		200				{object}	domain.Event		"Event stream"
+//	@Failure		401				{object}	utils.ErrorResponse	"Missing or invalid JWT"
+//	@Failure		403				{object}	utils.ErrorResponse	"No topics allowed for the role"
+//	@Router			/events [get]
+func (h *EventHandler) Stream(c *fiber.Ctx) error {
+	role, _ := c.Locals("userRole").(string)
+	topics := filterTopics(domain.TopicsForRole(domain.Role(role)), c.Query("topics"))
+	if len(topics) == 0 {
+		return utils.ErrorResponseJSON(c, fiber.StatusForbidden, "No event topics available for this role", nil)
+	}
+
+	lastEventID := c.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("lastEventId")
+	}
+
+	// A stream outlives the request that authorized it, so it is closed when
+	// the token expires rather than serving events until the client leaves.
+	expiresAt, _ := c.Locals("tokenExpiresAt").(time.Time)
+
+	sub, replay := h.eventBus.Subscribe(topics, lastEventID)
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer sub.Close()
+
+		for _, event := range replay {
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		var expired <-chan time.Time
+		if !expiresAt.IsZero() {
+			timer := time.NewTimer(time.Until(expiresAt))
+			defer timer.Stop()
+			expired = timer.C
+		}
+
+		for {
+			select {
+			case event, ok := <-sub.C:
+				if !ok {
+					return
+				}
+				if err := writeEvent(w, event); err != nil {
+					return
+				}
+			case <-heartbeat.C:
+				if _, err := w.WriteString(": ping\n\n"); err != nil {
+					return
+				}
+			case <-expired:
+				return
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// filterTopics narrows the allowed topics to the requested ones, if any.
+func filterTopics(allowed []domain.ActivityType, requested string) []domain.ActivityType {
+	if requested == "" {
+		return allowed
+	}
+
+	wanted := make(map[domain.ActivityType]bool)
+	for _, topic := range strings.Split(requested, ",") {
+		wanted[domain.ActivityType(strings.ToUpper(strings.TrimSpace(topic)))] = true
+	}
+
+	var topics []domain.ActivityType
+	for _, topic := range allowed {
+		if wanted[topic] {
+			topics = append(topics, topic)
+		}
+	}
+	return topics
+}
+
+func writeEvent(w *bufio.Writer, event domain.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Error encoding event %s: %v", event.ID, err)
+		return nil
+	}
+
+	// The SSE ID is the bus sequence, so Last-Event-ID replays in publish
+	// order; the event's own ID is in the data.
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.Type, data)
+	return err
+}
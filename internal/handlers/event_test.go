@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/events"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestEventStreamClosesWhenTokenExpires(t *testing.T) {
+	h := NewEventHandler(events.NewBus(10))
+	app := fiber.New()
+	expiresAt := time.Now().Add(300 * time.Millisecond)
+	app.Get("/events", func(c *fiber.Ctx) error {
+		c.Locals("userRole", string(domain.RoleAdmin))
+		c.Locals("tokenExpiresAt", expiresAt)
+		return c.Next()
+	}, h.Stream)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/events", nil), 5000)
+	if err != nil {
+		t.Fatalf("GET /events error = %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != fiber.StatusOK || !strings.HasPrefix(resp.Header.Get(fiber.HeaderContentType), "text/event-stream") {
+		t.Fatalf("GET /events = %d %q, want an event stream", resp.StatusCode, resp.Header.Get(fiber.HeaderContentType))
+	}
+
+	if _, err := io.ReadAll(resp.Body); err != nil {
+		t.Fatalf("failed to read stream: %v", err)
+	}
+	if time.Now().Before(expiresAt) {
+		t.Error("stream closed before the token expired")
+	}
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/export"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// exportFormat reads the file format a list or report request asks for. The
+// format query parameter wins over the Accept header; ok is false when the
+// client wants the usual JSON response.
+func exportFormat(c *fiber.Ctx) (format export.Format, ok bool, err error) {
+	if q := c.Query("format"); q != "" {
+		format = export.Format(q)
+		if format == "json" {
+			return "", false, nil
+		}
+		if !format.IsValid() {
+			return "", false, fmt.Errorf("invalid format %q, expected csv, xlsx or json", q)
+		}
+		return format, true, nil
+	}
+
+	switch c.Accepts(fiber.MIMEApplicationJSON, export.FormatCSV.ContentType(), export.FormatXLSX.ContentType()) {
+	case export.FormatCSV.ContentType():
+		return export.FormatCSV, true, nil
+	case export.FormatXLSX.ContentType():
+		return export.FormatXLSX, true, nil
+	}
+	return "", false, nil
+}
+
+// sendExport streams the export as a file download. The rows are written
+// after the handler returns, straight to the connection, so the status and
+// headers are sent before any row is read and a failure part way through
+// shows up as a truncated file and a failed export in the activity log.
+func sendExport(c *fiber.Ctx, exportService *service.ExportService, e *service.Export, format export.Format) error {
+	userID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	c.Set(fiber.HeaderContentType, format.ContentType())
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s-%s.%s"`, e.Name, time.Now().Format("20060102-150405"), format))
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// The request context is recycled once the handler returns.
+		if err := exportService.Write(context.Background(), e, format, w, userID); err != nil {
+			log.Printf("Error exporting %s: %v", e.Name, err)
+		}
+	})
+	return nil
+}
@@ -0,0 +1,414 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type FacilityHandler struct {
+	facilityService *service.FacilityService
+}
+
+func NewFacilityHandler(facilityService *service.FacilityService) *FacilityHandler {
+	return &FacilityHandler{
+		facilityService: facilityService,
+	}
+}
+
+// GetAllDepartments handles the request to get all departments.
+//
+//	@Summary		Get all departments
+//	@Description	Retrieve all hospital departments.
+//	@Tags			Departments
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.DepartmentEntity}	"List of departments"
+//	@Failure		500	{object}	utils.ErrorResponse										"Failed to retrieve departments"
+//	@Router			/departments [get]
+func (h *FacilityHandler) GetAllDepartments(c *fiber.Ctx) error {
+	departments, err := h.facilityService.GetAllDepartments(c.Context())
+	if err != nil {
+		log.Printf("Error getting departments: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve departments", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of departments", departments)
+}
+
+// GetDepartmentByID handles the request to get a department by ID.
+//
+//	@Summary		Get department by ID
+//	@Description	Retrieve a single department by its ID.
+//	@Tags			Departments
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string												true	"Department ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.DepartmentEntity}	"Department retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse									"Department not found"
+//	@Failure		500	{object}	utils.ErrorResponse									"Failed to retrieve department"
+//	@Router			/departments/{id} [get]
+func (h *FacilityHandler) GetDepartmentByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	department, err := h.facilityService.GetDepartmentByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting department %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve department", err.Error())
+	}
+
+	if department == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Department not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Department retrieved successfully", department)
+}
+
+// CreateDepartment handles the request to create a department.
+//
+//	@Summary		Create a new department
+//	@Description	Create a new hospital department.
+//	@Tags			Departments
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			department	body		domain.DepartmentRequest						true	"Department object to be created"
+//	@Success		201			{object}	utils.SuccessResponse{data=object{id=string}}	"Department created successfully"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to create department"
+//	@Router			/departments [post]
+func (h *FacilityHandler) CreateDepartment(c *fiber.Ctx) error {
+	var req domain.DepartmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.facilityService.CreateDepartment(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating department: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Department created successfully", fiber.Map{"id": id})
+}
+
+// UpdateDepartment handles the request to update a department.
+//
+//	@Summary		Update an existing department
+//	@Description	Update details of an existing department.
+//	@Tags			Departments
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string						true	"Department ID"
+//	@Param			department	body		domain.DepartmentRequest	true	"Department object with updated fields"
+//	@Success		204			{object}	utils.SuccessResponse		"Department updated successfully"
+//	@Failure		400			{object}	utils.ErrorResponse			"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse			"Failed to update department"
+//	@Router			/departments/{id} [put]
+func (h *FacilityHandler) UpdateDepartment(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.DepartmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.facilityService.UpdateDepartment(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating department: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Department updated successfully", nil)
+}
+
+// GetAllClinics handles the request to get all clinics.
+//
+//	@Summary		Get all clinics
+//	@Description	Retrieve all outpatient clinics, optionally only those of a department.
+//	@Tags			Clinics
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			departmentId	query		string												false	"Department ID"
+//	@Success		200				{object}	utils.SuccessResponse{data=[]domain.ClinicEntity}	"List of clinics"
+//	@Failure		500				{object}	utils.ErrorResponse									"Failed to retrieve clinics"
+//	@Router			/clinics [get]
+func (h *FacilityHandler) GetAllClinics(c *fiber.Ctx) error {
+	clinics, err := h.facilityService.GetAllClinics(c.Context(), c.Query("departmentId"))
+	if err != nil {
+		log.Printf("Error getting clinics: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve clinics", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of clinics", clinics)
+}
+
+// GetClinicByID handles the request to get a clinic by ID.
+//
+//	@Summary		Get clinic by ID
+//	@Description	Retrieve a single clinic by its ID.
+//	@Tags			Clinics
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Clinic ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ClinicEntity}	"Clinic retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse								"Clinic not found"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve clinic"
+//	@Router			/clinics/{id} [get]
+func (h *FacilityHandler) GetClinicByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	clinic, err := h.facilityService.GetClinicByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting clinic %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve clinic", err.Error())
+	}
+
+	if clinic == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Clinic not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Clinic retrieved successfully", clinic)
+}
+
+// CreateClinic handles the request to create a clinic.
+//
+//	@Summary		Create a new clinic
+//	@Description	Create a new outpatient clinic in a department, with its operating hours.
+//	@Tags			Clinics
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clinic	body		domain.ClinicRequest							true	"Clinic object to be created"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Clinic created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create clinic"
+//	@Router			/clinics [post]
+func (h *FacilityHandler) CreateClinic(c *fiber.Ctx) error {
+	var req domain.ClinicRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.facilityService.CreateClinic(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating clinic: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Clinic created successfully", fiber.Map{"id": id})
+}
+
+// UpdateClinic handles the request to update a clinic.
+//
+//	@Summary		Update an existing clinic
+//	@Description	Update details of an existing clinic. Moving it to another department moves its rooms too.
+//	@Tags			Clinics
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string					true	"Clinic ID"
+//	@Param			clinic	body		domain.ClinicRequest	true	"Clinic object with updated fields"
+//	@Success		204		{object}	utils.SuccessResponse	"Clinic updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse		"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse		"Failed to update clinic"
+//	@Router			/clinics/{id} [put]
+func (h *FacilityHandler) UpdateClinic(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.ClinicRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.facilityService.UpdateClinic(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating clinic: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Clinic updated successfully", nil)
+}
+
+// GetAllRooms handles the request to get all clinic rooms.
+//
+//	@Summary		Get all clinic rooms
+//	@Description	Retrieve all clinic rooms, optionally only those of a clinic or department.
+//	@Tags			Clinic Rooms
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			clinicId		query		string													false	"Clinic ID"
+//	@Param			departmentId	query		string													false	"Department ID"
+//	@Success		200				{object}	utils.SuccessResponse{data=[]domain.ClinicRoomEntity}	"List of rooms"
+//	@Failure		500				{object}	utils.ErrorResponse										"Failed to retrieve rooms"
+//	@Router			/clinic-rooms [get]
+func (h *FacilityHandler) GetAllRooms(c *fiber.Ctx) error {
+	rooms, err := h.facilityService.GetAllRooms(c.Context(), c.Query("clinicId"), c.Query("departmentId"))
+	if err != nil {
+		log.Printf("Error getting clinic rooms: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve rooms", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of rooms", rooms)
+}
+
+// GetRoomByID handles the request to get a clinic room by ID.
+//
+//	@Summary		Get clinic room by ID
+//	@Description	Retrieve a single clinic room by its ID.
+//	@Tags			Clinic Rooms
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string												true	"Room ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ClinicRoomEntity}	"Room retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse									"Room not found"
+//	@Failure		500	{object}	utils.ErrorResponse									"Failed to retrieve room"
+//	@Router			/clinic-rooms/{id} [get]
+func (h *FacilityHandler) GetRoomByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	room, err := h.facilityService.GetRoomByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting clinic room %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve room", err.Error())
+	}
+
+	if room == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Room not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Room retrieved successfully", room)
+}
+
+// CreateRoom handles the request to create a clinic room.
+//
+//	@Summary		Create a new clinic room
+//	@Description	Create a bookable room in a clinic with its capacity. Operating hours, when given, override the clinic's.
+//	@Tags			Clinic Rooms
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			room	body		domain.ClinicRoomRequest						true	"Room object to be created"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Room created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create room"
+//	@Router			/clinic-rooms [post]
+func (h *FacilityHandler) CreateRoom(c *fiber.Ctx) error {
+	var req domain.ClinicRoomRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.facilityService.CreateRoom(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating clinic room: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Room created successfully", fiber.Map{"id": id})
+}
+
+// UpdateRoom handles the request to update a clinic room.
+//
+//	@Summary		Update an existing clinic room
+//	@Description	Update details of an existing clinic room.
+//	@Tags			Clinic Rooms
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string						true	"Room ID"
+//	@Param			room	body		domain.ClinicRoomRequest	true	"Room object with updated fields"
+//	@Success		204		{object}	utils.SuccessResponse		"Room updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse			"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse			"Failed to update room"
+//	@Router			/clinic-rooms/{id} [put]
+func (h *FacilityHandler) UpdateRoom(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.ClinicRoomRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.facilityService.UpdateRoom(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating clinic room: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Room updated successfully", nil)
+}
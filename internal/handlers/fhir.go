@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/ekastn/hms-api/internal/fhir"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FHIRHandler serves the FHIR R4 facade under /fhir/R4. It answers in FHIR
+// JSON, with errors as OperationOutcome resources, so it is documented by
+// its CapabilityStatement rather than in Swagger.
+type FHIRHandler struct {
+	fhirService *service.FHIRService
+}
+
+func NewFHIRHandler(fhirService *service.FHIRService) *FHIRHandler {
+	return &FHIRHandler{
+		fhirService: fhirService,
+	}
+}
+
+// Negotiate rejects requests for a format other than JSON, given in the
+// _format parameter or the Accept header.
+func (h *FHIRHandler) Negotiate(c *fiber.Ctx) error {
+	format := c.Query("_format")
+	if format == "" {
+		format = c.Get(fiber.HeaderAccept)
+	}
+	if format != "" && !acceptsFHIRJSON(format) {
+		return sendFHIR(c, fiber.StatusNotAcceptable, fhir.NewOutcome("error", "not-supported", "only FHIR JSON is supported"))
+	}
+	return c.Next()
+}
+
+func acceptsFHIRJSON(format string) bool {
+	for _, part := range strings.Split(format, ",") {
+		mediaType, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		switch strings.ToLower(mediaType) {
+		case "json", fhir.MIMEType, fiber.MIMEApplicationJSON, "*/*", "application/*":
+			return true
+		}
+	}
+	return false
+}
+
+// Metadata returns the CapabilityStatement.
+func (h *FHIRHandler) Metadata(c *fiber.Ctx) error {
+	return sendFHIR(c, fiber.StatusOK, h.fhirService.Capability())
+}
+
+// Read returns a handler reading resources of the type by ID.
+func (h *FHIRHandler) Read(resourceType string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		resource, err := h.fhirService.Read(c.Context(), resourceType, c.Params("id"))
+		if err != nil {
+			return sendFHIRError(c, err)
+		}
+		return sendFHIR(c, fiber.StatusOK, resource)
+	}
+}
+
+// Search returns a handler searching resources of the type with the query
+// parameters.
+func (h *FHIRHandler) Search(resourceType string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		params := make(map[string][]string)
+		c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+			params[string(key)] = append(params[string(key)], string(value))
+		})
+
+		bundle, err := h.fhirService.Search(c.Context(), resourceType, params, string(c.Request().URI().QueryString()))
+		if err != nil {
+			return sendFHIRError(c, err)
+		}
+		return sendFHIR(c, fiber.StatusOK, bundle)
+	}
+}
+
+// Create returns a handler creating resources of the type. It answers with
+// the created resource as read back, and its URL in the Location header.
+func (h *FHIRHandler) Create(resourceType string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		mediaType, _, _ := strings.Cut(c.Get(fiber.HeaderContentType), ";")
+		switch strings.TrimSpace(strings.ToLower(mediaType)) {
+		case fhir.MIMEType, fiber.MIMEApplicationJSON:
+		default:
+			return sendFHIR(c, fiber.StatusUnsupportedMediaType, fhir.NewOutcome("error", "not-supported", "send the resource as "+fhir.MIMEType))
+		}
+
+		creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+		if err != nil {
+			return sendFHIR(c, fiber.StatusInternalServerError, fhir.NewOutcome("fatal", "exception", "Invalid user ID"))
+		}
+
+		id, err := h.fhirService.Create(c.Context(), resourceType, c.Body(), creatorID)
+		if err != nil {
+			var fhirErr *fhir.Error
+			if errors.As(err, &fhirErr) {
+				return sendFHIR(c, fhirErr.Status, fhirErr.Outcome())
+			}
+			// The services report rule violations, such as a doctor being
+			// booked already, as plain errors like the REST API does.
+			log.Printf("Error creating FHIR %s: %v", resourceType, err)
+			return sendFHIR(c, fiber.StatusUnprocessableEntity, fhir.NewOutcome("error", "processing", err.Error()))
+		}
+
+		resource, err := h.fhirService.Read(c.Context(), resourceType, id)
+		if err != nil {
+			return sendFHIRError(c, err)
+		}
+
+		c.Set(fiber.HeaderLocation, h.fhirService.Location(resourceType, id))
+		return sendFHIR(c, fiber.StatusCreated, resource)
+	}
+}
+
+// NotFound answers requests for resource types and interactions the facade
+// does not serve.
+func (h *FHIRHandler) NotFound(c *fiber.Ctx) error {
+	return sendFHIR(c, fiber.StatusNotFound, fhir.NewOutcome("error", "not-supported", c.Method()+" "+c.Path()+" is not supported"))
+}
+
+func sendFHIR(c *fiber.Ctx, status int, resource any) error {
+	c.Set(fiber.HeaderContentType, fhir.ContentType)
+	body, err := c.App().Config().JSONEncoder(resource)
+	if err != nil {
+		return err
+	}
+	return c.Status(status).Send(body)
+}
+
+func sendFHIRError(c *fiber.Ctx, err error) error {
+	var fhirErr *fhir.Error
+	if errors.As(err, &fhirErr) {
+		return sendFHIR(c, fhirErr.Status, fhirErr.Outcome())
+	}
+	log.Printf("Error serving FHIR request %s: %v", c.Path(), err)
+	return sendFHIR(c, fiber.StatusInternalServerError, fhir.NewOutcome("fatal", "exception", "internal server error"))
+}
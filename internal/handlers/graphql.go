@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/graph"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GraphQLHandler struct {
+	server *graph.Server
+}
+
+func NewGraphQLHandler(server *graph.Server) *GraphQLHandler {
+	return &GraphQLHandler{
+		server: server,
+	}
+}
+
+// HandleQuery handles a GraphQL query.
+//
+//	@Summary		Run a GraphQL query
+//	@Description	Query patients, doctors, appointments, medical records and activities, with their relations, in one request. Each query and restricted field is limited to the roles of the matching REST endpoint; a field the caller may not see is null with a FORBIDDEN error. Queries above the complexity limit are refused. The schema is available through introspection.
+//	@Tags			GraphQL
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			request	body		domain.GraphQLRequest	true	"GraphQL operation"
+//	@Success		200		{object}	domain.GraphQLResponse	"Query result, with any errors"
+//	@Failure		400		{object}	utils.ErrorResponse		"Invalid request body or validation failed"
+//	@Router			/graphql [post]
+func (h *GraphQLHandler) HandleQuery(c *fiber.Ctx) error {
+	var req domain.GraphQLRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	role, _ := c.Locals("userRole").(string)
+	res := h.server.Execute(c.Context(), domain.Role(role), &graphql.RawParams{
+		Query:         req.Query,
+		OperationName: req.OperationName,
+		Variables:     req.Variables,
+	})
+	return c.Status(fiber.StatusOK).JSON(res)
+}
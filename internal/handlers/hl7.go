@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type HL7Handler struct {
+	hl7Service *service.HL7Service
+}
+
+func NewHL7Handler(hl7Service *service.HL7Service) *HL7Handler {
+	return &HL7Handler{
+		hl7Service: hl7Service,
+	}
+}
+
+// GetMessages handles the request to get the HL7 message log.
+//
+//	@Summary		Get HL7 messages
+//	@Description	Retrieve the most recent HL7 v2 messages received and sent over MLLP, with their acknowledgments. Filter outbound messages by status DeadLetter to get the dead-letter list.
+//	@Tags			HL7
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			direction	query		string													false	"Direction (inbound, outbound)"
+//	@Param			status		query		string													false	"Status (Processed, Error, Rejected, Pending, Sent, Failed, DeadLetter)"
+//	@Param			messageType	query		string													false	"Message type, e.g. ADT^A08"
+//	@Param			patientId	query		string													false	"Patient ID"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.HL7MessageEntity}	"List of HL7 messages"
+//	@Failure		400			{object}	utils.ErrorResponse										"Invalid filter"
+//	@Router			/hl7/messages [get]
+func (h *HL7Handler) GetMessages(c *fiber.Ctx) error {
+	direction := domain.HL7Direction(c.Query("direction"))
+	status := domain.HL7MessageStatus(c.Query("status"))
+
+	msgs, err := h.hl7Service.GetMessages(c.Context(), direction, status, c.Query("messageType"), c.Query("patientId"))
+	if err != nil {
+		log.Printf("Error getting HL7 messages: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve HL7 messages", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of HL7 messages", msgs)
+}
+
+// GetMessageByID handles the request to get an HL7 message by ID.
+//
+//	@Summary		Get HL7 message by ID
+//	@Description	Retrieve a single HL7 message with its raw content, acknowledgment and attempt log.
+//	@Tags			HL7
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"HL7 message ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.HL7MessageEntity}	"HL7 message retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse								"HL7 message not found"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve HL7 message"
+//	@Router			/hl7/messages/{id} [get]
+func (h *HL7Handler) GetMessageByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	msg, err := h.hl7Service.GetMessageByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting HL7 message %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve HL7 message", err.Error())
+	}
+
+	if msg == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "HL7 message not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "HL7 message retrieved successfully", msg)
+}
+
+// RetryMessage handles the request to retry an outbound HL7 message.
+//
+//	@Summary		Retry an outbound HL7 message
+//	@Description	Put a failed or dead-lettered outbound message back in the send queue with a fresh attempt budget.
+//	@Tags			HL7
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string					true	"HL7 message ID"
+//	@Success		204	{object}	utils.SuccessResponse	"HL7 message queued for retry"
+//	@Failure		500	{object}	utils.ErrorResponse		"Failed to retry HL7 message"
+//	@Router			/hl7/messages/{id}/retry [post]
+func (h *HL7Handler) RetryMessage(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if err := h.hl7Service.RetryMessage(c.Context(), id); err != nil {
+		log.Printf("Error retrying HL7 message %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "HL7 message queued for retry", nil)
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"io"
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/export"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ImportHandler struct {
+	importService *service.ImportService
+	exportService *service.ExportService
+}
+
+func NewImportHandler(importService *service.ImportService, exportService *service.ExportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+		exportService: exportService,
+	}
+}
+
+// ImportPatients handles the upload of a patient CSV file.
+//
+//	@Summary		Import patients from CSV
+//	@Description	Upload a CSV file with the columns name, age, gender, phone, email and address, validated like a create patient request. Rows whose email or phone matches an existing patient or an earlier row are skipped as duplicates. The file is imported in the background; poll the returned job for progress. With dryRun nothing is created and the job reports what would happen to each row.
+//	@Tags			Import
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			file	formData	file											true	"CSV file"
+//	@Param			dryRun	query		bool											false	"Only check the rows"
+//	@Success		202		{object}	utils.SuccessResponse{data=domain.ImportJobDTO}	"Import queued"
+//	@Failure		400		{object}	utils.ErrorResponse								"Missing or invalid file"
+//	@Router			/import/patients [post]
+func (h *ImportHandler) ImportPatients(c *fiber.Ctx) error {
+	return h.createJob(c, domain.ImportPatients)
+}
+
+// ImportDoctors handles the upload of a doctor CSV file.
+//
+//	@Summary		Import doctors from CSV
+//	@Description	Upload a CSV file with the columns name, specialty, phone and email, and optionally departmentId and userId, validated like a create doctor request. Rows whose name, email or phone matches an existing doctor or an earlier row are skipped as duplicates. The file is imported in the background; poll the returned job for progress. With dryRun nothing is created and the job reports what would happen to each row.
+//	@Tags			Import
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			file	formData	file											true	"CSV file"
+//	@Param			dryRun	query		bool											false	"Only check the rows"
+//	@Success		202		{object}	utils.SuccessResponse{data=domain.ImportJobDTO}	"Import queued"
+//	@Failure		400		{object}	utils.ErrorResponse								"Missing or invalid file"
+//	@Router			/import/doctors [post]
+func (h *ImportHandler) ImportDoctors(c *fiber.Ctx) error {
+	return h.createJob(c, domain.ImportDoctors)
+}
+
+func (h *ImportHandler) createJob(c *fiber.Ctx, kind domain.ImportKind) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Missing file", "upload the CSV file in the 'file' form field")
+	}
+
+	f, err := fileHeader.Open()
+	if err != nil {
+		log.Printf("Error opening uploaded file: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid file", nil)
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		log.Printf("Error reading uploaded file: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid file", nil)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	job, err := h.importService.CreateJob(c.Context(), kind, fileHeader.Filename, content, c.QueryBool("dryRun"), creatorID)
+	if err != nil {
+		log.Printf("Error creating %s import: %v", kind, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid import file", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusAccepted, "Import queued", job.ToDTO(false))
+}
+
+// GetJobs handles the request to get the recent import jobs.
+//
+//	@Summary		Get import jobs
+//	@Description	Retrieve the most recent import jobs, optionally of one kind. Only admins see doctor imports.
+//	@Tags			Import
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			kind	query		string												false	"patients or doctors"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.ImportJobDTO}	"List of import jobs"
+//	@Failure		400		{object}	utils.ErrorResponse									"Invalid kind"
+//	@Router			/import/jobs [get]
+func (h *ImportHandler) GetJobs(c *fiber.Ctx) error {
+	kind := domain.ImportKind(c.Query("kind"))
+	if !canSeeImport(c, domain.ImportDoctors) {
+		kind = domain.ImportPatients
+	}
+
+	jobs, err := h.importService.GetJobs(c.Context(), kind)
+	if err != nil {
+		log.Printf("Error getting import jobs: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve import jobs", err.Error())
+	}
+
+	jobDTOs := make([]domain.ImportJobDTO, 0, len(jobs))
+	for _, job := range jobs {
+		jobDTOs = append(jobDTOs, job.ToDTO(false))
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of import jobs", jobDTOs)
+}
+
+// GetJob handles the request to get an import job.
+//
+//	@Summary		Get import job by ID
+//	@Description	Retrieve an import job with its progress and the rows that were not, or in a dry run would not be, imported, with the reason for each.
+//	@Tags			Import
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Import job ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ImportJobDTO}	"Import job retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse								"Import job not found"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve import job"
+//	@Router			/import/jobs/{id} [get]
+func (h *ImportHandler) GetJob(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	job, err := h.importService.GetJob(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting import job %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve import job", err.Error())
+	}
+
+	if job == nil || !canSeeImport(c, job.Kind) {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Import job not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Import job retrieved successfully", job.ToDTO(true))
+}
+
+// GetJobResult handles the request to download the result of an import job.
+//
+//	@Summary		Download import result
+//	@Description	Download the uploaded rows with the columns import_status, import_id and import_errors added, as CSV or Excel. Rows not processed yet have no status.
+//	@Tags			Import
+//	@Produce		text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string				true	"Import job ID"
+//	@Param			format	query		string				false	"File format, csv (default) or xlsx"
+//	@Success		200		{file}		file				"Import result"
+//	@Failure		400		{object}	utils.ErrorResponse	"Invalid export format"
+//	@Failure		404		{object}	utils.ErrorResponse	"Import job not found"
+//	@Failure		500		{object}	utils.ErrorResponse	"Failed to retrieve import result"
+//	@Router			/import/jobs/{id}/result [get]
+func (h *ImportHandler) GetJobResult(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if !asFile {
+		format = export.FormatCSV
+	}
+
+	id := c.Params("id")
+	job, err := h.importService.GetJob(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting import job %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve import result", err.Error())
+	}
+
+	if job == nil || !canSeeImport(c, job.Kind) {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Import job not found", nil)
+	}
+
+	e, err := h.importService.ResultExport(job)
+	if err != nil {
+		log.Printf("Error getting import result %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve import result", err.Error())
+	}
+
+	return sendExport(c, h.exportService, e, format)
+}
+
+// canSeeImport reports whether the user may see imports of the kind: doctor
+// imports, like doctors, are for admins only.
+func canSeeImport(c *fiber.Ctx, kind domain.ImportKind) bool {
+	role, _ := c.Locals("userRole").(string)
+	return kind != domain.ImportDoctors || domain.Role(role) == domain.RoleAdmin
+}
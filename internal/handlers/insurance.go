@@ -0,0 +1,389 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type InsuranceHandler struct {
+	insuranceService *service.InsuranceService
+}
+
+func NewInsuranceHandler(insuranceService *service.InsuranceService) *InsuranceHandler {
+	return &InsuranceHandler{
+		insuranceService: insuranceService,
+	}
+}
+
+// GetPolicies handles the request to get insurance policies.
+//
+//	@Summary		Get insurance policies
+//	@Description	Retrieve insurance policies, optionally filtered by patient.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			patientId	query		string													false	"Patient ID"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.InsurancePolicyDTO}	"List of insurance policies"
+//	@Failure		500			{object}	utils.ErrorResponse										"Failed to retrieve insurance policies"
+//	@Router			/insurance/policies [get]
+func (h *InsuranceHandler) GetPolicies(c *fiber.Ctx) error {
+	policies, err := h.insuranceService.GetPolicies(c.Context(), c.Query("patientId"))
+	if err != nil {
+		log.Printf("Error getting insurance policies: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve insurance policies", err.Error())
+	}
+
+	var policyDTOs []domain.InsurancePolicyDTO
+	for _, policy := range policies {
+		policyDTOs = append(policyDTOs, policy.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of insurance policies", policyDTOs)
+}
+
+// GetPolicyByID handles the request to get an insurance policy by ID.
+//
+//	@Summary		Get insurance policy by ID
+//	@Description	Retrieve a single insurance policy by its ID.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string												true	"Policy ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.InsurancePolicyDTO}	"Insurance policy retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse									"Insurance policy not found"
+//	@Failure		500	{object}	utils.ErrorResponse									"Failed to retrieve insurance policy"
+//	@Router			/insurance/policies/{id} [get]
+func (h *InsuranceHandler) GetPolicyByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	policy, err := h.insuranceService.GetPolicyByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting insurance policy %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve insurance policy", err.Error())
+	}
+
+	if policy == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Insurance policy not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Insurance policy retrieved successfully", policy.ToDTO())
+}
+
+// CreatePolicy handles the request to register an insurance policy.
+//
+//	@Summary		Create an insurance policy
+//	@Description	Register a BPJS or private insurance policy for a patient.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			policy	body		domain.InsurancePolicyRequest					true	"Insurance policy to be created"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Insurance policy created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create insurance policy"
+//	@Router			/insurance/policies [post]
+func (h *InsuranceHandler) CreatePolicy(c *fiber.Ctx) error {
+	var req domain.InsurancePolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.insuranceService.CreatePolicy(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating insurance policy: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Insurance policy created successfully", fiber.Map{"id": id})
+}
+
+// UpdatePolicy handles the request to update an insurance policy.
+//
+//	@Summary		Update an insurance policy
+//	@Description	Update the payer, member number or validity of an insurance policy.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string							true	"Policy ID"
+//	@Param			policy	body		domain.InsurancePolicyRequest	true	"Insurance policy with updated fields"
+//	@Success		204		{object}	utils.SuccessResponse			"Insurance policy updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse				"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse				"Failed to update insurance policy"
+//	@Router			/insurance/policies/{id} [put]
+func (h *InsuranceHandler) UpdatePolicy(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.InsurancePolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.insuranceService.UpdatePolicy(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating insurance policy: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Insurance policy updated successfully", nil)
+}
+
+// CheckEligibility handles the request to check insurance eligibility for an appointment.
+//
+//	@Summary		Check appointment eligibility
+//	@Description	Check the patient's insurance with the payer for the appointment date and store the result on the appointment.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string												true	"Appointment ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.EligibilityCheck}	"Eligibility checked successfully"
+//	@Failure		500	{object}	utils.ErrorResponse									"Failed to check eligibility"
+//	@Router			/appointments/{id}/eligibility [put]
+func (h *InsuranceHandler) CheckEligibility(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	check, err := h.insuranceService.CheckAppointmentEligibility(c.Context(), id, updaterID)
+	if err != nil {
+		log.Printf("Error checking eligibility for appointment %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Eligibility checked successfully", check)
+}
+
+// GetClaims handles the request to get insurance claims.
+//
+//	@Summary		Get insurance claims
+//	@Description	Retrieve insurance claims, optionally filtered by status and payer type.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			status		query		string											false	"Claim status (Draft, Submitted, Approved, Rejected, Paid)"
+//	@Param			payerType	query		string											false	"Payer type (BPJS or Private)"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.ClaimDTO}	"List of claims"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid claim status"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to retrieve claims"
+//	@Router			/claims [get]
+func (h *InsuranceHandler) GetClaims(c *fiber.Ctx) error {
+	status := domain.ClaimStatus(c.Query("status"))
+	if status != "" && !status.IsValid() {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid claim status", nil)
+	}
+
+	claims, err := h.insuranceService.GetClaims(c.Context(), status, domain.PayerType(c.Query("payerType")))
+	if err != nil {
+		log.Printf("Error getting claims: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve claims", err.Error())
+	}
+
+	var claimDTOs []domain.ClaimDTO
+	for _, claim := range claims {
+		claimDTOs = append(claimDTOs, claim.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of claims", claimDTOs)
+}
+
+// GetClaimByID handles the request to get a claim by ID.
+//
+//	@Summary		Get claim by ID
+//	@Description	Retrieve a single insurance claim with its status history.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string										true	"Claim ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ClaimDTO}	"Claim retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse							"Claim not found"
+//	@Failure		500	{object}	utils.ErrorResponse							"Failed to retrieve claim"
+//	@Router			/claims/{id} [get]
+func (h *InsuranceHandler) GetClaimByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	claim, err := h.insuranceService.GetClaimByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting claim %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve claim", err.Error())
+	}
+
+	if claim == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Claim not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Claim retrieved successfully", claim.ToDTO())
+}
+
+// CreateClaim handles the request to generate a claim from an invoice.
+//
+//	@Summary		Create a claim
+//	@Description	Generate a draft insurance claim for the outstanding balance of an invoice. The patient's policy covering the service date is used unless a policy is given.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			claim	body		domain.CreateClaimRequest						true	"Invoice to claim"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Claim created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create claim"
+//	@Router			/claims [post]
+func (h *InsuranceHandler) CreateClaim(c *fiber.Ctx) error {
+	var req domain.CreateClaimRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.insuranceService.CreateClaim(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating claim: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Claim created successfully", fiber.Map{"id": id})
+}
+
+// SubmitClaim handles the request to submit a claim to the payer.
+//
+//	@Summary		Submit a claim
+//	@Description	Send a draft claim to the payer.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string					true	"Claim ID"
+//	@Success		204	{object}	utils.SuccessResponse	"Claim submitted successfully"
+//	@Failure		500	{object}	utils.ErrorResponse		"Failed to submit claim"
+//	@Router			/claims/{id}/submit [put]
+func (h *InsuranceHandler) SubmitClaim(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.insuranceService.SubmitClaim(c.Context(), id, updaterID); err != nil {
+		log.Printf("Error submitting claim: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Claim submitted successfully", nil)
+}
+
+// UpdateClaimStatus handles the request to record the payer's decision on a claim.
+//
+//	@Summary		Update claim status
+//	@Description	Record approval, rejection or payment of a claim, or reopen a rejected claim as a draft. Paying a claim posts an insurance payment on the invoice.
+//	@Tags			Insurance
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string							true	"Claim ID"
+//	@Param			status	body		domain.UpdateClaimStatusRequest	true	"New claim status"
+//	@Success		204		{object}	utils.SuccessResponse			"Claim status updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse				"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse				"Failed to update claim status"
+//	@Router			/claims/{id}/status [put]
+func (h *InsuranceHandler) UpdateClaimStatus(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.UpdateClaimStatusRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.insuranceService.UpdateClaimStatus(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating claim status: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Claim status updated successfully", nil)
+}
+
+// ExportClaims handles the request to export claims as a batch file.
+//
+//	@Summary		Export claims
+//	@Description	Download the matching claims as a CSV batch file with one row per claimed item.
+//	@Tags			Insurance
+//	@Produce		text/csv
+//	@Security		ApiKeyAuth
+//	@Param			status		query		string				false	"Claim status (Draft, Submitted, Approved, Rejected, Paid)"
+//	@Param			payerType	query		string				false	"Payer type (BPJS or Private)"
+//	@Success		200			{file}		file				"Claim batch file"
+//	@Failure		400			{object}	utils.ErrorResponse	"Invalid claim status"
+//	@Failure		500			{object}	utils.ErrorResponse	"Failed to export claims"
+//	@Router			/claims/export [get]
+func (h *InsuranceHandler) ExportClaims(c *fiber.Ctx) error {
+	status := domain.ClaimStatus(c.Query("status"))
+	if status != "" && !status.IsValid() {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid claim status", nil)
+	}
+
+	data, err := h.insuranceService.ExportClaims(c.Context(), status, domain.PayerType(c.Query("payerType")))
+	if err != nil {
+		log.Printf("Error exporting claims: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to export claims", err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="claims-%s.csv"`, time.Now().Format("20060102-150405")))
+	return c.Send(data)
+}
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type LabHandler struct {
+	labService *service.LabService
+}
+
+func NewLabHandler(labService *service.LabService) *LabHandler {
+	return &LabHandler{
+		labService: labService,
+	}
+}
+
+// GetAllOrders handles the request to get all lab orders.
+//
+//	@Summary		Get all lab orders
+//	@Description	Retrieve a list of all lab orders, most recent first.
+//	@Tags			Labs
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.LabOrderDTO}	"List of lab orders"
+//	@Failure		500	{object}	utils.ErrorResponse									"Failed to retrieve lab orders"
+//	@Router			/labs/orders [get]
+func (h *LabHandler) GetAllOrders(c *fiber.Ctx) error {
+	orders, err := h.labService.GetAll(c.Context())
+	if err != nil {
+		log.Printf("Error getting lab orders: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve lab orders", err.Error())
+	}
+
+	var orderDTOs []domain.LabOrderDTO
+	for _, order := range orders {
+		orderDTOs = append(orderDTOs, order.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of lab orders", orderDTOs)
+}
+
+// GetOrderByID handles the request to get a lab order by ID.
+//
+//	@Summary		Get lab order by ID
+//	@Description	Retrieve a single lab order, including its results when available.
+//	@Tags			Labs
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Lab Order ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.LabOrderDTO}	"Lab order retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse								"Lab order not found"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve lab order"
+//	@Router			/labs/orders/{id} [get]
+func (h *LabHandler) GetOrderByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	order, err := h.labService.GetByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting lab order %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve lab order", err.Error())
+	}
+
+	if order == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Lab order not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Lab order retrieved successfully", order.ToDTO())
+}
+
+// GetPatientOrders handles the request to get the lab orders of a patient.
+//
+//	@Summary		Get lab orders by patient ID
+//	@Description	Retrieve all lab orders placed for a specific patient.
+//	@Tags			Labs
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			patientId	path		string												true	"Patient ID"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.LabOrderDTO}	"Patient lab orders"
+//	@Failure		500			{object}	utils.ErrorResponse									"Failed to retrieve lab orders"
+//	@Router			/labs/patients/{patientId} [get]
+func (h *LabHandler) GetPatientOrders(c *fiber.Ctx) error {
+	patientID := c.Params("patientId")
+
+	orders, err := h.labService.GetByPatientID(c.Context(), patientID)
+	if err != nil {
+		log.Printf("Error getting lab orders for patient %s: %v", patientID, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve lab orders", err.Error())
+	}
+
+	var orderDTOs []domain.LabOrderDTO
+	for _, order := range orders {
+		orderDTOs = append(orderDTOs, order.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Patient lab orders", orderDTOs)
+}
+
+// GetWorklist handles the request to get the lab worklist.
+//
+//	@Summary		Get lab worklist
+//	@Description	Retrieve open lab orders (Ordered and InProgress), ordered by priority and age.
+//	@Tags			Labs
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.LabWorklistItem}	"Lab worklist"
+//	@Failure		500	{object}	utils.ErrorResponse										"Failed to retrieve lab worklist"
+//	@Router			/labs/worklist [get]
+func (h *LabHandler) GetWorklist(c *fiber.Ctx) error {
+	items, err := h.labService.GetWorklist(c.Context())
+	if err != nil {
+		log.Printf("Error getting lab worklist: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve lab worklist", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Lab worklist", items)
+}
+
+// CreateOrder handles the request to place a new lab order.
+//
+//	@Summary		Place a lab order
+//	@Description	Place a lab order for a patient, linked to one of their appointments.
+//	@Tags			Labs
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			order	body		domain.CreateLabOrderRequest					true	"Lab order to be placed"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Lab order created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create lab order"
+//	@Router			/labs/orders [post]
+func (h *LabHandler) CreateOrder(c *fiber.Ctx) error {
+	var req domain.CreateLabOrderRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.labService.CreateOrder(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating lab order: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Lab order created successfully", fiber.Map{"id": id})
+}
+
+// UpdateOrderStatus handles the request to update a lab order status.
+//
+//	@Summary		Update lab order status
+//	@Description	Move an open lab order to InProgress, back to Ordered, or cancel it.
+//	@Tags			Labs
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string								true	"Lab Order ID"
+//	@Param			status	body		domain.UpdateLabOrderStatusRequest	true	"New status for the lab order"
+//	@Success		204		{object}	utils.SuccessResponse				"Lab order status updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse					"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse					"Failed to update lab order status"
+//	@Router			/labs/orders/{id}/status [put]
+func (h *LabHandler) UpdateOrderStatus(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.UpdateLabOrderStatusRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body for lab status update: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.labService.UpdateStatus(c.Context(), id, req.Status, updaterID); err != nil {
+		log.Printf("Error updating lab order status: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Lab order status updated successfully", nil)
+}
+
+// EnterResults handles the request to enter results for a lab order.
+//
+//	@Summary		Enter lab results
+//	@Description	Enter results for every test on a lab order and mark it as completed. Flags are derived from the reference range when omitted.
+//	@Tags			Labs
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string							true	"Lab Order ID"
+//	@Param			results	body		domain.EnterLabResultsRequest	true	"Lab results"
+//	@Success		204		{object}	utils.SuccessResponse			"Lab results entered successfully"
+//	@Failure		400		{object}	utils.ErrorResponse				"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse				"Failed to enter lab results"
+//	@Router			/labs/orders/{id}/results [put]
+func (h *LabHandler) EnterResults(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.EnterLabResultsRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body for lab results: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	labUserID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.labService.EnterResults(c.Context(), id, &req, labUserID); err != nil {
+		log.Printf("Error entering lab results: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Lab results entered successfully", nil)
+}
@@ -13,20 +13,31 @@ import (
 
 type MedicalRecordHandler struct {
 	recordService *service.MedicalRecordService
+	exportService *service.ExportService
 }
 
 // GetAll retrieves all medical records
 //
 //	@Summary		Get all medical records
-//	@Description	Retrieve a list of all medical records.
+//	@Description	Retrieve a list of all medical records, or download it as CSV or Excel.
 //	@Tags			Medical Records
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.MedicalRecordDTO}	"Medical records retrieved successfully"
-//	@Failure		500	{object}	utils.ErrorResponse										"Failed to get medical records"
+//	@Param			format	query		string													false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.MedicalRecordDTO}	"Medical records retrieved successfully"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid export format"
+//	@Failure		500		{object}	utils.ErrorResponse										"Failed to get medical records"
 //	@Router			/records [get]
 func (h *MedicalRecordHandler) GetAll(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		return sendExport(c, h.exportService, h.exportService.MedicalRecordExport(), format)
+	}
+
 	records, err := h.recordService.GetAll(c.Context())
 	if err != nil {
 		log.Printf("Error getting medical records: %v", err)
@@ -42,9 +53,10 @@ func (h *MedicalRecordHandler) GetAll(c *fiber.Ctx) error {
 	return utils.ResponseJSON(c, fiber.StatusOK, "Medical records retrieved successfully", dtos)
 }
 
-func NewMedicalRecordHandler(recordService *service.MedicalRecordService) *MedicalRecordHandler {
+func NewMedicalRecordHandler(recordService *service.MedicalRecordService, exportService *service.ExportService) *MedicalRecordHandler {
 	return &MedicalRecordHandler{
 		recordService: recordService,
+		exportService: exportService,
 	}
 }
 
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type OutboxHandler struct {
+	outboxDispatcher *service.OutboxDispatcher
+}
+
+func NewOutboxHandler(outboxDispatcher *service.OutboxDispatcher) *OutboxHandler {
+	return &OutboxHandler{
+		outboxDispatcher: outboxDispatcher,
+	}
+}
+
+// GetDead handles the request to get the dead outbox messages.
+//
+//	@Summary		Get dead outbox messages
+//	@Description	Retrieve the most recent events that could not be dispatched after the maximum number of attempts, with their last error. Their activity, stream, webhook, HL7 and SATUSEHAT deliveries are on hold until requeued.
+//	@Tags			Outbox
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.OutboxMessageEntity}	"List of dead outbox messages"
+//	@Failure		500	{object}	utils.ErrorResponse										"Failed to retrieve dead outbox messages"
+//	@Router			/outbox/dead [get]
+func (h *OutboxHandler) GetDead(c *fiber.Ctx) error {
+	msgs, err := h.outboxDispatcher.GetDead(c.Context())
+	if err != nil {
+		log.Printf("Error getting dead outbox messages: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve dead outbox messages", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of dead outbox messages", msgs)
+}
+
+// Requeue handles the request to requeue a dead outbox message.
+//
+//	@Summary		Requeue a dead outbox message
+//	@Description	Put a dead outbox message back in the queue with a fresh attempt budget.
+//	@Tags			Outbox
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string					true	"Outbox message (event) ID"
+//	@Success		204	{object}	utils.SuccessResponse	"Outbox message requeued"
+//	@Failure		500	{object}	utils.ErrorResponse		"Failed to requeue outbox message"
+//	@Router			/outbox/{id}/requeue [post]
+func (h *OutboxHandler) Requeue(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if err := h.outboxDispatcher.Requeue(c.Context(), id); err != nil {
+		log.Printf("Error requeuing outbox message %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Outbox message requeued", nil)
+}
@@ -13,26 +13,38 @@ import (
 
 type PatientHandler struct {
 	patientService *service.PatientService
+	exportService  *service.ExportService
 }
 
-func NewPatientHandler(patientService *service.PatientService) *PatientHandler {
+func NewPatientHandler(patientService *service.PatientService, exportService *service.ExportService) *PatientHandler {
 	return &PatientHandler{
 		patientService: patientService,
+		exportService:  exportService,
 	}
 }
 
 // GetAll handles the request to get all patients.
 //
 //	@Summary		Get all patients
-//	@Description	Retrieve a list of all registered patients.
+//	@Description	Retrieve a list of all registered patients, or download it as CSV or Excel.
 //	@Tags			Patients
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.PatientDTO}	"List of patients"
-//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve patients"
+//	@Param			format	query		string											false	"Download as csv or xlsx instead of JSON (or send Accept: text/csv)"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.PatientDTO}	"List of patients"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid export format"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to retrieve patients"
 //	@Router			/patients [get]
 func (h *PatientHandler) GetAll(c *fiber.Ctx) error {
+	format, asFile, err := exportFormat(c)
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid export format", err.Error())
+	}
+	if asFile {
+		return sendExport(c, h.exportService, h.exportService.PatientExport(), format)
+	}
+
 	patients, err := h.patientService.GetAll(c.Context())
 	if err != nil {
 		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
@@ -0,0 +1,392 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PharmacyHandler struct {
+	pharmacyService *service.PharmacyService
+}
+
+func NewPharmacyHandler(pharmacyService *service.PharmacyService) *PharmacyHandler {
+	return &PharmacyHandler{
+		pharmacyService: pharmacyService,
+	}
+}
+
+// GetAllItems handles the request to get the pharmacy item catalogue.
+//
+//	@Summary		Get all pharmacy items
+//	@Description	Retrieve the catalogue of drugs and consumables.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.PharmacyItemDTO}	"List of pharmacy items"
+//	@Failure		500	{object}	utils.ErrorResponse										"Failed to retrieve pharmacy items"
+//	@Router			/pharmacy/items [get]
+func (h *PharmacyHandler) GetAllItems(c *fiber.Ctx) error {
+	items, err := h.pharmacyService.GetAllItems(c.Context())
+	if err != nil {
+		log.Printf("Error getting pharmacy items: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve pharmacy items", err.Error())
+	}
+
+	var itemDTOs []domain.PharmacyItemDTO
+	for _, item := range items {
+		itemDTOs = append(itemDTOs, item.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of pharmacy items", itemDTOs)
+}
+
+// GetItemByID handles the request to get a pharmacy item by ID.
+//
+//	@Summary		Get pharmacy item by ID
+//	@Description	Retrieve a single pharmacy item.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Pharmacy item ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.PharmacyItemDTO}	"Pharmacy item retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse								"Pharmacy item not found"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve pharmacy item"
+//	@Router			/pharmacy/items/{id} [get]
+func (h *PharmacyHandler) GetItemByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	item, err := h.pharmacyService.GetItemByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting pharmacy item %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve pharmacy item", err.Error())
+	}
+
+	if item == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Pharmacy item not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Pharmacy item retrieved successfully", item.ToDTO())
+}
+
+// CreateItem handles the request to create a pharmacy item.
+//
+//	@Summary		Create a new pharmacy item
+//	@Description	Add a drug or consumable to the pharmacy catalogue.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			item	body		domain.PharmacyItemRequest						true	"Pharmacy item object to be created"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Pharmacy item created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create pharmacy item"
+//	@Router			/pharmacy/items [post]
+func (h *PharmacyHandler) CreateItem(c *fiber.Ctx) error {
+	var req domain.PharmacyItemRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.pharmacyService.CreateItem(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating pharmacy item: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Pharmacy item created successfully", fiber.Map{"id": id})
+}
+
+// UpdateItem handles the request to update a pharmacy item.
+//
+//	@Summary		Update an existing pharmacy item
+//	@Description	Update a pharmacy item, including its reorder level.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string						true	"Pharmacy item ID"
+//	@Param			item	body		domain.PharmacyItemRequest	true	"Pharmacy item object with updated fields"
+//	@Success		204		{object}	utils.SuccessResponse		"Pharmacy item updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse			"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse			"Failed to update pharmacy item"
+//	@Router			/pharmacy/items/{id} [put]
+func (h *PharmacyHandler) UpdateItem(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.PharmacyItemRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.pharmacyService.UpdateItem(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating pharmacy item: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Pharmacy item updated successfully", nil)
+}
+
+// GetStockLevels handles the request to get stock levels.
+//
+//	@Summary		Get stock levels
+//	@Description	Retrieve the unexpired stock of every item per location, optionally for a single location.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			location	query		string											false	"Stock location"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.StockLevel}	"Stock levels"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to retrieve stock levels"
+//	@Router			/pharmacy/stock [get]
+func (h *PharmacyHandler) GetStockLevels(c *fiber.Ctx) error {
+	levels, err := h.pharmacyService.GetStockLevels(c.Context(), c.Query("location"))
+	if err != nil {
+		log.Printf("Error getting stock levels: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve stock levels", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Stock levels", levels)
+}
+
+// GetBatches handles the request to get stock batches.
+//
+//	@Summary		Get stock batches
+//	@Description	Retrieve batches that still hold stock, ordered by expiry date.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			itemId		query		string													false	"Pharmacy item ID"
+//	@Param			location	query		string													false	"Stock location"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.StockBatchEntity}	"List of stock batches"
+//	@Failure		500			{object}	utils.ErrorResponse										"Failed to retrieve stock batches"
+//	@Router			/pharmacy/batches [get]
+func (h *PharmacyHandler) GetBatches(c *fiber.Ctx) error {
+	batches, err := h.pharmacyService.GetBatches(c.Context(), c.Query("itemId"), c.Query("location"))
+	if err != nil {
+		log.Printf("Error getting stock batches: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve stock batches", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of stock batches", batches)
+}
+
+// ReceiveStock handles the request to book goods received.
+//
+//	@Summary		Receive stock
+//	@Description	Book goods received into a batch with its expiry date. Receiving an existing batch number at the same location tops it up.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			stock	body		domain.ReceiveStockRequest						true	"Goods received"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Stock received successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to receive stock"
+//	@Router			/pharmacy/stock/receive [post]
+func (h *PharmacyHandler) ReceiveStock(c *fiber.Ctx) error {
+	var req domain.ReceiveStockRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.pharmacyService.ReceiveStock(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error receiving stock: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Stock received successfully", fiber.Map{"id": id})
+}
+
+// Dispense handles the request to dispense items to a patient.
+//
+//	@Summary		Dispense items
+//	@Description	Dispense items to a patient from the unexpired batches at a location, first-expiry-first-out. Nothing is dispensed if any item is short.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			dispense	body		domain.DispenseRequest										true	"Items to dispense"
+//	@Success		201			{object}	utils.SuccessResponse{data=[]domain.StockMovementEntity}	"Items dispensed successfully"
+//	@Failure		400			{object}	utils.ErrorResponse											"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse											"Failed to dispense items"
+//	@Router			/pharmacy/dispense [post]
+func (h *PharmacyHandler) Dispense(c *fiber.Ctx) error {
+	var req domain.DispenseRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	movements, err := h.pharmacyService.Dispense(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error dispensing items: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Items dispensed successfully", movements)
+}
+
+// AdjustStock handles the request to adjust the quantity of a batch.
+//
+//	@Summary		Adjust stock
+//	@Description	Apply a manual correction to a batch, e.g. after a stock count. A reason is required.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			adjustment	body		domain.AdjustStockRequest	true	"Stock adjustment"
+//	@Success		204			{object}	utils.SuccessResponse		"Stock adjusted successfully"
+//	@Failure		400			{object}	utils.ErrorResponse			"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse			"Failed to adjust stock"
+//	@Router			/pharmacy/stock/adjust [post]
+func (h *PharmacyHandler) AdjustStock(c *fiber.Ctx) error {
+	var req domain.AdjustStockRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.pharmacyService.AdjustStock(c.Context(), &req, creatorID); err != nil {
+		log.Printf("Error adjusting stock: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Stock adjusted successfully", nil)
+}
+
+// ExpireBatch handles the request to write off an expired batch.
+//
+//	@Summary		Write off an expired batch
+//	@Description	Write off the remaining quantity of a batch as expired.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string					true	"Stock batch ID"
+//	@Success		204	{object}	utils.SuccessResponse	"Batch written off successfully"
+//	@Failure		500	{object}	utils.ErrorResponse		"Failed to write off batch"
+//	@Router			/pharmacy/batches/{id}/expire [post]
+func (h *PharmacyHandler) ExpireBatch(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.pharmacyService.ExpireBatch(c.Context(), id, creatorID); err != nil {
+		log.Printf("Error writing off batch %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Batch written off successfully", nil)
+}
+
+// GetMovements handles the request to get the stock movement ledger.
+//
+//	@Summary		Get stock movements
+//	@Description	Retrieve the most recent stock movements, optionally filtered by item and type.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			itemId	query		string														false	"Pharmacy item ID"
+//	@Param			type	query		string														false	"Movement type (Received, Dispensed, Adjusted, Expired)"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.StockMovementEntity}	"List of stock movements"
+//	@Failure		400		{object}	utils.ErrorResponse											"Invalid filter"
+//	@Router			/pharmacy/movements [get]
+func (h *PharmacyHandler) GetMovements(c *fiber.Ctx) error {
+	movementType := domain.StockMovementType(c.Query("type"))
+
+	movements, err := h.pharmacyService.GetMovements(c.Context(), c.Query("itemId"), movementType)
+	if err != nil {
+		log.Printf("Error getting stock movements: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve stock movements", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of stock movements", movements)
+}
+
+// GetAlerts handles the request to get pharmacy alerts.
+//
+//	@Summary		Get stock alerts
+//	@Description	Retrieve low-stock levels and batches that are expired or close to expiry.
+//	@Tags			Pharmacy
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			location	query		string											false	"Stock location"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.StockAlert}	"Stock alerts"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to retrieve stock alerts"
+//	@Router			/pharmacy/alerts [get]
+func (h *PharmacyHandler) GetAlerts(c *fiber.Ctx) error {
+	alerts, err := h.pharmacyService.GetAlerts(c.Context(), c.Query("location"))
+	if err != nil {
+		log.Printf("Error getting stock alerts: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve stock alerts", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Stock alerts", alerts)
+}
@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QueueHandler struct {
+	queueService *service.QueueService
+}
+
+func NewQueueHandler(queueService *service.QueueService) *QueueHandler {
+	return &QueueHandler{
+		queueService: queueService,
+	}
+}
+
+// GetQueues handles the request to get the queues of a day.
+//
+//	@Summary		Get queues
+//	@Description	Retrieve the outpatient queues of a day, optionally filtered by doctor or polyclinic.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			date		query		string											false	"Date (YYYY-MM-DD), defaults to today"
+//	@Param			doctorId	query		string											false	"Doctor ID"
+//	@Param			polyclinic	query		string											false	"Polyclinic name"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.QueueEntity}	"List of queues"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid filter"
+//	@Router			/queues [get]
+func (h *QueueHandler) GetQueues(c *fiber.Ctx) error {
+	queues, err := h.queueService.GetQueues(c.Context(), c.Query("date"), c.Query("doctorId"), c.Query("polyclinic"))
+	if err != nil {
+		log.Printf("Error getting queues: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve queues", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of queues", queues)
+}
+
+// GetQueueDetail handles the request to get a queue with its tickets.
+//
+//	@Summary		Get queue by ID
+//	@Description	Retrieve a queue with its tickets in calling order and the estimated wait of each waiting ticket.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string												true	"Queue ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.QueueDetailResponse}	"Queue retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse									"Queue not found"
+//	@Router			/queues/{id} [get]
+func (h *QueueHandler) GetQueueDetail(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	detail, err := h.queueService.GetQueueDetail(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting queue %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Queue not found", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Queue retrieved successfully", detail)
+}
+
+// GetBoard handles the request to get the display board of a queue.
+//
+//	@Summary		Get queue display board
+//	@Description	Retrieve what the waiting-room display shows: tickets being called and the next ones up, without patient names. Subscribe to the QUEUE topic on /events for live updates.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string												true	"Queue ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.QueueBoardResponse}	"Queue board retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse									"Queue not found"
+//	@Router			/queues/{id}/board [get]
+func (h *QueueHandler) GetBoard(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	board, err := h.queueService.GetBoard(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting board of queue %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Queue not found", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Queue board retrieved successfully", board)
+}
+
+// IssueWalkIn handles the request to issue a walk-in ticket.
+//
+//	@Summary		Issue a walk-in ticket
+//	@Description	Issue a ticket in today's queue of a doctor or polyclinic for a walk-in patient. Unregistered patients can be queued by name.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			ticket	body		domain.WalkInTicketRequest								true	"Walk-in ticket"
+//	@Success		201		{object}	utils.SuccessResponse{data=domain.QueueTicketEntity}	"Ticket issued successfully"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse										"Failed to issue ticket"
+//	@Router			/queues/tickets [post]
+func (h *QueueHandler) IssueWalkIn(c *fiber.Ctx) error {
+	var req domain.WalkInTicketRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	issuerID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	ticket, err := h.queueService.IssueWalkIn(c.Context(), &req, issuerID)
+	if err != nil {
+		log.Printf("Error issuing walk-in ticket: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Ticket issued successfully", ticket)
+}
+
+// CheckIn handles the request to check in a scheduled appointment.
+//
+//	@Summary		Check in an appointment
+//	@Description	Put a patient with an appointment today into the doctor's queue. Checking in again returns the existing ticket.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			checkIn	body		domain.CheckInRequest									true	"Appointment to check in"
+//	@Success		201		{object}	utils.SuccessResponse{data=domain.QueueTicketEntity}	"Patient checked in successfully"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse										"Failed to check in"
+//	@Router			/queues/check-in [post]
+func (h *QueueHandler) CheckIn(c *fiber.Ctx) error {
+	var req domain.CheckInRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	issuerID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	ticket, err := h.queueService.CheckIn(c.Context(), &req, issuerID)
+	if err != nil {
+		log.Printf("Error checking in appointment %s: %v", req.AppointmentID, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Patient checked in successfully", ticket)
+}
+
+// CallNext handles the request to call the next ticket of a queue.
+//
+//	@Summary		Call the next patient
+//	@Description	Call the next waiting ticket, by priority and then ticket number, to the counter. The ticket previously called at the same counter is completed.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string													true	"Queue ID"
+//	@Param			call	body		domain.CallNextRequest									false	"Counter or room the patient is called to"
+//	@Success		200		{object}	utils.SuccessResponse{data=domain.QueueTicketEntity}	"Ticket called"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid request body or nobody waiting"
+//	@Router			/queues/{id}/call-next [post]
+func (h *QueueHandler) CallNext(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.CallNextRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			log.Printf("Error parsing request body: %v", err)
+			return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+		}
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	ticket, err := h.queueService.CallNext(c.Context(), id, req.Counter, updaterID)
+	if err != nil {
+		log.Printf("Error calling next ticket of queue %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Ticket called", ticket)
+}
+
+// SkipTicket handles the request to skip a ticket.
+//
+//	@Summary		Skip a ticket
+//	@Description	Take a ticket whose patient did not answer the call out of the queue. It can be recalled later.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string													true	"Ticket ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.QueueTicketEntity}	"Ticket skipped"
+//	@Failure		400	{object}	utils.ErrorResponse										"Ticket cannot be skipped"
+//	@Router			/queues/tickets/{id}/skip [put]
+func (h *QueueHandler) SkipTicket(c *fiber.Ctx) error {
+	return h.transition(c, "skipped", h.queueService.Skip)
+}
+
+// RecallTicket handles the request to recall a ticket.
+//
+//	@Summary		Recall a ticket
+//	@Description	Call a called or skipped ticket again, e.g. when the patient turns up late.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string													true	"Ticket ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.QueueTicketEntity}	"Ticket recalled"
+//	@Failure		400	{object}	utils.ErrorResponse										"Ticket cannot be recalled"
+//	@Router			/queues/tickets/{id}/recall [put]
+func (h *QueueHandler) RecallTicket(c *fiber.Ctx) error {
+	return h.transition(c, "recalled", h.queueService.Recall)
+}
+
+// CompleteTicket handles the request to complete a ticket.
+//
+//	@Summary		Complete a ticket
+//	@Description	Mark a called ticket as served.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string													true	"Ticket ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.QueueTicketEntity}	"Ticket completed"
+//	@Failure		400	{object}	utils.ErrorResponse										"Ticket cannot be completed"
+//	@Router			/queues/tickets/{id}/complete [put]
+func (h *QueueHandler) CompleteTicket(c *fiber.Ctx) error {
+	return h.transition(c, "completed", h.queueService.Complete)
+}
+
+func (h *QueueHandler) transition(c *fiber.Ctx, verb string, action func(ctx context.Context, ticketID string, updaterID primitive.ObjectID) (*domain.QueueTicketEntity, error)) error {
+	id := c.Params("id")
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	ticket, err := action(c.Context(), id, updaterID)
+	if err != nil {
+		log.Printf("Error updating ticket %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Ticket "+verb, ticket)
+}
@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReferralHandler struct {
+	referralService *service.ReferralService
+}
+
+func NewReferralHandler(referralService *service.ReferralService) *ReferralHandler {
+	return &ReferralHandler{
+		referralService: referralService,
+	}
+}
+
+// GetAll handles the request to get referrals.
+//
+//	@Summary		Get referrals
+//	@Description	Retrieve referrals, newest first, optionally filtered by patient, referring doctor and status.
+//	@Tags			Referrals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			patientId		query		string												false	"Patient ID"
+//	@Param			fromDoctorId	query		string												false	"Referring doctor ID"
+//	@Param			status			query		string												false	"Referral status (Sent, Accepted, Declined, Completed)"
+//	@Success		200				{object}	utils.SuccessResponse{data=[]domain.ReferralEntity}	"List of referrals"
+//	@Failure		400				{object}	utils.ErrorResponse									"Invalid filter"
+//	@Router			/referrals [get]
+func (h *ReferralHandler) GetAll(c *fiber.Ctx) error {
+	status := domain.ReferralStatus(c.Query("status"))
+
+	referrals, err := h.referralService.GetAll(c.Context(), c.Query("patientId"), c.Query("fromDoctorId"), status)
+	if err != nil {
+		log.Printf("Error getting referrals: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve referrals", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of referrals", referrals)
+}
+
+// GetInbox handles the request to get the referral inbox of a specialty or doctor.
+//
+//	@Summary		Get referral inbox
+//	@Description	Retrieve the referrals waiting on a specialty, or on a doctor together with the untaken referrals of their specialty. Most urgent first, then oldest first. Without a status only open referrals (Sent, Accepted) are returned.
+//	@Tags			Referrals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			specialty	query		string													false	"Specialty, e.g. Cardiology"
+//	@Param			doctorId	query		string													false	"Receiving doctor ID"
+//	@Param			status		query		string													false	"Referral status (Sent, Accepted, Declined, Completed)"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.ReferralInboxItem}	"Referral inbox"
+//	@Failure		400			{object}	utils.ErrorResponse										"Invalid filter"
+//	@Router			/referrals/inbox [get]
+func (h *ReferralHandler) GetInbox(c *fiber.Ctx) error {
+	status := domain.ReferralStatus(c.Query("status"))
+
+	items, err := h.referralService.GetInbox(c.Context(), c.Query("specialty"), c.Query("doctorId"), status)
+	if err != nil {
+		log.Printf("Error getting referral inbox: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve referral inbox", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Referral inbox", items)
+}
+
+// GetByID handles the request to get a referral by its ID.
+//
+//	@Summary		Get referral by ID
+//	@Description	Retrieve a single referral by its ID.
+//	@Tags			Referrals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Referral ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ReferralEntity}	"Referral retrieved successfully"
+//	@Failure		400	{object}	utils.ErrorResponse								"Invalid referral ID"
+//	@Failure		404	{object}	utils.ErrorResponse								"Referral not found"
+//	@Router			/referrals/{id} [get]
+func (h *ReferralHandler) GetByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	referral, err := h.referralService.GetByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting referral %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve referral", err.Error())
+	}
+	if referral == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Referral not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Referral retrieved successfully", referral)
+}
+
+// Create handles the request to refer a patient.
+//
+//	@Summary		Refer a patient
+//	@Description	Refer a patient to a doctor or to a specialty, with the reason, urgency and supporting medical records.
+//	@Tags			Referrals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			referral	body		domain.CreateReferralRequest						true	"Referral"
+//	@Success		201			{object}	utils.SuccessResponse{data=domain.ReferralEntity}	"Referral sent successfully"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to send referral"
+//	@Router			/referrals [post]
+func (h *ReferralHandler) Create(c *fiber.Ctx) error {
+	var req domain.CreateReferralRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	referral, err := h.referralService.Create(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating referral: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Referral sent successfully", referral)
+}
+
+// Respond handles the request to accept or decline a referral.
+//
+//	@Summary		Accept or decline a referral
+//	@Description	Accept or decline a sent referral. Declining requires notes. A specialty referral can be assigned to the accepting doctor.
+//	@Tags			Referrals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string												true	"Referral ID"
+//	@Param			response	body		domain.RespondReferralRequest						true	"Response"
+//	@Success		200			{object}	utils.SuccessResponse{data=domain.ReferralEntity}	"Referral updated successfully"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to respond to referral"
+//	@Router			/referrals/{id}/respond [put]
+func (h *ReferralHandler) Respond(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.RespondReferralRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	responderID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	referral, err := h.referralService.Respond(c.Context(), id, &req, responderID)
+	if err != nil {
+		log.Printf("Error responding to referral %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Referral updated successfully", referral)
+}
+
+// LinkAppointment handles the request to link the appointment booked for a referral.
+//
+//	@Summary		Link referral appointment
+//	@Description	Record the appointment booked for an accepted referral. The appointment must be for the referred patient with the referred doctor or a doctor of the referred specialty.
+//	@Tags			Referrals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string												true	"Referral ID"
+//	@Param			appointment	body		domain.LinkReferralAppointmentRequest				true	"Appointment"
+//	@Success		200			{object}	utils.SuccessResponse{data=domain.ReferralEntity}	"Appointment linked successfully"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to link appointment"
+//	@Router			/referrals/{id}/appointment [put]
+func (h *ReferralHandler) LinkAppointment(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.LinkReferralAppointmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	referral, err := h.referralService.LinkAppointment(c.Context(), id, &req, updaterID)
+	if err != nil {
+		log.Printf("Error linking appointment to referral %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Appointment linked successfully", referral)
+}
+
+// Complete handles the request to complete a referral.
+//
+//	@Summary		Complete a referral
+//	@Description	Close an accepted referral once the patient has been seen.
+//	@Tags			Referrals
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string											true	"Referral ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ReferralEntity}	"Referral completed successfully"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to complete referral"
+//	@Router			/referrals/{id}/complete [put]
+func (h *ReferralHandler) Complete(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	referral, err := h.referralService.Complete(c.Context(), id, updaterID)
+	if err != nil {
+		log.Printf("Error completing referral %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Referral completed successfully", referral)
+}
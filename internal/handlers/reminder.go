@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReminderHandler struct {
+	reminderService *service.ReminderService
+}
+
+func NewReminderHandler(reminderService *service.ReminderService) *ReminderHandler {
+	return &ReminderHandler{
+		reminderService: reminderService,
+	}
+}
+
+// GetReminders handles the request to get the appointment reminder log.
+//
+//	@Summary		Get appointment reminders
+//	@Description	Retrieve the most recent appointment reminders and their delivery outcome.
+//	@Tags			Reminders
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			appointmentId	query		string												false	"Appointment ID"
+//	@Param			patientId		query		string												false	"Patient ID"
+//	@Param			status			query		string												false	"Reminder status (Pending, Sent, Failed, Skipped)"
+//	@Success		200				{object}	utils.SuccessResponse{data=[]domain.ReminderEntity}	"List of reminders"
+//	@Failure		400				{object}	utils.ErrorResponse									"Invalid filter"
+//	@Router			/reminders [get]
+func (h *ReminderHandler) GetReminders(c *fiber.Ctx) error {
+	status := domain.ReminderStatus(c.Query("status"))
+
+	reminders, err := h.reminderService.GetReminders(c.Context(), c.Query("appointmentId"), c.Query("patientId"), status)
+	if err != nil {
+		log.Printf("Error getting reminders: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve reminders", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of reminders", reminders)
+}
+
+// GetPreference handles the request to get the reminder preferences of a patient.
+//
+//	@Summary		Get patient reminder preferences
+//	@Description	Retrieve the reminder opt-out, language and channels of a patient. Hospital defaults are returned when the patient has not set any.
+//	@Tags			Reminders
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string														true	"Patient ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ReminderPreferenceEntity}	"Reminder preferences retrieved successfully"
+//	@Failure		400	{object}	utils.ErrorResponse											"Invalid patient ID"
+//	@Router			/patients/{id}/reminder-preferences [get]
+func (h *ReminderHandler) GetPreference(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	pref, err := h.reminderService.GetPreference(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting reminder preferences for patient %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve reminder preferences", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Reminder preferences retrieved successfully", pref)
+}
+
+// UpdatePreference handles the request to update the reminder preferences of a patient.
+//
+//	@Summary		Update patient reminder preferences
+//	@Description	Opt a patient out of appointment reminders, or choose their language (id, en) and channels (email, sms, whatsapp). Empty values fall back to the hospital defaults.
+//	@Tags			Reminders
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string								true	"Patient ID"
+//	@Param			preference	body		domain.ReminderPreferenceRequest	true	"Reminder preferences"
+//	@Success		204			{object}	utils.SuccessResponse				"Reminder preferences updated successfully"
+//	@Failure		400			{object}	utils.ErrorResponse					"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse					"Failed to update reminder preferences"
+//	@Router			/patients/{id}/reminder-preferences [put]
+func (h *ReminderHandler) UpdatePreference(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.ReminderPreferenceRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.reminderService.UpdatePreference(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating reminder preferences for patient %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Reminder preferences updated successfully", nil)
+}
@@ -0,0 +1,399 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RosterHandler struct {
+	rosterService *service.RosterService
+}
+
+func NewRosterHandler(rosterService *service.RosterService) *RosterHandler {
+	return &RosterHandler{
+		rosterService: rosterService,
+	}
+}
+
+// GetAllTemplates handles the request to get all shift templates.
+//
+//	@Summary		Get all shift templates
+//	@Description	Retrieve all shift templates, in order of start time.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.ShiftTemplateEntity}	"List of shift templates"
+//	@Failure		500	{object}	utils.ErrorResponse											"Failed to retrieve shift templates"
+//	@Router			/roster/templates [get]
+func (h *RosterHandler) GetAllTemplates(c *fiber.Ctx) error {
+	templates, err := h.rosterService.GetAllTemplates(c.Context())
+	if err != nil {
+		log.Printf("Error getting shift templates: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve shift templates", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of shift templates", templates)
+}
+
+// GetTemplateByID handles the request to get a shift template by ID.
+//
+//	@Summary		Get shift template by ID
+//	@Description	Retrieve a single shift template by its ID.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string													true	"Shift template ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ShiftTemplateEntity}	"Shift template retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse										"Shift template not found"
+//	@Failure		500	{object}	utils.ErrorResponse										"Failed to retrieve shift template"
+//	@Router			/roster/templates/{id} [get]
+func (h *RosterHandler) GetTemplateByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	template, err := h.rosterService.GetTemplateByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting shift template %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve shift template", err.Error())
+	}
+
+	if template == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Shift template not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Shift template retrieved successfully", template)
+}
+
+// CreateTemplate handles the request to create a shift template.
+//
+//	@Summary		Create a shift template
+//	@Description	Create a reusable shift, with its hours, the days it runs on and the staff per role it needs.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			template	body		domain.ShiftTemplateRequest						true	"Shift template to be created"
+//	@Success		201			{object}	utils.SuccessResponse{data=object{id=string}}	"Shift template created successfully"
+//	@Failure		400			{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse								"Failed to create shift template"
+//	@Router			/roster/templates [post]
+func (h *RosterHandler) CreateTemplate(c *fiber.Ctx) error {
+	var req domain.ShiftTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.rosterService.CreateTemplate(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating shift template: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Shift template created successfully", fiber.Map{"id": id})
+}
+
+// UpdateTemplate handles the request to update a shift template.
+//
+//	@Summary		Update a shift template
+//	@Description	Update a shift template. Shifts already rostered keep their times.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string						true	"Shift template ID"
+//	@Param			template	body		domain.ShiftTemplateRequest	true	"Shift template with updated fields"
+//	@Success		204			{object}	utils.SuccessResponse		"Shift template updated successfully"
+//	@Failure		400			{object}	utils.ErrorResponse			"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse			"Failed to update shift template"
+//	@Router			/roster/templates/{id} [put]
+func (h *RosterHandler) UpdateTemplate(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.ShiftTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.rosterService.UpdateTemplate(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating shift template: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Shift template updated successfully", nil)
+}
+
+// GetAssignments handles the request to get the roster.
+//
+//	@Summary		Get the roster
+//	@Description	Retrieve the scheduled shifts dated from..to inclusive, optionally only those of a user or role. Both dates default to today.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			from	query		string														false	"First date (YYYY-MM-DD)"
+//	@Param			to		query		string														false	"Last date (YYYY-MM-DD)"
+//	@Param			userId	query		string														false	"User ID"
+//	@Param			role	query		string														false	"Role, e.g. Nurse"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.ShiftAssignmentEntity}	"Roster"
+//	@Failure		400		{object}	utils.ErrorResponse											"Invalid filter"
+//	@Router			/roster/assignments [get]
+func (h *RosterHandler) GetAssignments(c *fiber.Ctx) error {
+	role := domain.Role(c.Query("role"))
+
+	assignments, err := h.rosterService.GetAssignments(c.Context(), c.Query("from"), c.Query("to"), c.Query("userId"), role)
+	if err != nil {
+		log.Printf("Error getting roster: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve roster", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Roster", assignments)
+}
+
+// CreateAssignment handles the request to roster a staff member on a shift.
+//
+//	@Summary		Roster a shift
+//	@Description	Roster a staff member on a shift template for a day, optionally as on-call. The shift must run on that day and must not overlap another of their shifts.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			assignment	body		domain.CreateShiftAssignmentRequest							true	"Shift assignment"
+//	@Success		201			{object}	utils.SuccessResponse{data=domain.ShiftAssignmentEntity}	"Shift assigned successfully"
+//	@Failure		400			{object}	utils.ErrorResponse											"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse											"Failed to assign shift"
+//	@Router			/roster/assignments [post]
+func (h *RosterHandler) CreateAssignment(c *fiber.Ctx) error {
+	var req domain.CreateShiftAssignmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	assignment, err := h.rosterService.CreateAssignment(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error assigning shift: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Shift assigned successfully", assignment)
+}
+
+// CancelAssignment handles the request to cancel a rostered shift.
+//
+//	@Summary		Cancel a rostered shift
+//	@Description	Take a staff member off a shift. Shifts with a pending swap request cannot be cancelled.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string														true	"Shift assignment ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.ShiftAssignmentEntity}	"Shift cancelled successfully"
+//	@Failure		500	{object}	utils.ErrorResponse											"Failed to cancel shift"
+//	@Router			/roster/assignments/{id}/cancel [put]
+func (h *RosterHandler) CancelAssignment(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	assignment, err := h.rosterService.CancelAssignment(c.Context(), id, updaterID)
+	if err != nil {
+		log.Printf("Error cancelling shift %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Shift cancelled successfully", assignment)
+}
+
+// GetSwaps handles the request to get shift swap requests.
+//
+//	@Summary		Get shift swaps
+//	@Description	Retrieve shift swap requests, newest first, optionally filtered by status and by a user on either side.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			status	query		string													false	"Swap status (Pending, Approved, Rejected, Cancelled)"
+//	@Param			userId	query		string													false	"User ID"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.ShiftSwapEntity}	"List of shift swaps"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid filter"
+//	@Router			/roster/swaps [get]
+func (h *RosterHandler) GetSwaps(c *fiber.Ctx) error {
+	status := domain.ShiftSwapStatus(c.Query("status"))
+
+	swaps, err := h.rosterService.GetSwaps(c.Context(), status, c.Query("userId"))
+	if err != nil {
+		log.Printf("Error getting shift swaps: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve shift swaps", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of shift swaps", swaps)
+}
+
+// RequestSwap handles the request to swap a shift.
+//
+//	@Summary		Request a shift swap
+//	@Description	Ask to hand a shift over to a colleague of the same role, or to trade it for one of theirs. Staff can only offer their own shifts. The swap takes effect once approved.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			swap	body		domain.CreateShiftSwapRequest						true	"Shift swap"
+//	@Success		201		{object}	utils.SuccessResponse{data=domain.ShiftSwapEntity}	"Shift swap requested successfully"
+//	@Failure		400		{object}	utils.ErrorResponse									"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse									"Failed to request shift swap"
+//	@Router			/roster/swaps [post]
+func (h *RosterHandler) RequestSwap(c *fiber.Ctx) error {
+	var req domain.CreateShiftSwapRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	requesterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+	role, _ := c.Locals("userRole").(string)
+
+	swap, err := h.rosterService.RequestSwap(c.Context(), &req, requesterID, domain.Role(role))
+	if err != nil {
+		log.Printf("Error requesting shift swap: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Shift swap requested successfully", swap)
+}
+
+// DecideSwap handles the request to approve or reject a shift swap.
+//
+//	@Summary		Approve or reject a shift swap
+//	@Description	Decide a pending shift swap. Approving moves the shift to the colleague, and theirs back for a trade, as long as nobody ends up with overlapping shifts.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string												true	"Shift swap ID"
+//	@Param			decision	body		domain.DecideShiftSwapRequest						true	"Decision"
+//	@Success		200			{object}	utils.SuccessResponse{data=domain.ShiftSwapEntity}	"Shift swap decided successfully"
+//	@Failure		400			{object}	utils.ErrorResponse									"Invalid request body or validation failed"
+//	@Failure		500			{object}	utils.ErrorResponse									"Failed to decide shift swap"
+//	@Router			/roster/swaps/{id}/decide [put]
+func (h *RosterHandler) DecideSwap(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.DecideShiftSwapRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	deciderID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	swap, err := h.rosterService.DecideSwap(c.Context(), id, &req, deciderID)
+	if err != nil {
+		log.Printf("Error deciding shift swap %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Shift swap decided successfully", swap)
+}
+
+// GetCoverage handles the request to check the roster for coverage gaps.
+//
+//	@Summary		Get roster coverage
+//	@Description	Compare the shifts rostered from..to against the staff per role each active shift template needs. On-call shifts do not count. Both dates default to today.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			from		query		string												false	"First date (YYYY-MM-DD)"
+//	@Param			to			query		string												false	"Last date (YYYY-MM-DD)"
+//	@Param			gapsOnly	query		bool												false	"Only return understaffed slots"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.CoverageSlot}	"Roster coverage"
+//	@Failure		400			{object}	utils.ErrorResponse									"Invalid date range"
+//	@Router			/roster/coverage [get]
+func (h *RosterHandler) GetCoverage(c *fiber.Ctx) error {
+	slots, err := h.rosterService.GetCoverage(c.Context(), c.Query("from"), c.Query("to"), c.QueryBool("gapsOnly"))
+	if err != nil {
+		log.Printf("Error getting roster coverage: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve roster coverage", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Roster coverage", slots)
+}
+
+// GetOnCall handles the request to get who is on call.
+//
+//	@Summary		Get on-call staff
+//	@Description	Retrieve the staff on call at a time, or now.
+//	@Tags			Roster
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			at	query		string												false	"Time (RFC 3339), defaults to now"
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.OnCallEntry}	"On-call staff"
+//	@Failure		400	{object}	utils.ErrorResponse									"Invalid time"
+//	@Router			/roster/on-call [get]
+func (h *RosterHandler) GetOnCall(c *fiber.Ctx) error {
+	entries, err := h.rosterService.GetOnCall(c.Context(), c.Query("at"))
+	if err != nil {
+		log.Printf("Error getting on-call staff: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve on-call staff", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "On-call staff", entries)
+}
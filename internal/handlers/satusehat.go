@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type SatuSehatHandler struct {
+	satusehatService *service.SatuSehatService
+}
+
+func NewSatuSehatHandler(satusehatService *service.SatuSehatService) *SatuSehatHandler {
+	return &SatuSehatHandler{
+		satusehatService: satusehatService,
+	}
+}
+
+// GetSyncs handles the request to get the SATUSEHAT sync log.
+//
+//	@Summary		Get SATUSEHAT syncs
+//	@Description	Retrieve the most recently updated submissions of completed appointments (as Encounters) and medical record diagnoses (as Conditions) to SATUSEHAT. Filter by status DeadLetter to get the records that need attention.
+//	@Tags			SATUSEHAT
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			sourceType	query		string														false	"Source type (appointment, medical_record)"
+//	@Param			status		query		string														false	"Status (Pending, Synced, Failed, DeadLetter, Skipped)"
+//	@Param			patientId	query		string														false	"Patient ID"
+//	@Success		200			{object}	utils.SuccessResponse{data=[]domain.SatuSehatSyncEntity}	"List of SATUSEHAT syncs"
+//	@Failure		400			{object}	utils.ErrorResponse											"Invalid filter"
+//	@Router			/satusehat/syncs [get]
+func (h *SatuSehatHandler) GetSyncs(c *fiber.Ctx) error {
+	sourceType := domain.SatuSehatSourceType(c.Query("sourceType"))
+	status := domain.SatuSehatSyncStatus(c.Query("status"))
+
+	syncs, err := h.satusehatService.GetSyncs(c.Context(), sourceType, status, c.Query("patientId"))
+	if err != nil {
+		log.Printf("Error getting SATUSEHAT syncs: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve SATUSEHAT syncs", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of SATUSEHAT syncs", syncs)
+}
+
+// GetSyncByID handles the request to get a SATUSEHAT sync by ID.
+//
+//	@Summary		Get SATUSEHAT sync by ID
+//	@Description	Retrieve a single sync entry with the payload last sent and its attempt log.
+//	@Tags			SATUSEHAT
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string												true	"SATUSEHAT sync ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.SatuSehatSyncEntity}	"SATUSEHAT sync retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse									"SATUSEHAT sync not found"
+//	@Failure		500	{object}	utils.ErrorResponse									"Failed to retrieve SATUSEHAT sync"
+//	@Router			/satusehat/syncs/{id} [get]
+func (h *SatuSehatHandler) GetSyncByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	sync, err := h.satusehatService.GetSyncByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting SATUSEHAT sync %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve SATUSEHAT sync", err.Error())
+	}
+
+	if sync == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "SATUSEHAT sync not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "SATUSEHAT sync retrieved successfully", sync)
+}
+
+// RetrySync handles the request to retry a SATUSEHAT sync.
+//
+//	@Summary		Retry a SATUSEHAT sync
+//	@Description	Put a failed or dead-lettered record back in the submission queue with a fresh attempt budget, e.g. after registering a missing SATUSEHAT ID.
+//	@Tags			SATUSEHAT
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string					true	"SATUSEHAT sync ID"
+//	@Success		204	{object}	utils.SuccessResponse	"SATUSEHAT sync queued for retry"
+//	@Failure		500	{object}	utils.ErrorResponse		"Failed to retry SATUSEHAT sync"
+//	@Router			/satusehat/syncs/{id}/retry [post]
+func (h *SatuSehatHandler) RetrySync(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if err := h.satusehatService.RetrySync(c.Context(), id); err != nil {
+		log.Printf("Error retrying SATUSEHAT sync %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "SATUSEHAT sync queued for retry", nil)
+}
+
+// Backfill handles the request to queue the records of a period.
+//
+//	@Summary		Backfill SATUSEHAT syncs
+//	@Description	Queue the completed appointments and diagnosed medical records in a period that were never submitted, or whose submission failed or was dead-lettered. Use it for records that predate the integration and after fixing reference mappings.
+//	@Tags			SATUSEHAT
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			from	query		string													false	"First day (YYYY-MM-DD), defaults to 30 days before to"
+//	@Param			to		query		string													false	"Last day (YYYY-MM-DD), defaults to today"
+//	@Success		200		{object}	utils.SuccessResponse{data=domain.SatuSehatBackfillResult}	"Records queued"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid date range or integration not configured"
+//	@Router			/satusehat/syncs/backfill [post]
+func (h *SatuSehatHandler) Backfill(c *fiber.Ctx) error {
+	result, err := h.satusehatService.Backfill(c.Context(), c.Query("from"), c.Query("to"))
+	if err != nil {
+		log.Printf("Error backfilling SATUSEHAT syncs: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to queue records", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Records queued", result)
+}
+
+// GetReconciliation handles the request to get the reconciliation report.
+//
+//	@Summary		Get SATUSEHAT reconciliation report
+//	@Description	Compare the completed appointments and diagnosed medical records in a period with what was submitted to SATUSEHAT: counts per sync status, including records that were never queued, and the list of records that are not synced.
+//	@Tags			SATUSEHAT
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			from	query		string																false	"First day (YYYY-MM-DD), defaults to 30 days before to"
+//	@Param			to		query		string																false	"Last day (YYYY-MM-DD), defaults to today"
+//	@Success		200		{object}	utils.SuccessResponse{data=domain.SatuSehatReconciliationReport}	"SATUSEHAT reconciliation report"
+//	@Failure		400		{object}	utils.ErrorResponse													"Invalid date range"
+//	@Router			/satusehat/reconciliation [get]
+func (h *SatuSehatHandler) GetReconciliation(c *fiber.Ctx) error {
+	report, err := h.satusehatService.Reconcile(c.Context(), c.Query("from"), c.Query("to"))
+	if err != nil {
+		log.Printf("Error getting SATUSEHAT reconciliation report: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve reconciliation report", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "SATUSEHAT reconciliation report", report)
+}
+
+// GetReferences handles the request to get the SATUSEHAT reference mappings.
+//
+//	@Summary		Get SATUSEHAT references
+//	@Description	Retrieve the SATUSEHAT IDs registered for patients (IHS numbers), doctors (practitioner IDs) and clinic rooms (location IDs).
+//	@Tags			SATUSEHAT
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			kind	query		string															false	"Kind (patient, practitioner, location)"
+//	@Success		200		{object}	utils.SuccessResponse{data=[]domain.SatuSehatReferenceEntity}	"List of SATUSEHAT references"
+//	@Failure		400		{object}	utils.ErrorResponse												"Invalid kind"
+//	@Router			/satusehat/references [get]
+func (h *SatuSehatHandler) GetReferences(c *fiber.Ctx) error {
+	refs, err := h.satusehatService.GetReferences(c.Context(), domain.SatuSehatReferenceKind(c.Query("kind")))
+	if err != nil {
+		log.Printf("Error getting SATUSEHAT references: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve SATUSEHAT references", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of SATUSEHAT references", refs)
+}
+
+// SetReference handles the request to register a SATUSEHAT ID.
+//
+//	@Summary		Set a SATUSEHAT reference
+//	@Description	Register the SATUSEHAT ID of a patient, doctor or clinic room, replacing the one registered before.
+//	@Tags			SATUSEHAT
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			kind		path		string													true	"Kind (patient, practitioner, location)"
+//	@Param			localId		path		string													true	"Patient, doctor or clinic room ID"
+//	@Param			reference	body		domain.SatuSehatReferenceRequest						true	"SATUSEHAT ID"
+//	@Success		200			{object}	utils.SuccessResponse{data=domain.SatuSehatReferenceEntity}	"SATUSEHAT reference set successfully"
+//	@Failure		400			{object}	utils.ErrorResponse										"Invalid request body or validation failed"
+//	@Router			/satusehat/references/{kind}/{localId} [put]
+func (h *SatuSehatHandler) SetReference(c *fiber.Ctx) error {
+	var req domain.SatuSehatReferenceRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	kind := domain.SatuSehatReferenceKind(c.Params("kind"))
+	ref, err := h.satusehatService.SetReference(c.Context(), kind, c.Params("localId"), req, updaterID)
+	if err != nil {
+		log.Printf("Error setting SATUSEHAT reference: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to set SATUSEHAT reference", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "SATUSEHAT reference set successfully", ref)
+}
+
+// DeleteReference handles the request to remove a SATUSEHAT ID.
+//
+//	@Summary		Delete a SATUSEHAT reference
+//	@Description	Remove the SATUSEHAT ID registered for a patient, doctor or clinic room.
+//	@Tags			SATUSEHAT
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			kind	path		string					true	"Kind (patient, practitioner, location)"
+//	@Param			localId	path		string					true	"Patient, doctor or clinic room ID"
+//	@Success		204		{object}	utils.SuccessResponse	"SATUSEHAT reference deleted successfully"
+//	@Failure		400		{object}	utils.ErrorResponse		"Invalid kind or ID"
+//	@Router			/satusehat/references/{kind}/{localId} [delete]
+func (h *SatuSehatHandler) DeleteReference(c *fiber.Ctx) error {
+	kind := domain.SatuSehatReferenceKind(c.Params("kind"))
+	if err := h.satusehatService.DeleteReference(c.Context(), kind, c.Params("localId")); err != nil {
+		log.Printf("Error deleting SATUSEHAT reference: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to delete SATUSEHAT reference", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "SATUSEHAT reference deleted successfully", nil)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type TriageHandler struct {
+	triageService *service.TriageService
+}
+
+func NewTriageHandler(triageService *service.TriageService) *TriageHandler {
+	return &TriageHandler{
+		triageService: triageService,
+	}
+}
+
+// TriageAppointment handles the request to triage an emergency appointment.
+//
+//	@Summary		Triage an emergency appointment
+//	@Description	Record the Emergency Severity Index level, chief complaint and vital signs of an emergency appointment. If the patient is waiting in the queue, the ticket moves up by ESI level.
+//	@Tags			Appointments
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string													true	"Appointment ID"
+//	@Param			triage	body		domain.TriageRequest									true	"Triage assessment"
+//	@Success		200		{object}	utils.SuccessResponse{data=domain.AppointmentDTO}		"Appointment triaged successfully"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse										"Failed to triage appointment"
+//	@Router			/appointments/{id}/triage [put]
+func (h *TriageHandler) TriageAppointment(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.TriageRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	assessorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	appointment, err := h.triageService.TriageAppointment(c.Context(), id, &req, assessorID)
+	if err != nil {
+		log.Printf("Error triaging appointment %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Appointment triaged successfully", appointment.ToDTO())
+}
+
+// TriageTicket handles the request to triage a patient waiting in the queue.
+//
+//	@Summary		Triage a queue ticket
+//	@Description	Record the Emergency Severity Index level, chief complaint and vital signs of a waiting patient, such as an emergency walk-in. ESI 1 is called first, ESI 5 last, before untriaged tickets.
+//	@Tags			Queues
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string													true	"Ticket ID"
+//	@Param			triage	body		domain.TriageRequest									true	"Triage assessment"
+//	@Success		200		{object}	utils.SuccessResponse{data=domain.QueueTicketEntity}	"Ticket triaged successfully"
+//	@Failure		400		{object}	utils.ErrorResponse										"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse										"Failed to triage ticket"
+//	@Router			/queues/tickets/{id}/triage [put]
+func (h *TriageHandler) TriageTicket(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.TriageRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	assessorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	ticket, err := h.triageService.TriageTicket(c.Context(), id, &req, assessorID)
+	if err != nil {
+		log.Printf("Error triaging ticket %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Ticket triaged successfully", ticket)
+}
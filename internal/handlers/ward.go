@@ -0,0 +1,354 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type WardHandler struct {
+	wardService *service.WardService
+}
+
+func NewWardHandler(wardService *service.WardService) *WardHandler {
+	return &WardHandler{
+		wardService: wardService,
+	}
+}
+
+// GetAllWards handles the request to get all wards.
+//
+//	@Summary		Get all wards
+//	@Description	Retrieve a list of all inpatient wards.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.WardDTO}	"List of wards"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve wards"
+//	@Router			/wards [get]
+func (h *WardHandler) GetAllWards(c *fiber.Ctx) error {
+	wards, err := h.wardService.GetAllWards(c.Context())
+	if err != nil {
+		log.Printf("Error getting wards: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve wards", err.Error())
+	}
+
+	var wardDTOs []domain.WardDTO
+	for _, ward := range wards {
+		wardDTOs = append(wardDTOs, ward.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of wards", wardDTOs)
+}
+
+// GetWardByID handles the request to get a ward by ID.
+//
+//	@Summary		Get ward by ID
+//	@Description	Retrieve a single ward by its ID.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string										true	"Ward ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.WardDTO}	"Ward retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse							"Ward not found"
+//	@Failure		500	{object}	utils.ErrorResponse							"Failed to retrieve ward"
+//	@Router			/wards/{id} [get]
+func (h *WardHandler) GetWardByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	ward, err := h.wardService.GetWardByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting ward %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve ward", err.Error())
+	}
+
+	if ward == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Ward not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Ward retrieved successfully", ward.ToDTO())
+}
+
+// CreateWard handles the request to create a ward.
+//
+//	@Summary		Create a new ward
+//	@Description	Create a new inpatient ward.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			ward	body		domain.WardRequest								true	"Ward object to be created"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Ward created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create ward"
+//	@Router			/wards [post]
+func (h *WardHandler) CreateWard(c *fiber.Ctx) error {
+	var req domain.WardRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.wardService.CreateWard(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating ward: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Ward created successfully", fiber.Map{"id": id})
+}
+
+// UpdateWard handles the request to update a ward.
+//
+//	@Summary		Update an existing ward
+//	@Description	Update details of an existing ward.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string					true	"Ward ID"
+//	@Param			ward	body		domain.WardRequest		true	"Ward object with updated fields"
+//	@Success		204		{object}	utils.SuccessResponse	"Ward updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse		"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse		"Failed to update ward"
+//	@Router			/wards/{id} [put]
+func (h *WardHandler) UpdateWard(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.WardRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.wardService.UpdateWard(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating ward: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Ward updated successfully", nil)
+}
+
+// GetWardRooms handles the request to get the rooms of a ward.
+//
+//	@Summary		Get rooms of a ward
+//	@Description	Retrieve the rooms belonging to a ward.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string										true	"Ward ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.RoomDTO}	"List of rooms"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve rooms"
+//	@Router			/wards/{id}/rooms [get]
+func (h *WardHandler) GetWardRooms(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	rooms, err := h.wardService.GetRoomsByWard(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting rooms for ward %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve rooms", err.Error())
+	}
+
+	var roomDTOs []domain.RoomDTO
+	for _, room := range rooms {
+		roomDTOs = append(roomDTOs, room.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of rooms", roomDTOs)
+}
+
+// GetWardBeds handles the request to get the beds of a ward.
+//
+//	@Summary		Get beds of a ward
+//	@Description	Retrieve the beds belonging to a ward with their current status.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string										true	"Ward ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.BedDTO}	"List of beds"
+//	@Failure		500	{object}	utils.ErrorResponse							"Failed to retrieve beds"
+//	@Router			/wards/{id}/beds [get]
+func (h *WardHandler) GetWardBeds(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	beds, err := h.wardService.GetBedsByWard(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting beds for ward %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve beds", err.Error())
+	}
+
+	var bedDTOs []domain.BedDTO
+	for _, bed := range beds {
+		bedDTOs = append(bedDTOs, bed.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of beds", bedDTOs)
+}
+
+// CreateRoom handles the request to create a room.
+//
+//	@Summary		Create a new room
+//	@Description	Create a new room inside a ward.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			room	body		domain.CreateRoomRequest						true	"Room object to be created"
+//	@Success		201		{object}	utils.SuccessResponse{data=object{id=string}}	"Room created successfully"
+//	@Failure		400		{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse								"Failed to create room"
+//	@Router			/rooms [post]
+func (h *WardHandler) CreateRoom(c *fiber.Ctx) error {
+	var req domain.CreateRoomRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.wardService.CreateRoom(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating room: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Room created successfully", fiber.Map{"id": id})
+}
+
+// CreateBed handles the request to create a bed.
+//
+//	@Summary		Create a new bed
+//	@Description	Create a new bed inside a room. New beds start as Available.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			bed	body		domain.CreateBedRequest							true	"Bed object to be created"
+//	@Success		201	{object}	utils.SuccessResponse{data=object{id=string}}	"Bed created successfully"
+//	@Failure		400	{object}	utils.ErrorResponse								"Invalid request body or validation failed"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to create bed"
+//	@Router			/beds [post]
+func (h *WardHandler) CreateBed(c *fiber.Ctx) error {
+	var req domain.CreateBedRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, err := h.wardService.CreateBed(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating bed: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Bed created successfully", fiber.Map{"id": id})
+}
+
+// UpdateBedStatus handles the request to change the housekeeping status of a bed.
+//
+//	@Summary		Update bed status
+//	@Description	Mark a bed that is not occupied as Available, Cleaning or Maintenance.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id		path		string							true	"Bed ID"
+//	@Param			status	body		domain.UpdateBedStatusRequest	true	"New status for the bed"
+//	@Success		204		{object}	utils.SuccessResponse			"Bed status updated successfully"
+//	@Failure		400		{object}	utils.ErrorResponse				"Invalid request body or validation failed"
+//	@Failure		500		{object}	utils.ErrorResponse				"Failed to update bed status"
+//	@Router			/beds/{id}/status [put]
+func (h *WardHandler) UpdateBedStatus(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.UpdateBedStatusRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body for bed status update: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.wardService.UpdateBedStatus(c.Context(), id, req.Status, updaterID); err != nil {
+		log.Printf("Error updating bed status: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Bed status updated successfully", nil)
+}
+
+// GetOccupancyBoard handles the request to get the bed occupancy board.
+//
+//	@Summary		Get bed occupancy board
+//	@Description	Retrieve every active ward with its beds, their status and the admitted patient.
+//	@Tags			Wards
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.WardBoard}	"Bed occupancy board"
+//	@Failure		500	{object}	utils.ErrorResponse								"Failed to retrieve bed occupancy board"
+//	@Router			/beds/board [get]
+func (h *WardHandler) GetOccupancyBoard(c *fiber.Ctx) error {
+	board, err := h.wardService.GetOccupancyBoard(c.Context())
+	if err != nil {
+		log.Printf("Error getting bed occupancy board: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve bed occupancy board", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Bed occupancy board", board)
+}
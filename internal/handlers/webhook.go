@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/service"
+	"github.com/ekastn/hms-api/internal/utils"
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+}
+
+func NewWebhookHandler(webhookService *service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// GetAllSubscriptions handles the request to get webhook subscriptions.
+//
+//	@Summary		Get all webhook subscriptions
+//	@Description	Retrieve the webhook subscriptions. Secrets are never returned.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	utils.SuccessResponse{data=[]domain.WebhookSubscriptionDTO}	"List of webhook subscriptions"
+//	@Failure		500	{object}	utils.ErrorResponse											"Failed to retrieve webhook subscriptions"
+//	@Router			/webhooks [get]
+func (h *WebhookHandler) GetAllSubscriptions(c *fiber.Ctx) error {
+	subs, err := h.webhookService.GetAllSubscriptions(c.Context())
+	if err != nil {
+		log.Printf("Error getting webhook subscriptions: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve webhook subscriptions", err.Error())
+	}
+
+	var subDTOs []domain.WebhookSubscriptionDTO
+	for _, sub := range subs {
+		subDTOs = append(subDTOs, sub.ToDTO())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of webhook subscriptions", subDTOs)
+}
+
+// GetSubscriptionByID handles the request to get a webhook subscription by ID.
+//
+//	@Summary		Get webhook subscription by ID
+//	@Description	Retrieve a single webhook subscription.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string												true	"Webhook subscription ID"
+//	@Success		200	{object}	utils.SuccessResponse{data=domain.WebhookSubscriptionDTO}	"Webhook subscription retrieved successfully"
+//	@Failure		404	{object}	utils.ErrorResponse									"Webhook subscription not found"
+//	@Failure		500	{object}	utils.ErrorResponse									"Failed to retrieve webhook subscription"
+//	@Router			/webhooks/{id} [get]
+func (h *WebhookHandler) GetSubscriptionByID(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	sub, err := h.webhookService.GetSubscriptionByID(c.Context(), id)
+	if err != nil {
+		log.Printf("Error getting webhook subscription %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Failed to retrieve webhook subscription", err.Error())
+	}
+
+	if sub == nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusNotFound, "Webhook subscription not found", nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "Webhook subscription retrieved successfully", sub.ToDTO())
+}
+
+// CreateSubscription handles the request to create a webhook subscription.
+//
+//	@Summary		Create a new webhook subscription
+//	@Description	Subscribe a URL to event types such as appointment.created, appointment.status_changed or patient.updated. Deliveries are signed with HMAC-SHA256 over "<X-HMS-Timestamp>.<body>" and sent in the X-HMS-Signature header. A secret is generated when none is given; it is only returned in this response.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			subscription	body		domain.WebhookSubscriptionRequest							true	"Webhook subscription object to be created"
+//	@Success		201				{object}	utils.SuccessResponse{data=object{id=string,secret=string}}	"Webhook subscription created successfully"
+//	@Failure		400				{object}	utils.ErrorResponse											"Invalid request body or validation failed"
+//	@Failure		500				{object}	utils.ErrorResponse											"Failed to create webhook subscription"
+//	@Router			/webhooks [post]
+func (h *WebhookHandler) CreateSubscription(c *fiber.Ctx) error {
+	var req domain.WebhookSubscriptionRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	creatorID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	id, secret, err := h.webhookService.CreateSubscription(c.Context(), &req, creatorID)
+	if err != nil {
+		log.Printf("Error creating webhook subscription: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusCreated, "Webhook subscription created successfully", fiber.Map{"id": id, "secret": secret})
+}
+
+// UpdateSubscription handles the request to update a webhook subscription.
+//
+//	@Summary		Update an existing webhook subscription
+//	@Description	Update a webhook subscription. The secret is only rotated when a new one is given.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id				path		string								true	"Webhook subscription ID"
+//	@Param			subscription	body		domain.WebhookSubscriptionRequest	true	"Webhook subscription object with updated fields"
+//	@Success		204				{object}	utils.SuccessResponse				"Webhook subscription updated successfully"
+//	@Failure		400				{object}	utils.ErrorResponse					"Invalid request body or validation failed"
+//	@Failure		500				{object}	utils.ErrorResponse					"Failed to update webhook subscription"
+//	@Router			/webhooks/{id} [put]
+func (h *WebhookHandler) UpdateSubscription(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	var req domain.WebhookSubscriptionRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Printf("Error parsing request body: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Invalid request body", nil)
+	}
+
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Validation failed", validationErrors)
+	}
+
+	updaterID, err := primitive.ObjectIDFromHex(c.Locals("userID").(string))
+	if err != nil {
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, "Invalid user ID", nil)
+	}
+
+	if err := h.webhookService.UpdateSubscription(c.Context(), id, &req, updaterID); err != nil {
+		log.Printf("Error updating webhook subscription: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Webhook subscription updated successfully", nil)
+}
+
+// DeleteSubscription handles the request to delete a webhook subscription.
+//
+//	@Summary		Delete a webhook subscription
+//	@Description	Delete a webhook subscription. Queued deliveries for it are dead-lettered.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string					true	"Webhook subscription ID"
+//	@Success		204	{object}	utils.SuccessResponse	"Webhook subscription deleted successfully"
+//	@Failure		500	{object}	utils.ErrorResponse		"Failed to delete webhook subscription"
+//	@Router			/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteSubscription(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if err := h.webhookService.DeleteSubscription(c.Context(), id); err != nil {
+		log.Printf("Error deleting webhook subscription %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Webhook subscription deleted successfully", nil)
+}
+
+// SendTest handles the request to send a test event to a webhook subscription.
+//
+//	@Summary		Send a test event
+//	@Description	Queue a webhook.ping event for the subscription, regardless of its event types.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string													true	"Webhook subscription ID"
+//	@Success		202	{object}	utils.SuccessResponse{data=object{eventId=string}}	"Test event queued"
+//	@Failure		500	{object}	utils.ErrorResponse									"Failed to queue test event"
+//	@Router			/webhooks/{id}/test [post]
+func (h *WebhookHandler) SendTest(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	eventID, err := h.webhookService.SendTest(c.Context(), id)
+	if err != nil {
+		log.Printf("Error queuing test event for webhook %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusAccepted, "Test event queued", fiber.Map{"eventId": eventID})
+}
+
+// GetDeliveries handles the request to get the webhook delivery log.
+//
+//	@Summary		Get webhook deliveries
+//	@Description	Retrieve the most recent deliveries with their attempt log. Filter by status DeadLetter to get the dead-letter list.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			subscriptionId	query		string														false	"Webhook subscription ID"
+//	@Param			status			query		string														false	"Delivery status (Pending, Delivered, Failed, DeadLetter)"
+//	@Success		200				{object}	utils.SuccessResponse{data=[]domain.WebhookDeliveryEntity}	"List of webhook deliveries"
+//	@Failure		400				{object}	utils.ErrorResponse											"Invalid filter"
+//	@Router			/webhooks/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *fiber.Ctx) error {
+	status := domain.WebhookDeliveryStatus(c.Query("status"))
+
+	deliveries, err := h.webhookService.GetDeliveries(c.Context(), c.Query("subscriptionId"), status)
+	if err != nil {
+		log.Printf("Error getting webhook deliveries: %v", err)
+		return utils.ErrorResponseJSON(c, fiber.StatusBadRequest, "Failed to retrieve webhook deliveries", err.Error())
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusOK, "List of webhook deliveries", deliveries)
+}
+
+// RetryDelivery handles the request to retry a webhook delivery.
+//
+//	@Summary		Retry a webhook delivery
+//	@Description	Put a failed or dead-lettered delivery back in the queue with a fresh attempt budget.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string					true	"Webhook delivery ID"
+//	@Success		204	{object}	utils.SuccessResponse	"Webhook delivery queued for retry"
+//	@Failure		500	{object}	utils.ErrorResponse		"Failed to retry webhook delivery"
+//	@Router			/webhooks/deliveries/{id}/retry [post]
+func (h *WebhookHandler) RetryDelivery(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	if err := h.webhookService.RetryDelivery(c.Context(), id); err != nil {
+		log.Printf("Error retrying webhook delivery %s: %v", id, err)
+		return utils.ErrorResponseJSON(c, fiber.StatusInternalServerError, err.Error(), nil)
+	}
+
+	return utils.ResponseJSON(c, fiber.StatusNoContent, "Webhook delivery queued for retry", nil)
+}
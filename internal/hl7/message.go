@@ -0,0 +1,334 @@
+// Package hl7 reads and writes HL7 v2 messages and carries them over MLLP,
+// the framing HL7 v2 uses on TCP.
+package hl7
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// Version is the HL7 version of the messages the hospital sends.
+	Version = "2.5"
+
+	fieldSeparator     = '|'
+	componentSeparator = '^'
+	repetitionSep      = '~'
+	escapeChar         = '\\'
+	subcomponentSep    = '&'
+	encodingChars      = `^~\&`
+	segmentTerminator  = "\r"
+
+	timeLayout = "20060102150405"
+)
+
+// AckCode is the acknowledgment code of an ACK, MSA-1.
+type AckCode string
+
+const (
+	// AckAccept means the message was processed.
+	AckAccept AckCode = "AA"
+	// AckError means the message could not be processed, e.g. because the
+	// patient it is about is unknown.
+	AckError AckCode = "AE"
+	// AckReject means the message was not understood or is not supported.
+	AckReject AckCode = "AR"
+)
+
+// Segment is one segment of a message. Fields holds the raw, still escaped
+// fields, with Fields[0] being the segment name, so Fields[n] is field n of
+// the segment; for MSH, where the field separator is MSH-1, Fields[n] is
+// MSH-(n+1).
+type Segment struct {
+	Fields []string
+}
+
+// Name returns the segment ID, e.g. PID.
+func (s *Segment) Name() string {
+	return s.Fields[0]
+}
+
+// Field returns the raw field n, or "" when the segment has no such field.
+func (s *Segment) Field(n int) string {
+	if s.Name() == "MSH" {
+		if n == 1 {
+			return string(fieldSeparator)
+		}
+		n--
+	}
+	if n < 1 || n >= len(s.Fields) {
+		return ""
+	}
+	return s.Fields[n]
+}
+
+// Value returns component c (1-based) of the first repetition of field n,
+// unescaped.
+func (s *Segment) Value(n, c int) string {
+	repetition, _, _ := strings.Cut(s.Field(n), string(repetitionSep))
+	return component(repetition, c)
+}
+
+// Repetitions returns the repetitions of field n, still escaped; pass them
+// to Component to read them.
+func (s *Segment) Repetitions(n int) []string {
+	field := s.Field(n)
+	if field == "" {
+		return nil
+	}
+	return strings.Split(field, string(repetitionSep))
+}
+
+// Component returns component c (1-based) of a repetition, unescaped.
+func Component(repetition string, c int) string {
+	return component(repetition, c)
+}
+
+func component(repetition string, c int) string {
+	components := strings.Split(repetition, string(componentSeparator))
+	if c < 1 || c > len(components) {
+		return ""
+	}
+	value, _, _ := strings.Cut(components[c-1], string(subcomponentSep))
+	return Unescape(value)
+}
+
+// Message is an HL7 v2 message. Only the default encoding characters
+// ^~\& are supported, which is what practically every system sends.
+type Message struct {
+	Segments []*Segment
+}
+
+// Parse parses a message. Segments may be separated by CR, LF or CRLF.
+func Parse(data []byte) (*Message, error) {
+	text := strings.ReplaceAll(string(data), "\r\n", "\r")
+	text = strings.ReplaceAll(text, "\n", "\r")
+
+	msg := &Message{}
+	for _, line := range strings.Split(text, "\r") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(line) < 3 {
+			return nil, fmt.Errorf("invalid segment %q", line)
+		}
+		msg.Segments = append(msg.Segments, &Segment{Fields: strings.Split(line, string(fieldSeparator))})
+	}
+
+	if len(msg.Segments) == 0 {
+		return nil, errors.New("empty message")
+	}
+	msh := msg.Segments[0]
+	if msh.Name() != "MSH" || len(msh.Fields) < 2 || len(data) < 4 || data[3] != fieldSeparator {
+		return nil, errors.New("message does not start with an MSH segment")
+	}
+	if msh.Field(2) != encodingChars {
+		return nil, fmt.Errorf("unsupported encoding characters %q", msh.Field(2))
+	}
+	return msg, nil
+}
+
+// Segment returns the first segment with the name, or nil.
+func (m *Message) Segment(name string) *Segment {
+	for _, s := range m.Segments {
+		if s.Name() == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// Header returns the MSH segment.
+func (m *Message) Header() *Segment {
+	return m.Segments[0]
+}
+
+// Type returns the message type and trigger event, e.g. ADT^A01.
+func (m *Message) Type() string {
+	msh := m.Header()
+	return msh.Value(9, 1) + "^" + msh.Value(9, 2)
+}
+
+// ControlID returns the message control ID, MSH-10.
+func (m *Message) ControlID() string {
+	return m.Header().Value(10, 1)
+}
+
+// Bytes encodes the message with CR segment terminators.
+func (m *Message) Bytes() []byte {
+	var b strings.Builder
+	for _, s := range m.Segments {
+		b.WriteString(strings.Join(s.Fields, string(fieldSeparator)))
+		b.WriteString(segmentTerminator)
+	}
+	return []byte(b.String())
+}
+
+// Header identifies the sender and receiver of a message.
+type Header struct {
+	SendingApplication   string
+	SendingFacility      string
+	ReceivingApplication string
+	ReceivingFacility    string
+}
+
+// NewMessage starts a message of the type, e.g. ADT^A08^ADT_A01, with its
+// MSH segment.
+func NewMessage(h Header, messageType, controlID string, at time.Time) *Message {
+	return &Message{Segments: []*Segment{{Fields: []string{
+		"MSH", encodingChars,
+		Escape(h.SendingApplication), Escape(h.SendingFacility),
+		Escape(h.ReceivingApplication), Escape(h.ReceivingFacility),
+		FormatTime(at), "", messageType, Escape(controlID), "P", Version,
+	}}}}
+}
+
+// Add appends a segment. Fields are given raw, so values must be escaped
+// with Escape or joined with Components. Trailing empty fields are dropped.
+func (m *Message) Add(name string, fields ...string) {
+	for len(fields) > 0 && fields[len(fields)-1] == "" {
+		fields = fields[:len(fields)-1]
+	}
+	m.Segments = append(m.Segments, &Segment{Fields: append([]string{name}, fields...)})
+}
+
+// Ack builds the acknowledgment of the message, sent from its receiver back
+// to its sender. text explains an AE or AR.
+func (m *Message) Ack(code AckCode, controlID, text string, at time.Time) *Message {
+	msh := m.Header()
+	header := Header{
+		SendingApplication:   msh.Value(5, 1),
+		SendingFacility:      msh.Value(6, 1),
+		ReceivingApplication: msh.Value(3, 1),
+		ReceivingFacility:    msh.Value(4, 1),
+	}
+	ack := NewMessage(header, Components("ACK", msh.Value(9, 2), "ACK"), controlID, at)
+	if version := msh.Value(12, 1); version != "" {
+		ack.Header().Fields[11] = Escape(version)
+	}
+	if processingID := msh.Value(11, 1); processingID != "" {
+		ack.Header().Fields[10] = Escape(processingID)
+	}
+
+	ack.Add("MSA", string(code), Escape(m.ControlID()), Escape(text))
+	if code != AckAccept {
+		errorCode := Components("207", "Application internal error", "HL70357")
+		if code == AckReject {
+			errorCode = Components("200", "Unsupported message type", "HL70357")
+		}
+		ack.Add("ERR", "", "", errorCode, "E", "", "", "", Escape(text))
+	}
+	return ack
+}
+
+// RejectAck builds an AR acknowledgment for data that could not be parsed
+// as a message, so there is no header to answer to.
+func RejectAck(controlID, text string, at time.Time) *Message {
+	ack := NewMessage(Header{}, "ACK", controlID, at)
+	ack.Add("MSA", string(AckReject), "", Escape(text))
+	return ack
+}
+
+// AckCode returns MSA-1 of an acknowledgment, mapping the enhanced mode
+// codes CA, CE and CR to AA, AE and AR.
+func (m *Message) AckCode() AckCode {
+	msa := m.Segment("MSA")
+	if msa == nil {
+		return ""
+	}
+	code := msa.Value(1, 1)
+	if strings.HasPrefix(code, "C") {
+		code = "A" + code[1:]
+	}
+	return AckCode(code)
+}
+
+// AckText returns MSA-3 of an acknowledgment, or the diagnostics of its ERR
+// segment.
+func (m *Message) AckText() string {
+	if msa := m.Segment("MSA"); msa != nil {
+		if text := msa.Value(3, 1); text != "" {
+			return text
+		}
+	}
+	if err := m.Segment("ERR"); err != nil {
+		if text := err.Value(8, 1); text != "" {
+			return text
+		}
+		return err.Value(3, 2)
+	}
+	return ""
+}
+
+// Components escapes the values and joins them as the components of a field.
+func Components(values ...string) string {
+	escaped := make([]string, len(values))
+	for i, v := range values {
+		escaped[i] = Escape(v)
+	}
+	return strings.TrimRight(strings.Join(escaped, string(componentSeparator)), string(componentSeparator))
+}
+
+var escaper = strings.NewReplacer(
+	`\`, `\E\`,
+	"|", `\F\`,
+	"^", `\S\`,
+	"&", `\T\`,
+	"~", `\R\`,
+	"\r\n", `\.br\`,
+	"\n", `\.br\`,
+	"\r", `\.br\`,
+)
+
+var unescaper = strings.NewReplacer(
+	`\E\`, `\`,
+	`\F\`, "|",
+	`\S\`, "^",
+	`\T\`, "&",
+	`\R\`, "~",
+	`\.br\`, "\n",
+)
+
+// Escape escapes the delimiters in a value.
+func Escape(value string) string {
+	return escaper.Replace(value)
+}
+
+// Unescape undoes Escape. Other escape sequences are kept as they are.
+func Unescape(value string) string {
+	if !strings.ContainsRune(value, escapeChar) {
+		return value
+	}
+	return unescaper.Replace(value)
+}
+
+// FormatTime formats a time as an HL7 timestamp with its offset.
+func FormatTime(t time.Time) string {
+	return t.Format(timeLayout + "-0700")
+}
+
+// ParseTime parses an HL7 date or timestamp of any precision, from YYYY to
+// YYYYMMDDHHMMSS.SSSS, with an optional offset. Times without an offset are
+// in loc.
+func ParseTime(value string, loc *time.Location) (time.Time, error) {
+	digits, offset := value, ""
+	if i := strings.IndexAny(value, "+-"); i >= 0 {
+		digits, offset = value[:i], value[i:]
+	}
+	digits, _, _ = strings.Cut(digits, ".")
+
+	var layout string
+	switch len(digits) {
+	case 4, 6, 8, 10, 12, 14:
+		layout = timeLayout[:len(digits)]
+	default:
+		return time.Time{}, fmt.Errorf("invalid HL7 time %q", value)
+	}
+
+	if offset != "" {
+		return time.Parse(layout+"-0700", digits+offset)
+	}
+	return time.ParseInLocation(layout, digits, loc)
+}
@@ -0,0 +1,212 @@
+package hl7
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	startBlock     = 0x0b
+	endBlock       = 0x1c
+	carriageReturn = 0x0d
+
+	// MaxFrameSize bounds the messages read from a connection so a peer
+	// that never sends an end block cannot exhaust memory.
+	MaxFrameSize = 1 << 20
+)
+
+// ErrFrameTooLarge is returned by ReadFrame for messages over MaxFrameSize.
+var ErrFrameTooLarge = errors.New("MLLP frame too large")
+
+// ReadFrame reads one MLLP framed message, <VT>message<FS><CR>, and returns
+// the message. Bytes before the start block are skipped.
+func ReadFrame(r *bufio.Reader) ([]byte, error) {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if b == startBlock {
+			break
+		}
+	}
+
+	var frame []byte
+	for {
+		chunk, err := r.ReadSlice(endBlock)
+		if len(frame)+len(chunk) > MaxFrameSize+1 {
+			return nil, ErrFrameTooLarge
+		}
+		frame = append(frame, chunk...)
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		break
+	}
+
+	// The end block is followed by a carriage return, which some senders
+	// leave out. It is only dropped when it has already arrived: waiting for
+	// it would stall a sender that left it out and waits for the
+	// acknowledgment, and one arriving later is skipped by the next read.
+	if r.Buffered() == 0 {
+		return frame[:len(frame)-1], nil
+	}
+	if next, err := r.Peek(1); err == nil && next[0] == carriageReturn {
+		r.Discard(1)
+	}
+	return frame[:len(frame)-1], nil
+}
+
+// WriteFrame writes a message in an MLLP frame.
+func WriteFrame(w io.Writer, msg []byte) error {
+	frame := make([]byte, 0, len(msg)+3)
+	frame = append(frame, startBlock)
+	frame = append(frame, msg...)
+	frame = append(frame, endBlock, carriageReturn)
+	_, err := w.Write(frame)
+	return err
+}
+
+// Handler processes a message received from remoteAddr and returns the
+// acknowledgment to send back.
+type Handler func(ctx context.Context, msg []byte, remoteAddr string) []byte
+
+// Server accepts MLLP connections and hands every message on them to
+// Handler, answering each with the acknowledgment it returns. Messages on
+// one connection are handled in order.
+type Server struct {
+	Handler Handler
+	// IdleTimeout closes connections without a message for this long; zero
+	// keeps them open.
+	IdleTimeout time.Duration
+}
+
+// ListenAndServe listens on addr and serves until ctx is done.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, ln)
+}
+
+// Serve accepts connections on ln until ctx is done, then closes ln and the
+// open connections and waits for their handlers to return.
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.serveConn(ctx, conn)
+		}()
+	}
+}
+
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	remote := conn.RemoteAddr().String()
+	r := bufio.NewReader(conn)
+	for {
+		if s.IdleTimeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(s.IdleTimeout))
+		}
+		msg, err := ReadFrame(r)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				log.Printf("MLLP connection from %s: %v", remote, err)
+			}
+			return
+		}
+
+		ack := s.Handler(ctx, msg, remote)
+		if err := WriteFrame(conn, ack); err != nil {
+			log.Printf("MLLP connection from %s: writing acknowledgment: %v", remote, err)
+			return
+		}
+	}
+}
+
+// Send sends a message to the MLLP listener at addr and returns the
+// acknowledgment it answers with. timeout bounds the whole exchange.
+func Send(ctx context.Context, addr string, msg []byte, timeout time.Duration) (*Message, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	if err := WriteFrame(conn, msg); err != nil {
+		return nil, fmt.Errorf("sending message: %w", err)
+	}
+	frame, err := ReadFrame(bufio.NewReader(conn))
+	if err != nil {
+		return nil, fmt.Errorf("reading acknowledgment: %w", err)
+	}
+
+	ack, err := Parse(frame)
+	if err != nil {
+		return nil, fmt.Errorf("invalid acknowledgment: %w", err)
+	}
+	if msa := ack.Segment("MSA"); msa == nil {
+		return nil, errors.New("acknowledgment has no MSA segment")
+	} else if msa.Value(2, 1) != controlIDOf(msg) {
+		return nil, fmt.Errorf("acknowledgment is for message %q", msa.Value(2, 1))
+	}
+	return ack, nil
+}
+
+// controlIDOf returns MSH-10 of an encoded message, or "" when it does not
+// parse.
+func controlIDOf(msg []byte) string {
+	parsed, err := Parse(msg)
+	if err != nil {
+		return ""
+	}
+	return parsed.ControlID()
+}
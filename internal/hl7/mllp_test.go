@@ -0,0 +1,202 @@
+package hl7
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testMessage = "MSH|^~\\&|LIS|LAB|HMS|RS|20250717100000||ORU^R01|MSG0001|P|2.5\rPID|1||abc^^^HMS^MR\r"
+
+func frame(msg string) string {
+	return "\x0b" + msg + "\x1c\r"
+}
+
+func TestReadFrame(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("noise" + frame("first") + "\x0bsecond\x1c" + frame("third")))
+	for _, want := range []string{"first", "second", "third"} {
+		got, err := ReadFrame(r)
+		if err != nil {
+			t.Fatalf("reading %q: %v", want, err)
+		}
+		if string(got) != want {
+			t.Errorf("frame = %q, want %q", got, want)
+		}
+	}
+	if _, err := ReadFrame(r); err != io.EOF {
+		t.Errorf("error after the last frame = %v, want EOF", err)
+	}
+}
+
+func TestReadFrameTruncated(t *testing.T) {
+	_, err := ReadFrame(bufio.NewReader(strings.NewReader("\x0bMSH|never ends")))
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("error = %v, want unexpected EOF", err)
+	}
+}
+
+func TestReadFrameMaxSize(t *testing.T) {
+	largest := strings.Repeat("x", MaxFrameSize)
+	got, err := ReadFrame(bufio.NewReader(strings.NewReader(frame(largest))))
+	if err != nil {
+		t.Fatalf("frame of MaxFrameSize: %v", err)
+	}
+	if len(got) != MaxFrameSize {
+		t.Errorf("frame length = %d, want %d", len(got), MaxFrameSize)
+	}
+
+	_, err = ReadFrame(bufio.NewReader(strings.NewReader(frame(largest + "x"))))
+	if !errors.Is(err, ErrFrameTooLarge) {
+		t.Errorf("error = %v, want ErrFrameTooLarge", err)
+	}
+}
+
+func TestWriteFrame(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFrame(&buf, []byte(testMessage)); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != frame(testMessage) {
+		t.Errorf("frame = %q", got)
+	}
+
+	got, err := ReadFrame(bufio.NewReader(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != testMessage {
+		t.Errorf("read back %q", got)
+	}
+}
+
+// serve starts a server with the handler on a local port and returns its
+// address. The server is stopped when the test ends.
+func serve(t *testing.T, handler Handler) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- (&Server{Handler: handler}).Serve(ctx, ln)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Serve returned %v", err)
+		}
+	})
+	return ln.Addr().String()
+}
+
+func accept(_ context.Context, msg []byte, _ string) []byte {
+	parsed, err := Parse(msg)
+	if err != nil {
+		return RejectAck("ACK0001", err.Error(), time.Now()).Bytes()
+	}
+	return parsed.Ack(AckAccept, "ACK0001", "", time.Now()).Bytes()
+}
+
+func TestServerAndSend(t *testing.T) {
+	addr := serve(t, accept)
+
+	for i := 0; i < 2; i++ {
+		ack, err := Send(context.Background(), addr, []byte(testMessage), 5*time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ack.AckCode() != AckAccept {
+			t.Errorf("ack code = %q", ack.AckCode())
+		}
+		if got := ack.Segment("MSA").Value(2, 1); got != "MSG0001" {
+			t.Errorf("MSA-2 = %q", got)
+		}
+	}
+}
+
+func TestServerHandlesMessagesOnAConnectionInOrder(t *testing.T) {
+	addr := serve(t, accept)
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	// The second message leaves out the carriage return after the end block.
+	second := strings.Replace(testMessage, "MSG0001", "MSG0002", 1)
+	if _, err := io.WriteString(conn, frame(testMessage)+"\x0b"+second+"\x1c"); err != nil {
+		t.Fatal(err)
+	}
+
+	r := bufio.NewReader(conn)
+	for _, want := range []string{"MSG0001", "MSG0002"} {
+		data, err := ReadFrame(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ack, err := Parse(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ack.Segment("MSA").Value(2, 1); got != want {
+			t.Errorf("acknowledged %q, want %q", got, want)
+		}
+	}
+}
+
+func TestSendChecksControlID(t *testing.T) {
+	addr := serve(t, func(ctx context.Context, msg []byte, remoteAddr string) []byte {
+		other, _ := Parse([]byte(strings.Replace(string(msg), "MSG0001", "MSG9999", 1)))
+		return other.Ack(AckAccept, "ACK0001", "", time.Now()).Bytes()
+	})
+
+	_, err := Send(context.Background(), addr, []byte(testMessage), 5*time.Second)
+	if err == nil || !strings.Contains(err.Error(), "MSG9999") {
+		t.Errorf("error = %v, want the acknowledgment to be refused", err)
+	}
+}
+
+func TestSendWithoutMSA(t *testing.T) {
+	addr := serve(t, func(ctx context.Context, msg []byte, remoteAddr string) []byte {
+		return []byte("MSH|^~\\&|HMS|RS|LIS|LAB|20250717100000||ACK|ACK0001|P|2.5\r")
+	})
+
+	if _, err := Send(context.Background(), addr, []byte(testMessage), 5*time.Second); err == nil {
+		t.Error("expected an error for an acknowledgment without MSA")
+	}
+}
+
+func TestSendTimeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	// The listener accepts but never answers.
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			defer conn.Close()
+			io.Copy(io.Discard, conn)
+		}
+	}()
+
+	start := time.Now()
+	if _, err := Send(context.Background(), ln.Addr().String(), []byte(testMessage), 200*time.Millisecond); err == nil {
+		t.Fatal("expected a timeout")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Send took %v", elapsed)
+	}
+}
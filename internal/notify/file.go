@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileSender is a local backend for every channel, used when no real provider
+// is configured. Each message is appended as a JSON line to the file at path,
+// or written to the log when path is empty.
+type FileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+type fileMessage struct {
+	Channel string    `json:"channel"`
+	To      string    `json:"to"`
+	Subject string    `json:"subject,omitempty"`
+	Body    string    `json:"body"`
+	SentAt  time.Time `json:"sentAt"`
+}
+
+func (s *FileSender) SendEmail(ctx context.Context, to, subject, body string) error {
+	return s.write(fileMessage{Channel: "email", To: to, Subject: subject, Body: body})
+}
+
+func (s *FileSender) SendSMS(ctx context.Context, to, body string) error {
+	return s.write(fileMessage{Channel: "sms", To: to, Body: body})
+}
+
+func (s *FileSender) SendWhatsApp(ctx context.Context, to, body string) error {
+	return s.write(fileMessage{Channel: "whatsapp", To: to, Body: body})
+}
+
+func (s *FileSender) write(msg fileMessage) error {
+	if msg.To == "" {
+		return errors.New("recipient is required")
+	}
+	msg.SentAt = time.Now()
+
+	if s.path == "" {
+		log.Printf("[notify] %s to %s: %s", msg.Channel, msg.To, msg.Body)
+		return nil
+	}
+
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,19 @@
+// Package notify delivers messages to patients over email, SMS and WhatsApp.
+package notify
+
+import "context"
+
+// EmailSender is implemented by every email provider.
+type EmailSender interface {
+	SendEmail(ctx context.Context, to, subject, body string) error
+}
+
+// SMSSender is implemented by every SMS gateway.
+type SMSSender interface {
+	SendSMS(ctx context.Context, to, body string) error
+}
+
+// WhatsAppSender is implemented by every WhatsApp Business API provider.
+type WhatsAppSender interface {
+	SendWhatsApp(ctx context.Context, to, body string) error
+}
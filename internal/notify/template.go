@@ -0,0 +1,180 @@
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// ReminderData is the data available to the reminder templates.
+type ReminderData struct {
+	PatientName string
+	DoctorName  string
+	Location    string
+	DateTime    time.Time
+	// PreviousDateTime is the old time of a rescheduled appointment.
+	PreviousDateTime time.Time
+	// ConfirmURL and CancelURL are left out of the message when empty.
+	ConfirmURL string
+	CancelURL  string
+}
+
+type reminderTemplate struct {
+	subject string
+	short   string // SMS and WhatsApp
+	email   string
+}
+
+var reminderTemplates = map[string]reminderTemplate{
+	"id": {
+		subject: "Pengingat janji temu {{date .DateTime}}",
+		short:   "Halo {{.PatientName}}, ini pengingat janji temu Anda{{with .DoctorName}} dengan {{.}}{{end}} pada {{date .DateTime}} pukul {{clock .DateTime}} di {{.Location}}. Mohon datang 15 menit lebih awal.{{with .ConfirmURL}} Konfirmasi: {{.}}{{end}}{{with .CancelURL}} Batalkan: {{.}}{{end}}",
+		email: `Yth. {{.PatientName}},
+
+Kami mengingatkan janji temu Anda{{with .DoctorName}} dengan {{.}}{{end}}:
+
+Tanggal : {{date .DateTime}}
+Pukul   : {{clock .DateTime}}
+Lokasi  : {{.Location}}
+
+Mohon datang 15 menit sebelum jadwal dan membawa kartu identitas serta kartu asuransi Anda.
+{{with .ConfirmURL}}
+Konfirmasi kehadiran Anda: {{.}}
+{{end}}{{with .CancelURL}}
+Tidak dapat hadir? Batalkan janji temu: {{.}}
+{{end}}
+Salam,
+Rumah Sakit`,
+	},
+	"en": {
+		subject: "Appointment reminder for {{date .DateTime}}",
+		short:   "Hi {{.PatientName}}, this is a reminder of your appointment{{with .DoctorName}} with {{.}}{{end}} on {{date .DateTime}} at {{clock .DateTime}} in {{.Location}}. Please arrive 15 minutes early.{{with .ConfirmURL}} Confirm: {{.}}{{end}}{{with .CancelURL}} Cancel: {{.}}{{end}}",
+		email: `Dear {{.PatientName}},
+
+This is a reminder of your appointment{{with .DoctorName}} with {{.}}{{end}}:
+
+Date     : {{date .DateTime}}
+Time     : {{clock .DateTime}}
+Location : {{.Location}}
+
+Please arrive 15 minutes early and bring your ID and insurance card.
+{{with .ConfirmURL}}
+Confirm your attendance: {{.}}
+{{end}}{{with .CancelURL}}
+Can't make it? Cancel the appointment: {{.}}
+{{end}}
+Kind regards,
+The Hospital`,
+	},
+}
+
+var rescheduledTemplates = map[string]reminderTemplate{
+	"id": {
+		subject: "Perubahan jadwal janji temu {{date .PreviousDateTime}}",
+		short:   "Halo {{.PatientName}}, mohon maaf, janji temu Anda{{with .DoctorName}} dengan {{.}}{{end}} pada {{date .PreviousDateTime}} pukul {{clock .PreviousDateTime}} dipindahkan ke {{date .DateTime}} pukul {{clock .DateTime}} di {{.Location}} karena penanganan pasien gawat darurat.{{with .ConfirmURL}} Konfirmasi: {{.}}{{end}}{{with .CancelURL}} Batalkan: {{.}}{{end}}",
+		email: `Yth. {{.PatientName}},
+
+Mohon maaf, karena dokter harus menangani pasien gawat darurat, janji temu Anda{{with .DoctorName}} dengan {{.}}{{end}} dipindahkan:
+
+Jadwal lama : {{date .PreviousDateTime}} pukul {{clock .PreviousDateTime}}
+Jadwal baru : {{date .DateTime}} pukul {{clock .DateTime}}
+Lokasi      : {{.Location}}
+{{with .ConfirmURL}}
+Konfirmasi kehadiran Anda pada jadwal baru: {{.}}
+{{end}}{{with .CancelURL}}
+Tidak dapat hadir? Batalkan janji temu: {{.}}
+{{end}}
+Terima kasih atas pengertian Anda.
+
+Salam,
+Rumah Sakit`,
+	},
+	"en": {
+		subject: "Your appointment on {{date .PreviousDateTime}} has been moved",
+		short:   "Hi {{.PatientName}}, we are sorry, your appointment{{with .DoctorName}} with {{.}}{{end}} on {{date .PreviousDateTime}} at {{clock .PreviousDateTime}} has been moved to {{date .DateTime}} at {{clock .DateTime}} in {{.Location}} due to an emergency.{{with .ConfirmURL}} Confirm: {{.}}{{end}}{{with .CancelURL}} Cancel: {{.}}{{end}}",
+		email: `Dear {{.PatientName}},
+
+We are sorry, because the doctor has to attend to an emergency, your appointment{{with .DoctorName}} with {{.}}{{end}} has been moved:
+
+Previous time : {{date .PreviousDateTime}} at {{clock .PreviousDateTime}}
+New time      : {{date .DateTime}} at {{clock .DateTime}}
+Location      : {{.Location}}
+{{with .ConfirmURL}}
+Confirm your attendance at the new time: {{.}}
+{{end}}{{with .CancelURL}}
+Can't make it? Cancel the appointment: {{.}}
+{{end}}
+Thank you for your understanding.
+
+Kind regards,
+The Hospital`,
+	},
+}
+
+var indonesianMonths = [...]string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"}
+
+var indonesianDays = [...]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
+
+func templateFuncs(lang string) template.FuncMap {
+	return template.FuncMap{
+		"date": func(t time.Time) string {
+			if lang == "id" {
+				return fmt.Sprintf("%s, %d %s %d", indonesianDays[t.Weekday()], t.Day(), indonesianMonths[t.Month()-1], t.Year())
+			}
+			return t.Format("Monday, 2 January 2006")
+		},
+		"clock": func(t time.Time) string {
+			if lang == "id" {
+				return t.Format("15.04")
+			}
+			return t.Format("15:04")
+		},
+	}
+}
+
+// RenderReminder renders the reminder for the language ("id" or "en"). The
+// email flag selects the long email body over the short SMS/WhatsApp text.
+func RenderReminder(lang string, email bool, data ReminderData) (subject, body string, err error) {
+	return renderMessage(reminderTemplates, lang, email, data)
+}
+
+// RenderRescheduled renders the notice telling a patient their appointment
+// was moved from data.PreviousDateTime to data.DateTime.
+func RenderRescheduled(lang string, email bool, data ReminderData) (subject, body string, err error) {
+	return renderMessage(rescheduledTemplates, lang, email, data)
+}
+
+func renderMessage(templates map[string]reminderTemplate, lang string, email bool, data ReminderData) (subject, body string, err error) {
+	tmpl, ok := templates[lang]
+	if !ok {
+		return "", "", fmt.Errorf("no reminder template for language %q", lang)
+	}
+
+	text := tmpl.short
+	if email {
+		text = tmpl.email
+	}
+
+	if subject, err = render(lang, tmpl.subject, data); err != nil {
+		return "", "", err
+	}
+	if body, err = render(lang, text, data); err != nil {
+		return "", "", err
+	}
+	return subject, body, nil
+}
+
+func render(lang, text string, data interface{}) (string, error) {
+	t, err := template.New("").Funcs(templateFuncs(lang)).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,75 @@
+package payer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+)
+
+// FakeGateway is an in-memory payer used when no real payer API is configured.
+// Policies are eligible when they cover the visit date unless the member
+// number has been marked ineligible, and every claim is accepted.
+type FakeGateway struct {
+	mu         sync.Mutex
+	ineligible map[string]string
+	submitted  []string
+}
+
+func NewFakeGateway() *FakeGateway {
+	return &FakeGateway{
+		ineligible: make(map[string]string),
+	}
+}
+
+// MarkIneligible makes eligibility checks for the member number fail with reason.
+func (g *FakeGateway) MarkIneligible(memberNumber, reason string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.ineligible[memberNumber] = reason
+}
+
+// Submitted returns the numbers of the claims submitted so far.
+func (g *FakeGateway) Submitted() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]string(nil), g.submitted...)
+}
+
+func (g *FakeGateway) CheckEligibility(ctx context.Context, policy *domain.InsurancePolicyEntity, date time.Time) (*domain.EligibilityCheck, error) {
+	g.mu.Lock()
+	reason, blocked := g.ineligible[policy.MemberNumber]
+	g.mu.Unlock()
+
+	check := &domain.EligibilityCheck{
+		PolicyID:  &policy.ID,
+		PayerType: policy.PayerType,
+		CheckedAt: time.Now(),
+	}
+
+	switch {
+	case blocked:
+		check.Reason = reason
+	case !policy.CoversDate(date):
+		check.Reason = "policy is not active on the service date"
+	default:
+		check.Eligible = true
+		check.PayerRefCode = "ELG-" + policy.MemberNumber
+	}
+
+	return check, nil
+}
+
+func (g *FakeGateway) SubmitClaim(ctx context.Context, claim *domain.ClaimEntity) (string, error) {
+	if claim.ClaimedAmount <= 0 {
+		return "", errors.New("claimed amount must be positive")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.submitted = append(g.submitted, claim.Number)
+
+	return "FAKE-" + claim.Number, nil
+}
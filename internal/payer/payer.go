@@ -0,0 +1,20 @@
+// Package payer connects the hospital to insurance payers such as BPJS
+// Kesehatan and private insurers.
+package payer
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+)
+
+// Gateway is implemented by every payer integration. The insurance service
+// only talks to payers through this interface so it can run against the fake
+// gateway in development and tests.
+type Gateway interface {
+	// CheckEligibility asks the payer whether the policy covers a visit on the given date.
+	CheckEligibility(ctx context.Context, policy *domain.InsurancePolicyEntity, date time.Time) (*domain.EligibilityCheck, error)
+	// SubmitClaim sends a claim to the payer and returns the payer's reference for it.
+	SubmitClaim(ctx context.Context, claim *domain.ClaimEntity) (string, error)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ekastn/hms-api/internal/domain"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -18,8 +19,22 @@ func NewActivityRepository(coll *mongo.Collection) *ActivityRepository {
 }
 
 func (r *ActivityRepository) Create(ctx context.Context, activity *domain.ActivityEntity) error {
+	res, err := r.coll.InsertOne(ctx, activity)
+	if err != nil {
+		return err
+	}
+	activity.ID = res.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// CreateIfAbsent inserts the activity unless one with the same ID already
+// exists, so replaying it is harmless.
+func (r *ActivityRepository) CreateIfAbsent(ctx context.Context, activity *domain.ActivityEntity) error {
 	_, err := r.coll.InsertOne(ctx, activity)
-	return err
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
 }
 
 func (r *ActivityRepository) GetRecent(ctx context.Context, limit int) ([]*domain.ActivityEntity, error) {
@@ -60,3 +75,24 @@ func (r *ActivityRepository) GetAll(ctx context.Context) ([]*domain.ActivityEnti
 
 	return activities, nil
 }
+
+// ForEach calls fn with each activity, reading them from the cursor one at a
+// time.
+func (r *ActivityRepository) ForEach(ctx context.Context, fn func(*domain.ActivityEntity) error) error {
+	cur, err := r.coll.Find(ctx, bson.D{})
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var activity domain.ActivityEntity
+		if err := cur.Decode(&activity); err != nil {
+			return err
+		}
+		if err := fn(&activity); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
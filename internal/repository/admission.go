@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AdmissionRepository struct {
+	coll *mongo.Collection
+}
+
+func NewAdmissionRepository(coll *mongo.Collection) *AdmissionRepository {
+	return &AdmissionRepository{coll}
+}
+
+// EnsureIndexes creates the partial unique index that allows one active
+// admission per patient. Without it two requests admitting the same patient
+// at the same time could both pass the check for an active admission.
+func (r *AdmissionRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "patientId", Value: 1}},
+		Options: options.Index().
+			SetName("active_admission").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": domain.AdmissionStatusAdmitted}),
+	})
+	return err
+}
+
+func (r *AdmissionRepository) Create(ctx context.Context, admission *domain.AdmissionEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	admission.CreatedAt = now
+	admission.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, admission)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *AdmissionRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.AdmissionEntity, error) {
+	var admission domain.AdmissionEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&admission)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &admission, nil
+}
+
+// GetActiveByPatientID returns the current admission of a patient, if any.
+func (r *AdmissionRepository) GetActiveByPatientID(ctx context.Context, patientID primitive.ObjectID) (*domain.AdmissionEntity, error) {
+	var admission domain.AdmissionEntity
+	filter := bson.M{"patientId": patientID, "status": domain.AdmissionStatusAdmitted}
+	err := r.coll.FindOne(ctx, filter).Decode(&admission)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &admission, nil
+}
+
+// GetAll returns admissions, optionally filtered by status, most recent first.
+func (r *AdmissionRepository) GetAll(ctx context.Context, status domain.AdmissionStatus) ([]*domain.AdmissionEntity, error) {
+	filter := bson.M{}
+	if status != "" {
+		filter["status"] = status
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "admittedAt", Value: -1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var admissions []*domain.AdmissionEntity
+	if err := cur.All(ctx, &admissions); err != nil {
+		return nil, err
+	}
+	return admissions, nil
+}
+
+func (r *AdmissionRepository) Update(ctx context.Context, id primitive.ObjectID, admission *domain.AdmissionEntity) error {
+	admission.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": admission})
+	return err
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestAdmissionEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("one active admission per patient", func(mt *mtest.T) {
+		repo := NewAdmissionRepository(mt.Coll)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		if err := repo.EnsureIndexes(context.Background()); err != nil {
+			t.Fatalf("EnsureIndexes() error = %v", err)
+		}
+
+		e := mt.GetStartedEvent()
+		if e == nil || e.CommandName != "createIndexes" {
+			t.Fatalf("command = %v, want createIndexes", e)
+		}
+		index := e.Command.Lookup("indexes").Array().Index(0).Value().Document()
+		if !index.Lookup("unique").Boolean() {
+			t.Error("index is not unique")
+		}
+		if _, err := index.Lookup("key").Document().LookupErr("patientId"); err != nil {
+			t.Errorf("key = %v, want patientId", index.Lookup("key"))
+		}
+		status, ok := index.Lookup("partialFilterExpression", "status").StringValueOK()
+		if !ok || status != string(domain.AdmissionStatusAdmitted) {
+			t.Errorf("partialFilterExpression = %v, want admitted admissions only", index.Lookup("partialFilterExpression"))
+		}
+	})
+}
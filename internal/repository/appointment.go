@@ -43,8 +43,9 @@ func (r *AppointmentRepository) GetByID(ctx context.Context, id primitive.Object
 	return &appointment, nil
 }
 
-func (r *AppointmentRepository) GetAll(ctx context.Context) ([]*domain.AppointmentEntity, error) {
-	cur, err := r.coll.Find(ctx, bson.D{})
+// GetAll returns the appointments, optionally only those of a department.
+func (r *AppointmentRepository) GetAll(ctx context.Context, departmentID *primitive.ObjectID) ([]*domain.AppointmentEntity, error) {
+	cur, err := r.coll.Find(ctx, departmentFilter(departmentID))
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +67,27 @@ func (r *AppointmentRepository) GetAll(ctx context.Context) ([]*domain.Appointme
 	return appointments, nil
 }
 
+// ForEach calls fn with each appointment GetAll would return, reading them
+// from the cursor one at a time.
+func (r *AppointmentRepository) ForEach(ctx context.Context, departmentID *primitive.ObjectID, fn func(*domain.AppointmentEntity) error) error {
+	cur, err := r.coll.Find(ctx, departmentFilter(departmentID))
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var appointment domain.AppointmentEntity
+		if err := cur.Decode(&appointment); err != nil {
+			return err
+		}
+		if err := fn(&appointment); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
 func (r *AppointmentRepository) Update(ctx context.Context, id primitive.ObjectID, appointment *domain.AppointmentEntity) error {
 	appointment.UpdatedAt = time.Now()
 
@@ -78,20 +100,19 @@ func (r *AppointmentRepository) Update(ctx context.Context, id primitive.ObjectI
 	return err
 }
 
-func (r *AppointmentRepository) GetUpcomingAppointments(ctx context.Context, limit int) ([]*domain.UpcomingAppointment, error) {
+func (r *AppointmentRepository) GetUpcomingAppointments(ctx context.Context, limit int, departmentID *primitive.ObjectID) ([]*domain.UpcomingAppointment, error) {
 	now := time.Now()
 	end := now.Add(7 * 24 * time.Hour) // Next 7 days
 
+	match := departmentFilter(departmentID)
+	match["dateTime"] = bson.M{
+		"$gte": now,
+		"$lte": end,
+	}
+	match["status"] = bson.M{"$in": []string{"Scheduled", "Confirmed"}}
+
 	pipeline := []bson.M{
-		{
-			"$match": bson.M{
-				"dateTime": bson.M{
-					"$gte": now,
-					"$lte": end,
-				},
-				"status": bson.M{"$in": []string{"Scheduled", "Confirmed"}},
-			},
-		},
+		{"$match": match},
 		{"$sort": bson.M{"date": 1}},
 		{"$limit": limit},
 		{
@@ -137,29 +158,75 @@ func (r *AppointmentRepository) GetUpcomingAppointments(ctx context.Context, lim
 	return appointments, nil
 }
 
-func (r *AppointmentRepository) Count(ctx context.Context) (int64, error) {
-	return r.coll.CountDocuments(ctx, bson.M{})
+func (r *AppointmentRepository) Count(ctx context.Context, departmentID *primitive.ObjectID) (int64, error) {
+	return r.coll.CountDocuments(ctx, departmentFilter(departmentID))
 }
 
 // GetAppointmentsCount is kept for backward compatibility
 func (r *AppointmentRepository) GetAppointmentsCount(ctx context.Context) (int64, error) {
-	return r.Count(ctx)
+	return r.Count(ctx, nil)
+}
+
+// CountPatients returns how many distinct patients have appointments in the
+// department.
+func (r *AppointmentRepository) CountPatients(ctx context.Context, departmentID primitive.ObjectID) (int64, error) {
+	ids, err := r.coll.Distinct(ctx, "patientId", bson.M{"departmentId": departmentID})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(ids)), nil
+}
+
+func departmentFilter(departmentID *primitive.ObjectID) bson.M {
+	filter := bson.M{}
+	if departmentID != nil {
+		filter["departmentId"] = *departmentID
+	}
+	return filter
 }
 
+// GetByDoctorAndDateRange returns the doctor's appointments that overlap
+// [start, end), taking each appointment's duration into account.
 func (r *AppointmentRepository) GetByDoctorAndDateRange(
 	ctx context.Context,
 	doctorID primitive.ObjectID,
 	start, end time.Time,
 ) ([]*domain.AppointmentEntity, error) {
-	filter := bson.M{
-		"doctorId": doctorID,
-		"startTime": bson.M{
-			"$gte": start,
-			"$lt":  end,
+	filter := overlapFilter(start, end)
+	filter["doctorId"] = doctorID
+
+	return r.findOverlapping(ctx, filter)
+}
+
+// GetByRoomAndDateRange returns the room's appointments that overlap
+// [start, end), taking each appointment's duration into account.
+func (r *AppointmentRepository) GetByRoomAndDateRange(
+	ctx context.Context,
+	roomID primitive.ObjectID,
+	start, end time.Time,
+) ([]*domain.AppointmentEntity, error) {
+	filter := overlapFilter(start, end)
+	filter["roomId"] = roomID
+
+	return r.findOverlapping(ctx, filter)
+}
+
+// overlapFilter matches appointments that overlap [start, end).
+func overlapFilter(start, end time.Time) bson.M {
+	return bson.M{
+		"dateTime": bson.M{"$lt": end},
+		// dateTime + duration minutes > start
+		"$expr": bson.M{
+			"$gt": bson.A{
+				bson.M{"$add": bson.A{"$dateTime", bson.M{"$multiply": bson.A{"$duration", 60 * 1000}}}},
+				start,
+			},
 		},
 	}
+}
 
-	cur, err := r.coll.Find(ctx, filter)
+func (r *AppointmentRepository) findOverlapping(ctx context.Context, filter bson.M) ([]*domain.AppointmentEntity, error) {
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "dateTime", Value: 1}}))
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +259,71 @@ func (r *AppointmentRepository) GetByPatientID(ctx context.Context, patientID pr
 	return appointments, nil
 }
 
+// GetByPatientIDs returns the appointments of the given patients, latest
+// first.
+func (r *AppointmentRepository) GetByPatientIDs(ctx context.Context, patientIDs []primitive.ObjectID) ([]*domain.AppointmentEntity, error) {
+	filter := bson.M{"patientId": bson.M{"$in": patientIDs}}
+	opts := options.Find().SetSort(bson.D{{Key: "dateTime", Value: -1}})
+
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var appointments []*domain.AppointmentEntity
+	if err := cur.All(ctx, &appointments); err != nil {
+		return nil, err
+	}
+
+	return appointments, nil
+}
+
+// Search returns up to limit appointments starting in [from, to), optionally
+// only those of a patient or a doctor, latest first. A zero from or to leaves
+// that end of the range open.
+func (r *AppointmentRepository) Search(ctx context.Context, patientID, doctorID *primitive.ObjectID, from, to time.Time, limit int) ([]*domain.AppointmentEntity, error) {
+	filter := bson.M{}
+	if patientID != nil {
+		filter["patientId"] = *patientID
+	}
+	if doctorID != nil {
+		filter["doctorId"] = *doctorID
+	}
+	if dateTime := rangeFilter(from, to); dateTime != nil {
+		filter["dateTime"] = dateTime
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "dateTime", Value: -1}}).SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var appointments []*domain.AppointmentEntity
+	if err := cur.All(ctx, &appointments); err != nil {
+		return nil, err
+	}
+
+	return appointments, nil
+}
+
+// rangeFilter matches times in [from, to), or nil when both are zero.
+func rangeFilter(from, to time.Time) bson.M {
+	if from.IsZero() && to.IsZero() {
+		return nil
+	}
+	filter := bson.M{}
+	if !from.IsZero() {
+		filter["$gte"] = from
+	}
+	if !to.IsZero() {
+		filter["$lt"] = to
+	}
+	return filter
+}
+
 // GetRecentPatientsByDoctorID returns a list of recent patients for a doctor
 func (r *AppointmentRepository) GetRecentPatientsByDoctorID(ctx context.Context, doctorID primitive.ObjectID, limit int) ([]primitive.ObjectID, error) {
 	pipeline := []bson.M{
@@ -239,3 +371,314 @@ func (r *AppointmentRepository) GetRecentPatientsByDoctorID(ctx context.Context,
 
 	return patientIDs, nil
 }
+
+// GetRecentPatientsByDoctorIDs returns up to limit recent patients of each of
+// the given doctors, most recently seen first.
+func (r *AppointmentRepository) GetRecentPatientsByDoctorIDs(ctx context.Context, doctorIDs []primitive.ObjectID, limit int) (map[primitive.ObjectID][]primitive.ObjectID, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{"doctorId": bson.M{"$in": doctorIDs}}},
+		{"$group": bson.M{
+			"_id":       bson.M{"doctorId": "$doctorId", "patientId": "$patientId"},
+			"lastVisit": bson.M{"$max": "$dateTime"},
+		}},
+		{"$sort": bson.M{"lastVisit": -1}},
+		{"$group": bson.M{
+			"_id":        "$_id.doctorId",
+			"patientIds": bson.M{"$push": "$_id.patientId"},
+		}},
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.M{"$project": bson.M{"patientIds": bson.M{"$slice": bson.A{"$patientIds", limit}}}})
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		DoctorID   primitive.ObjectID   `bson:"_id"`
+		PatientIDs []primitive.ObjectID `bson:"patientIds"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	patientIDs := make(map[primitive.ObjectID][]primitive.ObjectID, len(results))
+	for _, result := range results {
+		patientIDs[result.DoctorID] = result.PatientIDs
+	}
+	return patientIDs, nil
+}
+
+// GetByStatusAndTimeRange returns appointments with one of the given statuses
+// whose dateTime falls in [start, end).
+func (r *AppointmentRepository) GetByStatusAndTimeRange(ctx context.Context, statuses []domain.AppointmentStatus, start, end time.Time) ([]*domain.AppointmentEntity, error) {
+	filter := bson.M{
+		"status":   bson.M{"$in": statuses},
+		"dateTime": bson.M{"$gte": start, "$lt": end},
+	}
+
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "dateTime", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var appointments []*domain.AppointmentEntity
+	if err := cur.All(ctx, &appointments); err != nil {
+		return nil, err
+	}
+
+	return appointments, nil
+}
+
+// GetBumpedUnnotified returns the active appointments that were moved for an
+// emergency and whose patient has not been told yet.
+func (r *AppointmentRepository) GetBumpedUnnotified(ctx context.Context) ([]*domain.AppointmentEntity, error) {
+	filter := bson.M{
+		"bump":            bson.M{"$exists": true},
+		"bump.notifiedAt": bson.M{"$exists": false},
+		"status":          bson.M{"$in": []domain.AppointmentStatus{domain.AppointmentStatusScheduled, domain.AppointmentStatusConfirmed}},
+	}
+
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "dateTime", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var appointments []*domain.AppointmentEntity
+	if err := cur.All(ctx, &appointments); err != nil {
+		return nil, err
+	}
+
+	return appointments, nil
+}
+
+// MarkBumpNotified records when the patient was told about the move.
+func (r *AppointmentRepository) MarkBumpNotified(ctx context.Context, id primitive.ObjectID, at time.Time) error {
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"bump.notifiedAt": at}})
+	return err
+}
+
+// periodOf is the expression naming the interval bucket a date field falls in,
+// in the time zone.
+func periodOf(field string, interval domain.AnalyticsInterval, timezone string) bson.M {
+	return bson.M{"$dateToString": bson.M{
+		"format":   interval.PeriodFormat(),
+		"date":     field,
+		"timezone": timezone,
+	}}
+}
+
+// CountByPeriod counts the appointments dated in [start, end) per period, and
+// per status, type, doctor or specialty when groupBy is set.
+func (r *AppointmentRepository) CountByPeriod(ctx context.Context, start, end time.Time, interval domain.AnalyticsInterval, timezone string, groupBy domain.AppointmentGroupBy, departmentID *primitive.ObjectID) ([]domain.AppointmentCountPoint, error) {
+	match := departmentFilter(departmentID)
+	match["dateTime"] = bson.M{"$gte": start, "$lt": end}
+
+	pipeline := []bson.M{{"$match": match}}
+
+	var group interface{}
+	switch groupBy {
+	case domain.AppointmentGroupByStatus:
+		group = "$status"
+	case domain.AppointmentGroupByType:
+		group = "$type"
+	case domain.AppointmentGroupByDoctor:
+		group = "$doctorId"
+	case domain.AppointmentGroupBySpecialty:
+		pipeline = append(pipeline,
+			bson.M{"$lookup": bson.M{
+				"from":         "doctors",
+				"localField":   "doctorId",
+				"foreignField": "_id",
+				"as":           "doctor",
+			}},
+			bson.M{"$unwind": bson.M{"path": "$doctor", "preserveNullAndEmptyArrays": true}},
+		)
+		group = "$doctor.specialty"
+	}
+
+	pipeline = append(pipeline, bson.M{"$group": bson.M{
+		"_id":   bson.M{"period": periodOf("$dateTime", interval, timezone), "group": group},
+		"count": bson.M{"$sum": 1},
+	}})
+
+	project := bson.M{"_id": 0, "period": "$_id.period", "count": 1}
+	if groupBy != domain.AppointmentGroupByNone {
+		project["group"] = bson.M{"$ifNull": bson.A{bson.M{"$toString": "$_id.group"}, ""}}
+	}
+	if groupBy == domain.AppointmentGroupByDoctor {
+		pipeline = append(pipeline, bson.M{"$lookup": bson.M{
+			"from":         "doctors",
+			"localField":   "_id.group",
+			"foreignField": "_id",
+			"as":           "doctor",
+		}})
+		project["label"] = bson.M{"$ifNull": bson.A{bson.M{"$arrayElemAt": bson.A{"$doctor.name", 0}}, ""}}
+	}
+	pipeline = append(pipeline,
+		bson.M{"$project": project},
+		bson.M{"$sort": bson.D{{Key: "period", Value: 1}, {Key: "group", Value: 1}}},
+	)
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	points := []domain.AppointmentCountPoint{}
+	if err := cursor.All(ctx, &points); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+// RatesByPeriod counts the outcomes of the appointments dated in [start, end)
+// per period. Appointments before now that are still Scheduled or Confirmed
+// count as no-shows.
+func (r *AppointmentRepository) RatesByPeriod(ctx context.Context, start, end, now time.Time, interval domain.AnalyticsInterval, timezone string, departmentID *primitive.ObjectID) ([]domain.AppointmentRatePoint, error) {
+	match := departmentFilter(departmentID)
+	match["dateTime"] = bson.M{"$gte": start, "$lt": end}
+
+	countIf := func(cond interface{}) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{cond, 1, 0}}}
+	}
+
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{
+			"_id":       periodOf("$dateTime", interval, timezone),
+			"total":     bson.M{"$sum": 1},
+			"completed": countIf(bson.M{"$eq": bson.A{"$status", domain.AppointmentStatusCompleted}}),
+			"cancelled": countIf(bson.M{"$eq": bson.A{"$status", domain.AppointmentStatusCancelled}}),
+			"noShow": countIf(bson.M{"$and": bson.A{
+				bson.M{"$in": bson.A{"$status", bson.A{domain.AppointmentStatusScheduled, domain.AppointmentStatusConfirmed}}},
+				bson.M{"$lt": bson.A{"$dateTime", now}},
+			}}),
+		}},
+		{"$sort": bson.M{"_id": 1}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	points := []domain.AppointmentRatePoint{}
+	if err := cursor.All(ctx, &points); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+// PatientMixByPeriod counts the distinct patients with an appointment dated in
+// [start, end) per period, split into those whose first ever appointment falls
+// in that period and those who had been before. Cancelled appointments are not
+// visits.
+func (r *AppointmentRepository) PatientMixByPeriod(ctx context.Context, start, end time.Time, interval domain.AnalyticsInterval, timezone string, departmentID *primitive.ObjectID) ([]domain.PatientMixPoint, error) {
+	// A patient's first visit is looked for across the whole hospital, so the
+	// department only narrows the visits counted
+	visits := bson.M{"visits.dateTime": bson.M{"$gte": start}}
+	if departmentID != nil {
+		visits["visits.departmentId"] = *departmentID
+	}
+
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"status":   bson.M{"$ne": domain.AppointmentStatusCancelled},
+			"dateTime": bson.M{"$lt": end},
+		}},
+		{"$group": bson.M{
+			"_id":    "$patientId",
+			"first":  bson.M{"$min": "$dateTime"},
+			"visits": bson.M{"$push": bson.M{"dateTime": "$dateTime", "departmentId": "$departmentId"}},
+		}},
+		{"$unwind": "$visits"},
+		{"$match": visits},
+		{"$project": bson.M{
+			"period": periodOf("$visits.dateTime", interval, timezone),
+			"isNew": bson.M{"$eq": bson.A{
+				periodOf("$first", interval, timezone),
+				periodOf("$visits.dateTime", interval, timezone),
+			}},
+		}},
+		{"$group": bson.M{
+			"_id":   bson.M{"period": "$period", "patientId": "$_id"},
+			"isNew": bson.M{"$max": "$isNew"},
+		}},
+		{"$group": bson.M{
+			"_id":       "$_id.period",
+			"new":       bson.M{"$sum": bson.M{"$cond": bson.A{"$isNew", 1, 0}}},
+			"returning": bson.M{"$sum": bson.M{"$cond": bson.A{"$isNew", 0, 1}}},
+		}},
+		{"$sort": bson.M{"_id": 1}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	points := []domain.PatientMixPoint{}
+	if err := cursor.All(ctx, &points); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+// UtilizationByDoctor sums up, per doctor, the appointments dated in
+// [start, end): their outcomes, the minutes booked and the average hours from
+// booking to visit. Cancelled appointments count towards neither the minutes
+// nor the lead time; appointments before now that are still Scheduled or
+// Confirmed count as no-shows.
+func (r *AppointmentRepository) UtilizationByDoctor(ctx context.Context, start, end, now time.Time, departmentID *primitive.ObjectID) ([]domain.DoctorUtilization, error) {
+	match := departmentFilter(departmentID)
+	match["dateTime"] = bson.M{"$gte": start, "$lt": end}
+
+	notCancelled := bson.M{"$ne": bson.A{"$status", domain.AppointmentStatusCancelled}}
+	countIf := func(cond interface{}) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{cond, 1, 0}}}
+	}
+
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{
+			"_id":       "$doctorId",
+			"total":     bson.M{"$sum": 1},
+			"completed": countIf(bson.M{"$eq": bson.A{"$status", domain.AppointmentStatusCompleted}}),
+			"cancelled": countIf(bson.M{"$eq": bson.A{"$status", domain.AppointmentStatusCancelled}}),
+			"noShow": countIf(bson.M{"$and": bson.A{
+				bson.M{"$in": bson.A{"$status", bson.A{domain.AppointmentStatusScheduled, domain.AppointmentStatusConfirmed}}},
+				bson.M{"$lt": bson.A{"$dateTime", now}},
+			}}),
+			"bookedMinutes": bson.M{"$sum": bson.M{"$cond": bson.A{notCancelled, "$duration", 0}}},
+			"leadTimeMs": bson.M{"$avg": bson.M{"$cond": bson.A{
+				notCancelled,
+				bson.M{"$subtract": bson.A{"$dateTime", "$createdAt"}},
+				nil,
+			}}},
+		}},
+		{"$addFields": bson.M{
+			"avgLeadTimeHours": bson.M{"$ifNull": bson.A{bson.M{"$divide": bson.A{"$leadTimeMs", 3600000}}, 0}},
+		}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var stats []domain.DoctorUtilization
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
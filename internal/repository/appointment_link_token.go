@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type AppointmentLinkTokenRepository struct {
+	coll *mongo.Collection
+}
+
+func NewAppointmentLinkTokenRepository(coll *mongo.Collection) *AppointmentLinkTokenRepository {
+	return &AppointmentLinkTokenRepository{coll}
+}
+
+// MarkUsed records the token as used. It returns false when the token had
+// already been used.
+func (r *AppointmentLinkTokenRepository) MarkUsed(ctx context.Context, token *domain.AppointmentLinkTokenEntity) (bool, error) {
+	_, err := r.coll.InsertOne(ctx, token)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
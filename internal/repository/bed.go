@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type BedRepository struct {
+	coll *mongo.Collection
+}
+
+func NewBedRepository(coll *mongo.Collection) *BedRepository {
+	return &BedRepository{coll}
+}
+
+func (r *BedRepository) Create(ctx context.Context, bed *domain.BedEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	bed.CreatedAt = now
+	bed.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, bed)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *BedRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.BedEntity, error) {
+	var bed domain.BedEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&bed)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &bed, nil
+}
+
+// GetByRoomAndLabel looks up a bed by its label within a room.
+func (r *BedRepository) GetByRoomAndLabel(ctx context.Context, roomID primitive.ObjectID, label string) (*domain.BedEntity, error) {
+	var bed domain.BedEntity
+	err := r.coll.FindOne(ctx, bson.M{"roomId": roomID, "label": label}).Decode(&bed)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &bed, nil
+}
+
+func (r *BedRepository) GetByWardID(ctx context.Context, wardID primitive.ObjectID) ([]*domain.BedEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "label", Value: 1}})
+	cur, err := r.coll.Find(ctx, bson.M{"wardId": wardID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var beds []*domain.BedEntity
+	if err := cur.All(ctx, &beds); err != nil {
+		return nil, err
+	}
+	return beds, nil
+}
+
+// Occupy atomically claims an available bed for an admission.
+// It returns false when the bed is missing or not available.
+func (r *BedRepository) Occupy(ctx context.Context, id, admissionID, updaterID primitive.ObjectID) (bool, error) {
+	res, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": domain.BedStatusAvailable},
+		bson.M{"$set": bson.M{
+			"status":             domain.BedStatusOccupied,
+			"currentAdmissionId": admissionID,
+			"updatedBy":          updaterID,
+			"updatedAt":          time.Now(),
+		}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// Release frees a bed held by the given admission and sends it to cleaning.
+func (r *BedRepository) Release(ctx context.Context, id, admissionID, updaterID primitive.ObjectID) error {
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "currentAdmissionId": admissionID},
+		bson.M{"$set": bson.M{
+			"status":             domain.BedStatusCleaning,
+			"currentAdmissionId": nil,
+			"updatedBy":          updaterID,
+			"updatedAt":          time.Now(),
+		}},
+	)
+	return err
+}
+
+// SetStatus changes the housekeeping status of a bed that is not occupied.
+// It returns false when the bed is missing or currently occupied.
+func (r *BedRepository) SetStatus(ctx context.Context, id primitive.ObjectID, status domain.BedStatus, updaterID primitive.ObjectID) (bool, error) {
+	res, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": bson.M{"$ne": domain.BedStatusOccupied}},
+		bson.M{"$set": bson.M{
+			"status":    status,
+			"updatedBy": updaterID,
+			"updatedAt": time.Now(),
+		}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount == 1, nil
+}
+
+// CountByStatus returns the number of beds per status, optionally limited to a ward.
+func (r *BedRepository) CountByStatus(ctx context.Context, wardID *primitive.ObjectID) (map[domain.BedStatus]int64, error) {
+	match := bson.M{}
+	if wardID != nil {
+		match["wardId"] = *wardID
+	}
+
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Status domain.BedStatus `bson:"_id"`
+		Count  int64            `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[domain.BedStatus]int64, len(results))
+	for _, result := range results {
+		counts[result.Status] = result.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ClaimRepository struct {
+	coll *mongo.Collection
+}
+
+func NewClaimRepository(coll *mongo.Collection) *ClaimRepository {
+	return &ClaimRepository{coll}
+}
+
+func (r *ClaimRepository) Create(ctx context.Context, claim *domain.ClaimEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	claim.CreatedAt = now
+	claim.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, claim)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *ClaimRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.ClaimEntity, error) {
+	var claim domain.ClaimEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&claim)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &claim, nil
+}
+
+// GetOpenByInvoiceID returns the claim for an invoice that has not been rejected.
+func (r *ClaimRepository) GetOpenByInvoiceID(ctx context.Context, invoiceID primitive.ObjectID) (*domain.ClaimEntity, error) {
+	var claim domain.ClaimEntity
+	filter := bson.M{"invoiceId": invoiceID, "status": bson.M{"$ne": domain.ClaimStatusRejected}}
+	err := r.coll.FindOne(ctx, filter).Decode(&claim)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &claim, nil
+}
+
+// GetAll returns claims, optionally filtered by status and payer type, oldest service date first.
+func (r *ClaimRepository) GetAll(ctx context.Context, status domain.ClaimStatus, payerType domain.PayerType) ([]*domain.ClaimEntity, error) {
+	filter := bson.M{}
+	if status != "" {
+		filter["status"] = status
+	}
+	if payerType != "" {
+		filter["payerType"] = payerType
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "serviceDate", Value: 1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var claims []*domain.ClaimEntity
+	if err := cur.All(ctx, &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// Update saves the claim if it is still in status from. It returns false
+// when the claim's status changed since it was read, e.g. by a concurrent
+// request.
+func (r *ClaimRepository) Update(ctx context.Context, claim *domain.ClaimEntity, from domain.ClaimStatus) (bool, error) {
+	claim.UpdatedAt = time.Now()
+
+	res, err := r.coll.UpdateOne(ctx, bson.M{"_id": claim.ID, "status": from}, bson.M{"$set": claim})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount == 1, nil
+}
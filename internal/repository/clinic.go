@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ClinicRepository struct {
+	coll *mongo.Collection
+}
+
+func NewClinicRepository(coll *mongo.Collection) *ClinicRepository {
+	return &ClinicRepository{coll}
+}
+
+func (r *ClinicRepository) Create(ctx context.Context, clinic *domain.ClinicEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	clinic.CreatedAt = now
+	clinic.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, clinic)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *ClinicRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.ClinicEntity, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *ClinicRepository) GetByCode(ctx context.Context, code string) (*domain.ClinicEntity, error) {
+	return r.findOne(ctx, bson.M{"code": code})
+}
+
+// GetAll returns the clinics, optionally only those of a department.
+func (r *ClinicRepository) GetAll(ctx context.Context, departmentID *primitive.ObjectID) ([]*domain.ClinicEntity, error) {
+	filter := bson.M{}
+	if departmentID != nil {
+		filter["departmentId"] = *departmentID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var clinics []*domain.ClinicEntity
+	if err := cur.All(ctx, &clinics); err != nil {
+		return nil, err
+	}
+	return clinics, nil
+}
+
+func (r *ClinicRepository) Update(ctx context.Context, id primitive.ObjectID, clinic *domain.ClinicEntity) error {
+	clinic.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": clinic})
+	return err
+}
+
+func (r *ClinicRepository) findOne(ctx context.Context, filter bson.M) (*domain.ClinicEntity, error) {
+	var clinic domain.ClinicEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&clinic)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &clinic, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ClinicRoomRepository struct {
+	coll *mongo.Collection
+}
+
+func NewClinicRoomRepository(coll *mongo.Collection) *ClinicRoomRepository {
+	return &ClinicRoomRepository{coll}
+}
+
+func (r *ClinicRoomRepository) Create(ctx context.Context, room *domain.ClinicRoomEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	room.CreatedAt = now
+	room.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, room)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *ClinicRoomRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.ClinicRoomEntity, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *ClinicRoomRepository) GetByClinicAndName(ctx context.Context, clinicID primitive.ObjectID, name string) (*domain.ClinicRoomEntity, error) {
+	return r.findOne(ctx, bson.M{"clinicId": clinicID, "name": name})
+}
+
+// GetAll returns the rooms, optionally only those of a clinic or department.
+func (r *ClinicRoomRepository) GetAll(ctx context.Context, clinicID, departmentID *primitive.ObjectID) ([]*domain.ClinicRoomEntity, error) {
+	filter := bson.M{}
+	if clinicID != nil {
+		filter["clinicId"] = *clinicID
+	}
+	if departmentID != nil {
+		filter["departmentId"] = *departmentID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "clinicId", Value: 1}, {Key: "name", Value: 1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var rooms []*domain.ClinicRoomEntity
+	if err := cur.All(ctx, &rooms); err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+func (r *ClinicRoomRepository) Update(ctx context.Context, id primitive.ObjectID, room *domain.ClinicRoomEntity) error {
+	room.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": room})
+	return err
+}
+
+// SetDepartmentByClinic moves every room of the clinic to the department, for
+// when the clinic itself moves.
+func (r *ClinicRoomRepository) SetDepartmentByClinic(ctx context.Context, clinicID, departmentID primitive.ObjectID) error {
+	update := bson.M{"$set": bson.M{"departmentId": departmentID, "updatedAt": time.Now()}}
+	_, err := r.coll.UpdateMany(ctx, bson.M{"clinicId": clinicID}, update)
+	return err
+}
+
+func (r *ClinicRoomRepository) findOne(ctx context.Context, filter bson.M) (*domain.ClinicRoomEntity, error) {
+	var room domain.ClinicRoomEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&room)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &room, nil
+}
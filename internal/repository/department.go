@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type DepartmentRepository struct {
+	coll *mongo.Collection
+}
+
+func NewDepartmentRepository(coll *mongo.Collection) *DepartmentRepository {
+	return &DepartmentRepository{coll}
+}
+
+func (r *DepartmentRepository) Create(ctx context.Context, department *domain.DepartmentEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	department.CreatedAt = now
+	department.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, department)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *DepartmentRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.DepartmentEntity, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *DepartmentRepository) GetByCode(ctx context.Context, code string) (*domain.DepartmentEntity, error) {
+	return r.findOne(ctx, bson.M{"code": code})
+}
+
+func (r *DepartmentRepository) GetAll(ctx context.Context) ([]*domain.DepartmentEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cur, err := r.coll.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var departments []*domain.DepartmentEntity
+	if err := cur.All(ctx, &departments); err != nil {
+		return nil, err
+	}
+	return departments, nil
+}
+
+func (r *DepartmentRepository) Update(ctx context.Context, id primitive.ObjectID, department *domain.DepartmentEntity) error {
+	department.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": department})
+	return err
+}
+
+func (r *DepartmentRepository) findOne(ctx context.Context, filter bson.M) (*domain.DepartmentEntity, error) {
+	var department domain.DepartmentEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&department)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &department, nil
+}
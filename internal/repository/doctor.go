@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/ekastn/hms-api/internal/domain"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type DoctorRepository struct {
@@ -73,8 +75,24 @@ func (r *DoctorRepository) GetByID(ctx context.Context, id primitive.ObjectID) (
 	return &doctor, nil
 }
 
-func (r *DoctorRepository) GetAll(ctx context.Context) ([]*domain.DoctorEntity, error) {
-	cur, err := r.coll.Find(ctx, bson.M{"isDeleted": bson.M{"$ne": true}})
+// GetByIDs returns the doctors with the given IDs, in no particular order.
+func (r *DoctorRepository) GetByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*domain.DoctorEntity, error) {
+	cur, err := r.coll.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var doctors []*domain.DoctorEntity
+	if err := cur.All(ctx, &doctors); err != nil {
+		return nil, err
+	}
+	return doctors, nil
+}
+
+// GetAll returns the doctors, optionally only those of a department.
+func (r *DoctorRepository) GetAll(ctx context.Context, departmentID *primitive.ObjectID) ([]*domain.DoctorEntity, error) {
+	cur, err := r.coll.Find(ctx, activeDoctorsFilter(departmentID))
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +109,28 @@ func (r *DoctorRepository) GetAll(ctx context.Context) ([]*domain.DoctorEntity,
 	return doctors, nil
 }
 
+// Search returns up to limit doctors whose name contains name, ignoring case,
+// sorted by name. An empty name matches every doctor.
+func (r *DoctorRepository) Search(ctx context.Context, name string, limit int) ([]*domain.DoctorEntity, error) {
+	filter := activeDoctorsFilter(nil)
+	if name != "" {
+		filter["name"] = bson.M{"$regex": regexp.QuoteMeta(name), "$options": "i"}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}}).SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var doctors []*domain.DoctorEntity
+	if err := cur.All(ctx, &doctors); err != nil {
+		return nil, err
+	}
+	return doctors, nil
+}
+
 func (r *DoctorRepository) Update(ctx context.Context, id primitive.ObjectID, doctor *domain.DoctorEntity) error {
 	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": doctor})
 	return err
@@ -101,6 +141,33 @@ func (r *DoctorRepository) Delete(ctx context.Context, id primitive.ObjectID) er
 	return err
 }
 
-func (r *DoctorRepository) Count(ctx context.Context) (int64, error) {
-	return r.coll.CountDocuments(ctx, bson.M{"isDeleted": bson.M{"$ne": true}})
+func (r *DoctorRepository) Count(ctx context.Context, departmentID *primitive.ObjectID) (int64, error) {
+	return r.coll.CountDocuments(ctx, activeDoctorsFilter(departmentID))
+}
+
+func activeDoctorsFilter(departmentID *primitive.ObjectID) bson.M {
+	filter := bson.M{"isDeleted": bson.M{"$ne": true}}
+	if departmentID != nil {
+		filter["departmentId"] = *departmentID
+	}
+	return filter
+}
+
+// GetBySpecialty returns the active doctors of a specialty, matched
+// case-insensitively.
+func (r *DoctorRepository) GetBySpecialty(ctx context.Context, specialty string) ([]*domain.DoctorEntity, error) {
+	filter := bson.M{"specialty": specialty, "isDeleted": bson.M{"$ne": true}}
+	opts := options.Find().SetCollation(&options.Collation{Locale: "en", Strength: 2})
+
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var doctors []*domain.DoctorEntity
+	if err := cur.All(ctx, &doctors); err != nil {
+		return nil, err
+	}
+	return doctors, nil
 }
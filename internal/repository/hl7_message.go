@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type HL7MessageRepository struct {
+	coll *mongo.Collection
+}
+
+func NewHL7MessageRepository(coll *mongo.Collection) *HL7MessageRepository {
+	return &HL7MessageRepository{coll}
+}
+
+func (r *HL7MessageRepository) Create(ctx context.Context, msg *domain.HL7MessageEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	msg.CreatedAt = now
+	msg.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, msg)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *HL7MessageRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.HL7MessageEntity, error) {
+	var msg domain.HL7MessageEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// GetProcessedInbound returns the processed inbound message with the control
+// ID from the sender, so a message the sender retransmits because it missed
+// the ACK is not applied twice.
+func (r *HL7MessageRepository) GetProcessedInbound(ctx context.Context, controlID, sendingApplication, sendingFacility string) (*domain.HL7MessageEntity, error) {
+	filter := bson.M{
+		"direction":          domain.HL7Inbound,
+		"status":             domain.HL7MessageProcessed,
+		"controlId":          controlID,
+		"sendingApplication": sendingApplication,
+		"sendingFacility":    sendingFacility,
+	}
+
+	var msg domain.HL7MessageEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&msg)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// ExistsForEvent reports whether an outbound message for the event was already queued.
+func (r *HL7MessageRepository) ExistsForEvent(ctx context.Context, eventID string) (bool, error) {
+	count, err := r.coll.CountDocuments(ctx, bson.M{"direction": domain.HL7Outbound, "eventId": eventID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetAll returns messages, optionally filtered by direction, status, message
+// type and patient, most recent first.
+func (r *HL7MessageRepository) GetAll(ctx context.Context, direction domain.HL7Direction, status domain.HL7MessageStatus, messageType, patientID string, limit int64) ([]*domain.HL7MessageEntity, error) {
+	filter := bson.M{}
+	if direction != "" {
+		filter["direction"] = direction
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	if messageType != "" {
+		filter["messageType"] = messageType
+	}
+	if patientID != "" {
+		filter["patientId"] = patientID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}).SetLimit(limit)
+	return r.find(ctx, filter, opts)
+}
+
+// GetDue returns pending or failed outbound messages whose next attempt is due.
+func (r *HL7MessageRepository) GetDue(ctx context.Context, at time.Time, limit int64) ([]*domain.HL7MessageEntity, error) {
+	filter := bson.M{
+		"direction":     domain.HL7Outbound,
+		"status":        bson.M{"$in": []domain.HL7MessageStatus{domain.HL7MessagePending, domain.HL7MessageFailed}},
+		"nextAttemptAt": bson.M{"$lte": at},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).SetLimit(limit)
+	return r.find(ctx, filter, opts)
+}
+
+// Claim leases a due outbound message until leaseUntil so that only one
+// sender sends it. It returns false when another sender got there first.
+func (r *HL7MessageRepository) Claim(ctx context.Context, id primitive.ObjectID, at, leaseUntil time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":           id,
+		"direction":     domain.HL7Outbound,
+		"status":        bson.M{"$in": []domain.HL7MessageStatus{domain.HL7MessagePending, domain.HL7MessageFailed}},
+		"nextAttemptAt": bson.M{"$lte": at},
+	}
+
+	res, err := r.coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"nextAttemptAt": leaseUntil}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+func (r *HL7MessageRepository) Update(ctx context.Context, id primitive.ObjectID, msg *domain.HL7MessageEntity) error {
+	msg.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": msg})
+	return err
+}
+
+func (r *HL7MessageRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*domain.HL7MessageEntity, error) {
+	cur, err := r.coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var msgs []*domain.HL7MessageEntity
+	if err := cur.All(ctx, &msgs); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
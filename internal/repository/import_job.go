@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ImportJobRepository struct {
+	coll *mongo.Collection
+}
+
+func NewImportJobRepository(coll *mongo.Collection) *ImportJobRepository {
+	return &ImportJobRepository{coll}
+}
+
+func (r *ImportJobRepository) Create(ctx context.Context, job *domain.ImportJobEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	job.LeaseUntil = now
+	if job.Results == nil {
+		job.Results = []domain.ImportRowResult{}
+	}
+
+	res, err := r.coll.InsertOne(ctx, job)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *ImportJobRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.ImportJobEntity, error) {
+	var job domain.ImportJobEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// GetAll returns jobs, optionally of one kind, most recent first and without
+// their uploaded files.
+func (r *ImportJobRepository) GetAll(ctx context.Context, kind domain.ImportKind, limit int64) ([]*domain.ImportJobEntity, error) {
+	filter := bson.M{}
+	if kind != "" {
+		filter["kind"] = kind
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+		SetLimit(limit).
+		SetProjection(bson.M{"content": 0})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var jobs []*domain.ImportJobEntity
+	if err := cur.All(ctx, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ClaimNext leases the oldest job that is waiting, or whose worker stopped
+// renewing its lease, until leaseUntil. It returns nil when there is none.
+func (r *ImportJobRepository) ClaimNext(ctx context.Context, at, leaseUntil time.Time) (*domain.ImportJobEntity, error) {
+	filter := bson.M{
+		"status":     bson.M{"$in": []domain.ImportJobStatus{domain.ImportJobPending, domain.ImportJobRunning}},
+		"leaseUntil": bson.M{"$lte": at},
+	}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"status":     domain.ImportJobRunning,
+		"leaseUntil": leaseUntil,
+		"startedAt":  bson.M{"$ifNull": bson.A{"$startedAt", at}},
+		"updatedAt":  at,
+	}}}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "createdAt", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job domain.ImportJobEntity
+	err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// SaveProgress appends the results of the rows processed since the last save
+// and renews the job's lease.
+func (r *ImportJobRepository) SaveProgress(ctx context.Context, id primitive.ObjectID, processedRows int, results []domain.ImportRowResult, leaseUntil time.Time) error {
+	update := bson.M{
+		"$set": bson.M{
+			"processedRows": processedRows,
+			"leaseUntil":    leaseUntil,
+			"updatedAt":     time.Now(),
+		},
+		"$push": bson.M{"results": bson.M{"$each": results}},
+	}
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// Finish marks a job completed or failed.
+func (r *ImportJobRepository) Finish(ctx context.Context, id primitive.ObjectID, status domain.ImportJobStatus, errMsg string) error {
+	now := time.Now()
+	update := bson.M{"$set": bson.M{
+		"status":     status,
+		"error":      errMsg,
+		"finishedAt": now,
+		"updatedAt":  now,
+	}}
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type InsurancePolicyRepository struct {
+	coll *mongo.Collection
+}
+
+func NewInsurancePolicyRepository(coll *mongo.Collection) *InsurancePolicyRepository {
+	return &InsurancePolicyRepository{coll}
+}
+
+func (r *InsurancePolicyRepository) Create(ctx context.Context, policy *domain.InsurancePolicyEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	policy.CreatedAt = now
+	policy.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, policy)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *InsurancePolicyRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.InsurancePolicyEntity, error) {
+	var policy domain.InsurancePolicyEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&policy)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *InsurancePolicyRepository) GetByPayerAndMember(ctx context.Context, payerName, memberNumber string) (*domain.InsurancePolicyEntity, error) {
+	var policy domain.InsurancePolicyEntity
+	err := r.coll.FindOne(ctx, bson.M{"payerName": payerName, "memberNumber": memberNumber}).Decode(&policy)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// GetAll returns policies, optionally limited to a patient, BPJS first.
+func (r *InsurancePolicyRepository) GetAll(ctx context.Context, patientID *primitive.ObjectID) ([]*domain.InsurancePolicyEntity, error) {
+	filter := bson.M{}
+	if patientID != nil {
+		filter["patientId"] = *patientID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "payerType", Value: 1}, {Key: "validFrom", Value: -1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var policies []*domain.InsurancePolicyEntity
+	if err := cur.All(ctx, &policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+func (r *InsurancePolicyRepository) Update(ctx context.Context, id primitive.ObjectID, policy *domain.InsurancePolicyEntity) error {
+	policy.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": policy})
+	return err
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type InvoiceRepository struct {
+	coll *mongo.Collection
+}
+
+func NewInvoiceRepository(coll *mongo.Collection) *InvoiceRepository {
+	return &InvoiceRepository{coll}
+}
+
+func (r *InvoiceRepository) Create(ctx context.Context, invoice *domain.InvoiceEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	invoice.CreatedAt = now
+	invoice.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, invoice)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *InvoiceRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.InvoiceEntity, error) {
+	var invoice domain.InvoiceEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&invoice)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// GetByAppointmentID returns the non-void invoice raised for an appointment.
+func (r *InvoiceRepository) GetByAppointmentID(ctx context.Context, appointmentID primitive.ObjectID) (*domain.InvoiceEntity, error) {
+	var invoice domain.InvoiceEntity
+	filter := bson.M{"appointmentId": appointmentID, "status": bson.M{"$ne": domain.InvoiceStatusVoid}}
+	err := r.coll.FindOne(ctx, filter).Decode(&invoice)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// GetAll returns invoices, optionally filtered by patient and status, most recent first.
+func (r *InvoiceRepository) GetAll(ctx context.Context, patientID *primitive.ObjectID, status domain.InvoiceStatus) ([]*domain.InvoiceEntity, error) {
+	filter := bson.M{}
+	if patientID != nil {
+		filter["patientId"] = *patientID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "issuedAt", Value: -1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var invoices []*domain.InvoiceEntity
+	if err := cur.All(ctx, &invoices); err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+// Update saves the invoice if it is still in status from. It returns false
+// when the invoice's status changed since it was read, e.g. by a concurrent
+// payment.
+func (r *InvoiceRepository) Update(ctx context.Context, id primitive.ObjectID, invoice *domain.InvoiceEntity, from domain.InvoiceStatus) (bool, error) {
+	invoice.UpdatedAt = time.Now()
+
+	res, err := r.coll.UpdateOne(ctx, bson.M{"_id": id, "status": from}, bson.M{"$set": invoice})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount == 1, nil
+}
+
+// GetPatientBalance sums the non-void invoices of a patient.
+func (r *InvoiceRepository) GetPatientBalance(ctx context.Context, patientID primitive.ObjectID) (*domain.PatientBalance, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{"patientId": patientID, "status": bson.M{"$ne": domain.InvoiceStatusVoid}}},
+		{"$group": bson.M{
+			"_id":           nil,
+			"count":         bson.M{"$sum": 1},
+			"totalBilled":   bson.M{"$sum": "$total"},
+			"totalPaid":     bson.M{"$sum": "$amountPaid"},
+			"totalRefunded": bson.M{"$sum": "$amountRefunded"},
+		}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Count         int64 `bson:"count"`
+		TotalBilled   int64 `bson:"totalBilled"`
+		TotalPaid     int64 `bson:"totalPaid"`
+		TotalRefunded int64 `bson:"totalRefunded"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	balance := &domain.PatientBalance{
+		PatientID: patientID.Hex(),
+		Currency:  domain.DefaultCurrency,
+	}
+	if len(results) > 0 {
+		balance.InvoiceCount = results[0].Count
+		balance.TotalBilled = results[0].TotalBilled
+		balance.TotalPaid = results[0].TotalPaid
+		balance.TotalRefunded = results[0].TotalRefunded
+		balance.Outstanding = balance.TotalBilled - balance.TotalPaid + balance.TotalRefunded
+	}
+	return balance, nil
+}
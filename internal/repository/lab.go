@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type LabOrderRepository struct {
+	coll *mongo.Collection
+}
+
+func NewLabOrderRepository(coll *mongo.Collection) *LabOrderRepository {
+	return &LabOrderRepository{coll}
+}
+
+func (r *LabOrderRepository) Create(ctx context.Context, order *domain.LabOrderEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	order.CreatedAt = now
+	order.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, order)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *LabOrderRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.LabOrderEntity, error) {
+	var order domain.LabOrderEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&order)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *LabOrderRepository) GetAll(ctx context.Context) ([]*domain.LabOrderEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	return r.findOrders(ctx, bson.M{}, opts)
+}
+
+// GetByStatuses returns orders in any of the given statuses, oldest first.
+func (r *LabOrderRepository) GetByStatuses(ctx context.Context, statuses ...domain.LabOrderStatus) ([]*domain.LabOrderEntity, error) {
+	filter := bson.M{"status": bson.M{"$in": statuses}}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	return r.findOrders(ctx, filter, opts)
+}
+
+// GetByPatientID returns all orders for a patient, most recent first.
+func (r *LabOrderRepository) GetByPatientID(ctx context.Context, patientID primitive.ObjectID) ([]*domain.LabOrderEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	return r.findOrders(ctx, bson.M{"patientId": patientID}, opts)
+}
+
+// GetCompletedByPatientID returns the resulted orders for a patient, most recent first.
+func (r *LabOrderRepository) GetCompletedByPatientID(ctx context.Context, patientID primitive.ObjectID) ([]*domain.LabOrderEntity, error) {
+	filter := bson.M{"patientId": patientID, "status": domain.LabOrderStatusCompleted}
+	opts := options.Find().SetSort(bson.D{{Key: "completedAt", Value: -1}})
+	return r.findOrders(ctx, filter, opts)
+}
+
+func (r *LabOrderRepository) Update(ctx context.Context, id primitive.ObjectID, order *domain.LabOrderEntity) error {
+	order.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": order})
+	return err
+}
+
+// UpdateResults saves the order if its status and results are still from
+// and fromResults. It returns false when the order changed since it was
+// read, e.g. by results entered concurrently.
+func (r *LabOrderRepository) UpdateResults(ctx context.Context, order *domain.LabOrderEntity, from domain.LabOrderStatus, fromResults []domain.LabResult) (bool, error) {
+	order.UpdatedAt = time.Now()
+
+	filter := bson.M{"_id": order.ID, "status": from, "results": fromResults}
+	res, err := r.coll.UpdateOne(ctx, filter, bson.M{"$set": order})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount == 1, nil
+}
+
+func (r *LabOrderRepository) findOrders(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*domain.LabOrderEntity, error) {
+	cur, err := r.coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var orders []*domain.LabOrderEntity
+	if err := cur.All(ctx, &orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
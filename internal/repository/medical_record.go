@@ -37,6 +37,27 @@ func (r *MedicalRecordRepository) FindAll(ctx context.Context) ([]*domain.Medica
 	return r.findRecords(ctx, bson.M{})
 }
 
+// ForEach calls fn with each medical record, reading them from the cursor one
+// at a time.
+func (r *MedicalRecordRepository) ForEach(ctx context.Context, fn func(*domain.MedicalRecordEntity) error) error {
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var record domain.MedicalRecordEntity
+		if err := cursor.Decode(&record); err != nil {
+			return err
+		}
+		if err := fn(&record); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
 func (r *MedicalRecordRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*domain.MedicalRecordEntity, error) {
 	var record domain.MedicalRecordEntity
 	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&record)
@@ -70,6 +91,13 @@ func (r *MedicalRecordRepository) GetByPatientID(ctx context.Context, patientID
 	return r.findRecords(ctx, bson.M{"patientId": patientID}, opts)
 }
 
+// GetByPatientIDs returns the medical records of the given patients, latest
+// first.
+func (r *MedicalRecordRepository) GetByPatientIDs(ctx context.Context, patientIDs []primitive.ObjectID) ([]*domain.MedicalRecordEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: -1}})
+	return r.findRecords(ctx, bson.M{"patientId": bson.M{"$in": patientIDs}}, opts)
+}
+
 func (r *MedicalRecordRepository) GetByDateRange(ctx context.Context, start, end time.Time) ([]*domain.MedicalRecordEntity, error) {
 	filter := bson.M{
 		"date": bson.M{
@@ -81,6 +109,35 @@ func (r *MedicalRecordRepository) GetByDateRange(ctx context.Context, start, end
 	return r.findRecords(ctx, filter, options.Find().SetSort(bson.D{{Key: "date", Value: -1}}))
 }
 
+// GetByPatientAndDateRange retrieves a patient's records within a date range, oldest first.
+func (r *MedicalRecordRepository) GetByPatientAndDateRange(ctx context.Context, patientID primitive.ObjectID, start, end time.Time) ([]*domain.MedicalRecordEntity, error) {
+	filter := bson.M{
+		"patientId": patientID,
+		"date": bson.M{
+			"$gte": start,
+			"$lte": end,
+		},
+		"isDeleted": bson.M{"$ne": true},
+	}
+	return r.findRecords(ctx, filter, options.Find().SetSort(bson.D{{Key: "date", Value: 1}}))
+}
+
+// Search returns up to limit records dated in [from, to), optionally only
+// those of a patient, latest first. A zero from or to leaves that end of the
+// range open.
+func (r *MedicalRecordRepository) Search(ctx context.Context, patientID *primitive.ObjectID, from, to time.Time, limit int) ([]*domain.MedicalRecordEntity, error) {
+	filter := bson.M{"isDeleted": bson.M{"$ne": true}}
+	if patientID != nil {
+		filter["patientId"] = *patientID
+	}
+	if date := rangeFilter(from, to); date != nil {
+		filter["date"] = date
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: -1}}).SetLimit(int64(limit))
+	return r.findRecords(ctx, filter, opts)
+}
+
 func (r *MedicalRecordRepository) Update(ctx context.Context, id primitive.ObjectID, record *domain.MedicalRecordEntity) error {
 	record.UpdatedAt = time.Now()
 
@@ -100,3 +157,73 @@ func (r *MedicalRecordRepository) Delete(ctx context.Context, id primitive.Objec
 func (r *MedicalRecordRepository) Count(ctx context.Context) (int64, error) {
 	return r.collection.CountDocuments(ctx, bson.M{"isDeleted": bson.M{"$ne": true}})
 }
+
+// TopDiagnoses returns the diagnoses recorded most often in [start, end), with
+// how many distinct patients had them. Diagnoses differing only in case or
+// surrounding spaces are counted together.
+func (r *MedicalRecordRepository) TopDiagnoses(ctx context.Context, start, end time.Time, limit int) ([]domain.DiagnosisCount, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"date":      bson.M{"$gte": start, "$lt": end},
+			"diagnosis": bson.M{"$nin": bson.A{"", nil}},
+			"isDeleted": bson.M{"$ne": true},
+		}},
+		{"$group": bson.M{
+			"_id":       bson.M{"$toLower": bson.M{"$trim": bson.M{"input": "$diagnosis"}}},
+			"diagnosis": bson.M{"$first": bson.M{"$trim": bson.M{"input": "$diagnosis"}}},
+			"count":     bson.M{"$sum": 1},
+			"patients":  bson.M{"$addToSet": "$patientId"},
+		}},
+		{"$project": bson.M{
+			"_id":       0,
+			"diagnosis": 1,
+			"count":     1,
+			"patients":  bson.M{"$size": "$patients"},
+		}},
+		{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "diagnosis", Value: 1}}},
+		{"$limit": limit},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	diagnoses := []domain.DiagnosisCount{}
+	if err := cursor.All(ctx, &diagnoses); err != nil {
+		return nil, err
+	}
+	return diagnoses, nil
+}
+
+// CountByDoctor counts the records dated in [start, end) per doctor.
+func (r *MedicalRecordRepository) CountByDoctor(ctx context.Context, start, end time.Time) (map[primitive.ObjectID]int64, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"date":      bson.M{"$gte": start, "$lt": end},
+			"isDeleted": bson.M{"$ne": true},
+		}},
+		{"$group": bson.M{"_id": "$doctorId", "count": bson.M{"$sum": 1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		ID    primitive.ObjectID `bson:"_id"`
+		Count int64              `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[primitive.ObjectID]int64, len(results))
+	for _, result := range results {
+		counts[result.ID] = result.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type OutboxRepository struct {
+	coll *mongo.Collection
+}
+
+func NewOutboxRepository(coll *mongo.Collection) *OutboxRepository {
+	return &OutboxRepository{coll}
+}
+
+func (r *OutboxRepository) Create(ctx context.Context, msg *domain.OutboxMessageEntity) error {
+	_, err := r.coll.InsertOne(ctx, msg)
+	return err
+}
+
+// GetDue returns pending messages whose next attempt is due, oldest first.
+func (r *OutboxRepository) GetDue(ctx context.Context, at time.Time, limit int64) ([]*domain.OutboxMessageEntity, error) {
+	filter := bson.M{
+		"status":        domain.OutboxStatusPending,
+		"nextAttemptAt": bson.M{"$lte": at},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit)
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var msgs []*domain.OutboxMessageEntity
+	if err := cur.All(ctx, &msgs); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// Claim leases a due message until leaseUntil so that only one dispatcher
+// handles it. It returns false when another dispatcher got there first.
+func (r *OutboxRepository) Claim(ctx context.Context, id primitive.ObjectID, at, leaseUntil time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":           id,
+		"status":        domain.OutboxStatusPending,
+		"nextAttemptAt": bson.M{"$lte": at},
+	}
+
+	res, err := r.coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"nextAttemptAt": leaseUntil}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+func (r *OutboxRepository) MarkDispatched(ctx context.Context, id primitive.ObjectID, at time.Time) error {
+	update := bson.M{"$set": bson.M{"status": domain.OutboxStatusDispatched, "dispatchedAt": at, "lastError": ""}}
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// MarkFailed records a failed attempt and schedules the next one.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, lastError string, nextAttemptAt time.Time) error {
+	update := bson.M{
+		"$set": bson.M{"lastError": lastError, "nextAttemptAt": nextAttemptAt},
+		"$inc": bson.M{"attempts": 1},
+	}
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// MarkDead records the last failed attempt and stops retrying the message.
+func (r *OutboxRepository) MarkDead(ctx context.Context, id primitive.ObjectID, lastError string) error {
+	update := bson.M{
+		"$set": bson.M{"status": domain.OutboxStatusDead, "lastError": lastError},
+		"$inc": bson.M{"attempts": 1},
+	}
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// GetByStatus returns messages with the given status, newest first.
+func (r *OutboxRepository) GetByStatus(ctx context.Context, status domain.OutboxStatus, limit int64) ([]*domain.OutboxMessageEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(limit)
+	cur, err := r.coll.Find(ctx, bson.M{"status": status}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var msgs []*domain.OutboxMessageEntity
+	if err := cur.All(ctx, &msgs); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// Requeue puts a dead message back in the queue with a fresh attempt budget.
+// It returns false when there is no dead message with the ID.
+func (r *OutboxRepository) Requeue(ctx context.Context, id primitive.ObjectID, at time.Time) (bool, error) {
+	filter := bson.M{"_id": id, "status": domain.OutboxStatusDead}
+	update := bson.M{"$set": bson.M{"status": domain.OutboxStatusPending, "attempts": 0, "nextAttemptAt": at}}
+
+	res, err := r.coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// DeleteDispatchedBefore removes dispatched messages older than the given time.
+func (r *OutboxRepository) DeleteDispatchedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := r.coll.DeleteMany(ctx, bson.M{"status": domain.OutboxStatusDispatched, "dispatchedAt": bson.M{"$lt": before}})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/ekastn/hms-api/internal/domain"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type PatientRepository struct {
@@ -31,6 +33,21 @@ func (r *PatientRepository) GetByID(ctx context.Context, id primitive.ObjectID)
 	return &patient, nil
 }
 
+// GetByIDs returns the patients with the given IDs, in no particular order.
+func (r *PatientRepository) GetByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*domain.PatientEntity, error) {
+	cur, err := r.coll.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var patients []*domain.PatientEntity
+	if err := cur.All(ctx, &patients); err != nil {
+		return nil, err
+	}
+	return patients, nil
+}
+
 func (r *PatientRepository) GetByEmail(ctx context.Context, email string) (*domain.PatientEntity, error) {
 	var patient domain.PatientEntity
 	err := r.coll.FindOne(ctx, bson.M{"email": email}).Decode(&patient)
@@ -89,6 +106,49 @@ func (r *PatientRepository) GetAll(ctx context.Context) ([]*domain.PatientEntity
 	return patients, nil
 }
 
+// Search returns up to limit patients whose name contains name, ignoring
+// case, sorted by name. An empty name matches every patient.
+func (r *PatientRepository) Search(ctx context.Context, name string, limit int) ([]*domain.PatientEntity, error) {
+	filter := bson.M{"isDeleted": bson.M{"$ne": true}}
+	if name != "" {
+		filter["name"] = bson.M{"$regex": regexp.QuoteMeta(name), "$options": "i"}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}}).SetLimit(int64(limit))
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var patients []*domain.PatientEntity
+	if err := cur.All(ctx, &patients); err != nil {
+		return nil, err
+	}
+	return patients, nil
+}
+
+// ForEach calls fn with each patient GetAll would return, reading them from
+// the cursor one at a time.
+func (r *PatientRepository) ForEach(ctx context.Context, fn func(*domain.PatientEntity) error) error {
+	cur, err := r.coll.Find(ctx, bson.M{"isDeleted": bson.M{"$ne": true}})
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var patient domain.PatientEntity
+		if err := cur.Decode(&patient); err != nil {
+			return err
+		}
+		if err := fn(&patient); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
 func (r *PatientRepository) Update(ctx context.Context, id primitive.ObjectID, patient *domain.PatientEntity) error {
 	_, err := r.coll.UpdateOne(ctx, bson.M{
 		"_id": id,
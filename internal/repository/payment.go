@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PaymentRepository struct {
+	coll *mongo.Collection
+}
+
+func NewPaymentRepository(coll *mongo.Collection) *PaymentRepository {
+	return &PaymentRepository{coll}
+}
+
+func (r *PaymentRepository) Create(ctx context.Context, payment *domain.PaymentEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	payment.CreatedAt = now
+	payment.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, payment)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *PaymentRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.PaymentEntity, error) {
+	var payment domain.PaymentEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&payment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// GetAll returns payments and refunds, optionally limited to an invoice, most recent first.
+func (r *PaymentRepository) GetAll(ctx context.Context, invoiceID *primitive.ObjectID) ([]*domain.PaymentEntity, error) {
+	filter := bson.M{}
+	if invoiceID != nil {
+		filter["invoiceId"] = *invoiceID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "receivedAt", Value: -1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var payments []*domain.PaymentEntity
+	if err := cur.All(ctx, &payments); err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+func (r *PaymentRepository) Update(ctx context.Context, id primitive.ObjectID, payment *domain.PaymentEntity) error {
+	payment.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": payment})
+	return err
+}
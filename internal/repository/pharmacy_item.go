@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PharmacyItemRepository struct {
+	coll *mongo.Collection
+}
+
+func NewPharmacyItemRepository(coll *mongo.Collection) *PharmacyItemRepository {
+	return &PharmacyItemRepository{coll}
+}
+
+func (r *PharmacyItemRepository) Create(ctx context.Context, item *domain.PharmacyItemEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	item.CreatedAt = now
+	item.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, item)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *PharmacyItemRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.PharmacyItemEntity, error) {
+	var item domain.PharmacyItemEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&item)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *PharmacyItemRepository) GetByCode(ctx context.Context, code string) (*domain.PharmacyItemEntity, error) {
+	var item domain.PharmacyItemEntity
+	err := r.coll.FindOne(ctx, bson.M{"code": code}).Decode(&item)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *PharmacyItemRepository) GetAll(ctx context.Context) ([]*domain.PharmacyItemEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cur, err := r.coll.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var items []*domain.PharmacyItemEntity
+	if err := cur.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *PharmacyItemRepository) Update(ctx context.Context, id primitive.ObjectID, item *domain.PharmacyItemEntity) error {
+	item.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": item})
+	return err
+}
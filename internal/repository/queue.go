@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type QueueRepository struct {
+	coll *mongo.Collection
+}
+
+func NewQueueRepository(coll *mongo.Collection) *QueueRepository {
+	return &QueueRepository{coll}
+}
+
+// EnsureIndexes creates the unique index on the queue key that GetOrCreate
+// relies on. Without it two requests upserting the first ticket of the day
+// at the same time could both create the queue.
+func (r *QueueRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "date", Value: 1},
+			{Key: "doctorId", Value: 1},
+			{Key: "polyclinic", Value: 1},
+		},
+		Options: options.Index().SetName("queue_key").SetUnique(true),
+	})
+	return err
+}
+
+// GetOrCreate returns the queue of the doctor, or of the polyclinic when
+// doctorID is nil, for the given date, creating it on first use.
+func (r *QueueRepository) GetOrCreate(ctx context.Context, date string, doctorID *primitive.ObjectID, polyclinic string) (*domain.QueueEntity, error) {
+	queue, err := r.getOrCreate(ctx, date, doctorID, polyclinic)
+	if mongo.IsDuplicateKeyError(err) {
+		// Another request created the queue between the upsert's match and
+		// its insert; the queue exists now, so the retry finds it.
+		queue, err = r.getOrCreate(ctx, date, doctorID, polyclinic)
+	}
+	return queue, err
+}
+
+func (r *QueueRepository) getOrCreate(ctx context.Context, date string, doctorID *primitive.ObjectID, polyclinic string) (*domain.QueueEntity, error) {
+	filter := bson.M{"date": date}
+	if doctorID != nil {
+		filter["doctorId"] = *doctorID
+	} else {
+		filter["polyclinic"] = polyclinic
+		filter["doctorId"] = bson.M{"$exists": false}
+	}
+
+	now := time.Now()
+	update := bson.M{"$setOnInsert": bson.M{"lastNumber": 0, "createdAt": now, "updatedAt": now}}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var queue domain.QueueEntity
+	if err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&queue); err != nil {
+		return nil, err
+	}
+	return &queue, nil
+}
+
+// NextNumber hands out the next ticket number of the queue.
+func (r *QueueRepository) NextNumber(ctx context.Context, id primitive.ObjectID) (int, error) {
+	update := bson.M{
+		"$inc": bson.M{"lastNumber": 1},
+		"$set": bson.M{"updatedAt": time.Now()},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var queue domain.QueueEntity
+	if err := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": id}, update, opts).Decode(&queue); err != nil {
+		return 0, err
+	}
+	return queue.LastNumber, nil
+}
+
+func (r *QueueRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.QueueEntity, error) {
+	var queue domain.QueueEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&queue)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &queue, nil
+}
+
+// GetAll returns the queues of a date, optionally filtered by doctor or polyclinic.
+func (r *QueueRepository) GetAll(ctx context.Context, date string, doctorID *primitive.ObjectID, polyclinic string) ([]*domain.QueueEntity, error) {
+	filter := bson.M{"date": date}
+	if doctorID != nil {
+		filter["doctorId"] = *doctorID
+	}
+	if polyclinic != "" {
+		filter["polyclinic"] = polyclinic
+	}
+
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var queues []*domain.QueueEntity
+	if err := cur.All(ctx, &queues); err != nil {
+		return nil, err
+	}
+	return queues, nil
+}
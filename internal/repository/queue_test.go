@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestQueueGetOrCreate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("losing a concurrent insert returns the winner's queue", func(mt *mtest.T) {
+		repo := NewQueueRepository(mt.Coll)
+		id := primitive.NewObjectID()
+		mt.AddMockResponses(
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{
+				Index:   0,
+				Code:    11000,
+				Message: "E11000 duplicate key error collection: hms.queues index: queue_key",
+			}),
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{
+				{Key: "_id", Value: id},
+				{Key: "date", Value: "2025-07-17"},
+				{Key: "polyclinic", Value: "Poli Umum"},
+				{Key: "lastNumber", Value: 3},
+			}}),
+		)
+
+		queue, err := repo.GetOrCreate(context.Background(), "2025-07-17", nil, "Poli Umum")
+		if err != nil {
+			t.Fatalf("GetOrCreate() error = %v", err)
+		}
+		if queue.ID != id || queue.LastNumber != 3 {
+			t.Fatalf("GetOrCreate() = %+v, want queue %s", queue, id.Hex())
+		}
+	})
+
+	mt.Run("other errors are returned", func(mt *mtest.T) {
+		repo := NewQueueRepository(mt.Coll)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 91, Message: "shutdown in progress"}))
+
+		if _, err := repo.GetOrCreate(context.Background(), "2025-07-17", nil, "Poli Umum"); err == nil {
+			t.Fatal("GetOrCreate() swallowed a server error")
+		}
+		if e := mt.GetStartedEvent(); e == nil || mt.GetStartedEvent() != nil {
+			t.Error("GetOrCreate() retried a server error")
+		}
+	})
+}
+
+func TestQueueEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("unique queue key", func(mt *mtest.T) {
+		repo := NewQueueRepository(mt.Coll)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		if err := repo.EnsureIndexes(context.Background()); err != nil {
+			t.Fatalf("EnsureIndexes() error = %v", err)
+		}
+
+		e := mt.GetStartedEvent()
+		if e == nil || e.CommandName != "createIndexes" {
+			t.Fatalf("command = %v, want createIndexes", e)
+		}
+		index := e.Command.Lookup("indexes").Array().Index(0).Value().Document()
+		if !index.Lookup("unique").Boolean() {
+			t.Error("index is not unique")
+		}
+
+		var keys []string
+		elems, _ := index.Lookup("key").Document().Elements()
+		for _, elem := range elems {
+			keys = append(keys, elem.Key())
+		}
+		want := []string{"date", "doctorId", "polyclinic"}
+		if len(keys) != len(want) {
+			t.Fatalf("keys = %v, want %v", keys, want)
+		}
+		for i := range want {
+			if keys[i] != want[i] {
+				t.Fatalf("keys = %v, want %v", keys, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// queueOrder is the order waiting tickets are called in: highest priority
+// first, then by ticket number.
+var queueOrder = bson.D{{Key: "priority", Value: -1}, {Key: "number", Value: 1}}
+
+type QueueTicketRepository struct {
+	coll *mongo.Collection
+}
+
+func NewQueueTicketRepository(coll *mongo.Collection) *QueueTicketRepository {
+	return &QueueTicketRepository{coll}
+}
+
+func (r *QueueTicketRepository) Create(ctx context.Context, ticket *domain.QueueTicketEntity) (primitive.ObjectID, error) {
+	res, err := r.coll.InsertOne(ctx, ticket)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *QueueTicketRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *QueueTicketRepository) GetByAppointmentID(ctx context.Context, appointmentID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	return r.findOne(ctx, bson.M{"appointmentId": appointmentID})
+}
+
+// GetByQueueID returns every ticket of the queue, in calling order.
+func (r *QueueTicketRepository) GetByQueueID(ctx context.Context, queueID primitive.ObjectID) ([]*domain.QueueTicketEntity, error) {
+	cur, err := r.coll.Find(ctx, bson.M{"queueId": queueID}, options.Find().SetSort(queueOrder))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var tickets []*domain.QueueTicketEntity
+	if err := cur.All(ctx, &tickets); err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// CallNext atomically moves the first waiting ticket of the queue to Called.
+// It returns nil when nobody is waiting.
+func (r *QueueTicketRepository) CallNext(ctx context.Context, queueID primitive.ObjectID, counter string, at time.Time, updaterID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	filter := bson.M{"queueId": queueID, "status": domain.QueueTicketWaiting}
+	update := bson.M{"$set": bson.M{
+		"status":    domain.QueueTicketCalled,
+		"counter":   counter,
+		"calledAt":  at,
+		"updatedBy": updaterID,
+		"updatedAt": at,
+	}}
+	opts := options.FindOneAndUpdate().SetSort(queueOrder).SetReturnDocument(options.After)
+
+	var ticket domain.QueueTicketEntity
+	err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&ticket)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// GetCalled returns the tickets of the queue currently called at the counter.
+func (r *QueueTicketRepository) GetCalled(ctx context.Context, queueID primitive.ObjectID, counter string) ([]*domain.QueueTicketEntity, error) {
+	filter := bson.M{"queueId": queueID, "status": domain.QueueTicketCalled, "counter": counter}
+
+	cur, err := r.coll.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var tickets []*domain.QueueTicketEntity
+	if err := cur.All(ctx, &tickets); err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+func (r *QueueTicketRepository) Update(ctx context.Context, ticket *domain.QueueTicketEntity) error {
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": ticket.ID}, bson.M{"$set": ticket})
+	return err
+}
+
+func (r *QueueTicketRepository) findOne(ctx context.Context, filter bson.M) (*domain.QueueTicketEntity, error) {
+	var ticket domain.QueueTicketEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&ticket)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ticket, nil
+}
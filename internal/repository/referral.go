@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ReferralRepository struct {
+	coll *mongo.Collection
+}
+
+func NewReferralRepository(coll *mongo.Collection) *ReferralRepository {
+	return &ReferralRepository{coll}
+}
+
+func (r *ReferralRepository) Create(ctx context.Context, referral *domain.ReferralEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	referral.CreatedAt = now
+	referral.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, referral)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *ReferralRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.ReferralEntity, error) {
+	var referral domain.ReferralEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&referral)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &referral, nil
+}
+
+// GetAll returns referrals, newest first, optionally filtered by patient,
+// referring doctor and status.
+func (r *ReferralRepository) GetAll(ctx context.Context, patientID, fromDoctorID *primitive.ObjectID, status domain.ReferralStatus) ([]*domain.ReferralEntity, error) {
+	filter := bson.M{}
+	if patientID != nil {
+		filter["patientId"] = *patientID
+	}
+	if fromDoctorID != nil {
+		filter["fromDoctorId"] = *fromDoctorID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+
+	return r.find(ctx, filter)
+}
+
+// GetInbox returns the referrals addressed to a specialty. With a doctor
+// given, it returns the referrals addressed to that doctor plus the ones for
+// the specialty nobody has taken yet.
+func (r *ReferralRepository) GetInbox(ctx context.Context, specialty string, doctorID *primitive.ObjectID, statuses []domain.ReferralStatus) ([]*domain.ReferralEntity, error) {
+	filter := bson.M{"status": bson.M{"$in": statuses}}
+	if doctorID != nil {
+		filter["$or"] = bson.A{
+			bson.M{"toDoctorId": *doctorID},
+			bson.M{"toSpecialty": specialty, "toDoctorId": bson.M{"$exists": false}},
+		}
+	} else {
+		filter["toSpecialty"] = specialty
+	}
+
+	return r.find(ctx, filter, options.Find().SetCollation(&options.Collation{Locale: "en", Strength: 2}))
+}
+
+// Update saves the referral if it is still in status from. It returns false
+// when the referral's status changed since it was read, e.g. by a concurrent
+// response.
+func (r *ReferralRepository) Update(ctx context.Context, referral *domain.ReferralEntity, from domain.ReferralStatus) (bool, error) {
+	referral.UpdatedAt = time.Now()
+
+	res, err := r.coll.UpdateOne(ctx, bson.M{"_id": referral.ID, "status": from}, bson.M{"$set": referral})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount == 1, nil
+}
+
+func (r *ReferralRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*domain.ReferralEntity, error) {
+	opts = append(opts, options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}))
+
+	cur, err := r.coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var referrals []*domain.ReferralEntity
+	if err := cur.All(ctx, &referrals); err != nil {
+		return nil, err
+	}
+	return referrals, nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ReminderRepository struct {
+	coll *mongo.Collection
+}
+
+func NewReminderRepository(coll *mongo.Collection) *ReminderRepository {
+	return &ReminderRepository{coll}
+}
+
+// EnsureIndexes creates the unique index on the reminder key that Claim
+// relies on. Without it two instances upserting at the same time could both
+// insert the reminder.
+func (r *ReminderRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "appointmentId", Value: 1},
+			{Key: "kind", Value: 1},
+			{Key: "appointmentTime", Value: 1},
+			{Key: "offsetMinutes", Value: 1},
+			{Key: "channel", Value: 1},
+		},
+		Options: options.Index().SetName("reminder_key").SetUnique(true),
+	})
+	return err
+}
+
+// Claim records a pending reminder of the kind for the appointment slot,
+// offset and channel unless one already exists. It returns false when the reminder was
+// already claimed, by this process before a restart or by another instance.
+// The appointment time is part of the key, so a rescheduled appointment gets
+// fresh reminders.
+func (r *ReminderRepository) Claim(ctx context.Context, reminder *domain.ReminderEntity) (bool, error) {
+	filter := bson.M{
+		"appointmentId":   reminder.AppointmentID,
+		"kind":            reminder.Kind,
+		"appointmentTime": reminder.AppointmentTime,
+		"offsetMinutes":   reminder.OffsetMinutes,
+		"channel":         reminder.Channel,
+	}
+
+	now := time.Now()
+	reminder.Status = domain.ReminderStatusPending
+	reminder.CreatedAt = now
+	reminder.UpdatedAt = now
+
+	update := bson.M{"$setOnInsert": bson.M{
+		"patientId": reminder.PatientID,
+		"language":  reminder.Language,
+		"status":    reminder.Status,
+		"createdAt": reminder.CreatedAt,
+		"updatedAt": reminder.UpdatedAt,
+	}}
+
+	res, err := r.coll.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		// Another instance inserted the reminder first
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if res.UpsertedCount == 0 {
+		return false, nil
+	}
+
+	reminder.ID = res.UpsertedID.(primitive.ObjectID)
+	return true, nil
+}
+
+// Finish stores the outcome of a claimed reminder.
+func (r *ReminderRepository) Finish(ctx context.Context, reminder *domain.ReminderEntity) error {
+	reminder.UpdatedAt = time.Now()
+
+	update := bson.M{"$set": bson.M{
+		"recipient": reminder.Recipient,
+		"subject":   reminder.Subject,
+		"message":   reminder.Message,
+		"status":    reminder.Status,
+		"error":     reminder.Error,
+		"sentAt":    reminder.SentAt,
+		"updatedAt": reminder.UpdatedAt,
+	}}
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": reminder.ID}, update)
+	return err
+}
+
+// GetAll returns reminders, newest first, optionally filtered by appointment,
+// patient and status.
+func (r *ReminderRepository) GetAll(ctx context.Context, appointmentID, patientID *primitive.ObjectID, status domain.ReminderStatus, limit int64) ([]*domain.ReminderEntity, error) {
+	filter := bson.M{}
+	if appointmentID != nil {
+		filter["appointmentId"] = *appointmentID
+	}
+	if patientID != nil {
+		filter["patientId"] = *patientID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}).SetLimit(limit)
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var reminders []*domain.ReminderEntity
+	if err := cur.All(ctx, &reminders); err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
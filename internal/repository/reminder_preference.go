@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ReminderPreferenceRepository struct {
+	coll *mongo.Collection
+}
+
+func NewReminderPreferenceRepository(coll *mongo.Collection) *ReminderPreferenceRepository {
+	return &ReminderPreferenceRepository{coll}
+}
+
+func (r *ReminderPreferenceRepository) GetByPatientID(ctx context.Context, patientID primitive.ObjectID) (*domain.ReminderPreferenceEntity, error) {
+	var pref domain.ReminderPreferenceEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": patientID}).Decode(&pref)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &pref, nil
+}
+
+// Save creates or replaces the preferences of a patient.
+func (r *ReminderPreferenceRepository) Save(ctx context.Context, pref *domain.ReminderPreferenceEntity) error {
+	_, err := r.coll.ReplaceOne(ctx, bson.M{"_id": pref.PatientID}, pref, options.Replace().SetUpsert(true))
+	return err
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func testReminder() *domain.ReminderEntity {
+	return &domain.ReminderEntity{
+		AppointmentID:   primitive.NewObjectID(),
+		PatientID:       primitive.NewObjectID(),
+		Kind:            domain.ReminderKindReminder,
+		AppointmentTime: time.Now().Add(24 * time.Hour),
+		OffsetMinutes:   120,
+		Channel:         domain.ReminderChannelEmail,
+	}
+}
+
+func TestReminderClaim(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("first claim wins", func(mt *mtest.T) {
+		repo := NewReminderRepository(mt.Coll)
+		id := primitive.NewObjectID()
+		mt.AddMockResponses(mtest.CreateSuccessResponse(
+			bson.E{Key: "n", Value: 1},
+			bson.E{Key: "nModified", Value: 0},
+			bson.E{Key: "upserted", Value: bson.A{bson.D{{Key: "index", Value: 0}, {Key: "_id", Value: id}}}},
+		))
+
+		reminder := testReminder()
+		claimed, err := repo.Claim(context.Background(), reminder)
+		if err != nil {
+			t.Fatalf("Claim() error = %v", err)
+		}
+		if !claimed || reminder.ID != id {
+			t.Fatalf("Claim() = %v with ID %s, want claimed as %s", claimed, reminder.ID.Hex(), id.Hex())
+		}
+	})
+
+	mt.Run("existing reminder is already claimed", func(mt *mtest.T) {
+		repo := NewReminderRepository(mt.Coll)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 0}))
+
+		claimed, err := repo.Claim(context.Background(), testReminder())
+		if err != nil || claimed {
+			t.Fatalf("Claim() = %v, %v, want already claimed", claimed, err)
+		}
+	})
+
+	mt.Run("losing a concurrent insert is already claimed", func(mt *mtest.T) {
+		repo := NewReminderRepository(mt.Coll)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   0,
+			Code:    11000,
+			Message: "E11000 duplicate key error collection: hms.reminders index: reminder_key",
+		}))
+
+		claimed, err := repo.Claim(context.Background(), testReminder())
+		if err != nil || claimed {
+			t.Fatalf("Claim() = %v, %v, want already claimed", claimed, err)
+		}
+	})
+
+	mt.Run("other errors are returned", func(mt *mtest.T) {
+		repo := NewReminderRepository(mt.Coll)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 91, Message: "shutdown in progress"}))
+
+		if _, err := repo.Claim(context.Background(), testReminder()); err == nil {
+			t.Fatal("Claim() swallowed a server error")
+		}
+	})
+}
+
+func TestReminderEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("unique reminder key", func(mt *mtest.T) {
+		repo := NewReminderRepository(mt.Coll)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		if err := repo.EnsureIndexes(context.Background()); err != nil {
+			t.Fatalf("EnsureIndexes() error = %v", err)
+		}
+
+		e := mt.GetStartedEvent()
+		if e == nil || e.CommandName != "createIndexes" {
+			t.Fatalf("command = %v, want createIndexes", e)
+		}
+		index := e.Command.Lookup("indexes").Array().Index(0).Value().Document()
+		if !index.Lookup("unique").Boolean() {
+			t.Error("index is not unique")
+		}
+
+		var keys []string
+		elems, _ := index.Lookup("key").Document().Elements()
+		for _, elem := range elems {
+			keys = append(keys, elem.Key())
+		}
+		want := []string{"appointmentId", "kind", "appointmentTime", "offsetMinutes", "channel"}
+		if len(keys) != len(want) {
+			t.Fatalf("keys = %v, want %v", keys, want)
+		}
+		for i := range want {
+			if keys[i] != want[i] {
+				t.Fatalf("keys = %v, want %v", keys, want)
+			}
+		}
+	})
+}
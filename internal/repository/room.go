@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RoomRepository struct {
+	coll *mongo.Collection
+}
+
+func NewRoomRepository(coll *mongo.Collection) *RoomRepository {
+	return &RoomRepository{coll}
+}
+
+func (r *RoomRepository) Create(ctx context.Context, room *domain.RoomEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	room.CreatedAt = now
+	room.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, room)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *RoomRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.RoomEntity, error) {
+	var room domain.RoomEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&room)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &room, nil
+}
+
+// GetByWardAndNumber looks up a room by its number within a ward.
+func (r *RoomRepository) GetByWardAndNumber(ctx context.Context, wardID primitive.ObjectID, number string) (*domain.RoomEntity, error) {
+	var room domain.RoomEntity
+	err := r.coll.FindOne(ctx, bson.M{"wardId": wardID, "number": number}).Decode(&room)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &room, nil
+}
+
+func (r *RoomRepository) GetByWardID(ctx context.Context, wardID primitive.ObjectID) ([]*domain.RoomEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "number", Value: 1}})
+	cur, err := r.coll.Find(ctx, bson.M{"wardId": wardID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var rooms []*domain.RoomEntity
+	if err := cur.All(ctx, &rooms); err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SatuSehatReferenceRepository struct {
+	coll *mongo.Collection
+}
+
+func NewSatuSehatReferenceRepository(coll *mongo.Collection) *SatuSehatReferenceRepository {
+	return &SatuSehatReferenceRepository{coll}
+}
+
+// Set creates or replaces the SATUSEHAT ID of a hospital entity.
+func (r *SatuSehatReferenceRepository) Set(ctx context.Context, kind domain.SatuSehatReferenceKind, localID primitive.ObjectID, satusehatID string, updatedBy primitive.ObjectID) (*domain.SatuSehatReferenceEntity, error) {
+	now := time.Now()
+	filter := bson.M{"kind": kind, "localId": localID}
+	update := bson.M{
+		"$set": bson.M{
+			"satusehatId": satusehatID,
+			"updatedBy":   updatedBy,
+			"updatedAt":   now,
+		},
+		"$setOnInsert": bson.M{"createdAt": now},
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var ref domain.SatuSehatReferenceEntity
+	if err := r.coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&ref); err != nil {
+		return nil, err
+	}
+	return &ref, nil
+}
+
+// Get returns the reference of a hospital entity, or nil when it has none.
+func (r *SatuSehatReferenceRepository) Get(ctx context.Context, kind domain.SatuSehatReferenceKind, localID primitive.ObjectID) (*domain.SatuSehatReferenceEntity, error) {
+	var ref domain.SatuSehatReferenceEntity
+	err := r.coll.FindOne(ctx, bson.M{"kind": kind, "localId": localID}).Decode(&ref)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ref, nil
+}
+
+// GetAll returns the references, optionally of one kind.
+func (r *SatuSehatReferenceRepository) GetAll(ctx context.Context, kind domain.SatuSehatReferenceKind) ([]*domain.SatuSehatReferenceEntity, error) {
+	filter := bson.M{}
+	if kind != "" {
+		filter["kind"] = kind
+	}
+
+	cur, err := r.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "kind", Value: 1}, {Key: "updatedAt", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var refs []*domain.SatuSehatReferenceEntity
+	if err := cur.All(ctx, &refs); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+func (r *SatuSehatReferenceRepository) Delete(ctx context.Context, kind domain.SatuSehatReferenceKind, localID primitive.ObjectID) error {
+	_, err := r.coll.DeleteOne(ctx, bson.M{"kind": kind, "localId": localID})
+	return err
+}
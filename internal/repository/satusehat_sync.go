@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SatuSehatSyncRepository struct {
+	coll *mongo.Collection
+}
+
+func NewSatuSehatSyncRepository(coll *mongo.Collection) *SatuSehatSyncRepository {
+	return &SatuSehatSyncRepository{coll}
+}
+
+// Enqueue queues the source for submission with a fresh attempt budget,
+// creating its sync entry on the first call. Each call bumps the revision,
+// so an attempt already under way for an older revision does not mark this
+// one synced.
+func (r *SatuSehatSyncRepository) Enqueue(ctx context.Context, sourceType domain.SatuSehatSourceType, sourceID primitive.ObjectID, resourceType string, patientID primitive.ObjectID, deleted bool, at time.Time) error {
+	filter := bson.M{"sourceType": sourceType, "sourceId": sourceID}
+	update := bson.M{
+		"$set": bson.M{
+			"resourceType":  resourceType,
+			"patientId":     patientID,
+			"status":        domain.SatuSehatSyncPending,
+			"deleted":       deleted,
+			"attemptCount":  0,
+			"nextAttemptAt": at,
+			"updatedAt":     at,
+		},
+		"$inc":         bson.M{"revision": 1},
+		"$setOnInsert": bson.M{"createdAt": at},
+	}
+
+	_, err := r.coll.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *SatuSehatSyncRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.SatuSehatSyncEntity, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// GetBySource returns the sync entry of a source, or nil when it was never queued.
+func (r *SatuSehatSyncRepository) GetBySource(ctx context.Context, sourceType domain.SatuSehatSourceType, sourceID primitive.ObjectID) (*domain.SatuSehatSyncEntity, error) {
+	return r.findOne(ctx, bson.M{"sourceType": sourceType, "sourceId": sourceID})
+}
+
+// GetBySources returns the sync entries of the sources, keyed by source ID.
+func (r *SatuSehatSyncRepository) GetBySources(ctx context.Context, sourceType domain.SatuSehatSourceType, sourceIDs []primitive.ObjectID) (map[primitive.ObjectID]*domain.SatuSehatSyncEntity, error) {
+	syncs, err := r.find(ctx, bson.M{"sourceType": sourceType, "sourceId": bson.M{"$in": sourceIDs}})
+	if err != nil {
+		return nil, err
+	}
+
+	bySource := make(map[primitive.ObjectID]*domain.SatuSehatSyncEntity, len(syncs))
+	for _, s := range syncs {
+		bySource[s.SourceID] = s
+	}
+	return bySource, nil
+}
+
+// GetAll returns sync entries, optionally filtered by source type, status and
+// patient, most recently updated first.
+func (r *SatuSehatSyncRepository) GetAll(ctx context.Context, sourceType domain.SatuSehatSourceType, status domain.SatuSehatSyncStatus, patientID *primitive.ObjectID, limit int64) ([]*domain.SatuSehatSyncEntity, error) {
+	filter := bson.M{}
+	if sourceType != "" {
+		filter["sourceType"] = sourceType
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	if patientID != nil {
+		filter["patientId"] = *patientID
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "updatedAt", Value: -1}}).SetLimit(limit)
+	return r.find(ctx, filter, opts)
+}
+
+// GetDue returns pending or failed entries whose next attempt is due.
+func (r *SatuSehatSyncRepository) GetDue(ctx context.Context, at time.Time, limit int64) ([]*domain.SatuSehatSyncEntity, error) {
+	filter := bson.M{
+		"status":        bson.M{"$in": []domain.SatuSehatSyncStatus{domain.SatuSehatSyncPending, domain.SatuSehatSyncFailed}},
+		"nextAttemptAt": bson.M{"$lte": at},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).SetLimit(limit)
+	return r.find(ctx, filter, opts)
+}
+
+// Claim leases a due entry until leaseUntil so that only one worker submits
+// it. It returns false when another worker got there first.
+func (r *SatuSehatSyncRepository) Claim(ctx context.Context, id primitive.ObjectID, at, leaseUntil time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":           id,
+		"status":        bson.M{"$in": []domain.SatuSehatSyncStatus{domain.SatuSehatSyncPending, domain.SatuSehatSyncFailed}},
+		"nextAttemptAt": bson.M{"$lte": at},
+	}
+
+	res, err := r.coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"nextAttemptAt": leaseUntil}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// Update saves the entry if its revision is still current. It returns false
+// when the source was queued again in the meantime.
+func (r *SatuSehatSyncRepository) Update(ctx context.Context, sync *domain.SatuSehatSyncEntity) (bool, error) {
+	sync.UpdatedAt = time.Now()
+
+	res, err := r.coll.UpdateOne(ctx, bson.M{"_id": sync.ID, "revision": sync.Revision}, bson.M{"$set": sync})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount == 1, nil
+}
+
+// SetSatuSehatID records the ID SATUSEHAT assigned to the entry's resource,
+// whatever its revision, so later submissions update that resource instead
+// of creating another.
+func (r *SatuSehatSyncRepository) SetSatuSehatID(ctx context.Context, id primitive.ObjectID, satusehatID string) error {
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"satusehatId": satusehatID}})
+	return err
+}
+
+func (r *SatuSehatSyncRepository) findOne(ctx context.Context, filter bson.M) (*domain.SatuSehatSyncEntity, error) {
+	var sync domain.SatuSehatSyncEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&sync)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sync, nil
+}
+
+func (r *SatuSehatSyncRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*domain.SatuSehatSyncEntity, error) {
+	cur, err := r.coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var syncs []*domain.SatuSehatSyncEntity
+	if err := cur.All(ctx, &syncs); err != nil {
+		return nil, err
+	}
+	return syncs, nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ShiftAssignmentRepository struct {
+	coll *mongo.Collection
+}
+
+func NewShiftAssignmentRepository(coll *mongo.Collection) *ShiftAssignmentRepository {
+	return &ShiftAssignmentRepository{coll}
+}
+
+func (r *ShiftAssignmentRepository) Create(ctx context.Context, assignment *domain.ShiftAssignmentEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	assignment.CreatedAt = now
+	assignment.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, assignment)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *ShiftAssignmentRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.ShiftAssignmentEntity, error) {
+	var assignment domain.ShiftAssignmentEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&assignment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// GetByDateRange returns the scheduled shifts dated from..to inclusive, in
+// order of start, optionally only those of a user or role.
+func (r *ShiftAssignmentRepository) GetByDateRange(ctx context.Context, from, to string, userID *primitive.ObjectID, role domain.Role) ([]*domain.ShiftAssignmentEntity, error) {
+	filter := bson.M{
+		"date":   bson.M{"$gte": from, "$lte": to},
+		"status": domain.ShiftAssignmentScheduled,
+	}
+	if userID != nil {
+		filter["userId"] = *userID
+	}
+	if role != "" {
+		filter["role"] = role
+	}
+
+	return r.find(ctx, filter)
+}
+
+// GetOverlapping returns the user's scheduled shifts that overlap
+// [start, end).
+func (r *ShiftAssignmentRepository) GetOverlapping(ctx context.Context, userID primitive.ObjectID, start, end time.Time) ([]*domain.ShiftAssignmentEntity, error) {
+	filter := bson.M{
+		"userId": userID,
+		"status": domain.ShiftAssignmentScheduled,
+		"start":  bson.M{"$lt": end},
+		"end":    bson.M{"$gt": start},
+	}
+
+	return r.find(ctx, filter)
+}
+
+// GetActiveAt returns the scheduled shifts running at the time, optionally
+// only the on-call ones.
+func (r *ShiftAssignmentRepository) GetActiveAt(ctx context.Context, at time.Time, onCallOnly bool) ([]*domain.ShiftAssignmentEntity, error) {
+	filter := bson.M{
+		"status": domain.ShiftAssignmentScheduled,
+		"start":  bson.M{"$lte": at},
+		"end":    bson.M{"$gt": at},
+	}
+	if onCallOnly {
+		filter["onCall"] = true
+	}
+
+	return r.find(ctx, filter)
+}
+
+func (r *ShiftAssignmentRepository) Update(ctx context.Context, assignment *domain.ShiftAssignmentEntity) error {
+	assignment.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": assignment.ID}, bson.M{"$set": assignment})
+	return err
+}
+
+func (r *ShiftAssignmentRepository) find(ctx context.Context, filter bson.M) ([]*domain.ShiftAssignmentEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var assignments []*domain.ShiftAssignmentEntity
+	if err := cur.All(ctx, &assignments); err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
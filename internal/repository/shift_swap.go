@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ShiftSwapRepository struct {
+	coll *mongo.Collection
+}
+
+func NewShiftSwapRepository(coll *mongo.Collection) *ShiftSwapRepository {
+	return &ShiftSwapRepository{coll}
+}
+
+func (r *ShiftSwapRepository) Create(ctx context.Context, swap *domain.ShiftSwapEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	swap.CreatedAt = now
+	swap.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, swap)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *ShiftSwapRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.ShiftSwapEntity, error) {
+	var swap domain.ShiftSwapEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&swap)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &swap, nil
+}
+
+// GetAll returns swap requests, newest first, optionally filtered by status
+// and by a user on either side of the swap.
+func (r *ShiftSwapRepository) GetAll(ctx context.Context, status domain.ShiftSwapStatus, userID *primitive.ObjectID) ([]*domain.ShiftSwapEntity, error) {
+	filter := bson.M{}
+	if status != "" {
+		filter["status"] = status
+	}
+	if userID != nil {
+		filter["$or"] = bson.A{
+			bson.M{"fromUserId": *userID},
+			bson.M{"toUserId": *userID},
+		}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var swaps []*domain.ShiftSwapEntity
+	if err := cur.All(ctx, &swaps); err != nil {
+		return nil, err
+	}
+	return swaps, nil
+}
+
+// CountPendingForAssignment counts the pending swaps that involve the shift,
+// on either side.
+func (r *ShiftSwapRepository) CountPendingForAssignment(ctx context.Context, assignmentID primitive.ObjectID) (int64, error) {
+	filter := bson.M{
+		"status": domain.ShiftSwapPending,
+		"$or": bson.A{
+			bson.M{"assignmentId": assignmentID},
+			bson.M{"targetAssignmentId": assignmentID},
+		},
+	}
+	return r.coll.CountDocuments(ctx, filter)
+}
+
+func (r *ShiftSwapRepository) Update(ctx context.Context, swap *domain.ShiftSwapEntity) error {
+	swap.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": swap.ID}, bson.M{"$set": swap})
+	return err
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ShiftTemplateRepository struct {
+	coll *mongo.Collection
+}
+
+func NewShiftTemplateRepository(coll *mongo.Collection) *ShiftTemplateRepository {
+	return &ShiftTemplateRepository{coll}
+}
+
+func (r *ShiftTemplateRepository) Create(ctx context.Context, template *domain.ShiftTemplateEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	template.CreatedAt = now
+	template.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, template)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *ShiftTemplateRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.ShiftTemplateEntity, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *ShiftTemplateRepository) GetByCode(ctx context.Context, code string) (*domain.ShiftTemplateEntity, error) {
+	return r.findOne(ctx, bson.M{"code": code})
+}
+
+// GetAll returns the shift templates ordered by start time, optionally only
+// the active ones.
+func (r *ShiftTemplateRepository) GetAll(ctx context.Context, activeOnly bool) ([]*domain.ShiftTemplateEntity, error) {
+	filter := bson.M{}
+	if activeOnly {
+		filter["isActive"] = true
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}, {Key: "name", Value: 1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var templates []*domain.ShiftTemplateEntity
+	if err := cur.All(ctx, &templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (r *ShiftTemplateRepository) Update(ctx context.Context, id primitive.ObjectID, template *domain.ShiftTemplateEntity) error {
+	template.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": template})
+	return err
+}
+
+func (r *ShiftTemplateRepository) findOne(ctx context.Context, filter bson.M) (*domain.ShiftTemplateEntity, error) {
+	var template domain.ShiftTemplateEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&template)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &template, nil
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type StockBatchRepository struct {
+	coll *mongo.Collection
+}
+
+func NewStockBatchRepository(coll *mongo.Collection) *StockBatchRepository {
+	return &StockBatchRepository{coll}
+}
+
+func (r *StockBatchRepository) Create(ctx context.Context, batch *domain.StockBatchEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	batch.CreatedAt = now
+	batch.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, batch)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *StockBatchRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.StockBatchEntity, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// GetByBatchNumber returns the batch of an item with the given number at a location.
+func (r *StockBatchRepository) GetByBatchNumber(ctx context.Context, itemID primitive.ObjectID, batchNumber, location string) (*domain.StockBatchEntity, error) {
+	return r.findOne(ctx, bson.M{"itemId": itemID, "batchNumber": batchNumber, "location": location})
+}
+
+// GetAll returns batches that still hold stock, optionally filtered by item and location.
+func (r *StockBatchRepository) GetAll(ctx context.Context, itemID *primitive.ObjectID, location string) ([]*domain.StockBatchEntity, error) {
+	filter := bson.M{"quantity": bson.M{"$gt": 0}}
+	if itemID != nil {
+		filter["itemId"] = *itemID
+	}
+	if location != "" {
+		filter["location"] = location
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "expiryDate", Value: 1}})
+	return r.findBatches(ctx, filter, opts)
+}
+
+// GetDispensable returns the unexpired batches of an item at a location,
+// first-expiry-first-out.
+func (r *StockBatchRepository) GetDispensable(ctx context.Context, itemID primitive.ObjectID, location string, at time.Time) ([]*domain.StockBatchEntity, error) {
+	filter := bson.M{
+		"itemId":     itemID,
+		"location":   location,
+		"quantity":   bson.M{"$gt": 0},
+		"expiryDate": bson.M{"$gt": at},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "expiryDate", Value: 1}, {Key: "receivedAt", Value: 1}})
+	return r.findBatches(ctx, filter, opts)
+}
+
+// GetExpiringUnalerted returns batches with stock that expire before the given
+// time and have not been reported yet.
+func (r *StockBatchRepository) GetExpiringUnalerted(ctx context.Context, before time.Time) ([]*domain.StockBatchEntity, error) {
+	filter := bson.M{
+		"quantity":        bson.M{"$gt": 0},
+		"expiryDate":      bson.M{"$lte": before},
+		"expiryAlertedAt": bson.M{"$exists": false},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "expiryDate", Value: 1}})
+	return r.findBatches(ctx, filter, opts)
+}
+
+// Adjust changes the quantity of a batch by delta. Decrements only succeed when
+// enough stock is left, so concurrent dispenses cannot drive a batch negative.
+// It returns false when the batch did not have enough stock.
+func (r *StockBatchRepository) Adjust(ctx context.Context, id primitive.ObjectID, delta int) (bool, error) {
+	filter := bson.M{"_id": id}
+	if delta < 0 {
+		filter["quantity"] = bson.M{"$gte": -delta}
+	}
+
+	update := bson.M{
+		"$inc": bson.M{"quantity": delta},
+		"$set": bson.M{"updatedAt": time.Now()},
+	}
+
+	res, err := r.coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+func (r *StockBatchRepository) MarkExpiryAlerted(ctx context.Context, id primitive.ObjectID, at time.Time) error {
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"expiryAlertedAt": at}})
+	return err
+}
+
+// SumDispensable returns the unexpired stock of an item at a location.
+func (r *StockBatchRepository) SumDispensable(ctx context.Context, itemID primitive.ObjectID, location string, at time.Time) (int, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{"itemId": itemID, "location": location, "expiryDate": bson.M{"$gt": at}}},
+		{"$group": bson.M{"_id": nil, "quantity": bson.M{"$sum": "$quantity"}}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Quantity int `bson:"quantity"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+	return results[0].Quantity, nil
+}
+
+func (r *StockBatchRepository) findOne(ctx context.Context, filter bson.M) (*domain.StockBatchEntity, error) {
+	var batch domain.StockBatchEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&batch)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &batch, nil
+}
+
+func (r *StockBatchRepository) findBatches(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*domain.StockBatchEntity, error) {
+	cur, err := r.coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var batches []*domain.StockBatchEntity
+	if err := cur.All(ctx, &batches); err != nil {
+		return nil, err
+	}
+	return batches, nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type StockMovementRepository struct {
+	coll *mongo.Collection
+}
+
+func NewStockMovementRepository(coll *mongo.Collection) *StockMovementRepository {
+	return &StockMovementRepository{coll}
+}
+
+func (r *StockMovementRepository) Create(ctx context.Context, movement *domain.StockMovementEntity) (primitive.ObjectID, error) {
+	movement.CreatedAt = time.Now()
+
+	res, err := r.coll.InsertOne(ctx, movement)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+// GetAll returns movements, optionally filtered by item and type, most recent first.
+func (r *StockMovementRepository) GetAll(ctx context.Context, itemID *primitive.ObjectID, movementType domain.StockMovementType, limit int64) ([]*domain.StockMovementEntity, error) {
+	filter := bson.M{}
+	if itemID != nil {
+		filter["itemId"] = *itemID
+	}
+	if movementType != "" {
+		filter["type"] = movementType
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}).SetLimit(limit)
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var movements []*domain.StockMovementEntity
+	if err := cur.All(ctx, &movements); err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
+
+// GetDispensedForRecord returns the items dispensed against a medical record,
+// oldest first.
+func (r *StockMovementRepository) GetDispensedForRecord(ctx context.Context, recordID primitive.ObjectID) ([]*domain.StockMovementEntity, error) {
+	filter := bson.M{"medicalRecordId": recordID, "type": domain.StockMovementDispensed}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	cur, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var movements []*domain.StockMovementEntity
+	if err := cur.All(ctx, &movements); err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
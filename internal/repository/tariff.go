@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TariffRepository struct {
+	coll *mongo.Collection
+}
+
+func NewTariffRepository(coll *mongo.Collection) *TariffRepository {
+	return &TariffRepository{coll}
+}
+
+func (r *TariffRepository) Create(ctx context.Context, tariff *domain.TariffEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	tariff.CreatedAt = now
+	tariff.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, tariff)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *TariffRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.TariffEntity, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *TariffRepository) GetByCode(ctx context.Context, code string) (*domain.TariffEntity, error) {
+	return r.findOne(ctx, bson.M{"code": code})
+}
+
+// GetActiveByAppointmentType returns the active tariff billed when an
+// appointment of the given type is completed.
+func (r *TariffRepository) GetActiveByAppointmentType(ctx context.Context, appointmentType domain.AppointmentType) (*domain.TariffEntity, error) {
+	return r.findOne(ctx, bson.M{"appointmentType": appointmentType, "isActive": true})
+}
+
+func (r *TariffRepository) GetAll(ctx context.Context) ([]*domain.TariffEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "category", Value: 1}, {Key: "code", Value: 1}})
+	cur, err := r.coll.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var tariffs []*domain.TariffEntity
+	if err := cur.All(ctx, &tariffs); err != nil {
+		return nil, err
+	}
+	return tariffs, nil
+}
+
+func (r *TariffRepository) Update(ctx context.Context, id primitive.ObjectID, tariff *domain.TariffEntity) error {
+	tariff.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": tariff})
+	return err
+}
+
+func (r *TariffRepository) findOne(ctx context.Context, filter bson.M) (*domain.TariffEntity, error) {
+	var tariff domain.TariffEntity
+	err := r.coll.FindOne(ctx, filter).Decode(&tariff)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tariff, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type WardRepository struct {
+	coll *mongo.Collection
+}
+
+func NewWardRepository(coll *mongo.Collection) *WardRepository {
+	return &WardRepository{coll}
+}
+
+func (r *WardRepository) Create(ctx context.Context, ward *domain.WardEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	ward.CreatedAt = now
+	ward.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, ward)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *WardRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.WardEntity, error) {
+	var ward domain.WardEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&ward)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ward, nil
+}
+
+func (r *WardRepository) GetByCode(ctx context.Context, code string) (*domain.WardEntity, error) {
+	var ward domain.WardEntity
+	err := r.coll.FindOne(ctx, bson.M{"code": code}).Decode(&ward)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ward, nil
+}
+
+func (r *WardRepository) GetAll(ctx context.Context) ([]*domain.WardEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "floor", Value: 1}, {Key: "name", Value: 1}})
+	cur, err := r.coll.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var wards []*domain.WardEntity
+	if err := cur.All(ctx, &wards); err != nil {
+		return nil, err
+	}
+	return wards, nil
+}
+
+func (r *WardRepository) Update(ctx context.Context, id primitive.ObjectID, ward *domain.WardEntity) error {
+	ward.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": ward})
+	return err
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type WebhookDeliveryRepository struct {
+	coll *mongo.Collection
+}
+
+func NewWebhookDeliveryRepository(coll *mongo.Collection) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{coll}
+}
+
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, delivery *domain.WebhookDeliveryEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	delivery.CreatedAt = now
+	delivery.UpdatedAt = now
+	if delivery.Attempts == nil {
+		delivery.Attempts = []domain.WebhookAttempt{}
+	}
+
+	res, err := r.coll.InsertOne(ctx, delivery)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *WebhookDeliveryRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.WebhookDeliveryEntity, error) {
+	var delivery domain.WebhookDeliveryEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&delivery)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// ExistsForEvent reports whether a delivery of the event to the subscription was already queued.
+func (r *WebhookDeliveryRepository) ExistsForEvent(ctx context.Context, subscriptionID primitive.ObjectID, eventID string) (bool, error) {
+	count, err := r.coll.CountDocuments(ctx, bson.M{"subscriptionId": subscriptionID, "eventId": eventID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetAll returns deliveries, optionally filtered by subscription and status, most recent first.
+func (r *WebhookDeliveryRepository) GetAll(ctx context.Context, subscriptionID *primitive.ObjectID, status domain.WebhookDeliveryStatus, limit int64) ([]*domain.WebhookDeliveryEntity, error) {
+	filter := bson.M{}
+	if subscriptionID != nil {
+		filter["subscriptionId"] = *subscriptionID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}).SetLimit(limit)
+	return r.find(ctx, filter, opts)
+}
+
+// GetDue returns pending or failed deliveries whose next attempt is due.
+func (r *WebhookDeliveryRepository) GetDue(ctx context.Context, at time.Time, limit int64) ([]*domain.WebhookDeliveryEntity, error) {
+	filter := bson.M{
+		"status":        bson.M{"$in": []domain.WebhookDeliveryStatus{domain.WebhookDeliveryPending, domain.WebhookDeliveryFailed}},
+		"nextAttemptAt": bson.M{"$lte": at},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).SetLimit(limit)
+	return r.find(ctx, filter, opts)
+}
+
+// Claim leases a due delivery until leaseUntil so that only one dispatcher
+// sends it. It returns false when another dispatcher got there first.
+func (r *WebhookDeliveryRepository) Claim(ctx context.Context, id primitive.ObjectID, at, leaseUntil time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":           id,
+		"status":        bson.M{"$in": []domain.WebhookDeliveryStatus{domain.WebhookDeliveryPending, domain.WebhookDeliveryFailed}},
+		"nextAttemptAt": bson.M{"$lte": at},
+	}
+
+	res, err := r.coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"nextAttemptAt": leaseUntil}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, id primitive.ObjectID, delivery *domain.WebhookDeliveryEntity) error {
+	delivery.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": delivery})
+	return err
+}
+
+func (r *WebhookDeliveryRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*domain.WebhookDeliveryEntity, error) {
+	cur, err := r.coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var deliveries []*domain.WebhookDeliveryEntity
+	if err := cur.All(ctx, &deliveries); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
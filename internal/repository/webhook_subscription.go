@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type WebhookSubscriptionRepository struct {
+	coll *mongo.Collection
+}
+
+func NewWebhookSubscriptionRepository(coll *mongo.Collection) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{coll}
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, sub *domain.WebhookSubscriptionEntity) (primitive.ObjectID, error) {
+	now := time.Now()
+	sub.CreatedAt = now
+	sub.UpdatedAt = now
+
+	res, err := r.coll.InsertOne(ctx, sub)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return res.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *WebhookSubscriptionRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*domain.WebhookSubscriptionEntity, error) {
+	var sub domain.WebhookSubscriptionEntity
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&sub)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sub, nil
+}
+
+func (r *WebhookSubscriptionRepository) GetAll(ctx context.Context) ([]*domain.WebhookSubscriptionEntity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	return r.find(ctx, bson.M{}, opts)
+}
+
+// GetActiveByEventType returns the active subscriptions that want events of the given type.
+func (r *WebhookSubscriptionRepository) GetActiveByEventType(ctx context.Context, eventType domain.EventType) ([]*domain.WebhookSubscriptionEntity, error) {
+	return r.find(ctx, bson.M{"isActive": true, "eventTypes": eventType})
+}
+
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, id primitive.ObjectID, sub *domain.WebhookSubscriptionEntity) error {
+	sub.UpdatedAt = time.Now()
+
+	_, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": sub})
+	return err
+}
+
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *WebhookSubscriptionRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*domain.WebhookSubscriptionEntity, error) {
+	cur, err := r.coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var subs []*domain.WebhookSubscriptionEntity
+	if err := cur.All(ctx, &subs); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
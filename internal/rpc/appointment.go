@@ -0,0 +1,222 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/events"
+	hmsv1 "github.com/ekastn/hms-api/internal/rpc/hms/v1"
+	"github.com/ekastn/hms-api/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// appointmentEvents are the events that carry the changed appointment. Other
+// events on the appointment topic, such as activity entries, are not sent.
+var appointmentEvents = map[domain.EventType]bool{
+	domain.EventAppointmentCreated:       true,
+	domain.EventAppointmentUpdated:       true,
+	domain.EventAppointmentStatusChanged: true,
+	domain.EventAppointmentCancelled:     true,
+	domain.EventAppointmentRescheduled:   true,
+	domain.EventAppointmentTriaged:       true,
+}
+
+type appointmentServer struct {
+	hmsv1.UnimplementedAppointmentServiceServer
+	appointmentService *service.AppointmentService
+	eventBus           *events.Bus
+}
+
+func (s *appointmentServer) ListAppointments(ctx context.Context, req *hmsv1.ListAppointmentsRequest) (*hmsv1.ListAppointmentsResponse, error) {
+	appointments, err := s.appointmentService.GetAll(ctx, req.DepartmentId)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	res := &hmsv1.ListAppointmentsResponse{Appointments: make([]*hmsv1.Appointment, 0, len(appointments))}
+	for _, a := range appointments {
+		res.Appointments = append(res.Appointments, appointmentMessage(a.ToDTO()))
+	}
+	return res, nil
+}
+
+func (s *appointmentServer) GetAppointment(ctx context.Context, req *hmsv1.GetAppointmentRequest) (*hmsv1.GetAppointmentResponse, error) {
+	if err := checkID("appointment ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	appointment, err := s.appointmentService.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	if appointment == nil {
+		return nil, notFound("Appointment")
+	}
+
+	return &hmsv1.GetAppointmentResponse{Appointment: appointmentMessage(appointment.ToDTO())}, nil
+}
+
+func (s *appointmentServer) CreateAppointment(ctx context.Context, req *hmsv1.CreateAppointmentRequest) (*hmsv1.CreateAppointmentResponse, error) {
+	body := domain.CreateAppointmentRequest{
+		PatientID:      req.PatientId,
+		DoctorID:       req.DoctorId,
+		Type:           domain.AppointmentType(req.Type),
+		DateTime:       timeOf(req.DateTime),
+		Duration:       int(req.Duration),
+		Location:       req.Location,
+		RoomID:         req.RoomId,
+		Notes:          req.Notes,
+		PatientHistory: req.PatientHistory,
+		OverrideReason: req.OverrideReason,
+	}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	id, err := s.appointmentService.Create(ctx, &body, callerFrom(ctx).userID)
+	if err != nil {
+		log.Printf("Error creating appointment: %v", err)
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.CreateAppointmentResponse{Id: id}, nil
+}
+
+func (s *appointmentServer) UpdateAppointment(ctx context.Context, req *hmsv1.UpdateAppointmentRequest) (*hmsv1.UpdateAppointmentResponse, error) {
+	if err := checkID("appointment ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	body := domain.UpdateAppointmentRequest{
+		Location:       req.Location,
+		RoomID:         req.RoomId,
+		Notes:          req.Notes,
+		PatientHistory: req.PatientHistory,
+	}
+	if req.Type != nil {
+		appointmentType := domain.AppointmentType(*req.Type)
+		body.Type = &appointmentType
+	}
+	if req.Duration != nil {
+		duration := int(*req.Duration)
+		body.Duration = &duration
+	}
+	if req.Status != nil {
+		appointmentStatus := domain.AppointmentStatus(*req.Status)
+		body.Status = &appointmentStatus
+	}
+	if req.DateTime != nil {
+		dateTime := req.DateTime.AsTime()
+		body.DateTime = &dateTime
+	}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	if err := s.appointmentService.Update(ctx, req.Id, &body, callerFrom(ctx).userID); err != nil {
+		log.Printf("Error updating appointment: %v", err)
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.UpdateAppointmentResponse{}, nil
+}
+
+func (s *appointmentServer) UpdateAppointmentStatus(ctx context.Context, req *hmsv1.UpdateAppointmentStatusRequest) (*hmsv1.UpdateAppointmentStatusResponse, error) {
+	if err := checkID("appointment ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	body := domain.UpdateAppointmentStatusRequest{Status: domain.AppointmentStatus(req.Status)}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	if err := s.appointmentService.UpdateStatus(ctx, req.Id, body.Status, callerFrom(ctx).userID); err != nil {
+		log.Printf("Error updating appointment status: %v", err)
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.UpdateAppointmentStatusResponse{}, nil
+}
+
+func (s *appointmentServer) CancelAppointment(ctx context.Context, req *hmsv1.CancelAppointmentRequest) (*hmsv1.CancelAppointmentResponse, error) {
+	if err := checkID("appointment ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.appointmentService.Delete(ctx, req.Id, callerFrom(ctx).userID); err != nil {
+		log.Printf("Error deleting appointment %s: %v", req.Id, err)
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.CancelAppointmentResponse{}, nil
+}
+
+// WatchAppointments sends the appointment events from the event bus, the
+// same feed /api/events streams. The stream ends with Unavailable when the
+// client falls too far behind or the server shuts down; the client then
+// reconnects with the last event ID.
+func (s *appointmentServer) WatchAppointments(req *hmsv1.WatchAppointmentsRequest, stream grpc.ServerStreamingServer[hmsv1.WatchAppointmentsResponse]) error {
+	ctx := stream.Context()
+
+	allowed := false
+	for _, topic := range domain.TopicsForRole(callerFrom(ctx).role) {
+		if topic == domain.ActivityTypeAppointment {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "No appointment events available for this role")
+	}
+
+	sub, replay := s.eventBus.Subscribe([]domain.ActivityType{domain.ActivityTypeAppointment}, req.LastEventId)
+	defer sub.Close()
+
+	for _, event := range replay {
+		if err := sendAppointmentEvent(stream, event); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case event, ok := <-sub.C:
+			if !ok {
+				return status.Error(codes.Unavailable, "Appointment feed closed, reconnect with the last event ID")
+			}
+			if err := sendAppointmentEvent(stream, event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func sendAppointmentEvent(stream grpc.ServerStreamingServer[hmsv1.WatchAppointmentsResponse], event domain.Event) error {
+	if !appointmentEvents[event.Type] {
+		return nil
+	}
+
+	// Events carry the appointment DTO, encoded by the outbox.
+	var appointment domain.AppointmentDTO
+	data, err := json.Marshal(event.Data)
+	if err == nil {
+		err = json.Unmarshal(data, &appointment)
+	}
+	if err != nil {
+		log.Printf("Error decoding appointment event %s: %v", event.ID, err)
+		return nil
+	}
+
+	return stream.Send(&hmsv1.WatchAppointmentsResponse{
+		EventId:     event.ID,
+		Type:        string(event.Type),
+		Appointment: appointmentMessage(appointment),
+		Timestamp:   timestamp(event.Timestamp),
+	})
+}
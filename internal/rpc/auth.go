@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	hmsv1 "github.com/ekastn/hms-api/internal/rpc/hms/v1"
+	"github.com/ekastn/hms-api/internal/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	allStaff   = []domain.Role{domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist, domain.RoleManagement}
+	clinical   = []domain.Role{domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleManagement}
+	bookers    = []domain.Role{domain.RoleAdmin, domain.RoleDoctor, domain.RoleNurse, domain.RoleReceptionist}
+	registrars = []domain.Role{domain.RoleAdmin, domain.RoleReceptionist}
+	managers   = []domain.Role{domain.RoleAdmin, domain.RoleManagement}
+	clinicians = []domain.Role{domain.RoleAdmin, domain.RoleDoctor}
+	adminsOnly = []domain.Role{domain.RoleAdmin}
+)
+
+// methodRoles lists the roles that may call each method, the same as for the
+// matching REST routes. Methods not listed are refused.
+var methodRoles = map[string][]domain.Role{
+	hmsv1.PatientService_ListPatients_FullMethodName:  allStaff,
+	hmsv1.PatientService_GetPatient_FullMethodName:    allStaff,
+	hmsv1.PatientService_CreatePatient_FullMethodName: registrars,
+	hmsv1.PatientService_UpdatePatient_FullMethodName: registrars,
+	hmsv1.PatientService_DeletePatient_FullMethodName: adminsOnly,
+
+	hmsv1.DoctorService_ListDoctors_FullMethodName:  managers,
+	hmsv1.DoctorService_GetDoctor_FullMethodName:    managers,
+	hmsv1.DoctorService_CreateDoctor_FullMethodName: adminsOnly,
+	hmsv1.DoctorService_UpdateDoctor_FullMethodName: adminsOnly,
+	hmsv1.DoctorService_DeleteDoctor_FullMethodName: adminsOnly,
+
+	hmsv1.AppointmentService_ListAppointments_FullMethodName:        allStaff,
+	hmsv1.AppointmentService_GetAppointment_FullMethodName:          allStaff,
+	hmsv1.AppointmentService_CreateAppointment_FullMethodName:       bookers,
+	hmsv1.AppointmentService_UpdateAppointment_FullMethodName:       bookers,
+	hmsv1.AppointmentService_UpdateAppointmentStatus_FullMethodName: bookers,
+	hmsv1.AppointmentService_CancelAppointment_FullMethodName:       bookers,
+	// The feed is open to the roles that get appointment events on /api/events.
+	hmsv1.AppointmentService_WatchAppointments_FullMethodName: allStaff,
+
+	hmsv1.MedicalRecordService_ListMedicalRecords_FullMethodName:  clinical,
+	hmsv1.MedicalRecordService_GetMedicalRecord_FullMethodName:    clinical,
+	hmsv1.MedicalRecordService_CreateMedicalRecord_FullMethodName: clinicians,
+	hmsv1.MedicalRecordService_UpdateMedicalRecord_FullMethodName: clinicians,
+	hmsv1.MedicalRecordService_DeleteMedicalRecord_FullMethodName: adminsOnly,
+}
+
+type callerKey struct{}
+
+// caller is the authenticated user of a call.
+type caller struct {
+	userID primitive.ObjectID
+	role   domain.Role
+}
+
+func callerFrom(ctx context.Context) caller {
+	c, _ := ctx.Value(callerKey{}).(caller)
+	return c
+}
+
+// unaryAuth and streamAuth do for gRPC what JWTMiddleware and RBACMiddleware
+// do for the REST routes: the JWT is taken from the authorization metadata.
+func unaryAuth(secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, secret, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamAuth(secret string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), secret, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticate(ctx context.Context, secret, method string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "Missing or malformed JWT")
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || scheme != "Bearer" {
+		return nil, status.Error(codes.Unauthenticated, "Missing or malformed JWT")
+	}
+
+	parsed, err := utils.ParseToken(secret, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	id, err := primitive.ObjectIDFromHex(parsed.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid user ID")
+	}
+
+	for _, allowed := range methodRoles[method] {
+		if domain.Role(parsed.Role) == allowed {
+			return context.WithValue(ctx, callerKey{}, caller{userID: id, role: allowed}), nil
+		}
+	}
+
+	return nil, status.Error(codes.PermissionDenied, "Access denied")
+}
@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	hmsv1 "github.com/ekastn/hms-api/internal/rpc/hms/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// timeOf returns the zero time for an unset timestamp, like an omitted JSON
+// field.
+func timeOf(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func patientMessage(p *domain.PatientEntity) *hmsv1.Patient {
+	return &hmsv1.Patient{
+		Id:        p.ID.Hex(),
+		Name:      p.Name,
+		Age:       int32(p.Age),
+		Gender:    p.Gender,
+		Phone:     p.Phone,
+		Email:     p.Email,
+		Address:   p.Address,
+		LastVisit: timestamp(p.LastVisit),
+		CreatedAt: timestamp(p.CreatedAt),
+		UpdatedAt: timestamp(p.UpdatedAt),
+	}
+}
+
+func doctorMessage(d domain.DoctorDTO) *hmsv1.Doctor {
+	msg := &hmsv1.Doctor{
+		Id:           d.ID,
+		Name:         d.Name,
+		Specialty:    d.Specialty,
+		DepartmentId: d.DepartmentID,
+		UserId:       d.UserID,
+		Phone:        d.Phone,
+		Email:        d.Email,
+		CreatedAt:    timestamp(d.CreatedAt),
+		UpdatedAt:    timestamp(d.UpdatedAt),
+	}
+	for _, slot := range d.Availability {
+		msg.Availability = append(msg.Availability, &hmsv1.TimeSlot{
+			DayOfWeek: int32(slot.DayOfWeek),
+			StartTime: timestamp(slot.StartTime),
+			EndTime:   timestamp(slot.EndTime),
+		})
+	}
+	return msg
+}
+
+// appointmentMessage converts the DTO, which is also what appointment events
+// carry.
+func appointmentMessage(a domain.AppointmentDTO) *hmsv1.Appointment {
+	return &hmsv1.Appointment{
+		Id:             a.ID,
+		PatientId:      a.PatientID,
+		DoctorId:       a.DoctorID,
+		Type:           string(a.Type),
+		DateTime:       timestamp(a.DateTime),
+		Duration:       int32(a.Duration),
+		Status:         string(a.Status),
+		Location:       a.Location,
+		RoomId:         a.RoomID,
+		DepartmentId:   a.DepartmentID,
+		Notes:          a.Notes,
+		PatientHistory: a.PatientHistory,
+		CreatedAt:      timestamp(a.CreatedAt),
+		UpdatedAt:      timestamp(a.UpdatedAt),
+	}
+}
+
+func medicalRecordMessage(r *domain.MedicalRecordEntity) *hmsv1.MedicalRecord {
+	return &hmsv1.MedicalRecord{
+		Id:          r.ID.Hex(),
+		PatientId:   r.PatientID.Hex(),
+		DoctorId:    r.DoctorID.Hex(),
+		Date:        timestamp(r.Date),
+		RecordType:  string(r.RecordType),
+		Description: r.Description,
+		Diagnosis:   r.Diagnosis,
+		Treatment:   r.Treatment,
+		Notes:       r.Notes,
+		CreatedAt:   timestamp(r.CreatedAt),
+		UpdatedAt:   timestamp(r.UpdatedAt),
+	}
+}
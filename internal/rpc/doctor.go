@@ -0,0 +1,144 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	hmsv1 "github.com/ekastn/hms-api/internal/rpc/hms/v1"
+	"github.com/ekastn/hms-api/internal/service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type doctorServer struct {
+	hmsv1.UnimplementedDoctorServiceServer
+	docService *service.DoctorService
+}
+
+func (s *doctorServer) ListDoctors(ctx context.Context, req *hmsv1.ListDoctorsRequest) (*hmsv1.ListDoctorsResponse, error) {
+	doctors, err := s.docService.GetAll(ctx, req.DepartmentId)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	res := &hmsv1.ListDoctorsResponse{Doctors: make([]*hmsv1.Doctor, 0, len(doctors))}
+	for _, d := range doctors {
+		res.Doctors = append(res.Doctors, doctorMessage(d.ToDTO()))
+	}
+	return res, nil
+}
+
+func (s *doctorServer) GetDoctor(ctx context.Context, req *hmsv1.GetDoctorRequest) (*hmsv1.GetDoctorResponse, error) {
+	if err := checkID("doctor ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	doctor, err := s.docService.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	if doctor == nil {
+		return nil, notFound("Doctor")
+	}
+
+	return &hmsv1.GetDoctorResponse{Doctor: doctorMessage(doctor.ToDTO())}, nil
+}
+
+func (s *doctorServer) CreateDoctor(ctx context.Context, req *hmsv1.CreateDoctorRequest) (*hmsv1.CreateDoctorResponse, error) {
+	body := domain.CreateDoctorRequet{
+		Name:         req.Name,
+		Specialty:    req.Specialty,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		DepartmentID: req.DepartmentId,
+		UserID:       req.UserId,
+	}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	docEntity := domain.DoctorEntity{
+		Name:         body.Name,
+		Specialty:    body.Specialty,
+		Phone:        body.Phone,
+		Email:        body.Email,
+		DepartmentID: optionalID(body.DepartmentID),
+		UserID:       optionalID(body.UserID),
+		Availability: []domain.TimeSlot{},
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	id, err := s.docService.Create(ctx, &docEntity, callerFrom(ctx).userID)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.CreateDoctorResponse{Id: id}, nil
+}
+
+func (s *doctorServer) UpdateDoctor(ctx context.Context, req *hmsv1.UpdateDoctorRequest) (*hmsv1.UpdateDoctorResponse, error) {
+	if err := checkID("doctor ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	body := domain.UpdateDoctorRequet{
+		Name:         req.Name,
+		Specialty:    req.Specialty,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		DepartmentID: req.DepartmentId,
+		UserID:       req.UserId,
+	}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	existingDoc, err := s.docService.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	if existingDoc == nil {
+		return nil, notFound("Doctor")
+	}
+
+	docEntity := domain.DoctorEntity{
+		Name:         body.Name,
+		Specialty:    body.Specialty,
+		Phone:        body.Phone,
+		Email:        body.Email,
+		DepartmentID: optionalID(body.DepartmentID),
+		UserID:       optionalID(body.UserID),
+		Availability: existingDoc.Availability,
+		CreatedAt:    existingDoc.CreatedAt,
+		UpdatedAt:    time.Now(),
+	}
+
+	if err := s.docService.Update(ctx, req.Id, &docEntity, callerFrom(ctx).userID); err != nil {
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.UpdateDoctorResponse{}, nil
+}
+
+func (s *doctorServer) DeleteDoctor(ctx context.Context, req *hmsv1.DeleteDoctorRequest) (*hmsv1.DeleteDoctorResponse, error) {
+	if err := checkID("doctor ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.docService.Delete(ctx, req.Id); err != nil {
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.DeleteDoctorResponse{}, nil
+}
+
+// optionalID parses an ID the request validation accepted, which may be
+// empty.
+func optionalID(hex string) *primitive.ObjectID {
+	if hex == "" {
+		return nil
+	}
+	id, _ := primitive.ObjectIDFromHex(hex) // validated above
+	return &id
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"errors"
+
+	"github.com/ekastn/hms-api/internal/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validate checks a request the same way the REST handlers check its JSON
+// counterpart. The failures are sent as BadRequest details.
+func validate(req any) error {
+	validationErrors := utils.ValidateStruct(req)
+	if validationErrors == nil {
+		return nil
+	}
+
+	details := &errdetails.BadRequest{}
+	for _, e := range validationErrors {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       e.Field,
+			Description: e.Message,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, "Validation failed")
+	if withDetails, err := st.WithDetails(details); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// checkID rejects IDs that cannot be looked up before they reach the
+// services.
+func checkID(field, id string) error {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid %s", field)
+	}
+	return nil
+}
+
+func notFound(what string) error {
+	return status.Errorf(codes.NotFound, "%s not found", what)
+}
+
+// serviceError reports a service failure. As on the REST API, the services'
+// errors are returned as they are.
+func serviceError(err error) error {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
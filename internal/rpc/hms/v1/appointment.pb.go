@@ -0,0 +1,1124 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hms/v1/appointment.proto
+
+package hmsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Appointment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId string                 `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId  string                 `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	// check-up, follow-up, consultation, procedure or emergency.
+	Type     string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	DateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	// In minutes.
+	Duration int32 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Scheduled, Confirmed, Completed or Cancelled.
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Location       string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	RoomId         string                 `protobuf:"bytes,9,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	DepartmentId   string                 `protobuf:"bytes,10,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	Notes          string                 `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
+	PatientHistory string                 `protobuf:"bytes,12,opt,name=patient_history,json=patientHistory,proto3" json:"patient_history,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Appointment) Reset() {
+	*x = Appointment{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Appointment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appointment) ProtoMessage() {}
+
+func (x *Appointment) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
+func (*Appointment) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Appointment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Appointment) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *Appointment) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+func (x *Appointment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Appointment) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Appointment) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Appointment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Appointment) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Appointment) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *Appointment) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *Appointment) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Appointment) GetPatientHistory() string {
+	if x != nil {
+		return x.PatientHistory
+	}
+	return ""
+}
+
+func (x *Appointment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Appointment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListAppointmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list the appointments of this department.
+	DepartmentId  string `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppointmentsRequest) Reset() {
+	*x = ListAppointmentsRequest{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentsRequest) ProtoMessage() {}
+
+func (x *ListAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAppointmentsRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+type ListAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAppointmentsResponse) Reset() {
+	*x = ListAppointmentsResponse{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppointmentsResponse) ProtoMessage() {}
+
+func (x *ListAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAppointmentsResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+type GetAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentRequest) Reset() {
+	*x = GetAppointmentRequest{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentRequest) ProtoMessage() {}
+
+func (x *GetAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentResponse) Reset() {
+	*x = GetAppointmentResponse{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentResponse) ProtoMessage() {}
+
+func (x *GetAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*GetAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAppointmentResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+type CreateAppointmentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PatientId string                 `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId  string                 `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Type      string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DateTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Duration  int32                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Required unless room_id is set, in which case it is taken from the room.
+	Location       string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	RoomId         string `protobuf:"bytes,7,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Notes          string `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	PatientHistory string `protobuf:"bytes,9,opt,name=patient_history,json=patientHistory,proto3" json:"patient_history,omitempty"`
+	// Lets an emergency take a slot the doctor is already booked for; the
+	// appointments in the way are moved back.
+	OverrideReason string `protobuf:"bytes,10,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateAppointmentRequest) Reset() {
+	*x = CreateAppointmentRequest{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppointmentRequest) ProtoMessage() {}
+
+func (x *CreateAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAppointmentRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *CreateAppointmentRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateAppointmentRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetPatientHistory() string {
+	if x != nil {
+		return x.PatientHistory
+	}
+	return ""
+}
+
+func (x *CreateAppointmentRequest) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
+	}
+	return ""
+}
+
+type CreateAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppointmentResponse) Reset() {
+	*x = CreateAppointmentResponse{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppointmentResponse) ProtoMessage() {}
+
+func (x *CreateAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateAppointmentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateAppointmentRequest updates the fields of the appointment that are
+// set and leaves the others unchanged.
+type UpdateAppointmentRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	DateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Duration *int32                 `protobuf:"varint,4,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	Status   *string                `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Location *string                `protobuf:"bytes,6,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// Moves the appointment to another clinic room.
+	RoomId         *string `protobuf:"bytes,7,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	Notes          *string `protobuf:"bytes,8,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	PatientHistory *string `protobuf:"bytes,9,opt,name=patient_history,json=patientHistory,proto3,oneof" json:"patient_history,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateAppointmentRequest) Reset() {
+	*x = UpdateAppointmentRequest{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointmentRequest) ProtoMessage() {}
+
+func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAppointmentRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *UpdateAppointmentRequest) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *UpdateAppointmentRequest) GetDuration() int32 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *UpdateAppointmentRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateAppointmentRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *UpdateAppointmentRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *UpdateAppointmentRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateAppointmentRequest) GetPatientHistory() string {
+	if x != nil && x.PatientHistory != nil {
+		return *x.PatientHistory
+	}
+	return ""
+}
+
+type UpdateAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppointmentResponse) Reset() {
+	*x = UpdateAppointmentResponse{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointmentResponse) ProtoMessage() {}
+
+func (x *UpdateAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{8}
+}
+
+type UpdateAppointmentStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppointmentStatusRequest) Reset() {
+	*x = UpdateAppointmentStatusRequest{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointmentStatusRequest) ProtoMessage() {}
+
+func (x *UpdateAppointmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateAppointmentStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAppointmentStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateAppointmentStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppointmentStatusResponse) Reset() {
+	*x = UpdateAppointmentStatusResponse{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointmentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointmentStatusResponse) ProtoMessage() {}
+
+func (x *UpdateAppointmentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointmentStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{10}
+}
+
+type CancelAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAppointmentRequest) Reset() {
+	*x = CancelAppointmentRequest{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAppointmentRequest) ProtoMessage() {}
+
+func (x *CancelAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CancelAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelAppointmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAppointmentResponse) Reset() {
+	*x = CancelAppointmentResponse{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAppointmentResponse) ProtoMessage() {}
+
+func (x *CancelAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*CancelAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{12}
+}
+
+type WatchAppointmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the last event received, to replay the events published since.
+	LastEventId   string `protobuf:"bytes,1,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAppointmentsRequest) Reset() {
+	*x = WatchAppointmentsRequest{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAppointmentsRequest) ProtoMessage() {}
+
+func (x *WatchAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchAppointmentsRequest) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
+	}
+	return ""
+}
+
+type WatchAppointmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event ID to resume from.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// appointment.created, appointment.updated, appointment.status_changed,
+	// appointment.cancelled, appointment.rescheduled or appointment.triaged.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The appointment after the change.
+	Appointment   *Appointment           `protobuf:"bytes,3,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAppointmentsResponse) Reset() {
+	*x = WatchAppointmentsResponse{}
+	mi := &file_hms_v1_appointment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAppointmentsResponse) ProtoMessage() {}
+
+func (x *WatchAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_appointment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*WatchAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_appointment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchAppointmentsResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WatchAppointmentsResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WatchAppointmentsResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+func (x *WatchAppointmentsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_hms_v1_appointment_proto protoreflect.FileDescriptor
+
+const file_hms_v1_appointment_proto_rawDesc = "" +
+	"\n" +
+	"\x18hms/v1/appointment.proto\x12\x06hms.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x03\n" +
+	"\vAppointment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\tR\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\tR\bdoctorId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x127\n" +
+	"\tdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\x05R\bduration\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\blocation\x18\b \x01(\tR\blocation\x12\x17\n" +
+	"\aroom_id\x18\t \x01(\tR\x06roomId\x12#\n" +
+	"\rdepartment_id\x18\n" +
+	" \x01(\tR\fdepartmentId\x12\x14\n" +
+	"\x05notes\x18\v \x01(\tR\x05notes\x12'\n" +
+	"\x0fpatient_history\x18\f \x01(\tR\x0epatientHistory\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\">\n" +
+	"\x17ListAppointmentsRequest\x12#\n" +
+	"\rdepartment_id\x18\x01 \x01(\tR\fdepartmentId\"S\n" +
+	"\x18ListAppointmentsResponse\x127\n" +
+	"\fappointments\x18\x01 \x03(\v2\x13.hms.v1.AppointmentR\fappointments\"'\n" +
+	"\x15GetAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x16GetAppointmentResponse\x125\n" +
+	"\vappointment\x18\x01 \x01(\v2\x13.hms.v1.AppointmentR\vappointment\"\xdc\x02\n" +
+	"\x18CreateAppointmentRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\tR\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\tR\bdoctorId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x127\n" +
+	"\tdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x05R\bduration\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x17\n" +
+	"\aroom_id\x18\a \x01(\tR\x06roomId\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x12'\n" +
+	"\x0fpatient_history\x18\t \x01(\tR\x0epatientHistory\x12'\n" +
+	"\x0foverride_reason\x18\n" +
+	" \x01(\tR\x0eoverrideReason\"+\n" +
+	"\x19CreateAppointmentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9a\x03\n" +
+	"\x18UpdateAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x00R\x04type\x88\x01\x01\x127\n" +
+	"\tdate_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x1f\n" +
+	"\bduration\x18\x04 \x01(\x05H\x01R\bduration\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\blocation\x18\x06 \x01(\tH\x03R\blocation\x88\x01\x01\x12\x1c\n" +
+	"\aroom_id\x18\a \x01(\tH\x04R\x06roomId\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\b \x01(\tH\x05R\x05notes\x88\x01\x01\x12,\n" +
+	"\x0fpatient_history\x18\t \x01(\tH\x06R\x0epatientHistory\x88\x01\x01B\a\n" +
+	"\x05_typeB\v\n" +
+	"\t_durationB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_locationB\n" +
+	"\n" +
+	"\b_room_idB\b\n" +
+	"\x06_notesB\x12\n" +
+	"\x10_patient_history\"\x1b\n" +
+	"\x19UpdateAppointmentResponse\"H\n" +
+	"\x1eUpdateAppointmentStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"!\n" +
+	"\x1fUpdateAppointmentStatusResponse\"*\n" +
+	"\x18CancelAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19CancelAppointmentResponse\">\n" +
+	"\x18WatchAppointmentsRequest\x12\"\n" +
+	"\rlast_event_id\x18\x01 \x01(\tR\vlastEventId\"\xbb\x01\n" +
+	"\x19WatchAppointmentsResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x125\n" +
+	"\vappointment\x18\x03 \x01(\v2\x13.hms.v1.AppointmentR\vappointment\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x92\x05\n" +
+	"\x12AppointmentService\x12U\n" +
+	"\x10ListAppointments\x12\x1f.hms.v1.ListAppointmentsRequest\x1a .hms.v1.ListAppointmentsResponse\x12O\n" +
+	"\x0eGetAppointment\x12\x1d.hms.v1.GetAppointmentRequest\x1a\x1e.hms.v1.GetAppointmentResponse\x12X\n" +
+	"\x11CreateAppointment\x12 .hms.v1.CreateAppointmentRequest\x1a!.hms.v1.CreateAppointmentResponse\x12X\n" +
+	"\x11UpdateAppointment\x12 .hms.v1.UpdateAppointmentRequest\x1a!.hms.v1.UpdateAppointmentResponse\x12j\n" +
+	"\x17UpdateAppointmentStatus\x12&.hms.v1.UpdateAppointmentStatusRequest\x1a'.hms.v1.UpdateAppointmentStatusResponse\x12X\n" +
+	"\x11CancelAppointment\x12 .hms.v1.CancelAppointmentRequest\x1a!.hms.v1.CancelAppointmentResponse\x12Z\n" +
+	"\x11WatchAppointments\x12 .hms.v1.WatchAppointmentsRequest\x1a!.hms.v1.WatchAppointmentsResponse0\x01B\x8c\x01\n" +
+	"\n" +
+	"com.hms.v1B\x10AppointmentProtoP\x01Z3github.com/ekastn/hms-api/internal/rpc/hms/v1;hmsv1\xa2\x02\x03HXX\xaa\x02\x06Hms.V1\xca\x02\x06Hms\\V1\xe2\x02\x12Hms\\V1\\GPBMetadata\xea\x02\aHms::V1b\x06proto3"
+
+var (
+	file_hms_v1_appointment_proto_rawDescOnce sync.Once
+	file_hms_v1_appointment_proto_rawDescData []byte
+)
+
+func file_hms_v1_appointment_proto_rawDescGZIP() []byte {
+	file_hms_v1_appointment_proto_rawDescOnce.Do(func() {
+		file_hms_v1_appointment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hms_v1_appointment_proto_rawDesc), len(file_hms_v1_appointment_proto_rawDesc)))
+	})
+	return file_hms_v1_appointment_proto_rawDescData
+}
+
+var file_hms_v1_appointment_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_hms_v1_appointment_proto_goTypes = []any{
+	(*Appointment)(nil),                     // 0: hms.v1.Appointment
+	(*ListAppointmentsRequest)(nil),         // 1: hms.v1.ListAppointmentsRequest
+	(*ListAppointmentsResponse)(nil),        // 2: hms.v1.ListAppointmentsResponse
+	(*GetAppointmentRequest)(nil),           // 3: hms.v1.GetAppointmentRequest
+	(*GetAppointmentResponse)(nil),          // 4: hms.v1.GetAppointmentResponse
+	(*CreateAppointmentRequest)(nil),        // 5: hms.v1.CreateAppointmentRequest
+	(*CreateAppointmentResponse)(nil),       // 6: hms.v1.CreateAppointmentResponse
+	(*UpdateAppointmentRequest)(nil),        // 7: hms.v1.UpdateAppointmentRequest
+	(*UpdateAppointmentResponse)(nil),       // 8: hms.v1.UpdateAppointmentResponse
+	(*UpdateAppointmentStatusRequest)(nil),  // 9: hms.v1.UpdateAppointmentStatusRequest
+	(*UpdateAppointmentStatusResponse)(nil), // 10: hms.v1.UpdateAppointmentStatusResponse
+	(*CancelAppointmentRequest)(nil),        // 11: hms.v1.CancelAppointmentRequest
+	(*CancelAppointmentResponse)(nil),       // 12: hms.v1.CancelAppointmentResponse
+	(*WatchAppointmentsRequest)(nil),        // 13: hms.v1.WatchAppointmentsRequest
+	(*WatchAppointmentsResponse)(nil),       // 14: hms.v1.WatchAppointmentsResponse
+	(*timestamppb.Timestamp)(nil),           // 15: google.protobuf.Timestamp
+}
+var file_hms_v1_appointment_proto_depIdxs = []int32{
+	15, // 0: hms.v1.Appointment.date_time:type_name -> google.protobuf.Timestamp
+	15, // 1: hms.v1.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: hms.v1.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: hms.v1.ListAppointmentsResponse.appointments:type_name -> hms.v1.Appointment
+	0,  // 4: hms.v1.GetAppointmentResponse.appointment:type_name -> hms.v1.Appointment
+	15, // 5: hms.v1.CreateAppointmentRequest.date_time:type_name -> google.protobuf.Timestamp
+	15, // 6: hms.v1.UpdateAppointmentRequest.date_time:type_name -> google.protobuf.Timestamp
+	0,  // 7: hms.v1.WatchAppointmentsResponse.appointment:type_name -> hms.v1.Appointment
+	15, // 8: hms.v1.WatchAppointmentsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 9: hms.v1.AppointmentService.ListAppointments:input_type -> hms.v1.ListAppointmentsRequest
+	3,  // 10: hms.v1.AppointmentService.GetAppointment:input_type -> hms.v1.GetAppointmentRequest
+	5,  // 11: hms.v1.AppointmentService.CreateAppointment:input_type -> hms.v1.CreateAppointmentRequest
+	7,  // 12: hms.v1.AppointmentService.UpdateAppointment:input_type -> hms.v1.UpdateAppointmentRequest
+	9,  // 13: hms.v1.AppointmentService.UpdateAppointmentStatus:input_type -> hms.v1.UpdateAppointmentStatusRequest
+	11, // 14: hms.v1.AppointmentService.CancelAppointment:input_type -> hms.v1.CancelAppointmentRequest
+	13, // 15: hms.v1.AppointmentService.WatchAppointments:input_type -> hms.v1.WatchAppointmentsRequest
+	2,  // 16: hms.v1.AppointmentService.ListAppointments:output_type -> hms.v1.ListAppointmentsResponse
+	4,  // 17: hms.v1.AppointmentService.GetAppointment:output_type -> hms.v1.GetAppointmentResponse
+	6,  // 18: hms.v1.AppointmentService.CreateAppointment:output_type -> hms.v1.CreateAppointmentResponse
+	8,  // 19: hms.v1.AppointmentService.UpdateAppointment:output_type -> hms.v1.UpdateAppointmentResponse
+	10, // 20: hms.v1.AppointmentService.UpdateAppointmentStatus:output_type -> hms.v1.UpdateAppointmentStatusResponse
+	12, // 21: hms.v1.AppointmentService.CancelAppointment:output_type -> hms.v1.CancelAppointmentResponse
+	14, // 22: hms.v1.AppointmentService.WatchAppointments:output_type -> hms.v1.WatchAppointmentsResponse
+	16, // [16:23] is the sub-list for method output_type
+	9,  // [9:16] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_hms_v1_appointment_proto_init() }
+func file_hms_v1_appointment_proto_init() {
+	if File_hms_v1_appointment_proto != nil {
+		return
+	}
+	file_hms_v1_appointment_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hms_v1_appointment_proto_rawDesc), len(file_hms_v1_appointment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hms_v1_appointment_proto_goTypes,
+		DependencyIndexes: file_hms_v1_appointment_proto_depIdxs,
+		MessageInfos:      file_hms_v1_appointment_proto_msgTypes,
+	}.Build()
+	File_hms_v1_appointment_proto = out.File
+	file_hms_v1_appointment_proto_goTypes = nil
+	file_hms_v1_appointment_proto_depIdxs = nil
+}
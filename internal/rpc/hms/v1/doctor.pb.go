@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hms/v1/doctor.proto
+
+package hmsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TimeSlot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0-6, Sunday to Saturday.
+	DayOfWeek     int32                  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSlot) Reset() {
+	*x = TimeSlot{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlot) ProtoMessage() {}
+
+func (x *TimeSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlot.ProtoReflect.Descriptor instead.
+func (*TimeSlot) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimeSlot) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *TimeSlot) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TimeSlot) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type Doctor struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Specialty    string                 `protobuf:"bytes,3,opt,name=specialty,proto3" json:"specialty,omitempty"`
+	DepartmentId string                 `protobuf:"bytes,4,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// The doctor's login account.
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	Availability  []*TimeSlot            `protobuf:"bytes,8,rep,name=availability,proto3" json:"availability,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Doctor) Reset() {
+	*x = Doctor{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Doctor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Doctor) ProtoMessage() {}
+
+func (x *Doctor) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Doctor.ProtoReflect.Descriptor instead.
+func (*Doctor) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Doctor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Doctor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Doctor) GetSpecialty() string {
+	if x != nil {
+		return x.Specialty
+	}
+	return ""
+}
+
+func (x *Doctor) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *Doctor) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Doctor) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Doctor) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Doctor) GetAvailability() []*TimeSlot {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *Doctor) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Doctor) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListDoctorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list the doctors of this department.
+	DepartmentId  string `protobuf:"bytes,1,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDoctorsRequest) Reset() {
+	*x = ListDoctorsRequest{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDoctorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDoctorsRequest) ProtoMessage() {}
+
+func (x *ListDoctorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDoctorsRequest.ProtoReflect.Descriptor instead.
+func (*ListDoctorsRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDoctorsRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+type ListDoctorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Doctors       []*Doctor              `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDoctorsResponse) Reset() {
+	*x = ListDoctorsResponse{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDoctorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDoctorsResponse) ProtoMessage() {}
+
+func (x *ListDoctorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDoctorsResponse.ProtoReflect.Descriptor instead.
+func (*ListDoctorsResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDoctorsResponse) GetDoctors() []*Doctor {
+	if x != nil {
+		return x.Doctors
+	}
+	return nil
+}
+
+type GetDoctorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorRequest) Reset() {
+	*x = GetDoctorRequest{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorRequest) ProtoMessage() {}
+
+func (x *GetDoctorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorRequest.ProtoReflect.Descriptor instead.
+func (*GetDoctorRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDoctorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDoctorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Doctor        *Doctor                `protobuf:"bytes,1,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorResponse) Reset() {
+	*x = GetDoctorResponse{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorResponse) ProtoMessage() {}
+
+func (x *GetDoctorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorResponse.ProtoReflect.Descriptor instead.
+func (*GetDoctorResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDoctorResponse) GetDoctor() *Doctor {
+	if x != nil {
+		return x.Doctor
+	}
+	return nil
+}
+
+type CreateDoctorRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Specialty string                 `protobuf:"bytes,2,opt,name=specialty,proto3" json:"specialty,omitempty"`
+	// E.164, e.g. +6281234567890.
+	Phone         string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	DepartmentId  string `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	UserId        string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDoctorRequest) Reset() {
+	*x = CreateDoctorRequest{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDoctorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDoctorRequest) ProtoMessage() {}
+
+func (x *CreateDoctorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDoctorRequest.ProtoReflect.Descriptor instead.
+func (*CreateDoctorRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateDoctorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDoctorRequest) GetSpecialty() string {
+	if x != nil {
+		return x.Specialty
+	}
+	return ""
+}
+
+func (x *CreateDoctorRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateDoctorRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateDoctorRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *CreateDoctorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateDoctorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDoctorResponse) Reset() {
+	*x = CreateDoctorResponse{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDoctorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDoctorResponse) ProtoMessage() {}
+
+func (x *CreateDoctorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDoctorResponse.ProtoReflect.Descriptor instead.
+func (*CreateDoctorResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateDoctorResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateDoctorRequest replaces the fields of the doctor. The availability is
+// kept.
+type UpdateDoctorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Specialty     string                 `protobuf:"bytes,3,opt,name=specialty,proto3" json:"specialty,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	DepartmentId  string                 `protobuf:"bytes,6,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDoctorRequest) Reset() {
+	*x = UpdateDoctorRequest{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDoctorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDoctorRequest) ProtoMessage() {}
+
+func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDoctorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDoctorRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateDoctorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetSpecialty() string {
+	if x != nil {
+		return x.Specialty
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateDoctorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDoctorResponse) Reset() {
+	*x = UpdateDoctorResponse{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDoctorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDoctorResponse) ProtoMessage() {}
+
+func (x *UpdateDoctorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDoctorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDoctorResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{9}
+}
+
+type DeleteDoctorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDoctorRequest) Reset() {
+	*x = DeleteDoctorRequest{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDoctorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDoctorRequest) ProtoMessage() {}
+
+func (x *DeleteDoctorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDoctorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDoctorRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteDoctorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDoctorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDoctorResponse) Reset() {
+	*x = DeleteDoctorResponse{}
+	mi := &file_hms_v1_doctor_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDoctorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDoctorResponse) ProtoMessage() {}
+
+func (x *DeleteDoctorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_doctor_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDoctorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDoctorResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_doctor_proto_rawDescGZIP(), []int{11}
+}
+
+var File_hms_v1_doctor_proto protoreflect.FileDescriptor
+
+const file_hms_v1_doctor_proto_rawDesc = "" +
+	"\n" +
+	"\x13hms/v1/doctor.proto\x12\x06hms.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x01\n" +
+	"\bTimeSlot\x12\x1e\n" +
+	"\vday_of_week\x18\x01 \x01(\x05R\tdayOfWeek\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xe0\x02\n" +
+	"\x06Doctor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tspecialty\x18\x03 \x01(\tR\tspecialty\x12#\n" +
+	"\rdepartment_id\x18\x04 \x01(\tR\fdepartmentId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\a \x01(\tR\x05email\x124\n" +
+	"\favailability\x18\b \x03(\v2\x10.hms.v1.TimeSlotR\favailability\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"9\n" +
+	"\x12ListDoctorsRequest\x12#\n" +
+	"\rdepartment_id\x18\x01 \x01(\tR\fdepartmentId\"?\n" +
+	"\x13ListDoctorsResponse\x12(\n" +
+	"\adoctors\x18\x01 \x03(\v2\x0e.hms.v1.DoctorR\adoctors\"\"\n" +
+	"\x10GetDoctorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x11GetDoctorResponse\x12&\n" +
+	"\x06doctor\x18\x01 \x01(\v2\x0e.hms.v1.DoctorR\x06doctor\"\xb1\x01\n" +
+	"\x13CreateDoctorRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tspecialty\x18\x02 \x01(\tR\tspecialty\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12#\n" +
+	"\rdepartment_id\x18\x05 \x01(\tR\fdepartmentId\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\"&\n" +
+	"\x14CreateDoctorResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc1\x01\n" +
+	"\x13UpdateDoctorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tspecialty\x18\x03 \x01(\tR\tspecialty\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12#\n" +
+	"\rdepartment_id\x18\x06 \x01(\tR\fdepartmentId\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\"\x16\n" +
+	"\x14UpdateDoctorResponse\"%\n" +
+	"\x13DeleteDoctorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14DeleteDoctorResponse2\xfa\x02\n" +
+	"\rDoctorService\x12F\n" +
+	"\vListDoctors\x12\x1a.hms.v1.ListDoctorsRequest\x1a\x1b.hms.v1.ListDoctorsResponse\x12@\n" +
+	"\tGetDoctor\x12\x18.hms.v1.GetDoctorRequest\x1a\x19.hms.v1.GetDoctorResponse\x12I\n" +
+	"\fCreateDoctor\x12\x1b.hms.v1.CreateDoctorRequest\x1a\x1c.hms.v1.CreateDoctorResponse\x12I\n" +
+	"\fUpdateDoctor\x12\x1b.hms.v1.UpdateDoctorRequest\x1a\x1c.hms.v1.UpdateDoctorResponse\x12I\n" +
+	"\fDeleteDoctor\x12\x1b.hms.v1.DeleteDoctorRequest\x1a\x1c.hms.v1.DeleteDoctorResponseB\x87\x01\n" +
+	"\n" +
+	"com.hms.v1B\vDoctorProtoP\x01Z3github.com/ekastn/hms-api/internal/rpc/hms/v1;hmsv1\xa2\x02\x03HXX\xaa\x02\x06Hms.V1\xca\x02\x06Hms\\V1\xe2\x02\x12Hms\\V1\\GPBMetadata\xea\x02\aHms::V1b\x06proto3"
+
+var (
+	file_hms_v1_doctor_proto_rawDescOnce sync.Once
+	file_hms_v1_doctor_proto_rawDescData []byte
+)
+
+func file_hms_v1_doctor_proto_rawDescGZIP() []byte {
+	file_hms_v1_doctor_proto_rawDescOnce.Do(func() {
+		file_hms_v1_doctor_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hms_v1_doctor_proto_rawDesc), len(file_hms_v1_doctor_proto_rawDesc)))
+	})
+	return file_hms_v1_doctor_proto_rawDescData
+}
+
+var file_hms_v1_doctor_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_hms_v1_doctor_proto_goTypes = []any{
+	(*TimeSlot)(nil),              // 0: hms.v1.TimeSlot
+	(*Doctor)(nil),                // 1: hms.v1.Doctor
+	(*ListDoctorsRequest)(nil),    // 2: hms.v1.ListDoctorsRequest
+	(*ListDoctorsResponse)(nil),   // 3: hms.v1.ListDoctorsResponse
+	(*GetDoctorRequest)(nil),      // 4: hms.v1.GetDoctorRequest
+	(*GetDoctorResponse)(nil),     // 5: hms.v1.GetDoctorResponse
+	(*CreateDoctorRequest)(nil),   // 6: hms.v1.CreateDoctorRequest
+	(*CreateDoctorResponse)(nil),  // 7: hms.v1.CreateDoctorResponse
+	(*UpdateDoctorRequest)(nil),   // 8: hms.v1.UpdateDoctorRequest
+	(*UpdateDoctorResponse)(nil),  // 9: hms.v1.UpdateDoctorResponse
+	(*DeleteDoctorRequest)(nil),   // 10: hms.v1.DeleteDoctorRequest
+	(*DeleteDoctorResponse)(nil),  // 11: hms.v1.DeleteDoctorResponse
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+}
+var file_hms_v1_doctor_proto_depIdxs = []int32{
+	12, // 0: hms.v1.TimeSlot.start_time:type_name -> google.protobuf.Timestamp
+	12, // 1: hms.v1.TimeSlot.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: hms.v1.Doctor.availability:type_name -> hms.v1.TimeSlot
+	12, // 3: hms.v1.Doctor.created_at:type_name -> google.protobuf.Timestamp
+	12, // 4: hms.v1.Doctor.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: hms.v1.ListDoctorsResponse.doctors:type_name -> hms.v1.Doctor
+	1,  // 6: hms.v1.GetDoctorResponse.doctor:type_name -> hms.v1.Doctor
+	2,  // 7: hms.v1.DoctorService.ListDoctors:input_type -> hms.v1.ListDoctorsRequest
+	4,  // 8: hms.v1.DoctorService.GetDoctor:input_type -> hms.v1.GetDoctorRequest
+	6,  // 9: hms.v1.DoctorService.CreateDoctor:input_type -> hms.v1.CreateDoctorRequest
+	8,  // 10: hms.v1.DoctorService.UpdateDoctor:input_type -> hms.v1.UpdateDoctorRequest
+	10, // 11: hms.v1.DoctorService.DeleteDoctor:input_type -> hms.v1.DeleteDoctorRequest
+	3,  // 12: hms.v1.DoctorService.ListDoctors:output_type -> hms.v1.ListDoctorsResponse
+	5,  // 13: hms.v1.DoctorService.GetDoctor:output_type -> hms.v1.GetDoctorResponse
+	7,  // 14: hms.v1.DoctorService.CreateDoctor:output_type -> hms.v1.CreateDoctorResponse
+	9,  // 15: hms.v1.DoctorService.UpdateDoctor:output_type -> hms.v1.UpdateDoctorResponse
+	11, // 16: hms.v1.DoctorService.DeleteDoctor:output_type -> hms.v1.DeleteDoctorResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_hms_v1_doctor_proto_init() }
+func file_hms_v1_doctor_proto_init() {
+	if File_hms_v1_doctor_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hms_v1_doctor_proto_rawDesc), len(file_hms_v1_doctor_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hms_v1_doctor_proto_goTypes,
+		DependencyIndexes: file_hms_v1_doctor_proto_depIdxs,
+		MessageInfos:      file_hms_v1_doctor_proto_msgTypes,
+	}.Build()
+	File_hms_v1_doctor_proto = out.File
+	file_hms_v1_doctor_proto_goTypes = nil
+	file_hms_v1_doctor_proto_depIdxs = nil
+}
@@ -0,0 +1,801 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hms/v1/medical_record.proto
+
+package hmsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MedicalRecord struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId string                 `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId  string                 `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	// checkup, followup, procedure or emergency.
+	RecordType    string                 `protobuf:"bytes,5,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Diagnosis     string                 `protobuf:"bytes,7,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	Treatment     string                 `protobuf:"bytes,8,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	Notes         string                 `protobuf:"bytes,9,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecord) Reset() {
+	*x = MedicalRecord{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecord) ProtoMessage() {}
+
+func (x *MedicalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecord.ProtoReflect.Descriptor instead.
+func (*MedicalRecord) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MedicalRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetDiagnosis() string {
+	if x != nil {
+		return x.Diagnosis
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *MedicalRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListMedicalRecordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list the records of this patient.
+	PatientId     string `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMedicalRecordsRequest) Reset() {
+	*x = ListMedicalRecordsRequest{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMedicalRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalRecordsRequest) ProtoMessage() {}
+
+func (x *ListMedicalRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMedicalRecordsRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+type ListMedicalRecordsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MedicalRecords []*MedicalRecord       `protobuf:"bytes,1,rep,name=medical_records,json=medicalRecords,proto3" json:"medical_records,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMedicalRecordsResponse) Reset() {
+	*x = ListMedicalRecordsResponse{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMedicalRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalRecordsResponse) ProtoMessage() {}
+
+func (x *ListMedicalRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMedicalRecordsResponse) GetMedicalRecords() []*MedicalRecord {
+	if x != nil {
+		return x.MedicalRecords
+	}
+	return nil
+}
+
+type GetMedicalRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMedicalRecordRequest) Reset() {
+	*x = GetMedicalRecordRequest{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMedicalRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMedicalRecordRequest) ProtoMessage() {}
+
+func (x *GetMedicalRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*GetMedicalRecordRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMedicalRecordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMedicalRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MedicalRecord *MedicalRecord         `protobuf:"bytes,1,opt,name=medical_record,json=medicalRecord,proto3" json:"medical_record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMedicalRecordResponse) Reset() {
+	*x = GetMedicalRecordResponse{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMedicalRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMedicalRecordResponse) ProtoMessage() {}
+
+func (x *GetMedicalRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMedicalRecordResponse.ProtoReflect.Descriptor instead.
+func (*GetMedicalRecordResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMedicalRecordResponse) GetMedicalRecord() *MedicalRecord {
+	if x != nil {
+		return x.MedicalRecord
+	}
+	return nil
+}
+
+type CreateMedicalRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     string                 `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId      string                 `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	RecordType    string                 `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Diagnosis     string                 `protobuf:"bytes,5,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	Notes         string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMedicalRecordRequest) Reset() {
+	*x = CreateMedicalRecordRequest{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMedicalRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMedicalRecordRequest) ProtoMessage() {}
+
+func (x *CreateMedicalRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*CreateMedicalRecordRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateMedicalRecordRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *CreateMedicalRecordRequest) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+func (x *CreateMedicalRecordRequest) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *CreateMedicalRecordRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMedicalRecordRequest) GetDiagnosis() string {
+	if x != nil {
+		return x.Diagnosis
+	}
+	return ""
+}
+
+func (x *CreateMedicalRecordRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *CreateMedicalRecordRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type CreateMedicalRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMedicalRecordResponse) Reset() {
+	*x = CreateMedicalRecordResponse{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMedicalRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMedicalRecordResponse) ProtoMessage() {}
+
+func (x *CreateMedicalRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMedicalRecordResponse.ProtoReflect.Descriptor instead.
+func (*CreateMedicalRecordResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateMedicalRecordResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateMedicalRecordRequest sets the fields of the record; the notes are
+// kept when empty.
+type UpdateMedicalRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecordType    string                 `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Diagnosis     string                 `protobuf:"bytes,4,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	Treatment     string                 `protobuf:"bytes,5,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	Notes         string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMedicalRecordRequest) Reset() {
+	*x = UpdateMedicalRecordRequest{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMedicalRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMedicalRecordRequest) ProtoMessage() {}
+
+func (x *UpdateMedicalRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMedicalRecordRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateMedicalRecordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetDiagnosis() string {
+	if x != nil {
+		return x.Diagnosis
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *UpdateMedicalRecordRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type UpdateMedicalRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMedicalRecordResponse) Reset() {
+	*x = UpdateMedicalRecordResponse{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMedicalRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMedicalRecordResponse) ProtoMessage() {}
+
+func (x *UpdateMedicalRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMedicalRecordResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMedicalRecordResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{8}
+}
+
+type DeleteMedicalRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMedicalRecordRequest) Reset() {
+	*x = DeleteMedicalRecordRequest{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMedicalRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMedicalRecordRequest) ProtoMessage() {}
+
+func (x *DeleteMedicalRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMedicalRecordRequest) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteMedicalRecordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMedicalRecordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMedicalRecordResponse) Reset() {
+	*x = DeleteMedicalRecordResponse{}
+	mi := &file_hms_v1_medical_record_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMedicalRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMedicalRecordResponse) ProtoMessage() {}
+
+func (x *DeleteMedicalRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hms_v1_medical_record_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMedicalRecordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMedicalRecordResponse) Descriptor() ([]byte, []int) {
+	return file_hms_v1_medical_record_proto_rawDescGZIP(), []int{10}
+}
+
+var File_hms_v1_medical_record_proto protoreflect.FileDescriptor
+
+const file_hms_v1_medical_record_proto_rawDesc = "" +
+	"\n" +
+	"\x1bhms/v1/medical_record.proto\x12\x06hms.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x03\n" +
+	"\rMedicalRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\tR\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\tR\bdoctorId\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1f\n" +
+	"\vrecord_type\x18\x05 \x01(\tR\n" +
+	"recordType\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tdiagnosis\x18\a \x01(\tR\tdiagnosis\x12\x1c\n" +
+	"\ttreatment\x18\b \x01(\tR\ttreatment\x12\x14\n" +
+	"\x05notes\x18\t \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\":\n" +
+	"\x19ListMedicalRecordsRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\tR\tpatientId\"\\\n" +
+	"\x1aListMedicalRecordsResponse\x12>\n" +
+	"\x0fmedical_records\x18\x01 \x03(\v2\x15.hms.v1.MedicalRecordR\x0emedicalRecords\")\n" +
+	"\x17GetMedicalRecordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"X\n" +
+	"\x18GetMedicalRecordResponse\x12<\n" +
+	"\x0emedical_record\x18\x01 \x01(\v2\x15.hms.v1.MedicalRecordR\rmedicalRecord\"\xed\x01\n" +
+	"\x1aCreateMedicalRecordRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\tR\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\tR\bdoctorId\x12\x1f\n" +
+	"\vrecord_type\x18\x03 \x01(\tR\n" +
+	"recordType\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tdiagnosis\x18\x05 \x01(\tR\tdiagnosis\x12\x1c\n" +
+	"\ttreatment\x18\x06 \x01(\tR\ttreatment\x12\x14\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\"-\n" +
+	"\x1bCreateMedicalRecordResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc1\x01\n" +
+	"\x1aUpdateMedicalRecordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrecord_type\x18\x02 \x01(\tR\n" +
+	"recordType\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tdiagnosis\x18\x04 \x01(\tR\tdiagnosis\x12\x1c\n" +
+	"\ttreatment\x18\x05 \x01(\tR\ttreatment\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\"\x1d\n" +
+	"\x1bUpdateMedicalRecordResponse\",\n" +
+	"\x1aDeleteMedicalRecordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
+	"\x1bDeleteMedicalRecordResponse2\xea\x03\n" +
+	"\x14MedicalRecordService\x12[\n" +
+	"\x12ListMedicalRecords\x12!.hms.v1.ListMedicalRecordsRequest\x1a\".hms.v1.ListMedicalRecordsResponse\x12U\n" +
+	"\x10GetMedicalRecord\x12\x1f.hms.v1.GetMedicalRecordRequest\x1a .hms.v1.GetMedicalRecordResponse\x12^\n" +
+	"\x13CreateMedicalRecord\x12\".hms.v1.CreateMedicalRecordRequest\x1a#.hms.v1.CreateMedicalRecordResponse\x12^\n" +
+	"\x13UpdateMedicalRecord\x12\".hms.v1.UpdateMedicalRecordRequest\x1a#.hms.v1.UpdateMedicalRecordResponse\x12^\n" +
+	"\x13DeleteMedicalRecord\x12\".hms.v1.DeleteMedicalRecordRequest\x1a#.hms.v1.DeleteMedicalRecordResponseB\x8e\x01\n" +
+	"\n" +
+	"com.hms.v1B\x12MedicalRecordProtoP\x01Z3github.com/ekastn/hms-api/internal/rpc/hms/v1;hmsv1\xa2\x02\x03HXX\xaa\x02\x06Hms.V1\xca\x02\x06Hms\\V1\xe2\x02\x12Hms\\V1\\GPBMetadata\xea\x02\aHms::V1b\x06proto3"
+
+var (
+	file_hms_v1_medical_record_proto_rawDescOnce sync.Once
+	file_hms_v1_medical_record_proto_rawDescData []byte
+)
+
+func file_hms_v1_medical_record_proto_rawDescGZIP() []byte {
+	file_hms_v1_medical_record_proto_rawDescOnce.Do(func() {
+		file_hms_v1_medical_record_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hms_v1_medical_record_proto_rawDesc), len(file_hms_v1_medical_record_proto_rawDesc)))
+	})
+	return file_hms_v1_medical_record_proto_rawDescData
+}
+
+var file_hms_v1_medical_record_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_hms_v1_medical_record_proto_goTypes = []any{
+	(*MedicalRecord)(nil),               // 0: hms.v1.MedicalRecord
+	(*ListMedicalRecordsRequest)(nil),   // 1: hms.v1.ListMedicalRecordsRequest
+	(*ListMedicalRecordsResponse)(nil),  // 2: hms.v1.ListMedicalRecordsResponse
+	(*GetMedicalRecordRequest)(nil),     // 3: hms.v1.GetMedicalRecordRequest
+	(*GetMedicalRecordResponse)(nil),    // 4: hms.v1.GetMedicalRecordResponse
+	(*CreateMedicalRecordRequest)(nil),  // 5: hms.v1.CreateMedicalRecordRequest
+	(*CreateMedicalRecordResponse)(nil), // 6: hms.v1.CreateMedicalRecordResponse
+	(*UpdateMedicalRecordRequest)(nil),  // 7: hms.v1.UpdateMedicalRecordRequest
+	(*UpdateMedicalRecordResponse)(nil), // 8: hms.v1.UpdateMedicalRecordResponse
+	(*DeleteMedicalRecordRequest)(nil),  // 9: hms.v1.DeleteMedicalRecordRequest
+	(*DeleteMedicalRecordResponse)(nil), // 10: hms.v1.DeleteMedicalRecordResponse
+	(*timestamppb.Timestamp)(nil),       // 11: google.protobuf.Timestamp
+}
+var file_hms_v1_medical_record_proto_depIdxs = []int32{
+	11, // 0: hms.v1.MedicalRecord.date:type_name -> google.protobuf.Timestamp
+	11, // 1: hms.v1.MedicalRecord.created_at:type_name -> google.protobuf.Timestamp
+	11, // 2: hms.v1.MedicalRecord.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: hms.v1.ListMedicalRecordsResponse.medical_records:type_name -> hms.v1.MedicalRecord
+	0,  // 4: hms.v1.GetMedicalRecordResponse.medical_record:type_name -> hms.v1.MedicalRecord
+	1,  // 5: hms.v1.MedicalRecordService.ListMedicalRecords:input_type -> hms.v1.ListMedicalRecordsRequest
+	3,  // 6: hms.v1.MedicalRecordService.GetMedicalRecord:input_type -> hms.v1.GetMedicalRecordRequest
+	5,  // 7: hms.v1.MedicalRecordService.CreateMedicalRecord:input_type -> hms.v1.CreateMedicalRecordRequest
+	7,  // 8: hms.v1.MedicalRecordService.UpdateMedicalRecord:input_type -> hms.v1.UpdateMedicalRecordRequest
+	9,  // 9: hms.v1.MedicalRecordService.DeleteMedicalRecord:input_type -> hms.v1.DeleteMedicalRecordRequest
+	2,  // 10: hms.v1.MedicalRecordService.ListMedicalRecords:output_type -> hms.v1.ListMedicalRecordsResponse
+	4,  // 11: hms.v1.MedicalRecordService.GetMedicalRecord:output_type -> hms.v1.GetMedicalRecordResponse
+	6,  // 12: hms.v1.MedicalRecordService.CreateMedicalRecord:output_type -> hms.v1.CreateMedicalRecordResponse
+	8,  // 13: hms.v1.MedicalRecordService.UpdateMedicalRecord:output_type -> hms.v1.UpdateMedicalRecordResponse
+	10, // 14: hms.v1.MedicalRecordService.DeleteMedicalRecord:output_type -> hms.v1.DeleteMedicalRecordResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_hms_v1_medical_record_proto_init() }
+func file_hms_v1_medical_record_proto_init() {
+	if File_hms_v1_medical_record_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hms_v1_medical_record_proto_rawDesc), len(file_hms_v1_medical_record_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hms_v1_medical_record_proto_goTypes,
+		DependencyIndexes: file_hms_v1_medical_record_proto_depIdxs,
+		MessageInfos:      file_hms_v1_medical_record_proto_msgTypes,
+	}.Build()
+	File_hms_v1_medical_record_proto = out.File
+	file_hms_v1_medical_record_proto_goTypes = nil
+	file_hms_v1_medical_record_proto_depIdxs = nil
+}
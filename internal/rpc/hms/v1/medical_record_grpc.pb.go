@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: hms/v1/medical_record.proto
+
+package hmsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MedicalRecordService_ListMedicalRecords_FullMethodName  = "/hms.v1.MedicalRecordService/ListMedicalRecords"
+	MedicalRecordService_GetMedicalRecord_FullMethodName    = "/hms.v1.MedicalRecordService/GetMedicalRecord"
+	MedicalRecordService_CreateMedicalRecord_FullMethodName = "/hms.v1.MedicalRecordService/CreateMedicalRecord"
+	MedicalRecordService_UpdateMedicalRecord_FullMethodName = "/hms.v1.MedicalRecordService/UpdateMedicalRecord"
+	MedicalRecordService_DeleteMedicalRecord_FullMethodName = "/hms.v1.MedicalRecordService/DeleteMedicalRecord"
+)
+
+// MedicalRecordServiceClient is the client API for MedicalRecordService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MedicalRecordService manages medical records. It takes the same fields and
+// enforces the same rules and roles as /api/records.
+type MedicalRecordServiceClient interface {
+	ListMedicalRecords(ctx context.Context, in *ListMedicalRecordsRequest, opts ...grpc.CallOption) (*ListMedicalRecordsResponse, error)
+	GetMedicalRecord(ctx context.Context, in *GetMedicalRecordRequest, opts ...grpc.CallOption) (*GetMedicalRecordResponse, error)
+	CreateMedicalRecord(ctx context.Context, in *CreateMedicalRecordRequest, opts ...grpc.CallOption) (*CreateMedicalRecordResponse, error)
+	UpdateMedicalRecord(ctx context.Context, in *UpdateMedicalRecordRequest, opts ...grpc.CallOption) (*UpdateMedicalRecordResponse, error)
+	// DeleteMedicalRecord soft-deletes a medical record.
+	DeleteMedicalRecord(ctx context.Context, in *DeleteMedicalRecordRequest, opts ...grpc.CallOption) (*DeleteMedicalRecordResponse, error)
+}
+
+type medicalRecordServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMedicalRecordServiceClient(cc grpc.ClientConnInterface) MedicalRecordServiceClient {
+	return &medicalRecordServiceClient{cc}
+}
+
+func (c *medicalRecordServiceClient) ListMedicalRecords(ctx context.Context, in *ListMedicalRecordsRequest, opts ...grpc.CallOption) (*ListMedicalRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMedicalRecordsResponse)
+	err := c.cc.Invoke(ctx, MedicalRecordService_ListMedicalRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *medicalRecordServiceClient) GetMedicalRecord(ctx context.Context, in *GetMedicalRecordRequest, opts ...grpc.CallOption) (*GetMedicalRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMedicalRecordResponse)
+	err := c.cc.Invoke(ctx, MedicalRecordService_GetMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *medicalRecordServiceClient) CreateMedicalRecord(ctx context.Context, in *CreateMedicalRecordRequest, opts ...grpc.CallOption) (*CreateMedicalRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMedicalRecordResponse)
+	err := c.cc.Invoke(ctx, MedicalRecordService_CreateMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *medicalRecordServiceClient) UpdateMedicalRecord(ctx context.Context, in *UpdateMedicalRecordRequest, opts ...grpc.CallOption) (*UpdateMedicalRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMedicalRecordResponse)
+	err := c.cc.Invoke(ctx, MedicalRecordService_UpdateMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *medicalRecordServiceClient) DeleteMedicalRecord(ctx context.Context, in *DeleteMedicalRecordRequest, opts ...grpc.CallOption) (*DeleteMedicalRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMedicalRecordResponse)
+	err := c.cc.Invoke(ctx, MedicalRecordService_DeleteMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MedicalRecordServiceServer is the server API for MedicalRecordService service.
+// All implementations must embed UnimplementedMedicalRecordServiceServer
+// for forward compatibility.
+//
+// MedicalRecordService manages medical records. It takes the same fields and
+// enforces the same rules and roles as /api/records.
+type MedicalRecordServiceServer interface {
+	ListMedicalRecords(context.Context, *ListMedicalRecordsRequest) (*ListMedicalRecordsResponse, error)
+	GetMedicalRecord(context.Context, *GetMedicalRecordRequest) (*GetMedicalRecordResponse, error)
+	CreateMedicalRecord(context.Context, *CreateMedicalRecordRequest) (*CreateMedicalRecordResponse, error)
+	UpdateMedicalRecord(context.Context, *UpdateMedicalRecordRequest) (*UpdateMedicalRecordResponse, error)
+	// DeleteMedicalRecord soft-deletes a medical record.
+	DeleteMedicalRecord(context.Context, *DeleteMedicalRecordRequest) (*DeleteMedicalRecordResponse, error)
+	mustEmbedUnimplementedMedicalRecordServiceServer()
+}
+
+// UnimplementedMedicalRecordServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMedicalRecordServiceServer struct{}
+
+func (UnimplementedMedicalRecordServiceServer) ListMedicalRecords(context.Context, *ListMedicalRecordsRequest) (*ListMedicalRecordsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMedicalRecords not implemented")
+}
+func (UnimplementedMedicalRecordServiceServer) GetMedicalRecord(context.Context, *GetMedicalRecordRequest) (*GetMedicalRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMedicalRecord not implemented")
+}
+func (UnimplementedMedicalRecordServiceServer) CreateMedicalRecord(context.Context, *CreateMedicalRecordRequest) (*CreateMedicalRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMedicalRecord not implemented")
+}
+func (UnimplementedMedicalRecordServiceServer) UpdateMedicalRecord(context.Context, *UpdateMedicalRecordRequest) (*UpdateMedicalRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMedicalRecord not implemented")
+}
+func (UnimplementedMedicalRecordServiceServer) DeleteMedicalRecord(context.Context, *DeleteMedicalRecordRequest) (*DeleteMedicalRecordResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMedicalRecord not implemented")
+}
+func (UnimplementedMedicalRecordServiceServer) mustEmbedUnimplementedMedicalRecordServiceServer() {}
+func (UnimplementedMedicalRecordServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeMedicalRecordServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MedicalRecordServiceServer will
+// result in compilation errors.
+type UnsafeMedicalRecordServiceServer interface {
+	mustEmbedUnimplementedMedicalRecordServiceServer()
+}
+
+func RegisterMedicalRecordServiceServer(s grpc.ServiceRegistrar, srv MedicalRecordServiceServer) {
+	// If the following call panics, it indicates UnimplementedMedicalRecordServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MedicalRecordService_ServiceDesc, srv)
+}
+
+func _MedicalRecordService_ListMedicalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMedicalRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalRecordServiceServer).ListMedicalRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalRecordService_ListMedicalRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalRecordServiceServer).ListMedicalRecords(ctx, req.(*ListMedicalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MedicalRecordService_GetMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalRecordServiceServer).GetMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalRecordService_GetMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalRecordServiceServer).GetMedicalRecord(ctx, req.(*GetMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MedicalRecordService_CreateMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalRecordServiceServer).CreateMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalRecordService_CreateMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalRecordServiceServer).CreateMedicalRecord(ctx, req.(*CreateMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MedicalRecordService_UpdateMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalRecordServiceServer).UpdateMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalRecordService_UpdateMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalRecordServiceServer).UpdateMedicalRecord(ctx, req.(*UpdateMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MedicalRecordService_DeleteMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalRecordServiceServer).DeleteMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalRecordService_DeleteMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalRecordServiceServer).DeleteMedicalRecord(ctx, req.(*DeleteMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MedicalRecordService_ServiceDesc is the grpc.ServiceDesc for MedicalRecordService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MedicalRecordService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hms.v1.MedicalRecordService",
+	HandlerType: (*MedicalRecordServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMedicalRecords",
+			Handler:    _MedicalRecordService_ListMedicalRecords_Handler,
+		},
+		{
+			MethodName: "GetMedicalRecord",
+			Handler:    _MedicalRecordService_GetMedicalRecord_Handler,
+		},
+		{
+			MethodName: "CreateMedicalRecord",
+			Handler:    _MedicalRecordService_CreateMedicalRecord_Handler,
+		},
+		{
+			MethodName: "UpdateMedicalRecord",
+			Handler:    _MedicalRecordService_UpdateMedicalRecord_Handler,
+		},
+		{
+			MethodName: "DeleteMedicalRecord",
+			Handler:    _MedicalRecordService_DeleteMedicalRecord_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hms/v1/medical_record.proto",
+}
@@ -0,0 +1,147 @@
+package rpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	hmsv1 "github.com/ekastn/hms-api/internal/rpc/hms/v1"
+	"github.com/ekastn/hms-api/internal/service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type medicalRecordServer struct {
+	hmsv1.UnimplementedMedicalRecordServiceServer
+	recordService *service.MedicalRecordService
+}
+
+func (s *medicalRecordServer) ListMedicalRecords(ctx context.Context, req *hmsv1.ListMedicalRecordsRequest) (*hmsv1.ListMedicalRecordsResponse, error) {
+	var records []*domain.MedicalRecordEntity
+	var err error
+	if req.PatientId != "" {
+		if err := checkID("patient ID", req.PatientId); err != nil {
+			return nil, err
+		}
+		records, err = s.recordService.GetByPatientID(ctx, req.PatientId)
+	} else {
+		records, err = s.recordService.GetAll(ctx)
+	}
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	res := &hmsv1.ListMedicalRecordsResponse{MedicalRecords: make([]*hmsv1.MedicalRecord, 0, len(records))}
+	for _, r := range records {
+		res.MedicalRecords = append(res.MedicalRecords, medicalRecordMessage(r))
+	}
+	return res, nil
+}
+
+func (s *medicalRecordServer) GetMedicalRecord(ctx context.Context, req *hmsv1.GetMedicalRecordRequest) (*hmsv1.GetMedicalRecordResponse, error) {
+	if err := checkID("record ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	record, err := s.recordService.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	if record == nil {
+		return nil, notFound("Medical record")
+	}
+
+	return &hmsv1.GetMedicalRecordResponse{MedicalRecord: medicalRecordMessage(record)}, nil
+}
+
+func (s *medicalRecordServer) CreateMedicalRecord(ctx context.Context, req *hmsv1.CreateMedicalRecordRequest) (*hmsv1.CreateMedicalRecordResponse, error) {
+	body := domain.CreateMedicalRecordRequest{
+		PatientID:   req.PatientId,
+		DoctorID:    req.DoctorId,
+		RecordType:  req.RecordType,
+		Description: req.Description,
+		Diagnosis:   req.Diagnosis,
+		Treatment:   req.Treatment,
+		Notes:       req.Notes,
+	}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	patientID, _ := primitive.ObjectIDFromHex(body.PatientID) // validated above
+	doctorID, _ := primitive.ObjectIDFromHex(body.DoctorID)
+	record := &domain.MedicalRecordEntity{
+		PatientID:   patientID,
+		DoctorID:    doctorID,
+		Date:        time.Now(),
+		RecordType:  domain.MedicalRecordType(body.RecordType),
+		Description: body.Description,
+		Diagnosis:   body.Diagnosis,
+		Treatment:   body.Treatment,
+		Notes:       body.Notes,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	id, err := s.recordService.Create(ctx, record)
+	if err != nil {
+		log.Printf("Error creating medical record: %v", err)
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.CreateMedicalRecordResponse{Id: id}, nil
+}
+
+func (s *medicalRecordServer) UpdateMedicalRecord(ctx context.Context, req *hmsv1.UpdateMedicalRecordRequest) (*hmsv1.UpdateMedicalRecordResponse, error) {
+	if err := checkID("record ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	body := domain.UpdateMedicalRecordRequest{
+		RecordType:  req.RecordType,
+		Description: req.Description,
+		Diagnosis:   req.Diagnosis,
+		Treatment:   req.Treatment,
+		Notes:       req.Notes,
+	}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	existingRecord, err := s.recordService.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	if existingRecord == nil {
+		return nil, notFound("Medical record")
+	}
+
+	existingRecord.RecordType = domain.MedicalRecordType(body.RecordType)
+	existingRecord.Description = body.Description
+	existingRecord.Diagnosis = body.Diagnosis
+	existingRecord.Treatment = body.Treatment
+	if body.Notes != "" {
+		existingRecord.Notes = body.Notes
+	}
+	existingRecord.UpdatedAt = time.Now()
+
+	if err := s.recordService.Update(ctx, req.Id, existingRecord, callerFrom(ctx).userID); err != nil {
+		log.Printf("Error updating medical record: %v", err)
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.UpdateMedicalRecordResponse{}, nil
+}
+
+func (s *medicalRecordServer) DeleteMedicalRecord(ctx context.Context, req *hmsv1.DeleteMedicalRecordRequest) (*hmsv1.DeleteMedicalRecordResponse, error) {
+	if err := checkID("record ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordService.Delete(ctx, req.Id, callerFrom(ctx).userID); err != nil {
+		log.Printf("Error deleting medical record: %v", err)
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.DeleteMedicalRecordResponse{}, nil
+}
@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	hmsv1 "github.com/ekastn/hms-api/internal/rpc/hms/v1"
+	"github.com/ekastn/hms-api/internal/service"
+)
+
+type patientServer struct {
+	hmsv1.UnimplementedPatientServiceServer
+	patientService *service.PatientService
+}
+
+func (s *patientServer) ListPatients(ctx context.Context, req *hmsv1.ListPatientsRequest) (*hmsv1.ListPatientsResponse, error) {
+	patients, err := s.patientService.GetAll(ctx)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	res := &hmsv1.ListPatientsResponse{Patients: make([]*hmsv1.Patient, 0, len(patients))}
+	for _, p := range patients {
+		res.Patients = append(res.Patients, patientMessage(p))
+	}
+	return res, nil
+}
+
+func (s *patientServer) GetPatient(ctx context.Context, req *hmsv1.GetPatientRequest) (*hmsv1.GetPatientResponse, error) {
+	if err := checkID("patient ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	patient, err := s.patientService.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	if patient == nil {
+		return nil, notFound("Patient")
+	}
+
+	return &hmsv1.GetPatientResponse{Patient: patientMessage(patient)}, nil
+}
+
+func (s *patientServer) CreatePatient(ctx context.Context, req *hmsv1.CreatePatientRequest) (*hmsv1.CreatePatientResponse, error) {
+	body := domain.CreatePatientRequest{
+		Name:    req.Name,
+		Age:     int(req.Age),
+		Gender:  req.Gender,
+		Phone:   req.Phone,
+		Email:   req.Email,
+		Address: req.Address,
+	}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	patientEntity := domain.PatientEntity{
+		Name:      body.Name,
+		Age:       body.Age,
+		Gender:    body.Gender,
+		Phone:     body.Phone,
+		Email:     body.Email,
+		Address:   body.Address,
+		LastVisit: time.Now(),
+	}
+
+	id, err := s.patientService.Create(ctx, &patientEntity)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.CreatePatientResponse{Id: id}, nil
+}
+
+func (s *patientServer) UpdatePatient(ctx context.Context, req *hmsv1.UpdatePatientRequest) (*hmsv1.UpdatePatientResponse, error) {
+	if err := checkID("patient ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	body := domain.UpdatePatientRequest{
+		Name:    req.Name,
+		Age:     int(req.Age),
+		Gender:  req.Gender,
+		Phone:   req.Phone,
+		Email:   req.Email,
+		Address: req.Address,
+	}
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	patientEntity := domain.PatientEntity{
+		Name:    body.Name,
+		Age:     body.Age,
+		Gender:  body.Gender,
+		Phone:   body.Phone,
+		Email:   body.Email,
+		Address: body.Address,
+	}
+
+	if err := s.patientService.Update(ctx, req.Id, &patientEntity, callerFrom(ctx).userID); err != nil {
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.UpdatePatientResponse{}, nil
+}
+
+func (s *patientServer) DeletePatient(ctx context.Context, req *hmsv1.DeletePatientRequest) (*hmsv1.DeletePatientResponse, error) {
+	if err := checkID("patient ID", req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.patientService.Delete(ctx, req.Id, callerFrom(ctx).userID); err != nil {
+		return nil, serviceError(err)
+	}
+
+	return &hmsv1.DeletePatientResponse{}, nil
+}
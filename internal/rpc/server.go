@@ -0,0 +1,83 @@
+// Package rpc serves the patient, doctor, appointment and medical record
+// operations over gRPC for internal services. The messages and services are
+// defined in proto/hms/v1; run `buf generate` after changing them.
+package rpc
+
+import (
+	"context"
+	"log"
+	"net"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/events"
+	hmsv1 "github.com/ekastn/hms-api/internal/rpc/hms/v1"
+	"github.com/ekastn/hms-api/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// keepaliveInterval pings idle connections, such as one only watching
+// appointments, so they stay open through proxies and dead clients are
+// noticed.
+const keepaliveInterval = time.Minute
+
+type Settings struct {
+	// Addr is the address to listen on; the gRPC API is disabled when it is
+	// empty.
+	Addr      string
+	JWTSecret string
+}
+
+type Server struct {
+	settings Settings
+	server   *grpc.Server
+}
+
+func NewServer(
+	settings Settings,
+	patientService *service.PatientService,
+	docService *service.DoctorService,
+	appointmentService *service.AppointmentService,
+	recordService *service.MedicalRecordService,
+	eventBus *events.Bus,
+) *Server {
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(unaryAuth(settings.JWTSecret)),
+		grpc.StreamInterceptor(streamAuth(settings.JWTSecret)),
+		grpc.KeepaliveParams(keepalive.ServerParameters{Time: keepaliveInterval}),
+	)
+
+	hmsv1.RegisterPatientServiceServer(server, &patientServer{patientService: patientService})
+	hmsv1.RegisterDoctorServiceServer(server, &doctorServer{docService: docService})
+	hmsv1.RegisterAppointmentServiceServer(server, &appointmentServer{
+		appointmentService: appointmentService,
+		eventBus:           eventBus,
+	})
+	hmsv1.RegisterMedicalRecordServiceServer(server, &medicalRecordServer{recordService: recordService})
+
+	return &Server{settings: settings, server: server}
+}
+
+// Listen serves on the configured address until ctx is cancelled, then
+// finishes the calls in flight. It returns at once when no address is
+// configured.
+func (s *Server) Listen(ctx context.Context) {
+	if s.settings.Addr == "" {
+		return
+	}
+
+	ln, err := net.Listen("tcp", s.settings.Addr)
+	if err != nil {
+		log.Printf("gRPC server stopped: %v", err)
+		return
+	}
+
+	// Appointment feeds end when the event bus closes on the same signal.
+	stop := context.AfterFunc(ctx, s.server.GracefulStop)
+	defer stop()
+
+	log.Printf("gRPC server on %s", s.settings.Addr)
+	if err := s.server.Serve(ln); err != nil {
+		log.Printf("gRPC server stopped: %v", err)
+	}
+}
@@ -0,0 +1,287 @@
+// Package satusehat submits encounters and diagnoses to SATUSEHAT, the
+// Indonesian Ministry of Health's national health data platform, which takes
+// FHIR R4 resources and authenticates facilities with OAuth 2.0 client
+// credentials.
+package satusehat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// tokenMargin is how long before it expires a token is renewed, so a
+	// request does not race its expiry.
+	tokenMargin = time.Minute
+
+	maxErrorBody = 64 << 10
+)
+
+// Client submits resources to SATUSEHAT. The service depends on the
+// interface rather than HTTPClient so it can be pointed at a stub.
+type Client interface {
+	// Create creates a resource and returns the ID SATUSEHAT assigned to it.
+	Create(ctx context.Context, resourceType string, resource any) (string, error)
+	// Update replaces the resource with the ID.
+	Update(ctx context.Context, resourceType, id string, resource any) error
+}
+
+// Config configures an HTTPClient.
+type Config struct {
+	// AuthURL is the OAuth base URL, e.g.
+	// https://api-satusehat-stg.dto.kemkes.go.id/oauth2/v1.
+	AuthURL string
+	// BaseURL is the FHIR base URL, e.g.
+	// https://api-satusehat-stg.dto.kemkes.go.id/fhir-r4/v1.
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+	Timeout      time.Duration
+}
+
+// Error is an error response from SATUSEHAT, or a failure to get one.
+type Error struct {
+	// StatusCode is 0 when the request did not get a response. It is a 2xx
+	// code when SATUSEHAT accepted the request but its response could not be
+	// read.
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.StatusCode == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("SATUSEHAT responded %d: %s", e.StatusCode, e.Message)
+}
+
+// Temporary reports whether the request may succeed when it is retried as
+// is: it got no response, hit a server error or was rate limited. Other
+// errors, like a rejected resource, need the data to be fixed first. An
+// accepted request with an unreadable response is not temporary either:
+// the resource was probably created, and creating it again would make a
+// duplicate.
+func (e *Error) Temporary() bool {
+	switch {
+	case e.StatusCode == 0,
+		e.StatusCode >= 500,
+		e.StatusCode == http.StatusTooManyRequests,
+		e.StatusCode == http.StatusRequestTimeout,
+		e.StatusCode == http.StatusUnauthorized:
+		return true
+	}
+	return false
+}
+
+// IsTemporary reports whether err is a temporary SATUSEHAT error. Errors
+// that are not SATUSEHAT errors are treated as temporary.
+func IsTemporary(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
+	}
+	return true
+}
+
+// HTTPClient is the Client for the SATUSEHAT API. It fetches an access
+// token with the client credentials and reuses it until shortly before it
+// expires; when a request is answered 401 anyway, the token is renewed and
+// the request sent once more.
+type HTTPClient struct {
+	cfg  Config
+	http *http.Client
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewHTTPClient(cfg Config) *HTTPClient {
+	cfg.AuthURL = strings.TrimRight(cfg.AuthURL, "/")
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	return &HTTPClient{
+		cfg:  cfg,
+		http: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+func (c *HTTPClient) Create(ctx context.Context, resourceType string, resource any) (string, error) {
+	status, body, err := c.do(ctx, http.MethodPost, c.cfg.BaseURL+"/"+resourceType, resource)
+	if err != nil {
+		if status != 0 {
+			return "", &Error{StatusCode: status, Message: fmt.Sprintf("%s may have been created: %v", resourceType, err)}
+		}
+		return "", err
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return "", &Error{StatusCode: status, Message: fmt.Sprintf("invalid %s response, it may have been created: %v", resourceType, err)}
+	}
+	if created.ID == "" {
+		return "", &Error{StatusCode: status, Message: fmt.Sprintf("%s response has no id, it may have been created", resourceType)}
+	}
+	return created.ID, nil
+}
+
+func (c *HTTPClient) Update(ctx context.Context, resourceType, id string, resource any) error {
+	_, _, err := c.do(ctx, http.MethodPut, c.cfg.BaseURL+"/"+resourceType+"/"+url.PathEscape(id), resource)
+	return err
+}
+
+// do sends the resource and returns the status code and body of a 2xx
+// response. The status code is also returned when the body of a 2xx
+// response could not be read.
+func (c *HTTPClient) do(ctx context.Context, method, target string, resource any) (int, []byte, error) {
+	payload, err := json.Marshal(resource)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to encode resource: %w", err)
+	}
+
+	for retried := false; ; retried = true {
+		token, err := c.accessToken(ctx, retried)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(payload))
+		if err != nil {
+			return 0, nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		res, err := c.http.Do(req)
+		if err != nil {
+			return 0, nil, &Error{Message: err.Error()}
+		}
+		body, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+		res.Body.Close()
+		if err != nil {
+			status := 0
+			if res.StatusCode >= 200 && res.StatusCode <= 299 {
+				status = res.StatusCode
+			}
+			return status, nil, &Error{Message: fmt.Sprintf("failed to read response: %v", err)}
+		}
+
+		if res.StatusCode == http.StatusUnauthorized && !retried {
+			continue
+		}
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return 0, nil, &Error{StatusCode: res.StatusCode, Message: outcomeMessage(body)}
+		}
+		return res.StatusCode, body, nil
+	}
+}
+
+// accessToken returns the cached token, or fetches a new one when there is
+// none, it is about to expire or renew is set.
+func (c *HTTPClient) accessToken(ctx context.Context, renew bool) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !renew && c.token != "" && time.Now().Before(c.expiresAt) {
+		return c.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("client_id", c.cfg.ClientID)
+	form.Set("client_secret", c.cfg.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.AuthURL+"/accesstoken?grant_type=client_credentials", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return "", &Error{Message: fmt.Sprintf("failed to get access token: %v", err)}
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+	if err != nil {
+		return "", &Error{Message: fmt.Sprintf("failed to read access token: %v", err)}
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", &Error{StatusCode: res.StatusCode, Message: "access token: " + outcomeMessage(body)}
+	}
+
+	// expires_in is a string of seconds in SATUSEHAT's responses, so it is
+	// read raw and accepts a number too.
+	var token struct {
+		AccessToken string          `json:"access_token"`
+		ExpiresIn   json.RawMessage `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil || token.AccessToken == "" {
+		return "", &Error{StatusCode: res.StatusCode, Message: "invalid access token response"}
+	}
+	seconds, err := strconv.Atoi(strings.Trim(string(token.ExpiresIn), `"`))
+	if err != nil {
+		seconds = 0
+	}
+
+	c.token = token.AccessToken
+	c.expiresAt = time.Now().Add(time.Duration(seconds)*time.Second - tokenMargin)
+	return c.token, nil
+}
+
+// outcomeMessage reads the diagnostics of an OperationOutcome, falling back
+// to the body itself.
+func outcomeMessage(body []byte) string {
+	var outcome struct {
+		Issue []struct {
+			Code        string `json:"code"`
+			Diagnostics string `json:"diagnostics"`
+			Details     struct {
+				Text string `json:"text"`
+			} `json:"details"`
+		} `json:"issue"`
+		Fault struct {
+			FaultString string `json:"faultstring"`
+		} `json:"fault"`
+	}
+	if err := json.Unmarshal(body, &outcome); err == nil {
+		var messages []string
+		for _, issue := range outcome.Issue {
+			switch {
+			case issue.Diagnostics != "":
+				messages = append(messages, issue.Diagnostics)
+			case issue.Details.Text != "":
+				messages = append(messages, issue.Details.Text)
+			case issue.Code != "":
+				messages = append(messages, issue.Code)
+			}
+		}
+		if len(messages) > 0 {
+			return strings.Join(messages, "; ")
+		}
+		if outcome.Fault.FaultString != "" {
+			return outcome.Fault.FaultString
+		}
+	}
+
+	text := strings.TrimSpace(string(body))
+	if len(text) > 500 {
+		text = text[:500] + "..."
+	}
+	if text == "" {
+		return "empty response"
+	}
+	return text
+}
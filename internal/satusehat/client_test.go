@@ -0,0 +1,270 @@
+package satusehat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeAPI is a SATUSEHAT stand-in serving the OAuth and FHIR endpoints.
+// Tokens are issued as token-1, token-2, ...; fhir answers the FHIR
+// requests made with a token.
+type fakeAPI struct {
+	mu        sync.Mutex
+	expiresIn string
+	tokens    int
+	requests  []*http.Request
+	fhir      func(w http.ResponseWriter, r *http.Request, token string)
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	if strings.HasPrefix(r.URL.Path, "/oauth2/v1/accesstoken") {
+		if r.URL.Query().Get("grant_type") != "client_credentials" || r.FormValue("client_id") != "id" || r.FormValue("client_secret") != "secret" {
+			f.mu.Unlock()
+			http.Error(w, `{"fault":{"faultstring":"Invalid client credentials"}}`, http.StatusUnauthorized)
+			return
+		}
+		f.tokens++
+		token := "token-" + strconv.Itoa(f.tokens)
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]string{"access_token": token, "expires_in": f.expiresIn})
+		return
+	}
+	f.requests = append(f.requests, r)
+	f.mu.Unlock()
+	f.fhir(w, r, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+}
+
+func newFakeAPI(t *testing.T, fhir func(w http.ResponseWriter, r *http.Request, token string)) (*fakeAPI, *HTTPClient) {
+	t.Helper()
+	api := &fakeAPI{expiresIn: "3599", fhir: fhir}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	client := NewHTTPClient(Config{
+		AuthURL:      server.URL + "/oauth2/v1/",
+		BaseURL:      server.URL + "/fhir-r4/v1/",
+		ClientID:     "id",
+		ClientSecret: "secret",
+	})
+	return api, client
+}
+
+func created(id string) func(w http.ResponseWriter, r *http.Request, token string) {
+	return func(w http.ResponseWriter, r *http.Request, token string) {
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"resourceType":"Encounter","id":"`+id+`"}`)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var body map[string]any
+	api, client := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request, token string) {
+		if r.Method != http.MethodPost || r.URL.Path != "/fhir-r4/v1/Encounter" {
+			t.Errorf("request = %s %s", r.Method, r.URL.Path)
+		}
+		if token != "token-1" || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("token = %q, content type = %q", token, r.Header.Get("Content-Type"))
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		created("enc-1")(w, r, token)
+	})
+
+	id, err := client.Create(context.Background(), "Encounter", map[string]string{"resourceType": "Encounter"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "enc-1" || body["resourceType"] != "Encounter" {
+		t.Errorf("id = %q, body = %v", id, body)
+	}
+	if api.tokens != 1 {
+		t.Errorf("tokens = %d, want 1", api.tokens)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	_, client := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request, token string) {
+		if r.Method != http.MethodPut || r.URL.EscapedPath() != "/fhir-r4/v1/Condition/a%2Fb" {
+			t.Errorf("request = %s %s", r.Method, r.URL.EscapedPath())
+		}
+		io.WriteString(w, `{"resourceType":"Condition","id":"a/b"}`)
+	})
+
+	if err := client.Update(context.Background(), "Condition", "a/b", map[string]string{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTokenIsCached(t *testing.T) {
+	api, client := newFakeAPI(t, created("enc-1"))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Create(context.Background(), "Encounter", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if api.tokens != 1 {
+		t.Errorf("tokens = %d, want the first one reused", api.tokens)
+	}
+}
+
+func TestTokenRenewedBeforeExpiry(t *testing.T) {
+	api, client := newFakeAPI(t, created("enc-1"))
+	// A token that expires within the renewal margin is renewed every time.
+	api.expiresIn = "30"
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Create(context.Background(), "Encounter", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if api.tokens != 2 {
+		t.Errorf("tokens = %d, want 2", api.tokens)
+	}
+}
+
+func TestTokenRenewedOn401(t *testing.T) {
+	api, client := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request, token string) {
+		if token == "token-1" {
+			http.Error(w, `{"fault":{"faultstring":"Access Token expired"}}`, http.StatusUnauthorized)
+			return
+		}
+		created("enc-1")(w, r, token)
+	})
+
+	id, err := client.Create(context.Background(), "Encounter", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "enc-1" || api.tokens != 2 || len(api.requests) != 2 {
+		t.Errorf("id = %q, tokens = %d, requests = %d", id, api.tokens, len(api.requests))
+	}
+
+	// The renewed token is kept.
+	if _, err := client.Create(context.Background(), "Encounter", nil); err != nil {
+		t.Fatal(err)
+	}
+	if api.tokens != 2 {
+		t.Errorf("tokens = %d, want the renewed one reused", api.tokens)
+	}
+}
+
+func TestRepeated401IsReturned(t *testing.T) {
+	api, client := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request, token string) {
+		http.Error(w, `{"fault":{"faultstring":"Invalid access token"}}`, http.StatusUnauthorized)
+	})
+
+	_, err := client.Create(context.Background(), "Encounter", nil)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized || apiErr.Message != "Invalid access token" {
+		t.Fatalf("error = %v", err)
+	}
+	if len(api.requests) != 2 {
+		t.Errorf("requests = %d, want one retry", len(api.requests))
+	}
+}
+
+func TestBadCredentials(t *testing.T) {
+	_, client := newFakeAPI(t, created("enc-1"))
+	client.cfg.ClientSecret = "wrong"
+
+	_, err := client.Create(context.Background(), "Encounter", nil)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized || !strings.Contains(apiErr.Message, "Invalid client credentials") {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func TestRejectedResource(t *testing.T) {
+	_, client := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request, token string) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"resourceType":"OperationOutcome","issue":[{"code":"required","diagnostics":"subject is required"},{"code":"value","details":{"text":"invalid period"}}]}`)
+	})
+
+	_, err := client.Create(context.Background(), "Encounter", nil)
+	if err == nil || err.Error() != "SATUSEHAT responded 400: subject is required; invalid period" {
+		t.Errorf("error = %v", err)
+	}
+	if IsTemporary(err) {
+		t.Error("a rejected resource should not be temporary")
+	}
+}
+
+// A create SATUSEHAT accepted but whose response cannot be read must not be
+// retried, or the resource would be created twice.
+func TestCreateWithUnreadableResponseIsPermanent(t *testing.T) {
+	tests := map[string]string{
+		"invalid JSON": `<html>OK</html>`,
+		"no id":        `{"resourceType":"Encounter"}`,
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			api, client := newFakeAPI(t, func(w http.ResponseWriter, r *http.Request, token string) {
+				w.WriteHeader(http.StatusCreated)
+				io.WriteString(w, body)
+			})
+
+			_, err := client.Create(context.Background(), "Encounter", nil)
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusCreated {
+				t.Fatalf("error = %v", err)
+			}
+			if IsTemporary(err) {
+				t.Errorf("error %v is temporary", err)
+			}
+			if len(api.requests) != 1 {
+				t.Errorf("requests = %d, want 1", len(api.requests))
+			}
+		})
+	}
+}
+
+func TestTemporary(t *testing.T) {
+	tests := []struct {
+		status int
+		want   bool
+	}{
+		{0, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusTooManyRequests, true},
+		{http.StatusRequestTimeout, true},
+		{http.StatusUnauthorized, true},
+		{http.StatusCreated, false},
+		{http.StatusBadRequest, false},
+		{http.StatusForbidden, false},
+		{http.StatusNotFound, false},
+		{http.StatusConflict, false},
+		{http.StatusUnprocessableEntity, false},
+	}
+	for _, tt := range tests {
+		if got := (&Error{StatusCode: tt.status}).Temporary(); got != tt.want {
+			t.Errorf("Temporary() for %d = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+
+	if !IsTemporary(errors.New("connection reset")) {
+		t.Error("errors other than *Error should be temporary")
+	}
+}
+
+func TestUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	client := NewHTTPClient(Config{AuthURL: server.URL, BaseURL: server.URL, ClientID: "id", ClientSecret: "secret"})
+
+	_, err := client.Create(context.Background(), "Encounter", nil)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 0 || !apiErr.Temporary() {
+		t.Errorf("error = %v, want a temporary error without a status", err)
+	}
+}
@@ -0,0 +1,165 @@
+package satusehat
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/fhir"
+)
+
+// The types below are the Encounter and Condition profiles SATUSEHAT takes,
+// which need elements the facade's resources in package fhir leave out.
+
+const (
+	terminologyBase         = "http://terminology.hl7.org/CodeSystem/"
+	actCodeSystem           = terminologyBase + "v3-ActCode"
+	participationTypeSystem = terminologyBase + "v3-ParticipationType"
+	conditionClinicalSystem = terminologyBase + "condition-clinical"
+	conditionVerifySystem   = terminologyBase + "condition-ver-status"
+	conditionCategorySystem = terminologyBase + "condition-category"
+	icd10System             = "http://hl7.org/fhir/sid/icd-10"
+	identifierBase          = "http://sys-ids.kemkes.go.id/"
+)
+
+type StatusHistory struct {
+	Status string      `json:"status"`
+	Period fhir.Period `json:"period"`
+}
+
+type EncounterLocation struct {
+	Location fhir.Reference `json:"location"`
+}
+
+type Encounter struct {
+	ResourceType    string                      `json:"resourceType"`
+	ID              string                      `json:"id,omitempty"`
+	Identifier      []fhir.Identifier           `json:"identifier,omitempty"`
+	Status          string                      `json:"status"`
+	Class           fhir.Coding                 `json:"class"`
+	Subject         fhir.Reference              `json:"subject"`
+	Participant     []fhir.EncounterParticipant `json:"participant"`
+	Period          fhir.Period                 `json:"period"`
+	Location        []EncounterLocation         `json:"location"`
+	StatusHistory   []StatusHistory             `json:"statusHistory"`
+	ServiceProvider fhir.Reference              `json:"serviceProvider"`
+}
+
+type Condition struct {
+	ResourceType       string                 `json:"resourceType"`
+	ID                 string                 `json:"id,omitempty"`
+	ClinicalStatus     *fhir.CodeableConcept  `json:"clinicalStatus,omitempty"`
+	VerificationStatus *fhir.CodeableConcept  `json:"verificationStatus,omitempty"`
+	Category           []fhir.CodeableConcept `json:"category"`
+	Code               fhir.CodeableConcept   `json:"code"`
+	Subject            fhir.Reference         `json:"subject"`
+	Encounter          fhir.Reference         `json:"encounter"`
+	RecordedDate       string                 `json:"recordedDate,omitempty"`
+}
+
+// Ref is a resource registered in SATUSEHAT, e.g. a patient's IHS number.
+type Ref struct {
+	ID      string
+	Display string
+}
+
+// Mapper maps completed appointments to Encounters and the diagnoses of
+// medical records to Conditions. Patients, practitioners and locations are
+// referenced by their SATUSEHAT IDs, which the caller looks up.
+type Mapper struct {
+	organizationID string
+}
+
+func NewMapper(organizationID string) *Mapper {
+	return &Mapper{organizationID: organizationID}
+}
+
+// Encounter maps a completed appointment as a finished visit that took the
+// appointment's slot.
+func (m *Mapper) Encounter(a *domain.AppointmentEntity, patient, practitioner, location Ref) *Encounter {
+	start := a.DateTime
+	end := start.Add(time.Duration(a.Duration) * time.Minute)
+
+	class := fhir.Coding{System: actCodeSystem, Code: "AMB", Display: "ambulatory"}
+	if a.Type == domain.AppointmentTypeEmergency {
+		class = fhir.Coding{System: actCodeSystem, Code: "EMER", Display: "emergency"}
+	}
+
+	return &Encounter{
+		ResourceType: fhir.TypeEncounter,
+		Identifier: []fhir.Identifier{{
+			System: identifierBase + "encounter/" + m.organizationID,
+			Value:  a.ID.Hex(),
+		}},
+		Status:  "finished",
+		Class:   class,
+		Subject: fhir.Reference{Reference: "Patient/" + patient.ID, Display: patient.Display},
+		Participant: []fhir.EncounterParticipant{{
+			Type: []fhir.CodeableConcept{{Coding: []fhir.Coding{
+				{System: participationTypeSystem, Code: "ATND", Display: "attender"},
+			}}},
+			Individual: &fhir.Reference{Reference: "Practitioner/" + practitioner.ID, Display: practitioner.Display},
+		}},
+		Period: fhir.Period{Start: formatTime(start), End: formatTime(end)},
+		Location: []EncounterLocation{{
+			Location: fhir.Reference{Reference: "Location/" + location.ID, Display: location.Display},
+		}},
+		StatusHistory: []StatusHistory{
+			{Status: "arrived", Period: fhir.Period{Start: formatTime(start), End: formatTime(start)}},
+			{Status: "in-progress", Period: fhir.Period{Start: formatTime(start), End: formatTime(end)}},
+			{Status: "finished", Period: fhir.Period{Start: formatTime(end), End: formatTime(end)}},
+		},
+		ServiceProvider: fhir.Reference{Reference: "Organization/" + m.organizationID},
+	}
+}
+
+// Condition maps the diagnosis of a medical record, made in the encounter
+// with the SATUSEHAT ID. A diagnosis that starts with an ICD-10 code, e.g.
+// "J10.1 Influenza", is coded; any other is sent as text only.
+func (m *Mapper) Condition(r *domain.MedicalRecordEntity, patient Ref, encounterID string) *Condition {
+	code := fhir.CodeableConcept{Text: strings.TrimSpace(r.Diagnosis)}
+	if icd, display := ICD10(r.Diagnosis); icd != "" {
+		code.Coding = []fhir.Coding{{System: icd10System, Code: icd, Display: display}}
+	}
+
+	return &Condition{
+		ResourceType: fhir.TypeCondition,
+		ClinicalStatus: &fhir.CodeableConcept{Coding: []fhir.Coding{
+			{System: conditionClinicalSystem, Code: "active", Display: "Active"},
+		}},
+		Category: []fhir.CodeableConcept{{Coding: []fhir.Coding{
+			{System: conditionCategorySystem, Code: "encounter-diagnosis", Display: "Encounter Diagnosis"},
+		}}},
+		Code:         code,
+		Subject:      fhir.Reference{Reference: "Patient/" + patient.ID, Display: patient.Display},
+		Encounter:    fhir.Reference{Reference: "Encounter/" + encounterID},
+		RecordedDate: formatTime(r.Date),
+	}
+}
+
+// EnteredInError marks a condition whose medical record was deleted. FHIR
+// forbids a clinical status on such a condition.
+func (c *Condition) EnteredInError() {
+	c.ClinicalStatus = nil
+	c.VerificationStatus = &fhir.CodeableConcept{Coding: []fhir.Coding{
+		{System: conditionVerifySystem, Code: "entered-in-error", Display: "Entered in Error"},
+	}}
+}
+
+var icd10Pattern = regexp.MustCompile(`^([A-Z][0-9][0-9AB](?:\.[0-9A-Z]{1,4})?)(?:\s*[-:]\s*|\s+|$)`)
+
+// ICD10 splits a diagnosis that starts with an ICD-10 code into the code and
+// the rest of the text. It returns empty strings when there is no code.
+func ICD10(diagnosis string) (code, display string) {
+	diagnosis = strings.TrimSpace(diagnosis)
+	match := icd10Pattern.FindStringSubmatchIndex(strings.ToUpper(diagnosis))
+	if match == nil {
+		return "", ""
+	}
+	return strings.ToUpper(diagnosis[match[2]:match[3]]), strings.TrimSpace(diagnosis[match[1]:])
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
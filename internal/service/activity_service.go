@@ -2,29 +2,70 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/ekastn/hms-api/internal/domain"
 	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type ActivityService struct {
 	activityRepo *repository.ActivityRepository
+	outboxRepo   *repository.OutboxRepository
 }
 
-func NewActivityService(activityRepo *repository.ActivityRepository) *ActivityService {
-	return &ActivityService{activityRepo}
+// NewActivityService creates the activity service. Activities and events are
+// written to the outbox and delivered by the OutboxDispatcher.
+func NewActivityService(activityRepo *repository.ActivityRepository, outboxRepo *repository.OutboxRepository) *ActivityService {
+	return &ActivityService{activityRepo, outboxRepo}
 }
 
+// CreateActivity records an activity feed entry. Pass the session context when
+// called inside a transaction so the entry is only created if it commits.
 func (s *ActivityService) CreateActivity(ctx context.Context, activityType domain.ActivityType, title, description string) error {
 	activity := &domain.ActivityEntity{
+		ID:          primitive.NewObjectID(),
 		Type:        activityType,
 		Title:       title,
 		Description: description,
 		Timestamp:   time.Now(),
 	}
 
-	return s.activityRepo.Create(ctx, activity)
+	return s.writeOutbox(ctx, domain.EventActivityCreated, activityType, activity.ID.Hex(), activity.ToDTO(), activity)
+}
+
+// PublishEvent records an entity change for the event stream and webhooks.
+// Like CreateActivity it must be given the session context of the change.
+func (s *ActivityService) PublishEvent(ctx context.Context, eventType domain.EventType, topic domain.ActivityType, entityID string, data interface{}) error {
+	return s.writeOutbox(ctx, eventType, topic, entityID, data, nil)
+}
+
+func (s *ActivityService) writeOutbox(ctx context.Context, eventType domain.EventType, topic domain.ActivityType, entityID string, data interface{}, activity *domain.ActivityEntity) error {
+	var payload string
+	if data != nil {
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode event data: %w", err)
+		}
+		payload = string(encoded)
+	}
+
+	now := time.Now()
+	msg := &domain.OutboxMessageEntity{
+		ID:            primitive.NewObjectID(),
+		Type:          eventType,
+		Topic:         topic,
+		EntityID:      entityID,
+		Payload:       payload,
+		Activity:      activity,
+		OccurredAt:    now,
+		Status:        domain.OutboxStatusPending,
+		NextAttemptAt: now,
+	}
+
+	return s.outboxRepo.Create(ctx, msg)
 }
 
 // GetAllActivities retrieves all activities.
@@ -36,13 +77,23 @@ func (s *ActivityService) GetAllActivities(ctx context.Context) ([]*domain.Activ
 
 	var activityDTOs []*domain.Activity
 	for _, activity := range activities {
-		activityDTOs = append(activityDTOs, &domain.Activity{
-			ID:          activity.ID.Hex(),
-			Type:        string(activity.Type),
-			Title:       activity.Title,
-			Description: activity.Description,
-			Timestamp:   activity.Timestamp,
-		})
+		dto := activity.ToDTO()
+		activityDTOs = append(activityDTOs, &dto)
+	}
+	return activityDTOs, nil
+}
+
+// GetRecentActivities returns up to limit activities, latest first.
+func (s *ActivityService) GetRecentActivities(ctx context.Context, limit int) ([]*domain.Activity, error) {
+	activities, err := s.activityRepo.GetRecent(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	activityDTOs := make([]*domain.Activity, 0, len(activities))
+	for _, activity := range activities {
+		dto := activity.ToDTO()
+		activityDTOs = append(activityDTOs, &dto)
 	}
 	return activityDTOs, nil
 }
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AdmissionService implements the admission, transfer and discharge (ADT) workflow.
+type AdmissionService struct {
+	admissionRepo   *repository.AdmissionRepository
+	bedRepo         *repository.BedRepository
+	patientRepo     *repository.PatientRepository
+	doctorRepo      *repository.DoctorRepository
+	recordRepo      *repository.MedicalRecordRepository
+	activityService *ActivityService
+	mongoClient     *mongo.Client
+}
+
+func NewAdmissionService(
+	admissionRepo *repository.AdmissionRepository,
+	bedRepo *repository.BedRepository,
+	patientRepo *repository.PatientRepository,
+	doctorRepo *repository.DoctorRepository,
+	recordRepo *repository.MedicalRecordRepository,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+) *AdmissionService {
+	return &AdmissionService{
+		admissionRepo:   admissionRepo,
+		bedRepo:         bedRepo,
+		patientRepo:     patientRepo,
+		doctorRepo:      doctorRepo,
+		recordRepo:      recordRepo,
+		activityService: activityService,
+		mongoClient:     mongoClient,
+	}
+}
+
+func (s *AdmissionService) GetAll(ctx context.Context, status domain.AdmissionStatus) ([]*domain.AdmissionEntity, error) {
+	return s.admissionRepo.GetAll(ctx, status)
+}
+
+func (s *AdmissionService) GetByID(ctx context.Context, id string) (*domain.AdmissionEntity, error) {
+	admissionID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	admission, err := s.admissionRepo.GetByID(ctx, admissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admission: %w", err)
+	}
+
+	return admission, nil
+}
+
+// Admit admits a patient into an available bed.
+func (s *AdmissionService) Admit(ctx context.Context, req *domain.AdmitPatientRequest, creatorID primitive.ObjectID) (string, error) {
+	patientID, err := primitive.ObjectIDFromHex(req.PatientID)
+	if err != nil {
+		return "", fmt.Errorf("invalid patient ID format: %w", err)
+	}
+	doctorID, err := primitive.ObjectIDFromHex(req.DoctorID)
+	if err != nil {
+		return "", fmt.Errorf("invalid doctor ID format: %w", err)
+	}
+	bedID, err := primitive.ObjectIDFromHex(req.BedID)
+	if err != nil {
+		return "", fmt.Errorf("invalid bed ID format: %w", err)
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, patientID)
+	if err != nil || patient == nil {
+		return "", errors.New("patient not found")
+	}
+	doctor, err := s.doctorRepo.GetByID(ctx, doctorID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get doctor: %w", err)
+	}
+	if doctor == nil {
+		return "", errors.New("doctor not found")
+	}
+
+	var newAdmissionID string
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		active, err := s.admissionRepo.GetActiveByPatientID(sessionContext, patientID)
+		if err != nil {
+			return fmt.Errorf("failed to check active admissions: %w", err)
+		}
+		if active != nil {
+			return errors.New("patient is already admitted")
+		}
+
+		bed, err := s.bedRepo.GetByID(sessionContext, bedID)
+		if err != nil {
+			return fmt.Errorf("failed to get bed: %w", err)
+		}
+		if bed == nil {
+			return errors.New("bed not found")
+		}
+
+		now := time.Now()
+		admission := &domain.AdmissionEntity{
+			PatientID:  patientID,
+			DoctorID:   doctorID,
+			WardID:     bed.WardID,
+			BedID:      bed.ID,
+			Status:     domain.AdmissionStatusAdmitted,
+			Reason:     req.Reason,
+			AdmittedAt: now,
+			CreatedBy:  creatorID,
+			UpdatedBy:  creatorID,
+		}
+
+		id, err := s.admissionRepo.Create(sessionContext, admission)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return errors.New("patient is already admitted")
+			}
+			return fmt.Errorf("failed to create admission: %w", err)
+		}
+
+		ok, err := s.bedRepo.Occupy(sessionContext, bed.ID, id, creatorID)
+		if err != nil {
+			return fmt.Errorf("failed to occupy bed: %w", err)
+		}
+		if !ok {
+			return errors.New("bed is not available")
+		}
+		newAdmissionID = id.Hex()
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAdmission, "Patient Admitted", fmt.Sprintf("Patient %s has been admitted to bed %s under Dr. %s.", patient.Name, bed.Label, doctor.Name))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for admission: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return newAdmissionID, nil
+}
+
+// Transfer moves an admitted patient to another available bed.
+func (s *AdmissionService) Transfer(ctx context.Context, id string, req *domain.TransferPatientRequest, updaterID primitive.ObjectID) error {
+	admissionID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+	toBedID, err := primitive.ObjectIDFromHex(req.BedID)
+	if err != nil {
+		return fmt.Errorf("invalid bed ID format: %w", err)
+	}
+
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		admission, err := s.admissionRepo.GetByID(sessionContext, admissionID)
+		if err != nil {
+			return fmt.Errorf("failed to get admission: %w", err)
+		}
+		if admission == nil {
+			return errors.New("admission not found")
+		}
+		if admission.Status != domain.AdmissionStatusAdmitted {
+			return errors.New("only admitted patients can be transferred")
+		}
+		if admission.BedID == toBedID {
+			return errors.New("patient is already in this bed")
+		}
+
+		toBed, err := s.bedRepo.GetByID(sessionContext, toBedID)
+		if err != nil {
+			return fmt.Errorf("failed to get bed: %w", err)
+		}
+		if toBed == nil {
+			return errors.New("bed not found")
+		}
+
+		ok, err := s.bedRepo.Occupy(sessionContext, toBed.ID, admission.ID, updaterID)
+		if err != nil {
+			return fmt.Errorf("failed to occupy bed: %w", err)
+		}
+		if !ok {
+			return errors.New("bed is not available")
+		}
+		if err := s.bedRepo.Release(sessionContext, admission.BedID, admission.ID, updaterID); err != nil {
+			return fmt.Errorf("failed to release bed: %w", err)
+		}
+
+		admission.Transfers = append(admission.Transfers, domain.BedTransfer{
+			FromBedID:     admission.BedID,
+			ToBedID:       toBed.ID,
+			Reason:        req.Reason,
+			TransferredBy: updaterID,
+			TransferredAt: time.Now(),
+		})
+		admission.BedID = toBed.ID
+		admission.WardID = toBed.WardID
+		admission.UpdatedBy = updaterID
+
+		if err := s.admissionRepo.Update(sessionContext, admission.ID, admission); err != nil {
+			return fmt.Errorf("failed to update admission: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAdmission, "Patient Transferred", fmt.Sprintf("Admission %s has been transferred to bed %s.", id, toBed.Label))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for transfer: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Discharge ends an admission, frees the bed and stores a discharge summary
+// generated from the medical records written during the stay.
+func (s *AdmissionService) Discharge(ctx context.Context, id string, req *domain.DischargePatientRequest, updaterID primitive.ObjectID) (*domain.DischargeSummary, error) {
+	admissionID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	var summary *domain.DischargeSummary
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		admission, err := s.admissionRepo.GetByID(sessionContext, admissionID)
+		if err != nil {
+			return fmt.Errorf("failed to get admission: %w", err)
+		}
+		if admission == nil {
+			return errors.New("admission not found")
+		}
+		if admission.Status != domain.AdmissionStatusAdmitted {
+			return errors.New("patient is already discharged")
+		}
+
+		now := time.Now()
+		records, err := s.recordRepo.GetByPatientAndDateRange(sessionContext, admission.PatientID, admission.AdmittedAt, now)
+		if err != nil {
+			return fmt.Errorf("failed to get medical records for the stay: %w", err)
+		}
+
+		summary = buildDischargeSummary(admission, records, now, req)
+
+		if err := s.bedRepo.Release(sessionContext, admission.BedID, admission.ID, updaterID); err != nil {
+			return fmt.Errorf("failed to release bed: %w", err)
+		}
+
+		admission.Status = domain.AdmissionStatusDischarged
+		admission.DischargedAt = &now
+		admission.DischargeSummary = summary
+		admission.UpdatedBy = updaterID
+
+		if err := s.admissionRepo.Update(sessionContext, admission.ID, admission); err != nil {
+			return fmt.Errorf("failed to update admission: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAdmission, "Patient Discharged", fmt.Sprintf("Admission %s has been discharged (%s) after %d day(s).", id, req.Disposition, summary.LengthOfStay))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for discharge: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func buildDischargeSummary(admission *domain.AdmissionEntity, records []*domain.MedicalRecordEntity, dischargedAt time.Time, req *domain.DischargePatientRequest) *domain.DischargeSummary {
+	summary := &domain.DischargeSummary{
+		AdmittedAt:      admission.AdmittedAt,
+		DischargedAt:    dischargedAt,
+		LengthOfStay:    int(math.Max(1, math.Ceil(dischargedAt.Sub(admission.AdmittedAt).Hours()/24))),
+		AdmissionReason: admission.Reason,
+		Diagnoses:       []string{},
+		Treatments:      []string{},
+		Records:         []domain.MedicalRecordDTO{},
+		Disposition:     req.Disposition,
+		Instructions:    req.Instructions,
+	}
+
+	seenDiagnoses := make(map[string]bool)
+	seenTreatments := make(map[string]bool)
+	for _, record := range records {
+		if record.Diagnosis != "" && !seenDiagnoses[record.Diagnosis] {
+			seenDiagnoses[record.Diagnosis] = true
+			summary.Diagnoses = append(summary.Diagnoses, record.Diagnosis)
+		}
+		if record.Treatment != "" && !seenTreatments[record.Treatment] {
+			seenTreatments[record.Treatment] = true
+			summary.Treatments = append(summary.Treatments, record.Treatment)
+		}
+		summary.Records = append(summary.Records, record.ToDTO())
+	}
+
+	return summary
+}
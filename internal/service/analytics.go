@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// defaultAnalyticsDays is how far back analytics look without a from date.
+	defaultAnalyticsDays = 30
+	// maxAnalyticsDays caps the range of a single analytics query.
+	maxAnalyticsDays = 731
+	// defaultTopDiagnoses is how many diagnoses are listed without a limit.
+	defaultTopDiagnoses = 10
+	maxTopDiagnoses     = 100
+)
+
+// AnalyticsService builds time series and rankings for management reporting
+// from aggregation pipelines over appointments and medical records.
+type AnalyticsService struct {
+	apptRepo   *repository.AppointmentRepository
+	recordRepo *repository.MedicalRecordRepository
+	docRepo    *repository.DoctorRepository
+	location   *time.Location
+}
+
+func NewAnalyticsService(
+	apptRepo *repository.AppointmentRepository,
+	recordRepo *repository.MedicalRecordRepository,
+	docRepo *repository.DoctorRepository,
+	location *time.Location,
+) *AnalyticsService {
+	return &AnalyticsService{
+		apptRepo:   apptRepo,
+		recordRepo: recordRepo,
+		docRepo:    docRepo,
+		location:   location,
+	}
+}
+
+// AnalyticsQuery holds the parameters shared by the analytics endpoints.
+// Dates are YYYY-MM-DD in the time zone, both inclusive; the time zone
+// defaults to the hospital's and the interval to day.
+type AnalyticsQuery struct {
+	From         string
+	To           string
+	Timezone     string
+	Interval     domain.AnalyticsInterval
+	DepartmentID string
+}
+
+// GetAppointmentTrend counts appointments per period, optionally broken down
+// by status, type, doctor or specialty.
+func (s *AnalyticsService) GetAppointmentTrend(ctx context.Context, q AnalyticsQuery, groupBy domain.AppointmentGroupBy) (*domain.AppointmentTrendResponse, error) {
+	if !groupBy.IsValid() {
+		return nil, fmt.Errorf("invalid groupBy: %s", groupBy)
+	}
+
+	r, start, end, err := s.resolve(q, true)
+	if err != nil {
+		return nil, err
+	}
+	departmentFilter, err := optionalObjectID(q.DepartmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.apptRepo.CountByPeriod(ctx, start, end, r.Interval, r.Timezone, groupBy, departmentFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count appointments: %w", err)
+	}
+
+	return &domain.AppointmentTrendResponse{AnalyticsRange: r, GroupBy: groupBy, Points: points}, nil
+}
+
+// GetAppointmentRates returns no-show and cancellation rates per period and
+// over the whole range.
+func (s *AnalyticsService) GetAppointmentRates(ctx context.Context, q AnalyticsQuery) (*domain.AppointmentRatesResponse, error) {
+	r, start, end, err := s.resolve(q, true)
+	if err != nil {
+		return nil, err
+	}
+	departmentFilter, err := optionalObjectID(q.DepartmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.apptRepo.RatesByPeriod(ctx, start, end, time.Now(), r.Interval, r.Timezone, departmentFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count appointment outcomes: %w", err)
+	}
+
+	overall := domain.AppointmentRatePoint{Period: r.From + "/" + r.To}
+	for i := range points {
+		points[i].Rates()
+		overall.Total += points[i].Total
+		overall.Completed += points[i].Completed
+		overall.Cancelled += points[i].Cancelled
+		overall.NoShow += points[i].NoShow
+	}
+	overall.Rates()
+
+	return &domain.AppointmentRatesResponse{AnalyticsRange: r, Overall: overall, Points: points}, nil
+}
+
+// GetPatientMix counts new and returning patients per period.
+func (s *AnalyticsService) GetPatientMix(ctx context.Context, q AnalyticsQuery) (*domain.PatientMixResponse, error) {
+	r, start, end, err := s.resolve(q, true)
+	if err != nil {
+		return nil, err
+	}
+	departmentFilter, err := optionalObjectID(q.DepartmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.apptRepo.PatientMixByPeriod(ctx, start, end, r.Interval, r.Timezone, departmentFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count patients: %w", err)
+	}
+
+	return &domain.PatientMixResponse{AnalyticsRange: r, Points: points}, nil
+}
+
+// GetTopDiagnoses returns the diagnoses recorded most often in the range.
+func (s *AnalyticsService) GetTopDiagnoses(ctx context.Context, q AnalyticsQuery, limit int) (*domain.TopDiagnosesResponse, error) {
+	if limit <= 0 {
+		limit = defaultTopDiagnoses
+	}
+	if limit > maxTopDiagnoses {
+		limit = maxTopDiagnoses
+	}
+
+	r, start, end, err := s.resolve(q, false)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnoses, err := s.recordRepo.TopDiagnoses(ctx, start, end, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank diagnoses: %w", err)
+	}
+
+	return &domain.TopDiagnosesResponse{AnalyticsRange: r, Diagnoses: diagnoses}, nil
+}
+
+// GetDoctorUtilization ranks the active doctors by how much of their weekly
+// availability was booked over the range, with their appointment outcomes,
+// booking lead time and the medical records they wrote.
+func (s *AnalyticsService) GetDoctorUtilization(ctx context.Context, q AnalyticsQuery) (*domain.DoctorUtilizationReport, error) {
+	r, start, end, err := s.resolve(q, false)
+	if err != nil {
+		return nil, err
+	}
+	departmentFilter, err := optionalObjectID(q.DepartmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	doctors, err := s.docRepo.GetAll(ctx, departmentFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get doctors: %w", err)
+	}
+	stats, err := s.apptRepo.UtilizationByDoctor(ctx, start, end, time.Now(), departmentFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum up appointments: %w", err)
+	}
+	records, err := s.recordRepo.CountByDoctor(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count medical records: %w", err)
+	}
+
+	byDoctor := make(map[primitive.ObjectID]domain.DoctorUtilization, len(stats))
+	for _, stat := range stats {
+		byDoctor[stat.DoctorID] = stat
+	}
+
+	rows := make([]domain.DoctorUtilization, 0, len(doctors))
+	for _, doctor := range doctors {
+		row := byDoctor[doctor.ID]
+		row.DoctorID = doctor.ID
+		row.DoctorName = doctor.Name
+		row.Specialty = doctor.Specialty
+		row.AvailableMinutes = availableMinutes(doctor.Availability, start, end)
+		if row.AvailableMinutes > 0 {
+			row.Utilization = float64(row.BookedMinutes) / float64(row.AvailableMinutes)
+		}
+		row.RecordsAuthored = records[doctor.ID]
+		rows = append(rows, row)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Utilization != rows[j].Utilization {
+			return rows[i].Utilization > rows[j].Utilization
+		}
+		if rows[i].BookedMinutes != rows[j].BookedMinutes {
+			return rows[i].BookedMinutes > rows[j].BookedMinutes
+		}
+		return rows[i].DoctorName < rows[j].DoctorName
+	})
+	for i := range rows {
+		rows[i].Rank = i + 1
+	}
+
+	return &domain.DoctorUtilizationReport{AnalyticsRange: r, Doctors: rows}, nil
+}
+
+// ExportAppointmentTrend returns the appointment trend as a table, one row per
+// period and group.
+func (s *AnalyticsService) ExportAppointmentTrend(ctx context.Context, q AnalyticsQuery, groupBy domain.AppointmentGroupBy) (*Export, error) {
+	trend, err := s.GetAppointmentTrend(ctx, q, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(trend.Points))
+	for _, p := range trend.Points {
+		rows = append(rows, []string{p.Period, p.Group, p.Label, strconv.FormatInt(p.Count, 10)})
+	}
+	return NewTableExport("appointment-trend", []string{"period", "group", "label", "count"}, rows), nil
+}
+
+// ExportAppointmentRates returns the appointment rates as a table, one row per
+// period followed by the overall row.
+func (s *AnalyticsService) ExportAppointmentRates(ctx context.Context, q AnalyticsQuery) (*Export, error) {
+	rates, err := s.GetAppointmentRates(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	row := func(period string, p domain.AppointmentRatePoint) []string {
+		return []string{
+			period,
+			strconv.FormatInt(p.Total, 10),
+			strconv.FormatInt(p.Completed, 10),
+			strconv.FormatInt(p.Cancelled, 10),
+			strconv.FormatInt(p.NoShow, 10),
+			strconv.FormatFloat(p.CancellationRate, 'f', 4, 64),
+			strconv.FormatFloat(p.NoShowRate, 'f', 4, 64),
+		}
+	}
+
+	rows := make([][]string, 0, len(rates.Points)+1)
+	for _, p := range rates.Points {
+		rows = append(rows, row(p.Period, p))
+	}
+	rows = append(rows, row("overall", rates.Overall))
+
+	header := []string{"period", "total", "completed", "cancelled", "no_show", "cancellation_rate", "no_show_rate"}
+	return NewTableExport("appointment-rates", header, rows), nil
+}
+
+// ExportPatientMix returns the new and returning patients as a table, one row
+// per period.
+func (s *AnalyticsService) ExportPatientMix(ctx context.Context, q AnalyticsQuery) (*Export, error) {
+	mix, err := s.GetPatientMix(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(mix.Points))
+	for _, p := range mix.Points {
+		rows = append(rows, []string{p.Period, strconv.FormatInt(p.New, 10), strconv.FormatInt(p.Returning, 10)})
+	}
+	return NewTableExport("patient-mix", []string{"period", "new", "returning"}, rows), nil
+}
+
+// ExportTopDiagnoses returns the top diagnoses as a table in rank order.
+func (s *AnalyticsService) ExportTopDiagnoses(ctx context.Context, q AnalyticsQuery, limit int) (*Export, error) {
+	top, err := s.GetTopDiagnoses(ctx, q, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(top.Diagnoses))
+	for _, d := range top.Diagnoses {
+		rows = append(rows, []string{d.Diagnosis, strconv.FormatInt(d.Count, 10), strconv.FormatInt(d.Patients, 10)})
+	}
+	return NewTableExport("top-diagnoses", []string{"diagnosis", "count", "patients"}, rows), nil
+}
+
+// doctorUtilizationExportHeader lists the columns of the utilization export.
+var doctorUtilizationExportHeader = []string{
+	"rank", "doctor_id", "doctor_name", "specialty", "available_minutes",
+	"booked_minutes", "utilization", "appointments", "completed", "cancelled",
+	"no_show", "avg_lead_time_hours", "records_authored",
+}
+
+// ExportDoctorUtilization returns the utilization report as a table, one row
+// per doctor in rank order.
+func (s *AnalyticsService) ExportDoctorUtilization(ctx context.Context, q AnalyticsQuery) (*Export, error) {
+	report, err := s.GetDoctorUtilization(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(report.Doctors))
+	for _, row := range report.Doctors {
+		rows = append(rows, []string{
+			strconv.Itoa(row.Rank),
+			row.DoctorID.Hex(),
+			row.DoctorName,
+			row.Specialty,
+			strconv.FormatInt(row.AvailableMinutes, 10),
+			strconv.FormatInt(row.BookedMinutes, 10),
+			strconv.FormatFloat(row.Utilization, 'f', 4, 64),
+			strconv.FormatInt(row.Appointments, 10),
+			strconv.FormatInt(row.Completed, 10),
+			strconv.FormatInt(row.Cancelled, 10),
+			strconv.FormatInt(row.NoShow, 10),
+			strconv.FormatFloat(row.AvgLeadTimeHours, 'f', 1, 64),
+			strconv.FormatInt(row.RecordsAuthored, 10),
+		})
+	}
+	return NewTableExport("doctor-utilization", doctorUtilizationExportHeader, rows), nil
+}
+
+// availableMinutes adds up the weekly time slots over the days from start up
+// to end, both midnight in the time zone the slots' clock times are read in.
+func availableMinutes(slots []domain.TimeSlot, start, end time.Time) int64 {
+	var minutes int64
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		for _, slot := range slots {
+			if slot.DayOfWeek != int(day.Weekday()) {
+				continue
+			}
+			from := slot.StartTime.In(start.Location())
+			until := slot.EndTime.In(start.Location())
+			length := time.Duration(until.Hour()-from.Hour())*time.Hour + time.Duration(until.Minute()-from.Minute())*time.Minute
+			if length <= 0 {
+				length += 24 * time.Hour
+			}
+			minutes += int64(length / time.Minute)
+		}
+	}
+	return minutes
+}
+
+// resolve validates the query and returns the range it covers along with the
+// [start, end) instants bounding it, which are in the query's time zone.
+func (s *AnalyticsService) resolve(q AnalyticsQuery, series bool) (domain.AnalyticsRange, time.Time, time.Time, error) {
+	var r domain.AnalyticsRange
+
+	location := s.location
+	if q.Timezone != "" {
+		loc, err := time.LoadLocation(q.Timezone)
+		if err != nil {
+			return r, time.Time{}, time.Time{}, fmt.Errorf("invalid timezone: %w", err)
+		}
+		location = loc
+	}
+	r.Timezone = location.String()
+
+	if series {
+		r.Interval = q.Interval
+		if r.Interval == "" {
+			r.Interval = domain.AnalyticsIntervalDay
+		}
+		if !r.Interval.IsValid() {
+			return r, time.Time{}, time.Time{}, fmt.Errorf("invalid interval: %s", q.Interval)
+		}
+	}
+
+	today := time.Now().In(location)
+	last := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, location)
+	if q.To != "" {
+		parsed, err := time.ParseInLocation(domain.AnalyticsDateFormat, q.To, location)
+		if err != nil {
+			return r, time.Time{}, time.Time{}, fmt.Errorf("invalid to date: %w", err)
+		}
+		last = parsed
+	}
+	first := last.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	if q.From != "" {
+		parsed, err := time.ParseInLocation(domain.AnalyticsDateFormat, q.From, location)
+		if err != nil {
+			return r, time.Time{}, time.Time{}, fmt.Errorf("invalid from date: %w", err)
+		}
+		first = parsed
+	}
+
+	if last.Before(first) {
+		return r, time.Time{}, time.Time{}, errors.New("to date is before from date")
+	}
+	if last.Sub(first) > maxAnalyticsDays*24*time.Hour {
+		return r, time.Time{}, time.Time{}, fmt.Errorf("date range is longer than %d days", maxAnalyticsDays)
+	}
+
+	r.From = first.Format(domain.AnalyticsDateFormat)
+	r.To = last.Format(domain.AnalyticsDateFormat)
+	return r, first, last.AddDate(0, 0, 1), nil
+}
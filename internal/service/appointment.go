@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/ekastn/hms-api/internal/domain"
@@ -17,7 +16,11 @@ type AppointmentService struct {
 	appRepo         *repository.AppointmentRepository
 	patientRepo     *repository.PatientRepository
 	recordRepo      *repository.MedicalRecordRepository
+	doctorRepo      *repository.DoctorRepository
 	activityService *ActivityService
+	billingService  *BillingService
+	facilityService *FacilityService
+	rosterService   *RosterService
 	mongoClient     *mongo.Client
 }
 
@@ -25,20 +28,34 @@ func NewAppointmentService(
 	repo *repository.AppointmentRepository,
 	patientRepo *repository.PatientRepository,
 	recordRepo *repository.MedicalRecordRepository,
+	doctorRepo *repository.DoctorRepository,
 	activityService *ActivityService,
+	billingService *BillingService,
+	facilityService *FacilityService,
+	rosterService *RosterService,
 	mongoClient *mongo.Client,
 ) *AppointmentService {
 	return &AppointmentService{
 		appRepo:         repo,
 		patientRepo:     patientRepo,
 		recordRepo:      recordRepo,
+		doctorRepo:      doctorRepo,
 		activityService: activityService,
+		billingService:  billingService,
+		facilityService: facilityService,
+		rosterService:   rosterService,
 		mongoClient:     mongoClient,
 	}
 }
 
-func (s *AppointmentService) GetAll(ctx context.Context) ([]*domain.AppointmentEntity, error) {
-	return s.appRepo.GetAll(ctx)
+// GetAll returns the appointments, optionally only those of a department.
+func (s *AppointmentService) GetAll(ctx context.Context, departmentID string) ([]*domain.AppointmentEntity, error) {
+	departmentFilter, err := optionalObjectID(departmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.appRepo.GetAll(ctx, departmentFilter)
 }
 
 func (s *AppointmentService) GetByID(ctx context.Context, id string) (*domain.AppointmentEntity, error) {
@@ -55,6 +72,21 @@ func (s *AppointmentService) GetByID(ctx context.Context, id string) (*domain.Ap
 	return appointment, nil
 }
 
+// GetByPatientIDs returns the appointments of each of the given patients,
+// latest first.
+func (s *AppointmentService) GetByPatientIDs(ctx context.Context, patientIDs []primitive.ObjectID) (map[primitive.ObjectID][]*domain.AppointmentEntity, error) {
+	appointments, err := s.appRepo.GetByPatientIDs(ctx, patientIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointments: %w", err)
+	}
+
+	byPatient := make(map[primitive.ObjectID][]*domain.AppointmentEntity)
+	for _, appointment := range appointments {
+		byPatient[appointment.PatientID] = append(byPatient[appointment.PatientID], appointment)
+	}
+	return byPatient, nil
+}
+
 func (s *AppointmentService) GetAppointmentDetail(ctx context.Context, id string) (*domain.AppointmentDetailResponse, error) {
 	// Get the appointment
 	appointment, err := s.GetByID(ctx, id)
@@ -92,20 +124,9 @@ func (s *AppointmentService) GetAppointmentDetail(ctx context.Context, id string
 }
 
 func (s *AppointmentService) Create(ctx context.Context, req *domain.CreateAppointmentRequest, creatorID primitive.ObjectID) (string, error) {
-	// Start a session for transaction
-	session, err := s.mongoClient.StartSession()
-	if err != nil {
-		return "", fmt.Errorf("failed to start session: %w", err)
-	}
-	defer session.EndSession(ctx)
-
 	var newAppointmentID string
 
-	err = mongo.WithSession(ctx, session, func(sessionContext mongo.SessionContext) error {
-		if err = session.StartTransaction(); err != nil {
-			return err
-		}
-
+	err := withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
 		patientID, err := primitive.ObjectIDFromHex(req.PatientID)
 		if err != nil {
 			return fmt.Errorf("invalid patient ID format: %w", err)
@@ -114,6 +135,10 @@ func (s *AppointmentService) Create(ctx context.Context, req *domain.CreateAppoi
 		if err != nil {
 			return fmt.Errorf("invalid doctor ID format: %w", err)
 		}
+		roomID, err := optionalObjectID(req.RoomID, "room")
+		if err != nil {
+			return err
+		}
 
 		appointment := domain.AppointmentEntity{
 			PatientID:      patientID,
@@ -127,6 +152,10 @@ func (s *AppointmentService) Create(ctx context.Context, req *domain.CreateAppoi
 			Status:         domain.AppointmentStatusScheduled, // Default status for new appointments
 		}
 
+		if err := s.checkAvailability(sessionContext, &appointment); err != nil {
+			return err
+		}
+
 		// Check for existing appointment at the same time
 		existing, err := s.appRepo.GetByDoctorAndDateRange(
 			sessionContext,
@@ -146,32 +175,66 @@ func (s *AppointmentService) Create(ctx context.Context, req *domain.CreateAppoi
 			}
 		}
 
-		if len(activeAppointments) > 0 {
+		// Emergencies may take the slot with a recorded reason; the
+		// appointments in the way are moved back
+		override := len(activeAppointments) > 0 && appointment.Type == domain.AppointmentTypeEmergency && req.OverrideReason != ""
+		if len(activeAppointments) > 0 && !override {
+			if appointment.Type == domain.AppointmentTypeEmergency {
+				return errors.New("doctor is not available at the requested time; give an overrideReason to book the emergency anyway")
+			}
 			return errors.New("doctor is not available at the requested time")
 		}
 
+		if err := s.assignRoom(sessionContext, &appointment, roomID); err != nil {
+			return err
+		}
+
 		// Set audit fields
 		appointment.CreatedBy = creatorID
 		appointment.UpdatedBy = creatorID
 
+		// The ID is needed up front to point the displaced appointments at it
+		appointment.ID = primitive.NewObjectID()
+		if override {
+			displaced, err := s.makeRoom(sessionContext, &appointment, activeAppointments, req.OverrideReason, creatorID)
+			if err != nil {
+				return err
+			}
+			appointment.ConflictOverride = &domain.ConflictOverride{
+				Reason:    req.OverrideReason,
+				Displaced: displaced,
+				By:        creatorID,
+				At:        time.Now(),
+			}
+
+			err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAppointment, "Emergency Override", fmt.Sprintf("Emergency appointment %s for patient %s overrode the schedule of doctor %s, moving %d appointment(s). Reason: %s", appointment.ID.Hex(), req.PatientID, req.DoctorID, len(displaced), req.OverrideReason))
+			if err != nil {
+				return fmt.Errorf("failed to log activity for emergency override: %w", err)
+			}
+		}
+
 		// Create the appointment
 		id, err := s.appRepo.Create(sessionContext, &appointment)
 		if err != nil {
 			return fmt.Errorf("failed to create appointment: %w", err)
 		}
+		appointment.ID = id
 		newAppointmentID = id.Hex()
 
-		// Log activity
+		// Log activity and publish the event with the appointment, so they
+		// only go out if it is committed
 		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAppointment, "New Appointment Scheduled", fmt.Sprintf("Appointment for patient %s with doctor %s on %s has been scheduled.", req.PatientID, req.DoctorID, req.DateTime.Format(time.RFC3339)))
 		if err != nil {
-			// Log the error but don't block the appointment creation
-			fmt.Printf("Warning: failed to log activity for new appointment: %v\n", err)
+			return fmt.Errorf("failed to log activity for new appointment: %w", err)
+		}
+		err = s.activityService.PublishEvent(sessionContext, domain.EventAppointmentCreated, domain.ActivityTypeAppointment, newAppointmentID, appointment.ToDTO())
+		if err != nil {
+			return fmt.Errorf("failed to publish appointment event: %w", err)
 		}
 
-		return session.CommitTransaction(sessionContext)
+		return nil
 	})
 	if err != nil {
-		session.AbortTransaction(ctx)
 		return "", err
 	}
 
@@ -184,18 +247,7 @@ func (s *AppointmentService) Update(ctx context.Context, id string, req *domain.
 		return fmt.Errorf("invalid ID format: %w", err)
 	}
 
-	// Start a session for transaction
-	session, err := s.mongoClient.StartSession()
-	if err != nil {
-		return fmt.Errorf("failed to start session: %w", err)
-	}
-	defer session.EndSession(ctx)
-
-	err = mongo.WithSession(ctx, session, func(sessionContext mongo.SessionContext) error {
-		if err = session.StartTransaction(); err != nil {
-			return err
-		}
-
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
 		// Get existing appointment to preserve created_at and other fields
 		existingAppointment, err := s.appRepo.GetByID(sessionContext, appointmentID)
 		if err != nil {
@@ -206,6 +258,17 @@ func (s *AppointmentService) Update(ctx context.Context, id string, req *domain.
 			return errors.New("appointment not found")
 		}
 
+		previousStatus := existingAppointment.Status
+
+		roomID := existingAppointment.RoomID
+		if req.RoomID != nil {
+			id, err := primitive.ObjectIDFromHex(*req.RoomID)
+			if err != nil {
+				return fmt.Errorf("invalid room ID format: %w", err)
+			}
+			roomID = &id
+		}
+
 		// Apply updates from request to existing appointment
 		updatedFields := req.ApplyUpdates(existingAppointment)
 
@@ -249,35 +312,106 @@ func (s *AppointmentService) Update(ctx context.Context, id string, req *domain.
 			if len(activeAppointments) > 0 {
 				return errors.New("doctor is not available at the requested time")
 			}
+
+			if req.DateTime != nil || req.Duration != nil {
+				if err := s.checkAvailability(sessionContext, existingAppointment); err != nil {
+					return err
+				}
+			}
+
+			// Re-book the room when it or the slot changes
+			if req.RoomID != nil || (roomID != nil && (req.DateTime != nil || req.Duration != nil)) {
+				if err := s.assignRoom(sessionContext, existingAppointment, roomID); err != nil {
+					return err
+				}
+				existingAppointment.UpdatedAt = time.Now()
+				existingAppointment.UpdatedBy = updaterID
+			}
 		}
 
 		if err := s.appRepo.Update(sessionContext, appointmentID, existingAppointment); err != nil {
 			return fmt.Errorf("failed to update appointment: %w", err)
 		}
 
+		if err := s.invoiceIfCompleted(sessionContext, previousStatus, existingAppointment, updaterID); err != nil {
+			return err
+		}
+
 		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAppointment, "Appointment Updated", fmt.Sprintf("Appointment %s has been updated. New status: %s.", id, existingAppointment.Status))
 		if err != nil {
-			// Log the error but don't block the appointment update
-			fmt.Printf("Warning: failed to log activity for appointment update: %v\n", err)
+			return fmt.Errorf("failed to log activity for appointment update: %w", err)
+		}
+		if err := s.publishChange(sessionContext, domain.EventAppointmentUpdated, existingAppointment); err != nil {
+			return err
+		}
+		if previousStatus != existingAppointment.Status {
+			if err := s.publishChange(sessionContext, domain.EventAppointmentStatusChanged, existingAppointment); err != nil {
+				return err
+			}
 		}
 
-		return session.CommitTransaction(sessionContext)
+		return nil
 	})
+}
+
+func (s *AppointmentService) Delete(ctx context.Context, id string, updaterID primitive.ObjectID) error {
+	appointmentID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		session.AbortTransaction(ctx)
-		return err
+		return fmt.Errorf("invalid ID format: %w", err)
 	}
 
-	return nil
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		// Check if appointment exists
+		existingAppointment, err := s.appRepo.GetByID(sessionContext, appointmentID)
+		if err != nil {
+			return fmt.Errorf("failed to get appointment: %w", err)
+		}
+
+		if existingAppointment == nil {
+			return errors.New("appointment not found")
+		}
+
+		// Prevent deletion of completed appointments
+		if existingAppointment.Status == domain.AppointmentStatusCompleted {
+			return errors.New("cannot delete a completed appointment")
+		}
+
+		// Instead of deleting, we'll mark it as cancelled
+		existingAppointment.Status = domain.AppointmentStatusCancelled
+		existingAppointment.UpdatedBy = updaterID
+		err = s.appRepo.Update(sessionContext, appointmentID, existingAppointment)
+		if err != nil {
+			return fmt.Errorf("failed to cancel appointment: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAppointment, "Appointment Cancelled", fmt.Sprintf("Appointment %s has been cancelled.", id))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for appointment cancellation: %w", err)
+		}
+		if err := s.publishChange(sessionContext, domain.EventAppointmentCancelled, existingAppointment); err != nil {
+			return err
+		}
+
+		return nil
+	})
 }
 
-func (s *AppointmentService) Delete(ctx context.Context, id string, updaterID primitive.ObjectID) error {
+// UpdateStatus updates the status of an appointment.
+func (s *AppointmentService) UpdateStatus(ctx context.Context, id string, status domain.AppointmentStatus, updaterID primitive.ObjectID) error {
 	appointmentID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return fmt.Errorf("invalid ID format: %w", err)
 	}
 
-	// Check if appointment exists
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		return s.updateStatus(sessionContext, appointmentID, status, updaterID)
+	})
+}
+
+// updateStatus changes the status of an appointment within the caller's
+// transaction.
+func (s *AppointmentService) updateStatus(ctx context.Context, appointmentID primitive.ObjectID, status domain.AppointmentStatus, updaterID primitive.ObjectID) error {
+	// Get existing appointment
 	existingAppointment, err := s.appRepo.GetByID(ctx, appointmentID)
 	if err != nil {
 		return fmt.Errorf("failed to get appointment: %w", err)
@@ -287,77 +421,173 @@ func (s *AppointmentService) Delete(ctx context.Context, id string, updaterID pr
 		return errors.New("appointment not found")
 	}
 
-	// Prevent deletion of completed appointments
-	if existingAppointment.Status == domain.AppointmentStatusCompleted {
-		return errors.New("cannot delete a completed appointment")
-	}
+	previousStatus := existingAppointment.Status
 
-	// Instead of deleting, we'll mark it as cancelled
-	existingAppointment.Status = domain.AppointmentStatusCancelled
+	// Update only the status
+	existingAppointment.Status = status
+	existingAppointment.UpdatedAt = time.Now()
 	existingAppointment.UpdatedBy = updaterID
-	err = s.appRepo.Update(ctx, appointmentID, existingAppointment)
-	if err != nil {
-		return fmt.Errorf("failed to cancel appointment: %w", err)
+
+	if err := s.appRepo.Update(ctx, appointmentID, existingAppointment); err != nil {
+		return fmt.Errorf("failed to update appointment status: %w", err)
 	}
 
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeAppointment, "Appointment Cancelled", fmt.Sprintf("Appointment %s has been cancelled.", id))
+	if err := s.invoiceIfCompleted(ctx, previousStatus, existingAppointment, updaterID); err != nil {
+		return err
+	}
+
+	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeAppointment, "Appointment Status Updated", fmt.Sprintf("Appointment %s status changed to %s.", appointmentID.Hex(), status))
 	if err != nil {
-		// Log the error but don't block the appointment cancellation
-		log.Printf("Warning: failed to log activity for appointment cancellation: %v", err)
+		return fmt.Errorf("failed to log activity for appointment status update: %w", err)
+	}
+	if previousStatus != status {
+		if err := s.publishChange(ctx, domain.EventAppointmentStatusChanged, existingAppointment); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// UpdateStatus updates the status of an appointment.
-func (s *AppointmentService) UpdateStatus(ctx context.Context, id string, status domain.AppointmentStatus, updaterID primitive.ObjectID) error {
-	appointmentID, err := primitive.ObjectIDFromHex(id)
-	if err != nil {
-		return fmt.Errorf("invalid ID format: %w", err)
-	}
+// makeRoom moves the conflicting appointments back so they start, one after
+// the other, once the emergency is over. Appointments they then run into are
+// moved as well, so the rest of the doctor's day slides back. Another
+// emergency is never moved, and the override fails when a moved appointment
+// would fall outside the doctor's availability or no longer fit its room. It
+// returns the IDs of the moved appointments.
+func (s *AppointmentService) makeRoom(ctx context.Context, emergency *domain.AppointmentEntity, conflicts []*domain.AppointmentEntity, reason string, updaterID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	emergencyEnd := emergency.DateTime.Add(time.Duration(emergency.Duration) * time.Minute)
+	next := emergencyEnd
+	moved := map[primitive.ObjectID]bool{}
+	var displaced []*domain.AppointmentEntity
+	now := time.Now()
+
+	for len(conflicts) > 0 {
+		for _, a := range conflicts {
+			if a.Type == domain.AppointmentTypeEmergency {
+				return nil, errors.New("doctor is attending another emergency at the requested time")
+			}
 
-	// Start a session for transaction
-	session, err := s.mongoClient.StartSession()
-	if err != nil {
-		return fmt.Errorf("failed to start session: %w", err)
-	}
-	defer session.EndSession(ctx)
+			if a.DateTime.Before(next) {
+				// Keep the time the patient was last told about
+				previous := a.DateTime
+				if a.Bump != nil && a.Bump.NotifiedAt == nil {
+					previous = a.Bump.PreviousDateTime
+				}
 
-	err = mongo.WithSession(ctx, session, func(sessionContext mongo.SessionContext) error {
-		if err = session.StartTransaction(); err != nil {
-			return err
+				a.DateTime = next
+				a.Bump = &domain.AppointmentBump{
+					PreviousDateTime: previous,
+					ByAppointmentID:  emergency.ID,
+					Reason:           reason,
+					At:               now,
+				}
+				a.UpdatedBy = updaterID
+
+				if err := s.appRepo.Update(ctx, a.ID, a); err != nil {
+					return nil, fmt.Errorf("failed to move appointment %s: %w", a.ID.Hex(), err)
+				}
+
+				moved[a.ID] = true
+				displaced = append(displaced, a)
+			}
+
+			if end := a.DateTime.Add(time.Duration(a.Duration) * time.Minute); end.After(next) {
+				next = end
+			}
 		}
 
-		// Get existing appointment
-		existingAppointment, err := s.appRepo.GetByID(sessionContext, appointmentID)
+		// Find the appointments the moved ones now run into
+		existing, err := s.appRepo.GetByDoctorAndDateRange(ctx, emergency.DoctorID, emergencyEnd, next)
 		if err != nil {
-			return fmt.Errorf("failed to get appointment: %w", err)
+			return nil, fmt.Errorf("failed to check for existing appointments: %w", err)
 		}
 
-		if existingAppointment == nil {
-			return errors.New("appointment not found")
+		conflicts = nil
+		for _, a := range existing {
+			if !moved[a.ID] && a.Status != domain.AppointmentStatusCancelled && a.DateTime.Before(next) && !a.DateTime.Before(emergencyEnd) {
+				conflicts = append(conflicts, a)
+			}
 		}
+	}
 
-		// Update only the status
-		existingAppointment.Status = status
-		existingAppointment.UpdatedAt = time.Now()
-		existingAppointment.UpdatedBy = updaterID
+	// Check the new slots once everything has moved, so the room bookings
+	// they are counted against are the ones after the move
+	ids := make([]primitive.ObjectID, 0, len(displaced))
+	for _, a := range displaced {
+		if err := s.checkAvailability(ctx, a); err != nil {
+			return nil, fmt.Errorf("cannot move appointment %s to %s: %w", a.ID.Hex(), a.DateTime.Format(time.RFC3339), err)
+		}
+		if a.RoomID != nil {
+			if err := s.assignRoom(ctx, a, a.RoomID); err != nil {
+				return nil, fmt.Errorf("cannot move appointment %s to %s: %w", a.ID.Hex(), a.DateTime.Format(time.RFC3339), err)
+			}
+		}
 
-		if err := s.appRepo.Update(sessionContext, appointmentID, existingAppointment); err != nil {
-			return fmt.Errorf("failed to update appointment status: %w", err)
+		if err := s.publishChange(ctx, domain.EventAppointmentRescheduled, a); err != nil {
+			return nil, err
 		}
+		ids = append(ids, a.ID)
+	}
+
+	return ids, nil
+}
 
-		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAppointment, "Appointment Status Updated", fmt.Sprintf("Appointment %s status changed to %s.", id, status))
+// assignRoom books the appointment into the room, taking its location and
+// department from the room. Without a room the appointment is filed under the
+// doctor's department.
+func (s *AppointmentService) assignRoom(ctx context.Context, appointment *domain.AppointmentEntity, roomID *primitive.ObjectID) error {
+	if roomID != nil {
+		end := appointment.DateTime.Add(time.Duration(appointment.Duration) * time.Minute)
+		room, clinic, err := s.facilityService.ReserveRoom(ctx, *roomID, appointment.DateTime, end, appointment.ID)
 		if err != nil {
-			log.Printf("Warning: failed to log activity for appointment status update: %v", err)
+			return err
 		}
 
-		return session.CommitTransaction(sessionContext)
-	})
+		appointment.RoomID = &room.ID
+		appointment.DepartmentID = &room.DepartmentID
+		appointment.Location = fmt.Sprintf("%s, %s", clinic.Name, room.Name)
+		return nil
+	}
+
+	doctor, err := s.doctorRepo.GetByID(ctx, appointment.DoctorID)
 	if err != nil {
-		session.AbortTransaction(ctx)
-		return err
+		return fmt.Errorf("failed to get doctor: %w", err)
+	}
+	if doctor == nil {
+		return errors.New("doctor not found")
 	}
 
+	appointment.RoomID = nil
+	appointment.DepartmentID = doctor.DepartmentID
+	return nil
+}
+
+// checkAvailability makes sure the doctor works at the appointment's time.
+func (s *AppointmentService) checkAvailability(ctx context.Context, appointment *domain.AppointmentEntity) error {
+	end := appointment.DateTime.Add(time.Duration(appointment.Duration) * time.Minute)
+	emergency := appointment.Type == domain.AppointmentTypeEmergency
+	return s.rosterService.CheckDoctorAvailability(ctx, appointment.DoctorID, appointment.DateTime, end, emergency)
+}
+
+// invoiceIfCompleted bills the appointment when it has just moved to Completed.
+func (s *AppointmentService) invoiceIfCompleted(ctx context.Context, previousStatus domain.AppointmentStatus, appointment *domain.AppointmentEntity, updaterID primitive.ObjectID) error {
+	if previousStatus == domain.AppointmentStatusCompleted || appointment.Status != domain.AppointmentStatusCompleted {
+		return nil
+	}
+
+	if _, err := s.billingService.CreateInvoiceForAppointment(ctx, appointment, updaterID); err != nil {
+		return fmt.Errorf("failed to invoice appointment: %w", err)
+	}
+
+	return nil
+}
+
+// publishChange writes an appointment event to the outbox in the caller's transaction.
+func (s *AppointmentService) publishChange(ctx context.Context, eventType domain.EventType, appointment *domain.AppointmentEntity) error {
+	err := s.activityService.PublishEvent(ctx, eventType, domain.ActivityTypeAppointment, appointment.ID.Hex(), appointment.ToDTO())
+	if err != nil {
+		return fmt.Errorf("failed to publish appointment event: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const appointmentLinkAudience = "appointment-link"
+
+// appointmentLinkClaims are carried by confirmation and cancellation tokens.
+// The appointment time is included so that links die when the appointment is
+// rescheduled.
+type appointmentLinkClaims struct {
+	Action          domain.AppointmentLinkAction `json:"act"`
+	AppointmentTime int64                        `json:"at"`
+	jwt.RegisteredClaims
+}
+
+// AppointmentLinkService issues and redeems the signed links patients use to
+// confirm or cancel an appointment without logging in. Each token can be used
+// once and expires at the appointment, or at the cancellation cut-off for
+// cancel links.
+type AppointmentLinkService struct {
+	appointmentRepo    *repository.AppointmentRepository
+	tokenRepo          *repository.AppointmentLinkTokenRepository
+	appointmentService *AppointmentService
+	secret             []byte
+	baseURL            string
+	cancelCutoff       time.Duration
+	mongoClient        *mongo.Client
+}
+
+func NewAppointmentLinkService(
+	appointmentRepo *repository.AppointmentRepository,
+	tokenRepo *repository.AppointmentLinkTokenRepository,
+	appointmentService *AppointmentService,
+	secret string,
+	baseURL string,
+	cancelCutoff time.Duration,
+	mongoClient *mongo.Client,
+) *AppointmentLinkService {
+	return &AppointmentLinkService{
+		appointmentRepo:    appointmentRepo,
+		tokenRepo:          tokenRepo,
+		appointmentService: appointmentService,
+		secret:             []byte(secret),
+		baseURL:            baseURL,
+		cancelCutoff:       cancelCutoff,
+		mongoClient:        mongoClient,
+	}
+}
+
+// Links returns the confirmation and cancellation links for the appointment.
+// A link is left empty when its action is no longer possible, e.g. the cancel
+// link once the cut-off has passed.
+func (s *AppointmentLinkService) Links(appointment *domain.AppointmentEntity, now time.Time) (confirmURL, cancelURL string, err error) {
+	if appointment.Status == domain.AppointmentStatusScheduled && now.Before(appointment.DateTime) {
+		if confirmURL, err = s.link(appointment, domain.AppointmentLinkConfirm, appointment.DateTime); err != nil {
+			return "", "", err
+		}
+	}
+
+	cancelDeadline := appointment.DateTime.Add(-s.cancelCutoff)
+	if isCancellable(appointment.Status) && now.Before(cancelDeadline) {
+		if cancelURL, err = s.link(appointment, domain.AppointmentLinkCancel, cancelDeadline); err != nil {
+			return "", "", err
+		}
+	}
+
+	return confirmURL, cancelURL, nil
+}
+
+func (s *AppointmentLinkService) link(appointment *domain.AppointmentEntity, action domain.AppointmentLinkAction, expiresAt time.Time) (string, error) {
+	claims := appointmentLinkClaims{
+		Action:          action,
+		AppointmentTime: appointment.DateTime.Unix(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        primitive.NewObjectID().Hex(),
+			Subject:   appointment.ID.Hex(),
+			Audience:  jwt.ClaimStrings{appointmentLinkAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign appointment link: %w", err)
+	}
+
+	return s.baseURL + "?token=" + url.QueryEscape(token), nil
+}
+
+// Inspect checks a token without using it, so the patient can be shown what
+// they are about to confirm or cancel.
+func (s *AppointmentLinkService) Inspect(ctx context.Context, tokenString string) (*domain.AppointmentLinkInfo, error) {
+	_, appointment, action, err := s.resolve(ctx, tokenString, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return linkInfo(appointment, action), nil
+}
+
+// Apply uses a token to confirm or cancel its appointment. The token is
+// marked used in the same transaction as the status change, so it is only
+// spent when the change is made. Tokens are single use.
+func (s *AppointmentLinkService) Apply(ctx context.Context, tokenString string) (*domain.AppointmentLinkInfo, error) {
+	now := time.Now()
+
+	claims, appointment, action, err := s.resolve(ctx, tokenString, now)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID, _ := primitive.ObjectIDFromHex(claims.ID)
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		used, err := s.tokenRepo.MarkUsed(sessionContext, &domain.AppointmentLinkTokenEntity{
+			ID:            tokenID,
+			AppointmentID: appointment.ID,
+			Action:        action,
+			UsedAt:        now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record link use: %w", err)
+		}
+		if !used {
+			return errors.New("link has already been used")
+		}
+
+		// Links are used by patients, not staff, so no user is recorded.
+		return s.appointmentService.updateStatus(sessionContext, appointment.ID, action.Status(), primitive.NilObjectID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appointment.Status = action.Status()
+	return linkInfo(appointment, action), nil
+}
+
+// resolve verifies the token and checks that its action is still allowed for
+// the appointment.
+func (s *AppointmentLinkService) resolve(ctx context.Context, tokenString string, now time.Time) (*appointmentLinkClaims, *domain.AppointmentEntity, domain.AppointmentLinkAction, error) {
+	claims := &appointmentLinkClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(appointmentLinkAudience),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, nil, "", errors.New("link has expired")
+		}
+		return nil, nil, "", errors.New("invalid link")
+	}
+
+	if !claims.Action.IsValid() {
+		return nil, nil, "", errors.New("invalid link")
+	}
+	if _, err := primitive.ObjectIDFromHex(claims.ID); err != nil {
+		return nil, nil, "", errors.New("invalid link")
+	}
+
+	appointmentID, err := primitive.ObjectIDFromHex(claims.Subject)
+	if err != nil {
+		return nil, nil, "", errors.New("invalid link")
+	}
+
+	appointment, err := s.appointmentRepo.GetByID(ctx, appointmentID)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to get appointment: %w", err)
+	}
+	if appointment == nil {
+		return nil, nil, "", errors.New("appointment not found")
+	}
+	if appointment.DateTime.Unix() != claims.AppointmentTime {
+		return nil, nil, "", errors.New("appointment has been rescheduled, this link is no longer valid")
+	}
+
+	switch claims.Action {
+	case domain.AppointmentLinkConfirm:
+		if appointment.Status != domain.AppointmentStatusScheduled {
+			return nil, nil, "", fmt.Errorf("appointment is %s and can no longer be confirmed", appointment.Status)
+		}
+	case domain.AppointmentLinkCancel:
+		if !isCancellable(appointment.Status) {
+			return nil, nil, "", fmt.Errorf("appointment is %s and can no longer be cancelled", appointment.Status)
+		}
+		// The cut-off is checked again in case it was tightened after the link was sent.
+		if !now.Before(appointment.DateTime.Add(-s.cancelCutoff)) {
+			return nil, nil, "", fmt.Errorf("appointments can only be cancelled online up to %s before they start, please contact the hospital", s.cancelCutoff)
+		}
+	}
+
+	return claims, appointment, claims.Action, nil
+}
+
+func isCancellable(status domain.AppointmentStatus) bool {
+	return status == domain.AppointmentStatusScheduled || status == domain.AppointmentStatusConfirmed
+}
+
+func linkInfo(appointment *domain.AppointmentEntity, action domain.AppointmentLinkAction) *domain.AppointmentLinkInfo {
+	return &domain.AppointmentLinkInfo{
+		Action:        action,
+		AppointmentID: appointment.ID.Hex(),
+		DateTime:      appointment.DateTime,
+		Location:      appointment.Location,
+		Status:        appointment.Status,
+	}
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func newTestAppointmentService(mt *mtest.T, availability domain.AvailabilitySource) *AppointmentService {
+	activityService := NewActivityService(
+		repository.NewActivityRepository(mt.DB.Collection("activities")),
+		repository.NewOutboxRepository(mt.DB.Collection("outbox")),
+	)
+	appRepo := repository.NewAppointmentRepository(mt.DB.Collection("appointments"))
+	doctorRepo := repository.NewDoctorRepository(mt.DB.Collection("doctors"))
+
+	facilityService := NewFacilityService(
+		repository.NewDepartmentRepository(mt.DB.Collection("departments")),
+		repository.NewClinicRepository(mt.DB.Collection("clinics")),
+		repository.NewClinicRoomRepository(mt.DB.Collection("clinic_rooms")),
+		appRepo,
+		time.UTC,
+	)
+	rosterService := NewRosterService(
+		repository.NewShiftTemplateRepository(mt.DB.Collection("shift_templates")),
+		repository.NewShiftAssignmentRepository(mt.DB.Collection("shift_assignments")),
+		repository.NewShiftSwapRepository(mt.DB.Collection("shift_swaps")),
+		repository.NewUserRepository(mt.DB.Collection("users")),
+		doctorRepo,
+		activityService,
+		mt.Client,
+		time.UTC,
+		availability,
+	)
+
+	return NewAppointmentService(
+		appRepo,
+		repository.NewPatientRepository(mt.DB.Collection("patients")),
+		repository.NewMedicalRecordRepository(mt.DB.Collection("medical_records")),
+		doctorRepo,
+		activityService,
+		nil,
+		facilityService,
+		rosterService,
+		mt.Client,
+	)
+}
+
+func TestMakeRoom(t *testing.T) {
+	mt := newMockDB(t)
+
+	// A Monday morning
+	start := time.Date(2025, 7, 14, 9, 0, 0, 0, time.UTC)
+	doctorID := primitive.NewObjectID()
+	department := &domain.DepartmentEntity{ID: primitive.NewObjectID(), Name: "Cardiology", IsActive: true}
+	clinic := &domain.ClinicEntity{ID: primitive.NewObjectID(), DepartmentID: department.ID, Name: "Poli Jantung", IsActive: true}
+	room := func(close string) *domain.ClinicRoomEntity {
+		return &domain.ClinicRoomEntity{
+			ID:             primitive.NewObjectID(),
+			ClinicID:       clinic.ID,
+			DepartmentID:   department.ID,
+			Name:           "Room 101",
+			Capacity:       1,
+			OperatingHours: []domain.OperatingHours{{DayOfWeek: int(time.Monday), Open: "08:00", Close: close}},
+			IsActive:       true,
+		}
+	}
+	emergency := func() *domain.AppointmentEntity {
+		return &domain.AppointmentEntity{
+			ID:       primitive.NewObjectID(),
+			DoctorID: doctorID,
+			Type:     domain.AppointmentTypeEmergency,
+			DateTime: start,
+			Duration: 30,
+		}
+	}
+	booked := func(roomID *primitive.ObjectID) *domain.AppointmentEntity {
+		return &domain.AppointmentEntity{
+			ID:       primitive.NewObjectID(),
+			DoctorID: doctorID,
+			Type:     domain.AppointmentTypeConsultation,
+			DateTime: start,
+			Duration: 30,
+			RoomID:   roomID,
+			Status:   domain.AppointmentStatusScheduled,
+		}
+	}
+
+	mt.Run("moved appointment is re-booked into its room", func(mt *mtest.T) {
+		s := newTestAppointmentService(mt, domain.AvailabilityNone)
+		r := room("17:00")
+		a := booked(&r.ID)
+		mt.AddMockResponses(
+			mockWrite(1), // move
+			mockFind(mt), // nothing else in the way
+			mockFind(mt, mockDoc(t, r)),
+			mockFind(mt, mockDoc(t, clinic)),
+			mockFind(mt, mockDoc(t, department)),
+			mockFind(mt, mockDoc(t, a)), // only itself in the room
+			mockWrite(1),                // rescheduled event
+		)
+
+		ids, err := s.makeRoom(context.Background(), emergency(), []*domain.AppointmentEntity{a}, "Cardiac arrest", primitive.NewObjectID())
+		if err != nil {
+			t.Fatalf("makeRoom() error = %v", err)
+		}
+		if !slices.Equal(ids, []primitive.ObjectID{a.ID}) {
+			t.Errorf("displaced = %v, want %s", ids, a.ID.Hex())
+		}
+		if !a.DateTime.Equal(start.Add(30 * time.Minute)) {
+			t.Errorf("moved to %s, want after the emergency", a.DateTime)
+		}
+		if a.Bump == nil || !a.Bump.PreviousDateTime.Equal(start) {
+			t.Errorf("bump = %+v, want the previous time kept", a.Bump)
+		}
+		if names := startedCommands(mt); !slices.Contains(names, "insert") {
+			t.Errorf("commands = %v, want the reschedule published", names)
+		}
+	})
+
+	mt.Run("room closed at the new time", func(mt *mtest.T) {
+		s := newTestAppointmentService(mt, domain.AvailabilityNone)
+		r := room("09:30")
+		a := booked(&r.ID)
+		mt.AddMockResponses(
+			mockWrite(1),
+			mockFind(mt),
+			mockFind(mt, mockDoc(t, r)),
+			mockFind(mt, mockDoc(t, clinic)),
+			mockFind(mt, mockDoc(t, department)),
+		)
+
+		_, err := s.makeRoom(context.Background(), emergency(), []*domain.AppointmentEntity{a}, "Cardiac arrest", primitive.NewObjectID())
+		if err == nil || !strings.Contains(err.Error(), "room is closed") {
+			t.Fatalf("makeRoom() error = %v, want the room closed", err)
+		}
+		if names := startedCommands(mt); slices.Contains(names, "insert") {
+			t.Errorf("commands = %v, want nothing published", names)
+		}
+	})
+
+	mt.Run("room full at the new time", func(mt *mtest.T) {
+		s := newTestAppointmentService(mt, domain.AvailabilityNone)
+		r := room("17:00")
+		a := booked(&r.ID)
+		other := booked(&r.ID)
+		other.DoctorID = primitive.NewObjectID()
+		other.DateTime = start.Add(30 * time.Minute)
+		mt.AddMockResponses(
+			mockWrite(1),
+			mockFind(mt),
+			mockFind(mt, mockDoc(t, r)),
+			mockFind(mt, mockDoc(t, clinic)),
+			mockFind(mt, mockDoc(t, department)),
+			mockFind(mt, mockDoc(t, a), mockDoc(t, other)),
+		)
+
+		_, err := s.makeRoom(context.Background(), emergency(), []*domain.AppointmentEntity{a}, "Cardiac arrest", primitive.NewObjectID())
+		if err == nil || !strings.Contains(err.Error(), "fully booked") {
+			t.Fatalf("makeRoom() error = %v, want the room fully booked", err)
+		}
+	})
+
+	mt.Run("doctor not available at the new time", func(mt *mtest.T) {
+		s := newTestAppointmentService(mt, domain.AvailabilityStatic)
+		a := booked(nil)
+		doctor := &domain.DoctorEntity{
+			ID:   doctorID,
+			Name: "Dr. John Doe",
+			Availability: []domain.TimeSlot{{
+				DayOfWeek: int(time.Monday),
+				StartTime: time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2025, 1, 1, 9, 30, 0, 0, time.UTC),
+			}},
+		}
+		mt.AddMockResponses(
+			mockWrite(1),
+			mockFind(mt),
+			mockFind(mt, mockDoc(t, doctor)),
+		)
+
+		_, err := s.makeRoom(context.Background(), emergency(), []*domain.AppointmentEntity{a}, "Cardiac arrest", primitive.NewObjectID())
+		if err == nil || !strings.Contains(err.Error(), "not available") {
+			t.Fatalf("makeRoom() error = %v, want the doctor unavailable", err)
+		}
+	})
+
+	mt.Run("another emergency is never moved", func(mt *mtest.T) {
+		s := newTestAppointmentService(mt, domain.AvailabilityNone)
+		other := emergency()
+
+		_, err := s.makeRoom(context.Background(), emergency(), []*domain.AppointmentEntity{other}, "Cardiac arrest", primitive.NewObjectID())
+		if err == nil {
+			t.Fatal("makeRoom() moved an emergency")
+		}
+	})
+}
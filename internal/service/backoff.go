@@ -0,0 +1,23 @@
+package service
+
+import "time"
+
+const (
+	// retryBaseBackoff and retryMaxBackoff bound the wait between attempts
+	// to deliver to an external system: webhooks, HL7 and SATUSEHAT.
+	retryBaseBackoff = 30 * time.Second
+	retryMaxBackoff  = 6 * time.Hour
+)
+
+// backoff returns the wait before the next attempt, doubling from base with
+// each failed attempt and capped at max: base, 2*base, 4*base, ...
+func backoff(base, max time.Duration, attempts int) time.Duration {
+	wait := base
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= max {
+			return max
+		}
+	}
+	return wait
+}
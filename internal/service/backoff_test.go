@@ -0,0 +1,30 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		base, max time.Duration
+		attempts  int
+		want      time.Duration
+	}{
+		{retryBaseBackoff, retryMaxBackoff, 1, 30 * time.Second},
+		{retryBaseBackoff, retryMaxBackoff, 2, time.Minute},
+		{retryBaseBackoff, retryMaxBackoff, 3, 2 * time.Minute},
+		{retryBaseBackoff, retryMaxBackoff, 10, 4*time.Hour + 16*time.Minute},
+		{retryBaseBackoff, retryMaxBackoff, 11, 6 * time.Hour},
+		{retryBaseBackoff, retryMaxBackoff, 100, 6 * time.Hour},
+		{outboxBaseBackoff, outboxMaxBackoff, 1, time.Second},
+		{outboxBaseBackoff, outboxMaxBackoff, 4, 8 * time.Second},
+		{outboxBaseBackoff, outboxMaxBackoff, 9, 256 * time.Second},
+		{outboxBaseBackoff, outboxMaxBackoff, 10, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.base, tt.max, tt.attempts); got != tt.want {
+			t.Errorf("backoff(%v, %v, %d) = %v, want %v", tt.base, tt.max, tt.attempts, got, tt.want)
+		}
+	}
+}
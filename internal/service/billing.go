@@ -0,0 +1,587 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// BillingService manages the tariff catalogue, invoices, payments and refunds.
+type BillingService struct {
+	tariffRepo      *repository.TariffRepository
+	invoiceRepo     *repository.InvoiceRepository
+	paymentRepo     *repository.PaymentRepository
+	patientRepo     *repository.PatientRepository
+	appRepo         *repository.AppointmentRepository
+	activityService *ActivityService
+	mongoClient     *mongo.Client
+}
+
+func NewBillingService(
+	tariffRepo *repository.TariffRepository,
+	invoiceRepo *repository.InvoiceRepository,
+	paymentRepo *repository.PaymentRepository,
+	patientRepo *repository.PatientRepository,
+	appRepo *repository.AppointmentRepository,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+) *BillingService {
+	return &BillingService{
+		tariffRepo:      tariffRepo,
+		invoiceRepo:     invoiceRepo,
+		paymentRepo:     paymentRepo,
+		patientRepo:     patientRepo,
+		appRepo:         appRepo,
+		activityService: activityService,
+		mongoClient:     mongoClient,
+	}
+}
+
+func (s *BillingService) GetAllTariffs(ctx context.Context) ([]*domain.TariffEntity, error) {
+	return s.tariffRepo.GetAll(ctx)
+}
+
+func (s *BillingService) CreateTariff(ctx context.Context, req *domain.TariffRequest, creatorID primitive.ObjectID) (string, error) {
+	existing, err := s.tariffRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return "", fmt.Errorf("error checking tariff code: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("tariff with this code already exists")
+	}
+
+	tariff := &domain.TariffEntity{
+		Code:            req.Code,
+		Name:            req.Name,
+		Category:        req.Category,
+		AppointmentType: req.AppointmentType,
+		Price:           req.Price,
+		TaxRate:         req.TaxRate,
+		IsActive:        true,
+		CreatedBy:       creatorID,
+		UpdatedBy:       creatorID,
+	}
+	if req.IsActive != nil {
+		tariff.IsActive = *req.IsActive
+	}
+
+	if err := s.checkAppointmentTypeTariff(ctx, tariff); err != nil {
+		return "", err
+	}
+
+	id, err := s.tariffRepo.Create(ctx, tariff)
+	if err != nil {
+		return "", fmt.Errorf("failed to create tariff: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *BillingService) UpdateTariff(ctx context.Context, id string, req *domain.TariffRequest, updaterID primitive.ObjectID) error {
+	tariffID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	tariff, err := s.tariffRepo.GetByID(ctx, tariffID)
+	if err != nil {
+		return fmt.Errorf("failed to get tariff: %w", err)
+	}
+	if tariff == nil {
+		return errors.New("tariff not found")
+	}
+
+	existing, err := s.tariffRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return fmt.Errorf("error checking tariff code: %w", err)
+	}
+	if existing != nil && existing.ID != tariff.ID {
+		return errors.New("tariff with this code already exists")
+	}
+
+	tariff.Code = req.Code
+	tariff.Name = req.Name
+	tariff.Category = req.Category
+	tariff.AppointmentType = req.AppointmentType
+	tariff.Price = req.Price
+	tariff.TaxRate = req.TaxRate
+	if req.IsActive != nil {
+		tariff.IsActive = *req.IsActive
+	}
+	tariff.UpdatedBy = updaterID
+
+	if err := s.checkAppointmentTypeTariff(ctx, tariff); err != nil {
+		return err
+	}
+
+	if err := s.tariffRepo.Update(ctx, tariff.ID, tariff); err != nil {
+		return fmt.Errorf("failed to update tariff: %w", err)
+	}
+
+	return nil
+}
+
+// checkAppointmentTypeTariff makes sure only one active tariff is billed per appointment type.
+func (s *BillingService) checkAppointmentTypeTariff(ctx context.Context, tariff *domain.TariffEntity) error {
+	if tariff.AppointmentType == "" || !tariff.IsActive {
+		return nil
+	}
+
+	existing, err := s.tariffRepo.GetActiveByAppointmentType(ctx, tariff.AppointmentType)
+	if err != nil {
+		return fmt.Errorf("error checking appointment type tariff: %w", err)
+	}
+	if existing != nil && existing.ID != tariff.ID {
+		return fmt.Errorf("tariff %s is already active for %s appointments", existing.Code, tariff.AppointmentType)
+	}
+
+	return nil
+}
+
+func (s *BillingService) GetAllInvoices(ctx context.Context, patientID string, status domain.InvoiceStatus) ([]*domain.InvoiceEntity, error) {
+	var patientObjID *primitive.ObjectID
+	if patientID != "" {
+		id, err := primitive.ObjectIDFromHex(patientID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid patient ID format: %w", err)
+		}
+		patientObjID = &id
+	}
+
+	return s.invoiceRepo.GetAll(ctx, patientObjID, status)
+}
+
+func (s *BillingService) GetInvoiceByID(ctx context.Context, id string) (*domain.InvoiceEntity, error) {
+	invoiceID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	invoice, err := s.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	return invoice, nil
+}
+
+// CreateInvoice raises an invoice manually, e.g. for procedures or walk-in charges.
+func (s *BillingService) CreateInvoice(ctx context.Context, req *domain.CreateInvoiceRequest, creatorID primitive.ObjectID) (string, error) {
+	patientID, err := primitive.ObjectIDFromHex(req.PatientID)
+	if err != nil {
+		return "", fmt.Errorf("invalid patient ID format: %w", err)
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, patientID)
+	if err != nil || patient == nil {
+		return "", errors.New("patient not found")
+	}
+
+	invoice := &domain.InvoiceEntity{
+		PatientID: patientID,
+		Notes:     req.Notes,
+		CreatedBy: creatorID,
+		UpdatedBy: creatorID,
+	}
+
+	if req.AppointmentID != "" {
+		appointmentID, err := primitive.ObjectIDFromHex(req.AppointmentID)
+		if err != nil {
+			return "", fmt.Errorf("invalid appointment ID format: %w", err)
+		}
+		appointment, err := s.appRepo.GetByID(ctx, appointmentID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get appointment: %w", err)
+		}
+		if appointment == nil {
+			return "", errors.New("appointment not found")
+		}
+		if appointment.PatientID != patientID {
+			return "", errors.New("appointment does not belong to the patient")
+		}
+		invoice.AppointmentID = &appointmentID
+	}
+
+	for _, item := range req.Items {
+		line, err := s.buildLineItem(ctx, item)
+		if err != nil {
+			return "", err
+		}
+		invoice.Items = append(invoice.Items, line)
+	}
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.issueInvoice(sessionContext, invoice); err != nil {
+			return err
+		}
+
+		err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypeBilling, "Invoice Issued", fmt.Sprintf("Invoice %s for patient %s has been issued.", invoice.Number, patient.Name))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for new invoice: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return invoice.ID.Hex(), nil
+}
+
+func (s *BillingService) buildLineItem(ctx context.Context, item domain.InvoiceItemRequest) (domain.InvoiceLineItem, error) {
+	line := domain.InvoiceLineItem{
+		Description: item.Description,
+		Quantity:    item.Quantity,
+		UnitPrice:   item.UnitPrice,
+		Discount:    item.Discount,
+		TaxRate:     item.TaxRate,
+	}
+
+	if item.TariffID != "" {
+		tariffID, err := primitive.ObjectIDFromHex(item.TariffID)
+		if err != nil {
+			return line, fmt.Errorf("invalid tariff ID format: %w", err)
+		}
+		tariff, err := s.tariffRepo.GetByID(ctx, tariffID)
+		if err != nil {
+			return line, fmt.Errorf("failed to get tariff: %w", err)
+		}
+		if tariff == nil || !tariff.IsActive {
+			return line, fmt.Errorf("tariff %s not found", item.TariffID)
+		}
+
+		line.TariffID = &tariff.ID
+		line.Code = tariff.Code
+		line.UnitPrice = tariff.Price
+		line.TaxRate = tariff.TaxRate
+		if line.Description == "" {
+			line.Description = tariff.Name
+		}
+	}
+
+	if item.Discount > line.UnitPrice*int64(item.Quantity) {
+		return line, fmt.Errorf("discount on %s exceeds the line amount", line.Description)
+	}
+
+	return line, nil
+}
+
+// issueInvoice assigns the invoice number, computes the totals and stores the invoice.
+func (s *BillingService) issueInvoice(ctx context.Context, invoice *domain.InvoiceEntity) error {
+	now := time.Now()
+	invoice.ID = primitive.NewObjectID()
+	invoice.Number = fmt.Sprintf("INV-%s-%s", now.Format("20060102"), strings.ToUpper(invoice.ID.Hex()[16:]))
+	invoice.Currency = domain.DefaultCurrency
+	invoice.IssuedAt = now
+	invoice.Recalculate()
+	invoice.RefreshStatus()
+
+	if _, err := s.invoiceRepo.Create(ctx, invoice); err != nil {
+		return fmt.Errorf("failed to create invoice: %w", err)
+	}
+
+	return nil
+}
+
+// CreateInvoiceForAppointment bills a completed appointment using the tariff
+// configured for its type. It is a no-op when the appointment already has an
+// invoice or no tariff is configured. ctx must be the session context of the
+// caller's transaction, so the invoice and its activity commit with the
+// appointment.
+func (s *BillingService) CreateInvoiceForAppointment(ctx context.Context, appointment *domain.AppointmentEntity, creatorID primitive.ObjectID) (*domain.InvoiceEntity, error) {
+	existing, err := s.invoiceRepo.GetByAppointmentID(ctx, appointment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing invoice: %w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	tariff, err := s.tariffRepo.GetActiveByAppointmentType(ctx, appointment.Type)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tariff: %w", err)
+	}
+	if tariff == nil {
+		log.Printf("Warning: no active tariff for %s appointments, appointment %s was not invoiced", appointment.Type, appointment.ID.Hex())
+		return nil, nil
+	}
+
+	appointmentID := appointment.ID
+	invoice := &domain.InvoiceEntity{
+		PatientID:     appointment.PatientID,
+		AppointmentID: &appointmentID,
+		Items: []domain.InvoiceLineItem{{
+			TariffID:    &tariff.ID,
+			Code:        tariff.Code,
+			Description: tariff.Name,
+			Quantity:    1,
+			UnitPrice:   tariff.Price,
+			TaxRate:     tariff.TaxRate,
+		}},
+		CreatedBy: creatorID,
+		UpdatedBy: creatorID,
+	}
+
+	if err := s.issueInvoice(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeBilling, "Invoice Issued", fmt.Sprintf("Invoice %s has been issued for appointment %s.", invoice.Number, appointment.ID.Hex()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to log activity for appointment invoice: %w", err)
+	}
+
+	return invoice, nil
+}
+
+// VoidInvoice cancels an invoice that has no outstanding payments.
+func (s *BillingService) VoidInvoice(ctx context.Context, id string, reason string, updaterID primitive.ObjectID) error {
+	invoiceID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		invoice, err := s.invoiceRepo.GetByID(sessionContext, invoiceID)
+		if err != nil {
+			return fmt.Errorf("failed to get invoice: %w", err)
+		}
+		if invoice == nil {
+			return errors.New("invoice not found")
+		}
+		if invoice.Status == domain.InvoiceStatusVoid {
+			return errors.New("invoice is already void")
+		}
+		if invoice.NetPaid() > 0 {
+			return errors.New("refund the payments before voiding the invoice")
+		}
+
+		from := invoice.Status
+		invoice.Status = domain.InvoiceStatusVoid
+		invoice.VoidReason = reason
+		invoice.UpdatedBy = updaterID
+		if err := s.updateInvoice(sessionContext, invoice, from); err != nil {
+			return err
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeBilling, "Invoice Voided", fmt.Sprintf("Invoice %s has been voided: %s.", invoice.Number, reason))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for voided invoice: %w", err)
+		}
+		return nil
+	})
+}
+
+// updateInvoice saves the invoice if it is still in status from.
+func (s *BillingService) updateInvoice(ctx context.Context, invoice *domain.InvoiceEntity, from domain.InvoiceStatus) error {
+	updated, err := s.invoiceRepo.Update(ctx, invoice.ID, invoice, from)
+	if err != nil {
+		return fmt.Errorf("failed to update invoice: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("invoice %s is no longer %s", invoice.Number, from)
+	}
+	return nil
+}
+
+func (s *BillingService) GetPatientBalance(ctx context.Context, patientID string) (*domain.PatientBalance, error) {
+	patientObjID, err := primitive.ObjectIDFromHex(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient ID format: %w", err)
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, patientObjID)
+	if err != nil || patient == nil {
+		return nil, errors.New("patient not found")
+	}
+
+	balance, err := s.invoiceRepo.GetPatientBalance(ctx, patientObjID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get patient balance: %w", err)
+	}
+
+	return balance, nil
+}
+
+func (s *BillingService) GetAllPayments(ctx context.Context, invoiceID string) ([]*domain.PaymentEntity, error) {
+	var invoiceObjID *primitive.ObjectID
+	if invoiceID != "" {
+		id, err := primitive.ObjectIDFromHex(invoiceID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid invoice ID format: %w", err)
+		}
+		invoiceObjID = &id
+	}
+
+	return s.paymentRepo.GetAll(ctx, invoiceObjID)
+}
+
+func (s *BillingService) GetPaymentByID(ctx context.Context, id string) (*domain.PaymentEntity, error) {
+	paymentID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	payment, err := s.paymentRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	return payment, nil
+}
+
+// RecordPayment records a full or partial payment against an invoice.
+func (s *BillingService) RecordPayment(ctx context.Context, req *domain.CreatePaymentRequest, creatorID primitive.ObjectID) (string, error) {
+	var newPaymentID string
+	err := withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		id, err := s.recordPayment(sessionContext, req, creatorID)
+		newPaymentID = id
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return newPaymentID, nil
+}
+
+// recordPayment records a payment in the caller's transaction; ctx must be
+// its session context.
+func (s *BillingService) recordPayment(ctx context.Context, req *domain.CreatePaymentRequest, creatorID primitive.ObjectID) (string, error) {
+	invoiceID, err := primitive.ObjectIDFromHex(req.InvoiceID)
+	if err != nil {
+		return "", fmt.Errorf("invalid invoice ID format: %w", err)
+	}
+
+	invoice, err := s.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get invoice: %w", err)
+	}
+	if invoice == nil {
+		return "", errors.New("invoice not found")
+	}
+	if invoice.Status == domain.InvoiceStatusVoid {
+		return "", errors.New("cannot pay a void invoice")
+	}
+	if req.Amount > invoice.Balance() {
+		return "", fmt.Errorf("payment of %d exceeds the outstanding balance of %d", req.Amount, invoice.Balance())
+	}
+
+	payment := &domain.PaymentEntity{
+		InvoiceID:  invoice.ID,
+		PatientID:  invoice.PatientID,
+		Type:       domain.PaymentTypePayment,
+		Method:     req.Method,
+		Amount:     req.Amount,
+		Reference:  req.Reference,
+		Notes:      req.Notes,
+		ReceivedAt: time.Now(),
+		CreatedBy:  creatorID,
+	}
+
+	id, err := s.paymentRepo.Create(ctx, payment)
+	if err != nil {
+		return "", fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	from := invoice.Status
+	invoice.AmountPaid += req.Amount
+	invoice.RefreshStatus()
+	invoice.UpdatedBy = creatorID
+	if err := s.updateInvoice(ctx, invoice, from); err != nil {
+		return "", err
+	}
+
+	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeBilling, "Payment Received", fmt.Sprintf("Payment of %d %s received for invoice %s (%s).", req.Amount, invoice.Currency, invoice.Number, invoice.Status))
+	if err != nil {
+		return "", fmt.Errorf("failed to log activity for payment: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+// RefundPayment refunds part or all of a payment and records the refund as a
+// payment entry of type Refund.
+func (s *BillingService) RefundPayment(ctx context.Context, id string, req *domain.RefundPaymentRequest, creatorID primitive.ObjectID) (string, error) {
+	paymentID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	var newRefundID string
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		payment, err := s.paymentRepo.GetByID(sessionContext, paymentID)
+		if err != nil {
+			return fmt.Errorf("failed to get payment: %w", err)
+		}
+		if payment == nil {
+			return errors.New("payment not found")
+		}
+		if payment.Type != domain.PaymentTypePayment {
+			return errors.New("only payments can be refunded")
+		}
+		if req.Amount > payment.Amount-payment.RefundedAmount {
+			return fmt.Errorf("refund of %d exceeds the refundable amount of %d", req.Amount, payment.Amount-payment.RefundedAmount)
+		}
+
+		invoice, err := s.invoiceRepo.GetByID(sessionContext, payment.InvoiceID)
+		if err != nil {
+			return fmt.Errorf("failed to get invoice: %w", err)
+		}
+		if invoice == nil {
+			return errors.New("invoice not found")
+		}
+
+		refund := &domain.PaymentEntity{
+			InvoiceID:  payment.InvoiceID,
+			PatientID:  payment.PatientID,
+			Type:       domain.PaymentTypeRefund,
+			Method:     payment.Method,
+			Amount:     req.Amount,
+			RefundOf:   &payment.ID,
+			Notes:      req.Reason,
+			ReceivedAt: time.Now(),
+			CreatedBy:  creatorID,
+		}
+
+		refundID, err := s.paymentRepo.Create(sessionContext, refund)
+		if err != nil {
+			return fmt.Errorf("failed to create refund: %w", err)
+		}
+		newRefundID = refundID.Hex()
+
+		payment.RefundedAmount += req.Amount
+		if err := s.paymentRepo.Update(sessionContext, payment.ID, payment); err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+
+		from := invoice.Status
+		invoice.AmountRefunded += req.Amount
+		invoice.RefreshStatus()
+		invoice.UpdatedBy = creatorID
+		if err := s.updateInvoice(sessionContext, invoice, from); err != nil {
+			return err
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeBilling, "Payment Refunded", fmt.Sprintf("Refund of %d %s issued for invoice %s: %s.", req.Amount, invoice.Currency, invoice.Number, req.Reason))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for refund: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return newRefundID, nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func newTestBillingService(mt *mtest.T) *BillingService {
+	return NewBillingService(
+		repository.NewTariffRepository(mt.DB.Collection("tariffs")),
+		repository.NewInvoiceRepository(mt.DB.Collection("invoices")),
+		repository.NewPaymentRepository(mt.DB.Collection("payments")),
+		repository.NewPatientRepository(mt.DB.Collection("patients")),
+		repository.NewAppointmentRepository(mt.DB.Collection("appointments")),
+		NewActivityService(
+			repository.NewActivityRepository(mt.DB.Collection("activities")),
+			repository.NewOutboxRepository(mt.DB.Collection("outbox")),
+		),
+		mt.Client,
+	)
+}
+
+func testInvoice(status domain.InvoiceStatus, paid int64) *domain.InvoiceEntity {
+	return &domain.InvoiceEntity{
+		ID:         primitive.NewObjectID(),
+		Number:     "INV-20250717-1F000001",
+		PatientID:  primitive.NewObjectID(),
+		Currency:   "IDR",
+		Total:      1500000,
+		AmountPaid: paid,
+		Status:     status,
+	}
+}
+
+func TestBillingVoidInvoice(t *testing.T) {
+	mt := newMockDB(t)
+	updaterID := primitive.NewObjectID()
+
+	mt.Run("unpaid invoice is voided", func(mt *mtest.T) {
+		s := newTestBillingService(mt)
+		invoice := testInvoice(domain.InvoiceStatusUnpaid, 0)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, invoice)),
+			mockWrite(1),                  // invoice update
+			mockWrite(1),                  // activity
+			mtest.CreateSuccessResponse(), // commit
+		)
+
+		if err := s.VoidInvoice(context.Background(), invoice.ID.Hex(), "duplicate", updaterID); err != nil {
+			mt.Fatalf("VoidInvoice() error = %v", err)
+		}
+
+		events := startedEvents(mt)
+		updates := decodeSets[domain.InvoiceEntity](mt.T, events, "invoices")
+		if len(updates) != 1 || updates[0].Status != domain.InvoiceStatusVoid || updates[0].VoidReason != "duplicate" {
+			mt.Fatalf("invoice updates = %+v, want it voided", updates)
+		}
+		for _, e := range events {
+			if e.CommandName != "update" || e.Command.Lookup("update").StringValue() != "invoices" {
+				continue
+			}
+			filter := e.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("q")
+			if got := filter.Document().Lookup("status").StringValue(); got != string(domain.InvoiceStatusUnpaid) {
+				mt.Errorf("update filter status = %q, want %q", got, domain.InvoiceStatusUnpaid)
+			}
+		}
+	})
+
+	mt.Run("paid invoice is refused", func(mt *mtest.T) {
+		s := newTestBillingService(mt)
+		invoice := testInvoice(domain.InvoiceStatusPartiallyPaid, 500000)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, invoice)),
+			mtest.CreateSuccessResponse(), // abort
+		)
+
+		if err := s.VoidInvoice(context.Background(), invoice.ID.Hex(), "duplicate", updaterID); err == nil {
+			mt.Fatal("VoidInvoice() voided an invoice with payments")
+		}
+		if names := startedCommands(mt); slices.Contains(names, "update") {
+			mt.Errorf("commands = %v, want the invoice left alone", names)
+		}
+	})
+
+	mt.Run("payment received meanwhile is not voided", func(mt *mtest.T) {
+		s := newTestBillingService(mt)
+		invoice := testInvoice(domain.InvoiceStatusUnpaid, 0)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, invoice)),
+			mockWrite(0),                  // paid by the other request
+			mtest.CreateSuccessResponse(), // abort
+		)
+
+		if err := s.VoidInvoice(context.Background(), invoice.ID.Hex(), "duplicate", updaterID); err == nil {
+			mt.Fatal("VoidInvoice() voided an invoice that is no longer unpaid")
+		}
+		if names := startedCommands(mt); slices.Contains(names, "commitTransaction") {
+			mt.Errorf("commands = %v, want the transaction aborted", names)
+		}
+	})
+}
@@ -13,6 +13,8 @@ type DashboardService struct {
 	apptRepo     *repository.AppointmentRepository
 	recordRepo   *repository.MedicalRecordRepository
 	activityRepo *repository.ActivityRepository
+	bedRepo      *repository.BedRepository
+	analytics    *AnalyticsService
 }
 
 func NewDashboardService(
@@ -21,6 +23,8 @@ func NewDashboardService(
 	apptRepo *repository.AppointmentRepository,
 	recordRepo *repository.MedicalRecordRepository,
 	activityRepo *repository.ActivityRepository,
+	bedRepo *repository.BedRepository,
+	analytics *AnalyticsService,
 ) *DashboardService {
 	return &DashboardService{
 		patientRepo:  patientRepo,
@@ -28,10 +32,20 @@ func NewDashboardService(
 		apptRepo:     apptRepo,
 		recordRepo:   recordRepo,
 		activityRepo: activityRepo,
+		bedRepo:      bedRepo,
+		analytics:    analytics,
 	}
 }
 
-func (s *DashboardService) GetDashboardData(ctx context.Context) (*domain.DashboardResponse, error) {
+// GetDashboardData builds the dashboard. With a department given, the patient,
+// doctor and appointment figures only cover that department; patients count
+// when they have an appointment there.
+func (s *DashboardService) GetDashboardData(ctx context.Context, departmentID string) (*domain.DashboardResponse, error) {
+	departmentFilter, err := optionalObjectID(departmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
 	// Get counts in parallel
 	patientsCh := make(chan int64)
 	doctorsCh := make(chan int64)
@@ -41,7 +55,13 @@ func (s *DashboardService) GetDashboardData(ctx context.Context) (*domain.Dashbo
 
 	// Get patients count
 	go func() {
-		count, err := s.patientRepo.Count(ctx)
+		var count int64
+		var err error
+		if departmentFilter != nil {
+			count, err = s.apptRepo.CountPatients(ctx, *departmentFilter)
+		} else {
+			count, err = s.patientRepo.Count(ctx)
+		}
 		if err != nil {
 			errCh <- err
 			return
@@ -51,7 +71,7 @@ func (s *DashboardService) GetDashboardData(ctx context.Context) (*domain.Dashbo
 
 	// Get doctors count
 	go func() {
-		count, err := s.docRepo.Count(ctx)
+		count, err := s.docRepo.Count(ctx, departmentFilter)
 		if err != nil {
 			errCh <- err
 			return
@@ -61,7 +81,7 @@ func (s *DashboardService) GetDashboardData(ctx context.Context) (*domain.Dashbo
 
 	// Get appointments count
 	go func() {
-		count, err := s.apptRepo.GetAppointmentsCount(ctx)
+		count, err := s.apptRepo.Count(ctx, departmentFilter)
 		if err != nil {
 			errCh <- err
 			return
@@ -97,7 +117,7 @@ func (s *DashboardService) GetDashboardData(ctx context.Context) (*domain.Dashbo
 	}
 
 	// Get upcoming appointments
-	upcomingAppts, err := s.apptRepo.GetUpcomingAppointments(ctx, 5) // Get next 5 upcoming appointments
+	upcomingAppts, err := s.apptRepo.GetUpcomingAppointments(ctx, 5, departmentFilter) // Get next 5 upcoming appointments
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +149,22 @@ func (s *DashboardService) GetDashboardData(ctx context.Context) (*domain.Dashbo
 		}
 	}
 
+	// Get bed occupancy
+	bedCounts, err := s.bedRepo.CountByStatus(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rank the doctors over the default analytics range
+	utilization, err := s.analytics.GetDoctorUtilization(ctx, AnalyticsQuery{DepartmentID: departmentID})
+	if err != nil {
+		return nil, err
+	}
+	topDoctors := utilization.Doctors
+	if len(topDoctors) > 5 {
+		topDoctors = topDoctors[:5]
+	}
+
 	return &domain.DashboardResponse{
 		Stats: domain.DashboardStats{
 			PatientsCount:       patientsCount,
@@ -136,7 +172,9 @@ func (s *DashboardService) GetDashboardData(ctx context.Context) (*domain.Dashbo
 			AppointmentsCount:   apptsCount,
 			MedicalRecordsCount: recordsCount,
 		},
+		BedOccupancy:         domain.NewBedOccupancyStats(bedCounts),
 		RecentActivities:     recentActivitiesDTOs,
 		UpcomingAppointments: upcomingAppointments,
+		DoctorUtilization:    topDoctors,
 	}, nil
 }
@@ -8,31 +8,50 @@ import (
 	"github.com/ekastn/hms-api/internal/domain"
 	"github.com/ekastn/hms-api/internal/repository"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// recentPatientLimit is how many of a doctor's recent patients are shown.
+const recentPatientLimit = 10
+
 type DoctorService struct {
 	doctorRepo      *repository.DoctorRepository
 	appointmentRepo *repository.AppointmentRepository
 	patientRepo     *repository.PatientRepository
+	departmentRepo  *repository.DepartmentRepository
+	userRepo        *repository.UserRepository
 	activityService *ActivityService
+	mongoClient     *mongo.Client
 }
 
 func NewDoctorService(
 	repo *repository.DoctorRepository,
 	appointmentRepo *repository.AppointmentRepository,
 	patientRepo *repository.PatientRepository,
+	departmentRepo *repository.DepartmentRepository,
+	userRepo *repository.UserRepository,
 	activityService *ActivityService,
+	mongoClient *mongo.Client,
 ) *DoctorService {
 	return &DoctorService{
 		doctorRepo:      repo,
 		appointmentRepo: appointmentRepo,
 		patientRepo:     patientRepo,
+		departmentRepo:  departmentRepo,
+		userRepo:        userRepo,
 		activityService: activityService,
+		mongoClient:     mongoClient,
 	}
 }
 
-func (s *DoctorService) GetAll(ctx context.Context) ([]*domain.DoctorEntity, error) {
-	return s.doctorRepo.GetAll(ctx)
+// GetAll returns the doctors, optionally only those of a department.
+func (s *DoctorService) GetAll(ctx context.Context, departmentID string) ([]*domain.DoctorEntity, error) {
+	departmentFilter, err := optionalObjectID(departmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.doctorRepo.GetAll(ctx, departmentFilter)
 }
 
 func (s *DoctorService) GetByID(ctx context.Context, id string) (*domain.DoctorEntity, error) {
@@ -44,6 +63,31 @@ func (s *DoctorService) GetByID(ctx context.Context, id string) (*domain.DoctorE
 	return s.doctorRepo.GetByID(ctx, doctorID)
 }
 
+// GetByIDs returns the doctors with the given IDs by ID. Unknown IDs are
+// left out.
+func (s *DoctorService) GetByIDs(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*domain.DoctorEntity, error) {
+	doctors, err := s.doctorRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get doctors: %w", err)
+	}
+
+	byID := make(map[primitive.ObjectID]*domain.DoctorEntity, len(doctors))
+	for _, doctor := range doctors {
+		byID[doctor.ID] = doctor
+	}
+	return byID, nil
+}
+
+// GetRecentPatientIDs returns the IDs of the recent patients of each of the
+// given doctors, most recently seen first.
+func (s *DoctorService) GetRecentPatientIDs(ctx context.Context, doctorIDs []primitive.ObjectID) (map[primitive.ObjectID][]primitive.ObjectID, error) {
+	patientIDs, err := s.appointmentRepo.GetRecentPatientsByDoctorIDs(ctx, doctorIDs, recentPatientLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent patients: %w", err)
+	}
+	return patientIDs, nil
+}
+
 func (s *DoctorService) GetDoctorDetail(ctx context.Context, id string) (*domain.DoctorDetailResponse, error) {
 	// Get the doctor
 	doctorID, err := primitive.ObjectIDFromHex(id)
@@ -60,20 +104,25 @@ func (s *DoctorService) GetDoctorDetail(ctx context.Context, id string) (*domain
 	}
 
 	// Get recent patient IDs
-	patientIDs, err := s.appointmentRepo.GetRecentPatientsByDoctorID(ctx, doctorID, 10) // Last 10 patients
+	patientIDs, err := s.appointmentRepo.GetRecentPatientsByDoctorID(ctx, doctorID, recentPatientLimit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get recent patients: %w", err)
 	}
 
-	// Get patient details
+	// Get patient details in one query, keeping the most recent first
+	patients, err := s.patientRepo.GetByIDs(ctx, patientIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent patients: %w", err)
+	}
+	patientsByID := make(map[primitive.ObjectID]*domain.PatientEntity, len(patients))
+	for _, patient := range patients {
+		patientsByID[patient.ID] = patient
+	}
 	recentPatients := make([]*domain.PatientEntity, 0, len(patientIDs))
 	for _, pid := range patientIDs {
-		patient, err := s.patientRepo.GetByID(ctx, pid)
-		if err != nil {
-			// Log the error but continue with other patients
-			continue
+		if patient, ok := patientsByID[pid]; ok {
+			recentPatients = append(recentPatients, patient)
 		}
-		recentPatients = append(recentPatients, patient)
 	}
 
 	// Convert to detail DTO
@@ -91,6 +140,14 @@ func (s *DoctorService) Create(ctx context.Context, doctor *domain.DoctorEntity,
 		return "", err
 	}
 
+	if err := s.checkDepartment(ctx, doctor.DepartmentID); err != nil {
+		return "", err
+	}
+
+	if err := s.checkUser(ctx, doctor.UserID); err != nil {
+		return "", err
+	}
+
 	// Set timestamps
 	now := time.Now()
 	doctor.CreatedAt = now
@@ -98,16 +155,22 @@ func (s *DoctorService) Create(ctx context.Context, doctor *domain.DoctorEntity,
 	doctor.CreatedBy = creatorID
 	doctor.UpdatedBy = creatorID
 
-	// Create doctor
-	id, err := s.doctorRepo.Create(ctx, doctor)
-	if err != nil {
-		return "", fmt.Errorf("failed to create doctor: %w", err)
-	}
+	var id primitive.ObjectID
+	err := withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		var err error
+		id, err = s.doctorRepo.Create(sessionContext, doctor)
+		if err != nil {
+			return fmt.Errorf("failed to create doctor: %w", err)
+		}
 
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeDoctor, "New Doctor Added", fmt.Sprintf("Dr. %s (%s) has been added as a %s specialist.", doctor.Name, doctor.Email, doctor.Specialty))
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeDoctor, "New Doctor Added", fmt.Sprintf("Dr. %s (%s) has been added as a %s specialist.", doctor.Name, doctor.Email, doctor.Specialty))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for new doctor: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		// Log the error but don't block the doctor creation
-		fmt.Printf("Warning: failed to log activity for new doctor: %v\n", err)
+		return "", err
 	}
 
 	return id.Hex(), nil
@@ -142,23 +205,31 @@ func (s *DoctorService) Update(ctx context.Context, id string, doctor *domain.Do
 		return err
 	}
 
+	if err := s.checkDepartment(ctx, doctor.DepartmentID); err != nil {
+		return err
+	}
+
+	if err := s.checkUser(ctx, doctor.UserID); err != nil {
+		return err
+	}
+
 	// Preserve created_at and update updated_at
 	doctor.CreatedAt = existing.CreatedAt
 	doctor.UpdatedAt = time.Now()
 	doctor.CreatedBy = existing.CreatedBy
 	doctor.UpdatedBy = updaterID
 
-	if err := s.doctorRepo.Update(ctx, docID, doctor); err != nil {
-		return fmt.Errorf("failed to update doctor: %w", err)
-	}
-
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeDoctor, "Doctor Information Updated", fmt.Sprintf("Dr. %s (%s) information has been updated.", doctor.Name, doctor.Email))
-	if err != nil {
-		// Log the error but don't block the doctor update
-		fmt.Printf("Warning: failed to log activity for doctor update: %v\n", err)
-	}
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.doctorRepo.Update(sessionContext, docID, doctor); err != nil {
+			return fmt.Errorf("failed to update doctor: %w", err)
+		}
 
-	return nil
+		err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypeDoctor, "Doctor Information Updated", fmt.Sprintf("Dr. %s (%s) information has been updated.", doctor.Name, doctor.Email))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for doctor update: %w", err)
+		}
+		return nil
+	})
 }
 
 // checkDuplicateDoctor is a helper function to check for existing doctors by name, email, or phone.
@@ -193,6 +264,46 @@ func (s *DoctorService) checkDuplicateDoctor(ctx context.Context, doctor *domain
 	return nil
 }
 
+// checkDepartment makes sure the department a doctor is assigned to exists
+// and is active.
+func (s *DoctorService) checkDepartment(ctx context.Context, departmentID *primitive.ObjectID) error {
+	if departmentID == nil {
+		return nil
+	}
+
+	department, err := s.departmentRepo.GetByID(ctx, *departmentID)
+	if err != nil {
+		return fmt.Errorf("error checking department: %w", err)
+	}
+	if department == nil {
+		return fmt.Errorf("department not found")
+	}
+	if !department.IsActive {
+		return fmt.Errorf("department is not active")
+	}
+	return nil
+}
+
+// checkUser makes sure the account a doctor is linked to is an active
+// doctor account.
+func (s *DoctorService) checkUser(ctx context.Context, userID *primitive.ObjectID) error {
+	if userID == nil {
+		return nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, *userID)
+	if err != nil {
+		return fmt.Errorf("error checking user: %w", err)
+	}
+	if user == nil {
+		return fmt.Errorf("user not found")
+	}
+	if user.Role != domain.RoleDoctor {
+		return fmt.Errorf("user is not a doctor")
+	}
+	return nil
+}
+
 func (s *DoctorService) Delete(ctx context.Context, id string) error {
 	docID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -208,15 +319,15 @@ func (s *DoctorService) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("doctor not found")
 	}
 
-	if err := s.doctorRepo.Delete(ctx, docID); err != nil {
-		return fmt.Errorf("failed to delete doctor: %w", err)
-	}
-
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeDoctor, "Doctor Deleted", fmt.Sprintf("Doctor with ID %s has been deleted.", id))
-	if err != nil {
-		// Log the error but don't block the doctor deletion
-		fmt.Printf("Warning: failed to log activity for doctor deletion: %v\n", err)
-	}
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.doctorRepo.Delete(sessionContext, docID); err != nil {
+			return fmt.Errorf("failed to delete doctor: %w", err)
+		}
 
-	return nil
+		err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypeDoctor, "Doctor Deleted", fmt.Sprintf("Doctor with ID %s has been deleted.", id))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for doctor deletion: %w", err)
+		}
+		return nil
+	})
 }
@@ -0,0 +1,438 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/document"
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const documentAudience = "document-verification"
+
+// documentClaims are carried by the QR code of a printed document. The
+// digest covers the data the document was printed from, so verification can
+// tell whether the record has changed since.
+type documentClaims struct {
+	Kind    domain.DocumentKind `json:"kind"`
+	Ref     string              `json:"ref"`
+	Patient string              `json:"pat"`
+	Digest  string              `json:"dig"`
+	jwt.RegisteredClaims
+}
+
+// RenderedDocument is a printed document ready to be sent.
+type RenderedDocument struct {
+	FileName string
+	Content  []byte
+}
+
+// visitSummaryData fills the visit-summary template.
+type visitSummaryData struct {
+	domain.AppointmentDetailResponse
+	DoctorName string
+	Specialty  string
+}
+
+// patientHistoryData fills the patient-history template.
+type patientHistoryData struct {
+	domain.PatientDetailResponse
+	// Doctors maps the doctor IDs in the history to names.
+	Doctors map[string]string
+}
+
+// medicalRecordData fills the medical-record template.
+type medicalRecordData struct {
+	Record     domain.MedicalRecordDTO
+	DoctorName string
+	Specialty  string
+	Medication []dispensedMedication
+}
+
+type dispensedMedication struct {
+	Name        string
+	Strength    string
+	Quantity    int
+	Unit        string
+	BatchNumber string
+	Reference   string
+	DispensedAt time.Time
+}
+
+// DocumentService prints visit summaries, patient histories, medical records
+// and invoices as PDF, each with a QR code anyone can use to check that the
+// printout is genuine and still current.
+type DocumentService struct {
+	appointmentService *AppointmentService
+	patientService     *PatientService
+	patientRepo        *repository.PatientRepository
+	doctorRepo         *repository.DoctorRepository
+	recordRepo         *repository.MedicalRecordRepository
+	invoiceRepo        *repository.InvoiceRepository
+	movementRepo       *repository.StockMovementRepository
+	itemRepo           *repository.PharmacyItemRepository
+	userRepo           *repository.UserRepository
+	activityService    *ActivityService
+	renderer           *document.Renderer
+	issuer             string
+	secret             []byte
+	verifyURL          string
+}
+
+func NewDocumentService(
+	appointmentService *AppointmentService,
+	patientService *PatientService,
+	patientRepo *repository.PatientRepository,
+	doctorRepo *repository.DoctorRepository,
+	recordRepo *repository.MedicalRecordRepository,
+	invoiceRepo *repository.InvoiceRepository,
+	movementRepo *repository.StockMovementRepository,
+	itemRepo *repository.PharmacyItemRepository,
+	userRepo *repository.UserRepository,
+	activityService *ActivityService,
+	hospital document.Hospital,
+	location *time.Location,
+	secret string,
+	verifyURL string,
+) *DocumentService {
+	return &DocumentService{
+		appointmentService: appointmentService,
+		patientService:     patientService,
+		patientRepo:        patientRepo,
+		doctorRepo:         doctorRepo,
+		recordRepo:         recordRepo,
+		invoiceRepo:        invoiceRepo,
+		movementRepo:       movementRepo,
+		itemRepo:           itemRepo,
+		userRepo:           userRepo,
+		activityService:    activityService,
+		renderer:           document.NewRenderer(hospital, location),
+		issuer:             hospital.Name,
+		secret:             []byte(secret),
+		verifyURL:          verifyURL,
+	}
+}
+
+// Render prints the document of the kind for the appointment, patient,
+// medical record or invoice with the ID. It returns nil when there is no
+// such record.
+func (s *DocumentService) Render(ctx context.Context, kind domain.DocumentKind, id string, userID primitive.ObjectID) (*RenderedDocument, error) {
+	doc, ref, err := s.build(ctx, kind, id)
+	if err != nil || doc == nil {
+		return nil, err
+	}
+
+	digest, err := documentDigest(doc.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	claims := documentClaims{
+		Kind:    kind,
+		Ref:     ref,
+		Patient: maskName(doc.Patient.Name),
+		Digest:  digest,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       primitive.NewObjectID().Hex(),
+			Subject:  id,
+			Audience: jwt.ClaimStrings{documentAudience},
+			IssuedAt: jwt.NewNumericDate(now),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign document: %w", err)
+	}
+
+	doc.IssuedAt = now
+	doc.VerifyURL = s.verifyURL + "?token=" + url.QueryEscape(token)
+
+	var buf bytes.Buffer
+	if err := s.renderer.Render(&buf, doc); err != nil {
+		return nil, fmt.Errorf("failed to render document: %w", err)
+	}
+
+	issuer := userID.Hex()
+	if user, err := s.userRepo.GetByID(ctx, userID); err == nil && user != nil {
+		issuer = user.Name
+	}
+	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeDocument, "Document Printed", fmt.Sprintf("%s printed the %s %s of patient %s.", issuer, strings.ToLower(kind.Title()), ref, doc.Patient.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record document: %w", err)
+	}
+
+	return &RenderedDocument{
+		FileName: fmt.Sprintf("%s-%s.pdf", kind, ref),
+		Content:  buf.Bytes(),
+	}, nil
+}
+
+// Verify checks the token from a document's QR code. Only what is needed to
+// match the paper is returned, with the patient's name masked, since anyone
+// holding the document can scan it.
+func (s *DocumentService) Verify(ctx context.Context, tokenString string) (*domain.DocumentVerification, error) {
+	claims := &documentClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(documentAudience),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil || !claims.Kind.IsValid() || claims.IssuedAt == nil {
+		return nil, errors.New("invalid document code")
+	}
+
+	doc, _, err := s.build(ctx, claims.Kind, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	current := false
+	if doc != nil {
+		digest, err := documentDigest(doc.Data)
+		if err != nil {
+			return nil, err
+		}
+		current = digest == claims.Digest
+	}
+
+	return &domain.DocumentVerification{
+		DocumentID: claims.ID,
+		Kind:       claims.Kind,
+		Title:      claims.Kind.Title(),
+		Reference:  claims.Ref,
+		Patient:    claims.Patient,
+		Issuer:     s.issuer,
+		IssuedAt:   claims.IssuedAt.Time,
+		Current:    current,
+	}, nil
+}
+
+// build gathers the data of a document and returns it with the reference
+// printed in its file name, or nil when the record does not exist.
+func (s *DocumentService) build(ctx context.Context, kind domain.DocumentKind, id string) (*document.Document, string, error) {
+	switch kind {
+	case domain.DocumentVisitSummary:
+		return s.visitSummary(ctx, id)
+	case domain.DocumentPatientHistory:
+		return s.patientHistory(ctx, id)
+	case domain.DocumentMedicalRecord:
+		return s.medicalRecord(ctx, id)
+	case domain.DocumentInvoice:
+		return s.invoice(ctx, id)
+	}
+	return nil, "", fmt.Errorf("unknown document kind %q", kind)
+}
+
+func (s *DocumentService) visitSummary(ctx context.Context, id string) (*document.Document, string, error) {
+	detail, err := s.appointmentService.GetAppointmentDetail(ctx, id)
+	if err != nil || detail == nil {
+		return nil, "", err
+	}
+
+	data := visitSummaryData{AppointmentDetailResponse: *detail}
+	if doctor, err := s.doctor(ctx, detail.Appointment.DoctorID); err != nil {
+		return nil, "", err
+	} else if doctor != nil {
+		data.DoctorName, data.Specialty = doctor.Name, doctor.Specialty
+	}
+
+	doc := &document.Document{
+		Template: string(domain.DocumentVisitSummary),
+		Title:    domain.DocumentVisitSummary.Title(),
+		Data:     data,
+	}
+	if detail.Patient != nil {
+		doc.Patient = documentPatient(*detail.Patient)
+	}
+	return doc, detail.Appointment.ID, nil
+}
+
+func (s *DocumentService) patientHistory(ctx context.Context, id string) (*document.Document, string, error) {
+	patientID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid patient ID: %w", err)
+	}
+	if _, err := s.patientRepo.GetByID(ctx, patientID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, "", nil
+		}
+		return nil, "", fmt.Errorf("failed to get patient: %w", err)
+	}
+
+	detail, err := s.patientService.GetPatientDetail(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data := patientHistoryData{PatientDetailResponse: *detail, Doctors: map[string]string{}}
+	for _, record := range detail.MedicalHistory {
+		data.Doctors[record.DoctorID] = ""
+	}
+	for _, appointment := range detail.RecentAppointments {
+		data.Doctors[appointment.DoctorID] = ""
+	}
+	for doctorID := range data.Doctors {
+		doctor, err := s.doctor(ctx, doctorID)
+		if err != nil {
+			return nil, "", err
+		}
+		if doctor != nil {
+			data.Doctors[doctorID] = doctor.Name
+		}
+	}
+
+	return &document.Document{
+		Template: string(domain.DocumentPatientHistory),
+		Title:    domain.DocumentPatientHistory.Title(),
+		Patient:  documentPatient(detail.Patient),
+		Data:     data,
+	}, detail.Patient.ID, nil
+}
+
+func (s *DocumentService) medicalRecord(ctx context.Context, id string) (*document.Document, string, error) {
+	recordID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid medical record ID: %w", err)
+	}
+	record, err := s.recordRepo.FindByID(ctx, recordID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get medical record: %w", err)
+	}
+	if record == nil {
+		return nil, "", nil
+	}
+
+	data := medicalRecordData{Record: record.ToDTO()}
+	if doctor, err := s.doctor(ctx, record.DoctorID.Hex()); err != nil {
+		return nil, "", err
+	} else if doctor != nil {
+		data.DoctorName, data.Specialty = doctor.Name, doctor.Specialty
+	}
+
+	movements, err := s.movementRepo.GetDispensedForRecord(ctx, record.ID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get dispensed medication: %w", err)
+	}
+	items := map[primitive.ObjectID]*domain.PharmacyItemEntity{}
+	for _, m := range movements {
+		item, ok := items[m.ItemID]
+		if !ok {
+			if item, err = s.itemRepo.GetByID(ctx, m.ItemID); err != nil {
+				return nil, "", fmt.Errorf("failed to get pharmacy item: %w", err)
+			}
+			items[m.ItemID] = item
+		}
+
+		medication := dispensedMedication{
+			Name:        m.ItemID.Hex(),
+			Quantity:    -m.Quantity,
+			BatchNumber: m.BatchNumber,
+			Reference:   m.Reference,
+			DispensedAt: m.CreatedAt,
+		}
+		if item != nil {
+			medication.Name, medication.Strength, medication.Unit = item.Name, item.Strength, item.Unit
+		}
+		data.Medication = append(data.Medication, medication)
+	}
+
+	doc := &document.Document{
+		Template: string(domain.DocumentMedicalRecord),
+		Title:    domain.DocumentMedicalRecord.Title(),
+		Data:     data,
+	}
+	if err := s.setPatient(ctx, doc, record.PatientID); err != nil {
+		return nil, "", err
+	}
+	return doc, data.Record.ID, nil
+}
+
+func (s *DocumentService) invoice(ctx context.Context, id string) (*document.Document, string, error) {
+	invoiceID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid invoice ID: %w", err)
+	}
+	invoice, err := s.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get invoice: %w", err)
+	}
+	if invoice == nil {
+		return nil, "", nil
+	}
+
+	doc := &document.Document{
+		Template: string(domain.DocumentInvoice),
+		Title:    domain.DocumentInvoice.Title(),
+		Data:     invoice.ToDTO(),
+	}
+	if err := s.setPatient(ctx, doc, invoice.PatientID); err != nil {
+		return nil, "", err
+	}
+	return doc, invoice.Number, nil
+}
+
+// setPatient prints the identifiers of the patient on the document.
+func (s *DocumentService) setPatient(ctx context.Context, doc *document.Document, patientID primitive.ObjectID) error {
+	patient, err := s.patientRepo.GetByID(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			doc.Patient = document.Patient{ID: patientID.Hex()}
+			return nil
+		}
+		return fmt.Errorf("failed to get patient: %w", err)
+	}
+	doc.Patient = documentPatient(patient.ToDTO())
+	return nil
+}
+
+// doctor returns the doctor with the hex ID, or nil if there is none.
+func (s *DocumentService) doctor(ctx context.Context, id string) (*domain.DoctorEntity, error) {
+	doctorID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+	doctor, err := s.doctorRepo.GetByID(ctx, doctorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get doctor: %w", err)
+	}
+	return doctor, nil
+}
+
+func documentPatient(p domain.PatientDTO) document.Patient {
+	return document.Patient{ID: p.ID, Name: p.Name, Age: p.Age, Gender: p.Gender}
+}
+
+// documentDigest fingerprints the data a document is printed from.
+func documentDigest(data any) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to fingerprint document: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}
+
+// maskName keeps the first letter of each part of a name, e.g. "J*** D**".
+func maskName(name string) string {
+	parts := strings.Fields(name)
+	for i, part := range parts {
+		r := []rune(part)
+		parts[i] = string(r[0]) + strings.Repeat("*", len(r)-1)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/export"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Export is a table ready to be written as a file. The rows of list exports
+// are read from the database only while the file is written, one at a time.
+type Export struct {
+	// Name names the data set in the file name and in the audit log.
+	Name   string
+	Header []string
+	rows   func(ctx context.Context, emit func([]string) error) error
+}
+
+// NewTableExport returns an export of rows already in memory, such as an
+// analytics report.
+func NewTableExport(name string, header []string, rows [][]string) *Export {
+	return &Export{
+		Name:   name,
+		Header: header,
+		rows: func(ctx context.Context, emit func([]string) error) error {
+			for _, row := range rows {
+				if err := emit(row); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+type ExportService struct {
+	patientRepo     *repository.PatientRepository
+	appointmentRepo *repository.AppointmentRepository
+	recordRepo      *repository.MedicalRecordRepository
+	activityRepo    *repository.ActivityRepository
+	userRepo        *repository.UserRepository
+	activityService *ActivityService
+}
+
+func NewExportService(
+	patientRepo *repository.PatientRepository,
+	appointmentRepo *repository.AppointmentRepository,
+	recordRepo *repository.MedicalRecordRepository,
+	activityRepo *repository.ActivityRepository,
+	userRepo *repository.UserRepository,
+	activityService *ActivityService,
+) *ExportService {
+	return &ExportService{
+		patientRepo:     patientRepo,
+		appointmentRepo: appointmentRepo,
+		recordRepo:      recordRepo,
+		activityRepo:    activityRepo,
+		userRepo:        userRepo,
+		activityService: activityService,
+	}
+}
+
+// Write writes the export to w in the given format and records who exported
+// what in the activity log, whether or not the export finished.
+func (s *ExportService) Write(ctx context.Context, e *Export, format export.Format, w io.Writer, userID primitive.ObjectID) error {
+	rows, err := s.write(ctx, e, format, w)
+
+	exporter := userID.Hex()
+	if user, userErr := s.userRepo.GetByID(ctx, userID); userErr == nil && user != nil {
+		exporter = user.Name
+	}
+
+	title, description := "Data Exported", fmt.Sprintf("%s exported %d %s row(s) as %s.", exporter, rows, e.Name, strings.ToUpper(string(format)))
+	if err != nil {
+		title, description = "Data Export Failed", fmt.Sprintf("%s's %s export as %s failed after %d row(s): %v.", exporter, e.Name, strings.ToUpper(string(format)), rows, err)
+	}
+	if auditErr := s.activityService.CreateActivity(ctx, domain.ActivityTypeExport, title, description); auditErr != nil {
+		return fmt.Errorf("failed to record export: %w", auditErr)
+	}
+
+	return err
+}
+
+// write writes the header and rows of the export and returns how many rows,
+// not counting the header, were written.
+func (s *ExportService) write(ctx context.Context, e *Export, format export.Format, w io.Writer) (int, error) {
+	rw, err := export.NewRowWriter(format, w, e.Name)
+	if err != nil {
+		return 0, err
+	}
+	if err := rw.Write(e.Header); err != nil {
+		return 0, err
+	}
+
+	rows := 0
+	err = e.rows(ctx, func(row []string) error {
+		if err := rw.Write(row); err != nil {
+			return err
+		}
+		rows++
+		return nil
+	})
+	if err != nil {
+		return rows, err
+	}
+
+	return rows, rw.Close()
+}
+
+// PatientExport exports the patients the patient list returns.
+func (s *ExportService) PatientExport() *Export {
+	return &Export{
+		Name:   "patients",
+		Header: []string{"id", "name", "age", "gender", "phone", "email", "address", "last_visit", "created_at"},
+		rows: func(ctx context.Context, emit func([]string) error) error {
+			return s.patientRepo.ForEach(ctx, func(p *domain.PatientEntity) error {
+				return emit([]string{
+					p.ID.Hex(),
+					p.Name,
+					strconv.Itoa(p.Age),
+					p.Gender,
+					p.Phone,
+					p.Email,
+					p.Address,
+					formatExportTime(p.LastVisit),
+					formatExportTime(p.CreatedAt),
+				})
+			})
+		},
+	}
+}
+
+// AppointmentExport exports the appointments the appointment list returns,
+// optionally only those of a department.
+func (s *ExportService) AppointmentExport(departmentID string) (*Export, error) {
+	departmentFilter, err := optionalObjectID(departmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Export{
+		Name:   "appointments",
+		Header: []string{"id", "patient_id", "doctor_id", "type", "date_time", "duration_minutes", "status", "location", "room_id", "department_id", "notes", "created_at"},
+		rows: func(ctx context.Context, emit func([]string) error) error {
+			return s.appointmentRepo.ForEach(ctx, departmentFilter, func(a *domain.AppointmentEntity) error {
+				return emit([]string{
+					a.ID.Hex(),
+					a.PatientID.Hex(),
+					a.DoctorID.Hex(),
+					string(a.Type),
+					formatExportTime(a.DateTime),
+					strconv.Itoa(a.Duration),
+					string(a.Status),
+					a.Location,
+					optionalHex(a.RoomID),
+					optionalHex(a.DepartmentID),
+					a.Notes,
+					formatExportTime(a.CreatedAt),
+				})
+			})
+		},
+	}, nil
+}
+
+// MedicalRecordExport exports the medical records the record list returns.
+func (s *ExportService) MedicalRecordExport() *Export {
+	return &Export{
+		Name:   "medical-records",
+		Header: []string{"id", "patient_id", "doctor_id", "date", "record_type", "description", "diagnosis", "treatment", "notes", "created_at"},
+		rows: func(ctx context.Context, emit func([]string) error) error {
+			return s.recordRepo.ForEach(ctx, func(r *domain.MedicalRecordEntity) error {
+				return emit([]string{
+					r.ID.Hex(),
+					r.PatientID.Hex(),
+					r.DoctorID.Hex(),
+					formatExportTime(r.Date),
+					string(r.RecordType),
+					r.Description,
+					r.Diagnosis,
+					r.Treatment,
+					r.Notes,
+					formatExportTime(r.CreatedAt),
+				})
+			})
+		},
+	}
+}
+
+// ActivityExport exports the activity log.
+func (s *ExportService) ActivityExport() *Export {
+	return &Export{
+		Name:   "activities",
+		Header: []string{"id", "type", "title", "description", "timestamp"},
+		rows: func(ctx context.Context, emit func([]string) error) error {
+			return s.activityRepo.ForEach(ctx, func(a *domain.ActivityEntity) error {
+				return emit([]string{
+					a.ID.Hex(),
+					string(a.Type),
+					a.Title,
+					a.Description,
+					formatExportTime(a.Timestamp),
+				})
+			})
+		},
+	}
+}
+
+// formatExportTime writes times as RFC 3339 in UTC, and zero times as blank.
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func optionalHex(id *primitive.ObjectID) string {
+	if id == nil {
+		return ""
+	}
+	return id.Hex()
+}
@@ -0,0 +1,416 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FacilityService manages departments, their clinics and the clinic rooms
+// appointments are booked into.
+type FacilityService struct {
+	departmentRepo  *repository.DepartmentRepository
+	clinicRepo      *repository.ClinicRepository
+	roomRepo        *repository.ClinicRoomRepository
+	appointmentRepo *repository.AppointmentRepository
+	location        *time.Location
+}
+
+func NewFacilityService(
+	departmentRepo *repository.DepartmentRepository,
+	clinicRepo *repository.ClinicRepository,
+	roomRepo *repository.ClinicRoomRepository,
+	appointmentRepo *repository.AppointmentRepository,
+	location *time.Location,
+) *FacilityService {
+	return &FacilityService{
+		departmentRepo:  departmentRepo,
+		clinicRepo:      clinicRepo,
+		roomRepo:        roomRepo,
+		appointmentRepo: appointmentRepo,
+		location:        location,
+	}
+}
+
+func (s *FacilityService) GetAllDepartments(ctx context.Context) ([]*domain.DepartmentEntity, error) {
+	return s.departmentRepo.GetAll(ctx)
+}
+
+func (s *FacilityService) GetDepartmentByID(ctx context.Context, id string) (*domain.DepartmentEntity, error) {
+	departmentID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	department, err := s.departmentRepo.GetByID(ctx, departmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get department: %w", err)
+	}
+
+	return department, nil
+}
+
+func (s *FacilityService) CreateDepartment(ctx context.Context, req *domain.DepartmentRequest, creatorID primitive.ObjectID) (string, error) {
+	existing, err := s.departmentRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return "", fmt.Errorf("error checking department code: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("department with this code already exists")
+	}
+
+	department := &domain.DepartmentEntity{
+		Name:        req.Name,
+		Code:        req.Code,
+		Description: req.Description,
+		IsActive:    true,
+		CreatedBy:   creatorID,
+		UpdatedBy:   creatorID,
+	}
+	if req.IsActive != nil {
+		department.IsActive = *req.IsActive
+	}
+
+	id, err := s.departmentRepo.Create(ctx, department)
+	if err != nil {
+		return "", fmt.Errorf("failed to create department: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *FacilityService) UpdateDepartment(ctx context.Context, id string, req *domain.DepartmentRequest, updaterID primitive.ObjectID) error {
+	department, err := s.GetDepartmentByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if department == nil {
+		return errors.New("department not found")
+	}
+
+	existing, err := s.departmentRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return fmt.Errorf("error checking department code: %w", err)
+	}
+	if existing != nil && existing.ID != department.ID {
+		return errors.New("department with this code already exists")
+	}
+
+	department.Name = req.Name
+	department.Code = req.Code
+	department.Description = req.Description
+	if req.IsActive != nil {
+		department.IsActive = *req.IsActive
+	}
+	department.UpdatedBy = updaterID
+
+	if err := s.departmentRepo.Update(ctx, department.ID, department); err != nil {
+		return fmt.Errorf("failed to update department: %w", err)
+	}
+
+	return nil
+}
+
+// GetAllClinics returns the clinics, optionally only those of a department.
+func (s *FacilityService) GetAllClinics(ctx context.Context, departmentID string) ([]*domain.ClinicEntity, error) {
+	departmentFilter, err := optionalObjectID(departmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.clinicRepo.GetAll(ctx, departmentFilter)
+}
+
+func (s *FacilityService) GetClinicByID(ctx context.Context, id string) (*domain.ClinicEntity, error) {
+	clinicID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	clinic, err := s.clinicRepo.GetByID(ctx, clinicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clinic: %w", err)
+	}
+
+	return clinic, nil
+}
+
+func (s *FacilityService) CreateClinic(ctx context.Context, req *domain.ClinicRequest, creatorID primitive.ObjectID) (string, error) {
+	department, err := s.GetDepartmentByID(ctx, req.DepartmentID)
+	if err != nil {
+		return "", err
+	}
+	if department == nil {
+		return "", errors.New("department not found")
+	}
+
+	existing, err := s.clinicRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return "", fmt.Errorf("error checking clinic code: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("clinic with this code already exists")
+	}
+
+	clinic := &domain.ClinicEntity{
+		DepartmentID:   department.ID,
+		Name:           req.Name,
+		Code:           req.Code,
+		Location:       req.Location,
+		OperatingHours: req.OperatingHours,
+		IsActive:       true,
+		CreatedBy:      creatorID,
+		UpdatedBy:      creatorID,
+	}
+	if req.IsActive != nil {
+		clinic.IsActive = *req.IsActive
+	}
+
+	id, err := s.clinicRepo.Create(ctx, clinic)
+	if err != nil {
+		return "", fmt.Errorf("failed to create clinic: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *FacilityService) UpdateClinic(ctx context.Context, id string, req *domain.ClinicRequest, updaterID primitive.ObjectID) error {
+	clinic, err := s.GetClinicByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if clinic == nil {
+		return errors.New("clinic not found")
+	}
+
+	department, err := s.GetDepartmentByID(ctx, req.DepartmentID)
+	if err != nil {
+		return err
+	}
+	if department == nil {
+		return errors.New("department not found")
+	}
+
+	existing, err := s.clinicRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return fmt.Errorf("error checking clinic code: %w", err)
+	}
+	if existing != nil && existing.ID != clinic.ID {
+		return errors.New("clinic with this code already exists")
+	}
+
+	moved := clinic.DepartmentID != department.ID
+
+	clinic.DepartmentID = department.ID
+	clinic.Name = req.Name
+	clinic.Code = req.Code
+	clinic.Location = req.Location
+	clinic.OperatingHours = req.OperatingHours
+	if req.IsActive != nil {
+		clinic.IsActive = *req.IsActive
+	}
+	clinic.UpdatedBy = updaterID
+
+	if err := s.clinicRepo.Update(ctx, clinic.ID, clinic); err != nil {
+		return fmt.Errorf("failed to update clinic: %w", err)
+	}
+
+	// The rooms follow their clinic to the new department
+	if moved {
+		if err := s.roomRepo.SetDepartmentByClinic(ctx, clinic.ID, department.ID); err != nil {
+			return fmt.Errorf("failed to move clinic rooms: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetAllRooms returns the clinic rooms, optionally only those of a clinic or
+// department.
+func (s *FacilityService) GetAllRooms(ctx context.Context, clinicID, departmentID string) ([]*domain.ClinicRoomEntity, error) {
+	clinicFilter, err := optionalObjectID(clinicID, "clinic")
+	if err != nil {
+		return nil, err
+	}
+	departmentFilter, err := optionalObjectID(departmentID, "department")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.roomRepo.GetAll(ctx, clinicFilter, departmentFilter)
+}
+
+func (s *FacilityService) GetRoomByID(ctx context.Context, id string) (*domain.ClinicRoomEntity, error) {
+	roomID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	room, err := s.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room: %w", err)
+	}
+
+	return room, nil
+}
+
+func (s *FacilityService) CreateRoom(ctx context.Context, req *domain.ClinicRoomRequest, creatorID primitive.ObjectID) (string, error) {
+	clinic, err := s.GetClinicByID(ctx, req.ClinicID)
+	if err != nil {
+		return "", err
+	}
+	if clinic == nil {
+		return "", errors.New("clinic not found")
+	}
+
+	existing, err := s.roomRepo.GetByClinicAndName(ctx, clinic.ID, req.Name)
+	if err != nil {
+		return "", fmt.Errorf("error checking room name: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("room with this name already exists in the clinic")
+	}
+
+	room := &domain.ClinicRoomEntity{
+		ClinicID:       clinic.ID,
+		DepartmentID:   clinic.DepartmentID,
+		Name:           req.Name,
+		Capacity:       req.Capacity,
+		OperatingHours: req.OperatingHours,
+		IsActive:       true,
+		CreatedBy:      creatorID,
+		UpdatedBy:      creatorID,
+	}
+	if req.IsActive != nil {
+		room.IsActive = *req.IsActive
+	}
+
+	id, err := s.roomRepo.Create(ctx, room)
+	if err != nil {
+		return "", fmt.Errorf("failed to create room: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *FacilityService) UpdateRoom(ctx context.Context, id string, req *domain.ClinicRoomRequest, updaterID primitive.ObjectID) error {
+	room, err := s.GetRoomByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if room == nil {
+		return errors.New("room not found")
+	}
+
+	clinic, err := s.GetClinicByID(ctx, req.ClinicID)
+	if err != nil {
+		return err
+	}
+	if clinic == nil {
+		return errors.New("clinic not found")
+	}
+
+	existing, err := s.roomRepo.GetByClinicAndName(ctx, clinic.ID, req.Name)
+	if err != nil {
+		return fmt.Errorf("error checking room name: %w", err)
+	}
+	if existing != nil && existing.ID != room.ID {
+		return errors.New("room with this name already exists in the clinic")
+	}
+
+	room.ClinicID = clinic.ID
+	room.DepartmentID = clinic.DepartmentID
+	room.Name = req.Name
+	room.Capacity = req.Capacity
+	room.OperatingHours = req.OperatingHours
+	if req.IsActive != nil {
+		room.IsActive = *req.IsActive
+	}
+	room.UpdatedBy = updaterID
+
+	if err := s.roomRepo.Update(ctx, room.ID, room); err != nil {
+		return fmt.Errorf("failed to update room: %w", err)
+	}
+
+	return nil
+}
+
+// ReserveRoom checks that an appointment can take the room for [start, end):
+// the room, its clinic and its department are active, the slot lies within
+// the room's operating hours (or the clinic's when the room has none), and
+// fewer than Capacity other appointments overlap it. Any overlap counts
+// against the capacity, even when those appointments do not overlap each
+// other. The appointment being moved, if any, is left out of the count.
+func (s *FacilityService) ReserveRoom(ctx context.Context, roomID primitive.ObjectID, start, end time.Time, appointmentID primitive.ObjectID) (*domain.ClinicRoomEntity, *domain.ClinicEntity, error) {
+	room, err := s.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get room: %w", err)
+	}
+	if room == nil {
+		return nil, nil, errors.New("room not found")
+	}
+
+	clinic, err := s.clinicRepo.GetByID(ctx, room.ClinicID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get clinic: %w", err)
+	}
+	if clinic == nil {
+		return nil, nil, errors.New("clinic of the room not found")
+	}
+
+	department, err := s.departmentRepo.GetByID(ctx, room.DepartmentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get department: %w", err)
+	}
+	if department == nil {
+		return nil, nil, errors.New("department of the room not found")
+	}
+
+	if !room.IsActive || !clinic.IsActive || !department.IsActive {
+		return nil, nil, errors.New("room is not available for booking")
+	}
+
+	hours := room.OperatingHours
+	if len(hours) == 0 {
+		hours = clinic.OperatingHours
+	}
+	if !domain.OpenDuring(hours, start.In(s.location), end.In(s.location)) {
+		return nil, nil, errors.New("room is closed at the requested time")
+	}
+
+	existing, err := s.appointmentRepo.GetByRoomAndDateRange(ctx, room.ID, start, end)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check room bookings: %w", err)
+	}
+
+	booked := 0
+	for _, a := range existing {
+		if a.ID != appointmentID && a.Status != domain.AppointmentStatusCancelled {
+			booked++
+		}
+	}
+	if booked >= room.Capacity {
+		return nil, nil, errors.New("room is fully booked at the requested time")
+	}
+
+	return room, clinic, nil
+}
+
+// optionalObjectID parses an optional ID filter; an empty string means no
+// filter.
+func optionalObjectID(hex, name string) (*primitive.ObjectID, error) {
+	if hex == "" {
+		return nil, nil
+	}
+
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s ID format: %w", name, err)
+	}
+	return &id, nil
+}
@@ -0,0 +1,529 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/fhir"
+	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/ekastn/hms-api/internal/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// FHIRService serves the FHIR R4 facade: patients, doctors, appointments and
+// medical records read, searched and created as FHIR resources. Creates go
+// through the same validation and services as the REST API. Errors the
+// client can fix are *fhir.Error.
+type FHIRService struct {
+	mapper             *fhir.Mapper
+	patientRepo        *repository.PatientRepository
+	doctorRepo         *repository.DoctorRepository
+	appointmentRepo    *repository.AppointmentRepository
+	recordRepo         *repository.MedicalRecordRepository
+	patientService     *PatientService
+	doctorService      *DoctorService
+	appointmentService *AppointmentService
+	recordService      *MedicalRecordService
+	startedAt          time.Time
+}
+
+func NewFHIRService(
+	mapper *fhir.Mapper,
+	patientRepo *repository.PatientRepository,
+	doctorRepo *repository.DoctorRepository,
+	appointmentRepo *repository.AppointmentRepository,
+	recordRepo *repository.MedicalRecordRepository,
+	patientService *PatientService,
+	doctorService *DoctorService,
+	appointmentService *AppointmentService,
+	recordService *MedicalRecordService,
+) *FHIRService {
+	return &FHIRService{
+		mapper:             mapper,
+		patientRepo:        patientRepo,
+		doctorRepo:         doctorRepo,
+		appointmentRepo:    appointmentRepo,
+		recordRepo:         recordRepo,
+		patientService:     patientService,
+		doctorService:      doctorService,
+		appointmentService: appointmentService,
+		recordService:      recordService,
+		startedAt:          time.Now(),
+	}
+}
+
+func (s *FHIRService) Capability() *fhir.CapabilityStatement {
+	return s.mapper.CapabilityStatement(s.startedAt)
+}
+
+// Read returns the resource of the type with the ID.
+func (s *FHIRService) Read(ctx context.Context, resourceType, id string) (any, error) {
+	if !fhir.Supports(resourceType, "read") {
+		return nil, fhir.NotSupported("%s is not supported", resourceType)
+	}
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fhir.NotFound(resourceType, id)
+	}
+
+	resource, err := s.read(ctx, resourceType, objID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return nil, fhir.NotFound(resourceType, id)
+	}
+	return resource, nil
+}
+
+// read returns the resource, or nil when there is none.
+func (s *FHIRService) read(ctx context.Context, resourceType string, id primitive.ObjectID) (any, error) {
+	switch resourceType {
+	case fhir.TypePatient:
+		patient, err := s.patientRepo.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to get patient: %w", err)
+		}
+		return s.mapper.Patient(patient), nil
+
+	case fhir.TypePractitioner:
+		doctor, err := s.doctorRepo.GetByID(ctx, id)
+		if err != nil || doctor == nil {
+			return nil, wrapErr("failed to get doctor", err)
+		}
+		return s.mapper.Practitioner(doctor), nil
+
+	case fhir.TypeAppointment:
+		appointment, err := s.appointmentRepo.GetByID(ctx, id)
+		if err != nil || appointment == nil {
+			return nil, wrapErr("failed to get appointment", err)
+		}
+		return s.mapper.Appointment(appointment), nil
+
+	case fhir.TypeEncounter, fhir.TypeCondition:
+		record, err := s.recordRepo.FindByID(ctx, id)
+		if err != nil || record == nil || record.IsDeleted {
+			return nil, wrapErr("failed to get medical record", err)
+		}
+		if resourceType == fhir.TypeCondition {
+			return s.mapper.Condition(record), nil
+		}
+		return s.mapper.Encounter(record), nil
+	}
+	return nil, nil
+}
+
+// fhirSearch is what a search asks for. A nil ID pointer means any, and a
+// zero from or to leaves that end of the date range open.
+type fhirSearch struct {
+	id        *primitive.ObjectID
+	name      string
+	patientID *primitive.ObjectID
+	doctorID  *primitive.ObjectID
+	from, to  time.Time
+	count     int
+	// none is set when a parameter can match nothing, such as an
+	// identifier of another system.
+	none bool
+}
+
+// Search returns the resources of the type matching the search parameters
+// as a searchset bundle. query is the search's query string, for the self
+// link. Parameters the facade does not know are ignored.
+func (s *FHIRService) Search(ctx context.Context, resourceType string, params map[string][]string, query string) (*fhir.Bundle, error) {
+	if !fhir.Supports(resourceType, "search-type") {
+		return nil, fhir.NotSupported("%s is not supported", resourceType)
+	}
+
+	search, err := s.parseSearch(resourceType, params)
+	if err != nil {
+		return nil, err
+	}
+
+	self := s.mapper.BaseURL() + "/" + resourceType
+	if query != "" {
+		self += "?" + query
+	}
+
+	entries := []fhir.BundleEntry{}
+	if search.none {
+		return s.mapper.SearchSet(self, entries), nil
+	}
+
+	add := func(id primitive.ObjectID, resource any) {
+		entries = append(entries, s.mapper.Entry(resourceType, id.Hex(), resource))
+	}
+
+	switch resourceType {
+	case fhir.TypePatient:
+		patients, err := s.searchPatients(ctx, search)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range patients {
+			add(p.ID, s.mapper.Patient(p))
+		}
+
+	case fhir.TypePractitioner:
+		doctors, err := s.searchDoctors(ctx, search)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range doctors {
+			add(d.ID, s.mapper.Practitioner(d))
+		}
+
+	case fhir.TypeAppointment:
+		appointments, err := s.searchAppointments(ctx, search)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range appointments {
+			add(a.ID, s.mapper.Appointment(a))
+		}
+
+	case fhir.TypeEncounter, fhir.TypeCondition:
+		records, err := s.searchRecords(ctx, search)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			if resourceType == fhir.TypeCondition {
+				add(r.ID, s.mapper.Condition(r))
+			} else {
+				add(r.ID, s.mapper.Encounter(r))
+			}
+		}
+	}
+
+	return s.mapper.SearchSet(self, entries), nil
+}
+
+func (s *FHIRService) parseSearch(resourceType string, params map[string][]string) (*fhirSearch, error) {
+	search := &fhirSearch{count: fhir.DefaultCount}
+	first := func(name string) string {
+		if values := params[name]; len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	// objectID parses an ID parameter; one that is not an ObjectID matches
+	// nothing.
+	objectID := func(id string) *primitive.ObjectID {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			search.none = true
+			return nil
+		}
+		return &objID
+	}
+
+	if count := first("_count"); count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 1 {
+			return nil, fhir.Invalid("_count must be a positive number")
+		}
+		search.count = min(n, fhir.MaxCount)
+	}
+
+	if id := first("_id"); id != "" {
+		search.id = objectID(id)
+	}
+	if identifier := first("identifier"); identifier != "" {
+		id, ok := s.mapper.ParseIdentifier(resourceType, identifier)
+		if !ok {
+			search.none = true
+		} else if objID := objectID(id); objID != nil {
+			if search.id != nil && *search.id != *objID {
+				search.none = true
+			}
+			search.id = objID
+		}
+	}
+
+	dateParam := ""
+	switch resourceType {
+	case fhir.TypePatient, fhir.TypePractitioner:
+		search.name = first("name")
+		if search.name == "" {
+			search.name = first("name:contains")
+		}
+	case fhir.TypeAppointment:
+		dateParam = "date"
+		if patient := first("patient"); patient != "" {
+			search.patientID = objectID(fhir.ParseReference(fhir.TypePatient, patient))
+		}
+		if practitioner := first("practitioner"); practitioner != "" {
+			search.doctorID = objectID(fhir.ParseReference(fhir.TypePractitioner, practitioner))
+		}
+	case fhir.TypeEncounter, fhir.TypeCondition:
+		dateParam = "date"
+		if resourceType == fhir.TypeCondition {
+			dateParam = "recorded-date"
+		}
+		patient := first("patient")
+		if patient == "" {
+			patient = first("subject")
+		}
+		if patient != "" {
+			search.patientID = objectID(fhir.ParseReference(fhir.TypePatient, patient))
+		}
+	}
+
+	if dateParam != "" && len(params[dateParam]) > 0 {
+		from, to, err := fhir.ParseDateRange(params[dateParam])
+		if err != nil {
+			return nil, err
+		}
+		search.from, search.to = from, to
+		if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+			search.none = true
+		}
+	}
+	return search, nil
+}
+
+// inRange reports whether t is in the search's date range.
+func (q *fhirSearch) inRange(t time.Time) bool {
+	return (q.from.IsZero() || !t.Before(q.from)) && (q.to.IsZero() || t.Before(q.to))
+}
+
+func sameID(want *primitive.ObjectID, id primitive.ObjectID) bool {
+	return want == nil || *want == id
+}
+
+func nameMatches(name, search string) bool {
+	return strings.Contains(strings.ToLower(name), strings.ToLower(search))
+}
+
+// The search functions below look an ID search up directly and check it
+// against the other parameters, and run every other search in Mongo.
+
+func (s *FHIRService) searchPatients(ctx context.Context, q *fhirSearch) ([]*domain.PatientEntity, error) {
+	if q.id == nil {
+		patients, err := s.patientRepo.Search(ctx, q.name, q.count)
+		return patients, wrapErr("failed to search patients", err)
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, *q.id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get patient: %w", err)
+	}
+	if patient.IsDeleted || !nameMatches(patient.Name, q.name) {
+		return nil, nil
+	}
+	return []*domain.PatientEntity{patient}, nil
+}
+
+func (s *FHIRService) searchDoctors(ctx context.Context, q *fhirSearch) ([]*domain.DoctorEntity, error) {
+	if q.id == nil {
+		doctors, err := s.doctorRepo.Search(ctx, q.name, q.count)
+		return doctors, wrapErr("failed to search doctors", err)
+	}
+
+	doctor, err := s.doctorRepo.GetByID(ctx, *q.id)
+	if err != nil || doctor == nil {
+		return nil, wrapErr("failed to get doctor", err)
+	}
+	if doctor.IsDeleted || !nameMatches(doctor.Name, q.name) {
+		return nil, nil
+	}
+	return []*domain.DoctorEntity{doctor}, nil
+}
+
+func (s *FHIRService) searchAppointments(ctx context.Context, q *fhirSearch) ([]*domain.AppointmentEntity, error) {
+	if q.id == nil {
+		appointments, err := s.appointmentRepo.Search(ctx, q.patientID, q.doctorID, q.from, q.to, q.count)
+		return appointments, wrapErr("failed to search appointments", err)
+	}
+
+	appointment, err := s.appointmentRepo.GetByID(ctx, *q.id)
+	if err != nil || appointment == nil {
+		return nil, wrapErr("failed to get appointment", err)
+	}
+	if !sameID(q.patientID, appointment.PatientID) || !sameID(q.doctorID, appointment.DoctorID) || !q.inRange(appointment.DateTime) {
+		return nil, nil
+	}
+	return []*domain.AppointmentEntity{appointment}, nil
+}
+
+func (s *FHIRService) searchRecords(ctx context.Context, q *fhirSearch) ([]*domain.MedicalRecordEntity, error) {
+	if q.id == nil {
+		records, err := s.recordRepo.Search(ctx, q.patientID, q.from, q.to, q.count)
+		return records, wrapErr("failed to search medical records", err)
+	}
+
+	record, err := s.recordRepo.FindByID(ctx, *q.id)
+	if err != nil || record == nil {
+		return nil, wrapErr("failed to get medical record", err)
+	}
+	if record.IsDeleted || !sameID(q.patientID, record.PatientID) || !q.inRange(record.Date) {
+		return nil, nil
+	}
+	return []*domain.MedicalRecordEntity{record}, nil
+}
+
+// Location returns the URL of a resource.
+func (s *FHIRService) Location(resourceType, id string) string {
+	return s.mapper.BaseURL() + "/" + resourceType + "/" + id
+}
+
+// Create creates the resource in body and returns its ID. The resource is
+// validated like the create request of the REST API; its id, if any, is
+// ignored.
+func (s *FHIRService) Create(ctx context.Context, resourceType string, body []byte, creatorID primitive.ObjectID) (string, error) {
+	if !fhir.Supports(resourceType, "create") {
+		return "", fhir.NotSupported("%s cannot be created", resourceType)
+	}
+
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(body, &header); err != nil {
+		return "", fhir.Invalid("invalid JSON: %v", err)
+	}
+	if header.ResourceType != resourceType {
+		return "", fhir.Invalid("expected a %s resource, got %q", resourceType, header.ResourceType)
+	}
+
+	switch resourceType {
+	case fhir.TypePatient:
+		var patient fhir.Patient
+		if err := json.Unmarshal(body, &patient); err != nil {
+			return "", fhir.Invalid("invalid Patient: %v", err)
+		}
+		return s.createPatient(ctx, s.mapper.PatientRequest(&patient, time.Now()), creatorID)
+
+	case fhir.TypePractitioner:
+		var practitioner fhir.Practitioner
+		if err := json.Unmarshal(body, &practitioner); err != nil {
+			return "", fhir.Invalid("invalid Practitioner: %v", err)
+		}
+		return s.createDoctor(ctx, s.mapper.PractitionerRequest(&practitioner), creatorID)
+
+	case fhir.TypeAppointment:
+		var appointment fhir.Appointment
+		if err := json.Unmarshal(body, &appointment); err != nil {
+			return "", fhir.Invalid("invalid Appointment: %v", err)
+		}
+		req, err := s.mapper.AppointmentRequest(&appointment)
+		if err != nil {
+			return "", fhir.Invalid("%v", err)
+		}
+		if err := validateFHIR(req); err != nil {
+			return "", err
+		}
+		return s.appointmentService.Create(ctx, &req, creatorID)
+
+	case fhir.TypeEncounter:
+		var encounter fhir.Encounter
+		if err := json.Unmarshal(body, &encounter); err != nil {
+			return "", fhir.Invalid("invalid Encounter: %v", err)
+		}
+		req, date, err := s.mapper.EncounterRequest(&encounter)
+		if err != nil {
+			return "", fhir.Invalid("%v", err)
+		}
+		return s.createRecord(ctx, req, date, creatorID)
+	}
+	return "", fhir.NotSupported("%s cannot be created", resourceType)
+}
+
+func (s *FHIRService) createPatient(ctx context.Context, req domain.CreatePatientRequest, creatorID primitive.ObjectID) (string, error) {
+	if err := validateFHIR(req); err != nil {
+		return "", err
+	}
+
+	patient := domain.PatientEntity{
+		Name:      req.Name,
+		Age:       req.Age,
+		Gender:    req.Gender,
+		Phone:     req.Phone,
+		Email:     req.Email,
+		Address:   req.Address,
+		CreatedBy: creatorID,
+		UpdatedBy: creatorID,
+	}
+	return s.patientService.Create(ctx, &patient)
+}
+
+func (s *FHIRService) createDoctor(ctx context.Context, req domain.CreateDoctorRequet, creatorID primitive.ObjectID) (string, error) {
+	if err := validateFHIR(req); err != nil {
+		return "", err
+	}
+
+	doctor := domain.DoctorEntity{
+		Name:         req.Name,
+		Specialty:    req.Specialty,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		Availability: []domain.TimeSlot{},
+	}
+	return s.doctorService.Create(ctx, &doctor, creatorID)
+}
+
+// createRecord creates the medical record of an encounter, dated now when
+// the encounter has no start.
+func (s *FHIRService) createRecord(ctx context.Context, req domain.CreateMedicalRecordRequest, date time.Time, creatorID primitive.ObjectID) (string, error) {
+	if err := validateFHIR(req); err != nil {
+		return "", err
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	// validated above
+	patientID, _ := primitive.ObjectIDFromHex(req.PatientID)
+	doctorID, _ := primitive.ObjectIDFromHex(req.DoctorID)
+
+	record := &domain.MedicalRecordEntity{
+		PatientID:   patientID,
+		DoctorID:    doctorID,
+		Date:        date,
+		RecordType:  domain.MedicalRecordType(req.RecordType),
+		Description: req.Description,
+		Diagnosis:   req.Diagnosis,
+		Treatment:   req.Treatment,
+		Notes:       req.Notes,
+		CreatedBy:   creatorID,
+		UpdatedBy:   creatorID,
+	}
+	return s.recordService.Create(ctx, record)
+}
+
+// validateFHIR validates a request mapped from a resource, naming the fields
+// of the REST request in the diagnostics.
+func validateFHIR(req any) error {
+	validationErrors := utils.ValidateStruct(req)
+	if len(validationErrors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(validationErrors))
+	for _, e := range validationErrors {
+		messages = append(messages, e.Message)
+	}
+	return fhir.Invalid("%s", strings.Join(messages, " "))
+}
+
+// wrapErr wraps err with msg, or returns nil when err is nil.
+func wrapErr(msg string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -0,0 +1,831 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/hl7"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	hl7BatchSize   = 50
+	hl7Lease       = 2 * time.Minute
+	hl7ListLimit   = 200
+	hl7IdleTimeout = 10 * time.Minute
+
+	// hl7Application is MSH-3 of the messages the hospital sends, and the
+	// assigning authority of its patient identifiers in PID-3.
+	hl7Application = "HMS"
+)
+
+var errNoHL7PatientID = errors.New("PID-3 has no " + hl7Application + " patient identifier")
+
+// HL7Settings configures the MLLP listener and sender. Either is disabled
+// when its address is empty.
+type HL7Settings struct {
+	ListenAddr           string
+	SendAddr             string
+	Facility             string
+	ReceivingApplication string
+	ReceivingFacility    string
+	AckTimeout           time.Duration
+	MaxAttempts          int
+	Location             *time.Location
+}
+
+// HL7Service exchanges HL7 v2 messages over MLLP with the lab analyzers and
+// the radiology system. Inbound ADT^A01, A03 and A08 messages admit,
+// discharge and update patients, and ORU^R01 messages enter lab results;
+// every message is answered with an ACK and kept in the message log.
+// Patients created or updated in the hospital are sent out as ADT^A04 and
+// ADT^A08, queued in the same log and sent with exponential backoff like
+// webhooks.
+type HL7Service struct {
+	messageRepo      *repository.HL7MessageRepository
+	patientRepo      *repository.PatientRepository
+	wardRepo         *repository.WardRepository
+	roomRepo         *repository.RoomRepository
+	bedRepo          *repository.BedRepository
+	admissionRepo    *repository.AdmissionRepository
+	patientService   *PatientService
+	admissionService *AdmissionService
+	labService       *LabService
+	settings         HL7Settings
+}
+
+func NewHL7Service(
+	messageRepo *repository.HL7MessageRepository,
+	patientRepo *repository.PatientRepository,
+	wardRepo *repository.WardRepository,
+	roomRepo *repository.RoomRepository,
+	bedRepo *repository.BedRepository,
+	admissionRepo *repository.AdmissionRepository,
+	patientService *PatientService,
+	admissionService *AdmissionService,
+	labService *LabService,
+	settings HL7Settings,
+) *HL7Service {
+	if settings.Location == nil {
+		settings.Location = time.Local
+	}
+	return &HL7Service{
+		messageRepo:      messageRepo,
+		patientRepo:      patientRepo,
+		wardRepo:         wardRepo,
+		roomRepo:         roomRepo,
+		bedRepo:          bedRepo,
+		admissionRepo:    admissionRepo,
+		patientService:   patientService,
+		admissionService: admissionService,
+		labService:       labService,
+		settings:         settings,
+	}
+}
+
+func (s *HL7Service) GetMessages(ctx context.Context, direction domain.HL7Direction, status domain.HL7MessageStatus, messageType, patientID string) ([]*domain.HL7MessageEntity, error) {
+	if direction != "" && !direction.IsValid() {
+		return nil, fmt.Errorf("invalid direction: %s", direction)
+	}
+	if status != "" && !status.IsValid() {
+		return nil, fmt.Errorf("invalid message status: %s", status)
+	}
+	if patientID != "" && !primitive.IsValidObjectID(patientID) {
+		return nil, errors.New("invalid patient ID format")
+	}
+
+	return s.messageRepo.GetAll(ctx, direction, status, messageType, patientID, hl7ListLimit)
+}
+
+func (s *HL7Service) GetMessageByID(ctx context.Context, id string) (*domain.HL7MessageEntity, error) {
+	messageID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	msg, err := s.messageRepo.GetByID(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HL7 message: %w", err)
+	}
+
+	return msg, nil
+}
+
+// RetryMessage puts a dead-lettered or failed outbound message back in the
+// queue with a fresh attempt budget.
+func (s *HL7Service) RetryMessage(ctx context.Context, id string) error {
+	msg, err := s.GetMessageByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if msg == nil {
+		return errors.New("HL7 message not found")
+	}
+	if msg.Direction != domain.HL7Outbound {
+		return errors.New("only outbound HL7 messages can be retried")
+	}
+	if msg.Status == domain.HL7MessageSent {
+		return errors.New("HL7 message has already been sent")
+	}
+
+	now := time.Now()
+	msg.Status = domain.HL7MessagePending
+	msg.AttemptCount = 0
+	msg.NextAttemptAt = &now
+
+	return s.messageRepo.Update(ctx, msg.ID, msg)
+}
+
+// Listen accepts MLLP connections on the configured address until ctx is
+// cancelled. It returns at once when no listen address is configured.
+func (s *HL7Service) Listen(ctx context.Context) {
+	if s.settings.ListenAddr == "" {
+		return
+	}
+
+	server := &hl7.Server{Handler: s.Handle, IdleTimeout: hl7IdleTimeout}
+	log.Printf("HL7 MLLP listener on %s", s.settings.ListenAddr)
+	if err := server.ListenAndServe(ctx, s.settings.ListenAddr); err != nil {
+		log.Printf("HL7 MLLP listener stopped: %v", err)
+	}
+}
+
+// Handle processes a message received over MLLP, logs it and returns the
+// acknowledgment: AA when it was applied, AE when it could not be, e.g.
+// because the patient is unknown, and AR when it is not a message or not a
+// type the hospital accepts. A message the sender retransmits after it was
+// processed is acknowledged again without being applied twice.
+func (s *HL7Service) Handle(ctx context.Context, data []byte, remoteAddr string) []byte {
+	now := time.Now()
+	entry := &domain.HL7MessageEntity{
+		Direction:   domain.HL7Inbound,
+		Peer:        remoteAddr,
+		Raw:         string(data),
+		ProcessedAt: &now,
+	}
+
+	var ack *hl7.Message
+	msg, err := hl7.Parse(data)
+	if err != nil {
+		entry.Error = err.Error()
+		ack = hl7.RejectAck(newHL7ControlID(), err.Error(), now)
+	} else {
+		msh := msg.Header()
+		entry.MessageType = msg.Type()
+		entry.ControlID = msg.ControlID()
+		entry.SendingApplication = msh.Value(3, 1)
+		entry.SendingFacility = msh.Value(4, 1)
+
+		code, text := s.process(ctx, msg, entry)
+		if code != hl7.AckAccept {
+			entry.Error = text
+		}
+		ack = msg.Ack(code, newHL7ControlID(), text, now)
+	}
+
+	entry.AckCode = string(ack.AckCode())
+	switch ack.AckCode() {
+	case hl7.AckAccept:
+		entry.Status = domain.HL7MessageProcessed
+	case hl7.AckError:
+		entry.Status = domain.HL7MessageError
+	default:
+		entry.Status = domain.HL7MessageRejected
+	}
+
+	encoded := ack.Bytes()
+	entry.Ack = string(encoded)
+	if _, err := s.messageRepo.Create(ctx, entry); err != nil {
+		log.Printf("HL7 message %s from %s: failed to log message: %v", entry.ControlID, remoteAddr, err)
+	}
+	return encoded
+}
+
+// process applies a message and returns the acknowledgment code and text.
+func (s *HL7Service) process(ctx context.Context, msg *hl7.Message, entry *domain.HL7MessageEntity) (hl7.AckCode, string) {
+	if entry.ControlID != "" {
+		previous, err := s.messageRepo.GetProcessedInbound(ctx, entry.ControlID, entry.SendingApplication, entry.SendingFacility)
+		if err != nil {
+			return hl7.AckError, fmt.Sprintf("failed to check for duplicates: %v", err)
+		}
+		if previous != nil {
+			entry.PatientID = previous.PatientID
+			return hl7.AckAccept, "duplicate of message " + previous.ID.Hex() + ", not applied again"
+		}
+	}
+
+	var err error
+	switch msg.Type() {
+	case "ADT^A01":
+		entry.PatientID, err = s.admit(ctx, msg)
+	case "ADT^A03":
+		entry.PatientID, err = s.discharge(ctx, msg)
+	case "ADT^A08":
+		var patient *domain.PatientEntity
+		if patient, err = s.updatePatient(ctx, msg); patient != nil {
+			entry.PatientID = patient.ID.Hex()
+		}
+	case "ORU^R01":
+		entry.PatientID, err = s.enterResults(ctx, msg)
+	default:
+		return hl7.AckReject, "unsupported message type " + msg.Type()
+	}
+	if err != nil {
+		return hl7.AckError, err.Error()
+	}
+	return hl7.AckAccept, ""
+}
+
+// patient returns the patient identified in PID-3 by an identifier without
+// an assigning authority or assigned by the hospital.
+func (s *HL7Service) patient(ctx context.Context, msg *hl7.Message) (*domain.PatientEntity, error) {
+	pid := msg.Segment("PID")
+	if pid == nil {
+		return nil, errors.New("message has no PID segment")
+	}
+
+	for _, identifier := range pid.Repetitions(3) {
+		authority := hl7.Component(identifier, 4)
+		if authority != "" && authority != hl7Application {
+			continue
+		}
+		patientID, err := primitive.ObjectIDFromHex(hl7.Component(identifier, 1))
+		if err != nil {
+			continue
+		}
+
+		patient, err := s.patientRepo.GetByID(ctx, patientID)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, fmt.Errorf("patient %s not found", patientID.Hex())
+			}
+			return nil, fmt.Errorf("failed to get patient: %w", err)
+		}
+		if patient.IsDeleted {
+			return nil, fmt.Errorf("patient %s not found", patientID.Hex())
+		}
+		return patient, nil
+	}
+
+	return nil, errNoHL7PatientID
+}
+
+// updatePatient applies the demographics in PID to the patient. Empty
+// fields leave the hospital's data as it is, and nothing is written when
+// nothing changed, so a system echoing the hospital's own ADT^A08 back does
+// not start a loop of updates.
+func (s *HL7Service) updatePatient(ctx context.Context, msg *hl7.Message) (*domain.PatientEntity, error) {
+	patient, err := s.patient(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	pid := msg.Segment("PID")
+	updated := *patient
+	if name := hl7PersonName(pid); name != "" {
+		updated.Name = name
+	}
+	if birthDate := pid.Value(7, 1); birthDate != "" {
+		born, err := hl7.ParseTime(birthDate, s.settings.Location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PID-7: %w", err)
+		}
+		updated.Age = ageOn(born, time.Now().In(s.settings.Location))
+	}
+	switch pid.Value(8, 1) {
+	case "M":
+		updated.Gender = "Male"
+	case "F":
+		updated.Gender = "Female"
+	case "O", "A", "N":
+		updated.Gender = "Other"
+	}
+	if address := hl7Address(pid); address != "" {
+		updated.Address = address
+	}
+	// The first phone number and e-mail address in PID-13, then PID-14, win.
+	phoneSet, emailSet := false, false
+	for _, telecom := range append(pid.Repetitions(13), pid.Repetitions(14)...) {
+		if hl7.Component(telecom, 2) == "NET" || hl7.Component(telecom, 3) == "Internet" {
+			if email := hl7.Component(telecom, 4); email != "" && !emailSet {
+				updated.Email, emailSet = email, true
+			}
+			continue
+		}
+		if phone := hl7.Component(telecom, 1); phone != "" && !phoneSet {
+			updated.Phone, phoneSet = phone, true
+		}
+	}
+
+	if updated.Name == patient.Name && updated.Age == patient.Age && updated.Gender == patient.Gender &&
+		updated.Address == patient.Address && updated.Phone == patient.Phone && updated.Email == patient.Email {
+		return patient, nil
+	}
+
+	if err := s.patientService.Update(ctx, patient.ID.Hex(), &updated, primitive.NilObjectID); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// admit admits the patient into the bed in PV1-3, ward code^room
+// number^bed label, under the doctor in PV1-7. An A01 for a patient already
+// in that bed is accepted as a repeat.
+func (s *HL7Service) admit(ctx context.Context, msg *hl7.Message) (string, error) {
+	patient, err := s.updatePatient(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+
+	pv1 := msg.Segment("PV1")
+	if pv1 == nil {
+		return patient.ID.Hex(), errors.New("message has no PV1 segment")
+	}
+	bed, err := s.bed(ctx, pv1.Value(3, 1), pv1.Value(3, 2), pv1.Value(3, 3))
+	if err != nil {
+		return patient.ID.Hex(), err
+	}
+
+	active, err := s.admissionRepo.GetActiveByPatientID(ctx, patient.ID)
+	if err != nil {
+		return patient.ID.Hex(), fmt.Errorf("failed to check active admissions: %w", err)
+	}
+	if active != nil && active.BedID == bed.ID {
+		return patient.ID.Hex(), nil
+	}
+
+	reason := "Admitted from " + msg.Header().Value(3, 1)
+	if pv2 := msg.Segment("PV2"); pv2 != nil {
+		if text := firstNonEmpty(pv2.Value(3, 2), pv2.Value(3, 1)); text != "" {
+			reason = text
+		}
+	}
+
+	req := &domain.AdmitPatientRequest{
+		PatientID: patient.ID.Hex(),
+		DoctorID:  pv1.Value(7, 1),
+		BedID:     bed.ID.Hex(),
+		Reason:    reason,
+	}
+	if _, err := s.admissionService.Admit(ctx, req, primitive.NilObjectID); err != nil {
+		return patient.ID.Hex(), err
+	}
+	return patient.ID.Hex(), nil
+}
+
+func (s *HL7Service) bed(ctx context.Context, wardCode, roomNumber, bedLabel string) (*domain.BedEntity, error) {
+	if wardCode == "" || roomNumber == "" || bedLabel == "" {
+		return nil, errors.New("PV1-3 must give the ward code, room number and bed label")
+	}
+
+	ward, err := s.wardRepo.GetByCode(ctx, wardCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ward: %w", err)
+	}
+	if ward == nil {
+		return nil, fmt.Errorf("ward %s not found", wardCode)
+	}
+	room, err := s.roomRepo.GetByWardAndNumber(ctx, ward.ID, roomNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room: %w", err)
+	}
+	if room == nil {
+		return nil, fmt.Errorf("room %s not found in ward %s", roomNumber, wardCode)
+	}
+	bed, err := s.bedRepo.GetByRoomAndLabel(ctx, room.ID, bedLabel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bed: %w", err)
+	}
+	if bed == nil {
+		return nil, fmt.Errorf("bed %s not found in room %s", bedLabel, roomNumber)
+	}
+	return bed, nil
+}
+
+// discharge discharges the patient's active admission with the disposition
+// in PV1-36.
+func (s *HL7Service) discharge(ctx context.Context, msg *hl7.Message) (string, error) {
+	patient, err := s.patient(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+
+	active, err := s.admissionRepo.GetActiveByPatientID(ctx, patient.ID)
+	if err != nil {
+		return patient.ID.Hex(), fmt.Errorf("failed to get active admission: %w", err)
+	}
+	if active == nil {
+		return patient.ID.Hex(), errors.New("patient is not admitted")
+	}
+
+	disposition := "Home"
+	if pv1 := msg.Segment("PV1"); pv1 != nil {
+		disposition = hl7Disposition(pv1.Value(36, 1))
+	}
+
+	req := &domain.DischargePatientRequest{Disposition: disposition}
+	if _, err := s.admissionService.Discharge(ctx, active.ID.Hex(), req, primitive.NilObjectID); err != nil {
+		return patient.ID.Hex(), err
+	}
+	return patient.ID.Hex(), nil
+}
+
+// hl7Disposition maps a discharge disposition from HL7 table 0112.
+func hl7Disposition(code string) string {
+	switch code {
+	case "02", "03", "04", "05", "06", "43", "61", "62", "63", "65", "66":
+		return "Referred"
+	case "07":
+		return "AMA"
+	case "20", "40", "41", "42":
+		return "Deceased"
+	}
+	return "Home"
+}
+
+// enterResults enters the OBX results of an ORU^R01 on the lab orders named
+// by the placer order number, OBR-2 or ORC-2. Results of several OBRs for
+// the same order are entered together. Analyzers report results as they
+// come, so a message need not cover every ordered test, and results whose
+// status, OBX-11 or else OBR-25, is not final are entered as preliminary.
+func (s *HL7Service) enterResults(ctx context.Context, msg *hl7.Message) (string, error) {
+	patient, err := s.patient(ctx, msg)
+	if err != nil && err != errNoHL7PatientID {
+		return "", err
+	}
+
+	var orderIDs []string
+	results := make(map[string][]domain.LabResultRequest)
+	preliminary := make(map[string]map[string]bool)
+	placer, orderID, orderStatus := "", "", ""
+	var last *domain.LabResultRequest
+	for _, seg := range msg.Segments {
+		switch seg.Name() {
+		case "ORC":
+			placer = seg.Value(2, 1)
+			last = nil
+		case "OBR":
+			orderID = firstNonEmpty(seg.Value(2, 1), placer)
+			if orderID == "" {
+				return "", errors.New("OBR has no placer order number")
+			}
+			if _, ok := results[orderID]; !ok {
+				orderIDs = append(orderIDs, orderID)
+				results[orderID] = nil
+				preliminary[orderID] = make(map[string]bool)
+			}
+			orderStatus = seg.Value(25, 1)
+			last = nil
+		case "OBX":
+			if orderID == "" {
+				return "", errors.New("OBX without an OBR")
+			}
+			result, err := hl7LabResult(seg)
+			if err != nil {
+				return "", err
+			}
+			results[orderID] = append(results[orderID], result)
+			last = &results[orderID][len(results[orderID])-1]
+			if !hl7FinalResult(firstNonEmpty(seg.Value(11, 1), orderStatus)) {
+				preliminary[orderID][result.TestCode] = true
+			}
+		case "NTE":
+			if last != nil {
+				last.Notes = strings.TrimSpace(last.Notes + "\n" + seg.Value(3, 1))
+			}
+		}
+	}
+	if len(orderIDs) == 0 {
+		return "", errors.New("message has no OBR segment")
+	}
+
+	patientID := ""
+	if patient != nil {
+		patientID = patient.ID.Hex()
+	}
+	for _, id := range orderIDs {
+		order, err := s.labService.GetByID(ctx, id)
+		if err != nil {
+			return patientID, fmt.Errorf("lab order %s: %w", id, err)
+		}
+		if order == nil {
+			return patientID, fmt.Errorf("lab order %s not found", id)
+		}
+		if patient != nil && order.PatientID != patient.ID {
+			return patientID, fmt.Errorf("lab order %s is for another patient", id)
+		}
+		patientID = order.PatientID.Hex()
+
+		if err := s.labService.enterResults(ctx, id, results[id], preliminary[id], false, primitive.NilObjectID); err != nil {
+			return patientID, fmt.Errorf("lab order %s: %w", id, err)
+		}
+	}
+	return patientID, nil
+}
+
+// hl7FinalResult reports whether an OBX-11 or OBR-25 result status is final:
+// F, a correction C or U, a change to final. A message without a status is
+// taken to be final.
+func hl7FinalResult(status string) bool {
+	switch status {
+	case "", "F", "C", "U":
+		return true
+	}
+	return false
+}
+
+func hl7LabResult(obx *hl7.Segment) (domain.LabResultRequest, error) {
+	result := domain.LabResultRequest{
+		TestCode: obx.Value(3, 1),
+		Value:    obx.Value(5, 1),
+		Unit:     obx.Value(6, 1),
+		Flag:     domain.AbnormalFlag(obx.Value(8, 1)),
+	}
+	if result.TestCode == "" {
+		return result, errors.New("OBX-3 has no test code")
+	}
+	if !result.Flag.IsValid() {
+		result.Flag = ""
+	}
+
+	if text := obx.Value(7, 1); text != "" {
+		result.ReferenceRange.Text = text
+		low, high, found := strings.Cut(text, "-")
+		if found {
+			if v, err := strconv.ParseFloat(strings.TrimSpace(low), 64); err == nil {
+				result.ReferenceRange.Low = &v
+			}
+			if v, err := strconv.ParseFloat(strings.TrimSpace(high), 64); err == nil {
+				result.ReferenceRange.High = &v
+			}
+		}
+	}
+	return result, nil
+}
+
+// Enqueue queues an ADT^A04 for a created patient and an ADT^A08 for an
+// updated one. Other events, and all events when no send address is
+// configured, are ignored. An event that was already queued is skipped, so
+// enqueuing the same event twice is harmless.
+func (s *HL7Service) Enqueue(ctx context.Context, event domain.Event) error {
+	if s.settings.SendAddr == "" {
+		return nil
+	}
+
+	var trigger string
+	switch event.Type {
+	case domain.EventPatientCreated:
+		trigger = "A04"
+	case domain.EventPatientUpdated:
+		trigger = "A08"
+	default:
+		return nil
+	}
+
+	exists, err := s.messageRepo.ExistsForEvent(ctx, event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check HL7 message: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	var patient domain.PatientDTO
+	if err := json.Unmarshal(data, &patient); err != nil {
+		return fmt.Errorf("failed to decode patient event: %w", err)
+	}
+
+	controlID := hl7ControlID(event.ID)
+	msg := s.adtMessage(trigger, patient, controlID, event.Timestamp)
+	now := time.Now()
+	entry := &domain.HL7MessageEntity{
+		Direction:          domain.HL7Outbound,
+		Status:             domain.HL7MessagePending,
+		MessageType:        "ADT^" + trigger,
+		ControlID:          controlID,
+		SendingApplication: hl7Application,
+		SendingFacility:    s.settings.Facility,
+		Peer:               s.settings.SendAddr,
+		EventID:            event.ID,
+		PatientID:          patient.ID,
+		Raw:                string(msg.Bytes()),
+		NextAttemptAt:      &now,
+	}
+
+	if _, err := s.messageRepo.Create(ctx, entry); err != nil {
+		return fmt.Errorf("failed to queue HL7 message: %w", err)
+	}
+	return nil
+}
+
+func (s *HL7Service) adtMessage(trigger string, patient domain.PatientDTO, controlID string, at time.Time) *hl7.Message {
+	header := hl7.Header{
+		SendingApplication:   hl7Application,
+		SendingFacility:      s.settings.Facility,
+		ReceivingApplication: s.settings.ReceivingApplication,
+		ReceivingFacility:    s.settings.ReceivingFacility,
+	}
+	at = at.In(s.settings.Location)
+	msg := hl7.NewMessage(header, hl7.Components("ADT", trigger, "ADT_A01"), controlID, at)
+
+	var telecom []string
+	if patient.Phone != "" {
+		telecom = append(telecom, hl7.Components(patient.Phone, "PRN", "PH"))
+	}
+	if patient.Email != "" {
+		telecom = append(telecom, hl7.Components("", "NET", "Internet", patient.Email))
+	}
+
+	msg.Add("EVN", trigger, hl7.FormatTime(at))
+	msg.Add("PID",
+		"1", "",
+		hl7.Components(patient.ID, "", "", hl7Application, "MR"), "",
+		hl7Name(patient.Name), "", "",
+		hl7Sex(patient.Gender), "", "",
+		hl7.Components(patient.Address), "",
+		strings.Join(telecom, "~"),
+	)
+	msg.Add("PV1", "1", "N")
+	return msg
+}
+
+// RunSender sends due outbound messages every interval until ctx is
+// cancelled. It returns at once when no send address is configured.
+func (s *HL7Service) RunSender(ctx context.Context, interval time.Duration) {
+	if s.settings.SendAddr == "" {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.sendDue(ctx); err != nil {
+			log.Printf("HL7 send failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *HL7Service) sendDue(ctx context.Context) error {
+	now := time.Now()
+	msgs, err := s.messageRepo.GetDue(ctx, now, hl7BatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get due HL7 messages: %w", err)
+	}
+
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		claimed, err := s.messageRepo.Claim(ctx, msg.ID, now, now.Add(hl7Lease))
+		if err != nil {
+			return fmt.Errorf("failed to claim HL7 message: %w", err)
+		}
+		if !claimed {
+			continue
+		}
+
+		s.attempt(ctx, msg)
+	}
+
+	return nil
+}
+
+// attempt sends an outbound message once and records the outcome. An AE is
+// retried; an AR is not, since the receiver will reject the message again.
+func (s *HL7Service) attempt(ctx context.Context, msg *domain.HL7MessageEntity) {
+	started := time.Now()
+	attempt := domain.HL7Attempt{AttemptedAt: started}
+
+	msg.Peer = s.settings.SendAddr
+	ack, err := hl7.Send(ctx, s.settings.SendAddr, []byte(msg.Raw), s.settings.AckTimeout)
+	if err != nil {
+		attempt.Error = err.Error()
+	} else {
+		msg.Ack = string(ack.Bytes())
+		msg.AckCode = string(ack.AckCode())
+		attempt.AckCode = msg.AckCode
+		if ack.AckCode() != hl7.AckAccept {
+			attempt.Error = firstNonEmpty(ack.AckText(), "acknowledged with "+msg.AckCode)
+		}
+	}
+	attempt.DurationMs = time.Since(started).Milliseconds()
+
+	msg.AttemptCount++
+	msg.Attempts = append(msg.Attempts, attempt)
+	msg.Error = attempt.Error
+
+	switch {
+	case attempt.Error == "":
+		msg.Status = domain.HL7MessageSent
+		msg.ProcessedAt = &started
+	case attempt.AckCode == string(hl7.AckReject) || msg.AttemptCount >= s.settings.MaxAttempts:
+		msg.Status = domain.HL7MessageDeadLetter
+		log.Printf("HL7 message %s (%s) to %s dead-lettered after %d attempts: %s", msg.ControlID, msg.MessageType, msg.Peer, msg.AttemptCount, attempt.Error)
+	default:
+		msg.Status = domain.HL7MessageFailed
+		next := time.Now().Add(backoff(retryBaseBackoff, retryMaxBackoff, msg.AttemptCount))
+		msg.NextAttemptAt = &next
+	}
+
+	if err := s.messageRepo.Update(ctx, msg.ID, msg); err != nil {
+		log.Printf("HL7 message %s: failed to record attempt: %v", msg.ControlID, err)
+	}
+}
+
+// hl7ControlID shortens an ObjectID in hex to base 36, which fits the 20
+// characters HL7 2.5 allows in MSH-10.
+func hl7ControlID(hexID string) string {
+	n, ok := new(big.Int).SetString(hexID, 16)
+	if !ok {
+		return hexID
+	}
+	return strings.ToUpper(n.Text(36))
+}
+
+func newHL7ControlID() string {
+	return hl7ControlID(primitive.NewObjectID().Hex())
+}
+
+// hl7Name encodes a name as family^given, taking the last word as the family
+// name; single-word names, common in Indonesia, are sent as the family name.
+func hl7Name(name string) string {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ""
+	}
+	return hl7.Components(words[len(words)-1], strings.Join(words[:len(words)-1], " "))
+}
+
+// hl7PersonName reads PID-5 back into a single name, given names first.
+func hl7PersonName(pid *hl7.Segment) string {
+	var parts []string
+	for _, c := range []int{2, 3, 1} {
+		if part := strings.TrimSpace(pid.Value(5, c)); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// hl7Address reads PID-11 into a single line.
+func hl7Address(pid *hl7.Segment) string {
+	var parts []string
+	for c := 1; c <= 6; c++ {
+		if part := strings.TrimSpace(pid.Value(11, c)); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func hl7Sex(gender string) string {
+	switch gender {
+	case "Male":
+		return "M"
+	case "Female":
+		return "F"
+	case "Other":
+		return "O"
+	}
+	return "U"
+}
+
+// ageOn returns the age in whole years on the given day.
+func ageOn(born, on time.Time) int {
+	age := on.Year() - born.Year()
+	if on.Month() < born.Month() || (on.Month() == born.Month() && on.Day() < born.Day()) {
+		age--
+	}
+	return age
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/hl7"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func newTestHL7Service(mt *mtest.T) *HL7Service {
+	activityService := NewActivityService(
+		repository.NewActivityRepository(mt.DB.Collection("activities")),
+		repository.NewOutboxRepository(mt.DB.Collection("outbox")),
+	)
+	patientRepo := repository.NewPatientRepository(mt.DB.Collection("patients"))
+	appointmentRepo := repository.NewAppointmentRepository(mt.DB.Collection("appointments"))
+	recordRepo := repository.NewMedicalRecordRepository(mt.DB.Collection("medical_records"))
+	labRepo := repository.NewLabOrderRepository(mt.DB.Collection("lab_orders"))
+	bedRepo := repository.NewBedRepository(mt.DB.Collection("beds"))
+	admissionRepo := repository.NewAdmissionRepository(mt.DB.Collection("admissions"))
+
+	return NewHL7Service(
+		repository.NewHL7MessageRepository(mt.DB.Collection("hl7_messages")),
+		patientRepo,
+		repository.NewWardRepository(mt.DB.Collection("wards")),
+		repository.NewRoomRepository(mt.DB.Collection("rooms")),
+		bedRepo,
+		admissionRepo,
+		NewPatientService(patientRepo, appointmentRepo, recordRepo, labRepo, activityService, mt.Client),
+		NewAdmissionService(admissionRepo, bedRepo, patientRepo, repository.NewDoctorRepository(mt.DB.Collection("doctors")), recordRepo, activityService, mt.Client),
+		NewLabService(labRepo, patientRepo, appointmentRepo, activityService, mt.Client),
+		HL7Settings{Facility: "RSUD", Location: time.UTC},
+	)
+}
+
+// hl7Message builds an inbound message from the segments after MSH.
+func hl7Message(messageType, controlID string, segments ...string) []byte {
+	msh := "MSH|^~\\&|LIS|LAB|HMS|RSUD|20250717100000||" + messageType + "|" + controlID + "|P|2.5"
+	return []byte(strings.Join(append([]string{msh}, segments...), "\r") + "\r")
+}
+
+func parseAck(t *testing.T, data []byte) *hl7.Message {
+	t.Helper()
+	ack, err := hl7.Parse(data)
+	if err != nil {
+		t.Fatalf("invalid acknowledgment %q: %v", data, err)
+	}
+	return ack
+}
+
+func testHL7Patient() *domain.PatientEntity {
+	return &domain.PatientEntity{
+		ID:     primitive.NewObjectID(),
+		Name:   "Siti Rahma",
+		Age:    34,
+		Gender: "Female",
+		Phone:  "081234567890",
+		Email:  "siti@example.com",
+	}
+}
+
+func TestHL7HandleADT(t *testing.T) {
+	mt := newMockDB(t)
+
+	mt.Run("A08 updates the patient", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		patient := testHL7Patient()
+		mt.AddMockResponses(
+			mockFind(mt), // not a duplicate
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mockFind(mt, mockDoc(mt.T, patient)), // PatientService.Update
+			mockWrite(1),                         // patient
+			mtest.CreateSuccessResponse(),        // activity
+			mtest.CreateSuccessResponse(),        // patient event
+			mtest.CreateSuccessResponse(),        // commitTransaction
+			mtest.CreateSuccessResponse(),        // message log
+		)
+
+		data := hl7Message("ADT^A08^ADT_A01", "MSG0001",
+			"PID|1||"+patient.ID.Hex()+"^^^HMS^MR||Rahma^Siti Nur||||||Jl. Merdeka 1^^Bandung|||",
+		)
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.5:4000"))
+		if ack.AckCode() != hl7.AckAccept {
+			mt.Fatalf("ack = %q %q", ack.AckCode(), ack.AckText())
+		}
+
+		events := startedEvents(mt)
+		updates := decodeSets[domain.PatientEntity](mt.T, events, "patients")
+		if len(updates) != 1 {
+			mt.Fatalf("patient updates = %d, want 1", len(updates))
+		}
+		if updates[0].Name != "Siti Nur Rahma" || updates[0].Address != "Jl. Merdeka 1, Bandung" || updates[0].Phone != patient.Phone {
+			mt.Errorf("updated patient = %+v", updates[0])
+		}
+		logged := decodeInserts[domain.HL7MessageEntity](mt.T, events, "hl7_messages")
+		if len(logged) != 1 || logged[0].Status != domain.HL7MessageProcessed || logged[0].PatientID != patient.ID.Hex() {
+			mt.Errorf("logged message = %+v", logged)
+		}
+	})
+
+	mt.Run("A08 for an unknown patient is an error", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		mt.AddMockResponses(
+			mockFind(mt),
+			mockFind(mt), // no such patient
+			mtest.CreateSuccessResponse(),
+		)
+
+		data := hl7Message("ADT^A08", "MSG0002", "PID|1||"+primitive.NewObjectID().Hex()+"^^^HMS^MR||Rahma^Siti")
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.5:4000"))
+		if ack.AckCode() != hl7.AckError || !strings.Contains(ack.AckText(), "not found") {
+			mt.Errorf("ack = %q %q, want AE for an unknown patient", ack.AckCode(), ack.AckText())
+		}
+	})
+
+	mt.Run("A01 admits the patient into the bed", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		patient := testHL7Patient()
+		doctor := &domain.DoctorEntity{ID: primitive.NewObjectID(), Name: "Dr. Budi"}
+		ward := &domain.WardEntity{ID: primitive.NewObjectID(), Code: "MLT", Name: "Melati", IsActive: true}
+		room := &domain.RoomEntity{ID: primitive.NewObjectID(), WardID: ward.ID, Number: "201"}
+		bed := &domain.BedEntity{ID: primitive.NewObjectID(), WardID: ward.ID, RoomID: room.ID, Label: "A", Status: domain.BedStatusAvailable}
+		mt.AddMockResponses(
+			mockFind(mt),
+			mockFind(mt, mockDoc(mt.T, patient)), // PID matches, nothing to update
+			mockFind(mt, mockDoc(mt.T, ward)),
+			mockFind(mt, mockDoc(mt.T, room)),
+			mockFind(mt, mockDoc(mt.T, bed)),
+			mockFind(mt), // not admitted
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mockFind(mt, mockDoc(mt.T, doctor)),
+			mockFind(mt), // not admitted, in the transaction
+			mockFind(mt, mockDoc(mt.T, bed)),
+			mtest.CreateSuccessResponse(), // admission
+			mockWrite(1),                  // bed occupied
+			mtest.CreateSuccessResponse(), // activity
+			mtest.CreateSuccessResponse(), // commitTransaction
+			mtest.CreateSuccessResponse(), // message log
+		)
+
+		data := hl7Message("ADT^A01^ADT_A01", "MSG0003",
+			"PID|1||"+patient.ID.Hex()+"^^^HMS^MR||Rahma^Siti|||F",
+			"PV1|1|I|MLT^201^A||||"+doctor.ID.Hex()+"^Budi",
+			"PV2|||^Dengue fever",
+		)
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.5:4000"))
+		if ack.AckCode() != hl7.AckAccept {
+			mt.Fatalf("ack = %q %q", ack.AckCode(), ack.AckText())
+		}
+
+		events := startedEvents(mt)
+		if updates := decodeSets[domain.PatientEntity](mt.T, events, "patients"); len(updates) != 0 {
+			mt.Errorf("patient was updated: %+v", updates)
+		}
+		admissions := decodeInserts[domain.AdmissionEntity](mt.T, events, "admissions")
+		if len(admissions) != 1 {
+			mt.Fatalf("admissions = %d, want 1", len(admissions))
+		}
+		a := admissions[0]
+		if a.PatientID != patient.ID || a.DoctorID != doctor.ID || a.BedID != bed.ID || a.Reason != "Dengue fever" {
+			mt.Errorf("admission = %+v", a)
+		}
+	})
+
+	mt.Run("A01 without a bed is an error", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		patient := testHL7Patient()
+		mt.AddMockResponses(
+			mockFind(mt),
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mtest.CreateSuccessResponse(),
+		)
+
+		data := hl7Message("ADT^A01", "MSG0004",
+			"PID|1||"+patient.ID.Hex()+"^^^HMS^MR||Rahma^Siti|||F",
+			"PV1|1|I|MLT",
+		)
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.5:4000"))
+		if ack.AckCode() != hl7.AckError {
+			mt.Errorf("ack = %q %q, want AE", ack.AckCode(), ack.AckText())
+		}
+		if admissions := decodeInserts[domain.AdmissionEntity](mt.T, startedEvents(mt), "admissions"); len(admissions) != 0 {
+			mt.Errorf("admitted: %+v", admissions)
+		}
+	})
+}
+
+func TestHL7HandleDuplicateAndUnsupported(t *testing.T) {
+	mt := newMockDB(t)
+
+	mt.Run("retransmitted message is not applied again", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		previous := &domain.HL7MessageEntity{ID: primitive.NewObjectID(), PatientID: primitive.NewObjectID().Hex()}
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(mt.T, previous)),
+			mtest.CreateSuccessResponse(),
+		)
+
+		data := hl7Message("ADT^A08", "MSG0001", "PID|1||"+previous.PatientID+"^^^HMS^MR||Rahma^Siti")
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.5:4000"))
+		if ack.AckCode() != hl7.AckAccept {
+			mt.Errorf("ack = %q %q", ack.AckCode(), ack.AckText())
+		}
+		if got := startedCommands(mt); strings.Join(got, ",") != "find,insert" {
+			mt.Errorf("commands = %v, want only the duplicate check and the log", got)
+		}
+	})
+
+	mt.Run("unsupported type is rejected", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		mt.AddMockResponses(
+			mockFind(mt),
+			mtest.CreateSuccessResponse(),
+		)
+
+		ack := parseAck(mt.T, s.Handle(context.Background(), hl7Message("SIU^S12", "MSG0005"), "10.0.0.5:4000"))
+		if ack.AckCode() != hl7.AckReject {
+			mt.Errorf("ack = %q, want AR", ack.AckCode())
+		}
+	})
+
+	mt.Run("garbage is rejected", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		ack := parseAck(mt.T, s.Handle(context.Background(), []byte("hello"), "10.0.0.5:4000"))
+		if ack.AckCode() != hl7.AckReject {
+			mt.Errorf("ack = %q, want AR", ack.AckCode())
+		}
+	})
+}
+
+func TestHL7HandleORU(t *testing.T) {
+	mt := newMockDB(t)
+
+	patient := testHL7Patient()
+	pid := "PID|1||" + patient.ID.Hex() + "^^^HMS^MR||Rahma^Siti"
+	order := func(results ...domain.LabResult) *domain.LabOrderEntity {
+		return &domain.LabOrderEntity{
+			ID:        primitive.NewObjectID(),
+			PatientID: patient.ID,
+			Tests:     []domain.LabTest{{Code: "HB", Name: "Hemoglobin"}, {Code: "WBC", Name: "Leukocytes"}},
+			Status:    domain.LabOrderStatusOrdered,
+			Results:   results,
+		}
+	}
+	// handle queues the responses to an ORU that enters results on the order,
+	// sends it and returns the acknowledgment and the order as it was saved.
+	handle := func(mt *mtest.T, o *domain.LabOrderEntity, segments ...string) (*hl7.Message, []*domain.LabOrderEntity) {
+		s := newTestHL7Service(mt)
+		mt.AddMockResponses(
+			mockFind(mt),
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mockFind(mt, mockDoc(mt.T, o)),
+			mockFind(mt, mockDoc(mt.T, o)), // LabService.enterResults
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mockWrite(1),                  // lab order
+			mtest.CreateSuccessResponse(), // activity
+			mtest.CreateSuccessResponse(), // commitTransaction
+			mtest.CreateSuccessResponse(), // message log
+		)
+
+		data := hl7Message("ORU^R01^ORU_R01", "MSG0100", append([]string{pid}, segments...)...)
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.7:4000"))
+		return ack, decodeSets[domain.LabOrderEntity](mt.T, startedEvents(mt), "lab_orders")
+	}
+
+	mt.Run("final results for every test complete the order", func(mt *mtest.T) {
+		o := order()
+		ack, saved := handle(mt, o,
+			obr(o.ID.Hex(), "F"),
+			"OBX|1|NM|HB||10.5|g/dL|12-16|L|||F",
+			"NTE|1||Repeat in a week",
+			"OBX|2|NM|WBC||7.2|10*3/uL|4-11||||F",
+		)
+		if ack.AckCode() != hl7.AckAccept {
+			mt.Fatalf("ack = %q %q", ack.AckCode(), ack.AckText())
+		}
+		if len(saved) != 1 || saved[0].Status != domain.LabOrderStatusCompleted || saved[0].CompletedAt == nil {
+			mt.Fatalf("saved = %+v", derefOrders(saved))
+		}
+		hb := saved[0].Results[0]
+		if hb.TestCode != "HB" || hb.Flag != domain.AbnormalFlag("L") || hb.Notes != "Repeat in a week" || hb.Preliminary {
+			mt.Errorf("HB result = %+v", hb)
+		}
+		if hb.ReferenceRange.Low == nil || *hb.ReferenceRange.Low != 12 {
+			mt.Errorf("HB reference range = %+v", hb.ReferenceRange)
+		}
+	})
+
+	mt.Run("partial results keep the order in progress", func(mt *mtest.T) {
+		o := order()
+		ack, saved := handle(mt, o,
+			obr(o.ID.Hex(), ""),
+			"OBX|1|NM|HB||13.1|g/dL|12-16",
+		)
+		if ack.AckCode() != hl7.AckAccept {
+			mt.Fatalf("ack = %q %q, want partial results accepted", ack.AckCode(), ack.AckText())
+		}
+		if len(saved) != 1 || saved[0].Status != domain.LabOrderStatusInProgress || saved[0].CompletedAt != nil {
+			mt.Fatalf("saved = %+v", derefOrders(saved))
+		}
+		if len(saved[0].Results) != 1 || saved[0].Results[0].TestCode != "HB" {
+			mt.Errorf("results = %+v", saved[0].Results)
+		}
+	})
+
+	mt.Run("preliminary results are entered as preliminary", func(mt *mtest.T) {
+		o := order()
+		ack, saved := handle(mt, o,
+			obr(o.ID.Hex(), "P"),
+			"OBX|1|NM|HB||13.1|g/dL",
+			"OBX|2|NM|WBC||7.2|10*3/uL|||||F",
+		)
+		if ack.AckCode() != hl7.AckAccept {
+			mt.Fatalf("ack = %q %q, want preliminary results accepted", ack.AckCode(), ack.AckText())
+		}
+		if len(saved) != 1 || saved[0].Status != domain.LabOrderStatusInProgress {
+			mt.Fatalf("saved = %+v", derefOrders(saved))
+		}
+		results := saved[0].Results
+		if len(results) != 2 || !results[0].Preliminary || results[1].Preliminary {
+			mt.Errorf("results = %+v, want HB preliminary and WBC final", results)
+		}
+	})
+
+	mt.Run("final result replaces a preliminary one and completes the order", func(mt *mtest.T) {
+		o := order(
+			domain.LabResult{TestCode: "HB", TestName: "Hemoglobin", Value: "13.1", Preliminary: true},
+			domain.LabResult{TestCode: "WBC", TestName: "Leukocytes", Value: "7.2"},
+		)
+		o.Status = domain.LabOrderStatusInProgress
+		ack, saved := handle(mt, o,
+			obr(o.ID.Hex(), "F"),
+			"OBX|1|NM|HB||13.4|g/dL",
+		)
+		if ack.AckCode() != hl7.AckAccept {
+			mt.Fatalf("ack = %q %q", ack.AckCode(), ack.AckText())
+		}
+		if len(saved) != 1 || saved[0].Status != domain.LabOrderStatusCompleted {
+			mt.Fatalf("saved = %+v", derefOrders(saved))
+		}
+		results := saved[0].Results
+		if len(results) != 2 || results[0].Value != "13.4" || results[0].Preliminary || results[1].Value != "7.2" {
+			mt.Errorf("results = %+v", results)
+		}
+	})
+
+	mt.Run("result for a test that was not ordered is an error", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		o := order()
+		mt.AddMockResponses(
+			mockFind(mt),
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mockFind(mt, mockDoc(mt.T, o)),
+			mockFind(mt, mockDoc(mt.T, o)),
+			mtest.CreateSuccessResponse(),
+		)
+
+		data := hl7Message("ORU^R01", "MSG0101", pid, obr(o.ID.Hex(), ""), "OBX|1|NM|GLU||98|mg/dL")
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.7:4000"))
+		if ack.AckCode() != hl7.AckError || !strings.Contains(ack.AckText(), "GLU was not ordered") {
+			mt.Errorf("ack = %q %q, want AE", ack.AckCode(), ack.AckText())
+		}
+		if saved := decodeSets[domain.LabOrderEntity](mt.T, startedEvents(mt), "lab_orders"); len(saved) != 0 {
+			mt.Errorf("order was saved: %+v", saved)
+		}
+	})
+
+	mt.Run("results entered meanwhile are not overwritten", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		o := order()
+		mt.AddMockResponses(
+			mockFind(mt),
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mockFind(mt, mockDoc(mt.T, o)),
+			mockFind(mt, mockDoc(mt.T, o)),
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mockWrite(0),                  // changed by the other request
+			mtest.CreateSuccessResponse(), // abortTransaction
+			mtest.CreateSuccessResponse(), // message log
+		)
+
+		data := hl7Message("ORU^R01", "MSG0103", pid, obr(o.ID.Hex(), "F"), "OBX|1|NM|HB||10.5|g/dL|12-16|L|||F")
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.7:4000"))
+		if ack.AckCode() != hl7.AckError || !strings.Contains(ack.AckText(), "was changed") {
+			mt.Errorf("ack = %q %q, want AE", ack.AckCode(), ack.AckText())
+		}
+		for _, e := range startedEvents(mt) {
+			if e.CommandName != "update" || e.Command.Lookup("update").StringValue() != "lab_orders" {
+				continue
+			}
+			filter := e.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("q").Document()
+			if status := filter.Lookup("status").StringValue(); status != string(o.Status) {
+				mt.Errorf("update filter status = %q, want %q", status, o.Status)
+			}
+			if _, err := filter.LookupErr("results"); err != nil {
+				mt.Errorf("update filter = %v, want it to match the results that were read", filter)
+			}
+		}
+	})
+
+	mt.Run("OBX without an OBR is an error", func(mt *mtest.T) {
+		s := newTestHL7Service(mt)
+		mt.AddMockResponses(
+			mockFind(mt),
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mtest.CreateSuccessResponse(),
+		)
+
+		data := hl7Message("ORU^R01", "MSG0102", pid, "OBX|1|NM|HB||13.1|g/dL")
+		ack := parseAck(mt.T, s.Handle(context.Background(), data, "10.0.0.7:4000"))
+		if ack.AckCode() != hl7.AckError {
+			mt.Errorf("ack = %q %q, want AE", ack.AckCode(), ack.AckText())
+		}
+	})
+}
+
+// obr builds an OBR for the lab order with the result status, OBR-25.
+func obr(orderID, status string) string {
+	return "OBR|1|" + orderID + strings.Repeat("|", 23) + status
+}
+
+func derefOrders(orders []*domain.LabOrderEntity) []domain.LabOrderEntity {
+	var values []domain.LabOrderEntity
+	for _, o := range orders {
+		values = append(values, *o)
+	}
+	return values
+}
@@ -0,0 +1,547 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/ekastn/hms-api/internal/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	importBatchSize = 100
+	importLease     = 2 * time.Minute
+	importListLimit = 100
+)
+
+// importColumns are the columns of each kind of import file, named like the
+// fields of the create request. Header names are matched ignoring case,
+// spaces, underscores and dashes, so "Department ID" reads as departmentId.
+var importColumns = map[domain.ImportKind]struct {
+	required []string
+	optional []string
+}{
+	domain.ImportPatients: {required: []string{"name", "age", "gender", "phone", "email", "address"}},
+	domain.ImportDoctors:  {required: []string{"name", "specialty", "phone", "email"}, optional: []string{"departmentId", "userId"}},
+}
+
+// ImportService imports patients and doctors from CSV files. Uploaded files
+// are queued as jobs in Mongo and imported by a background worker, row by
+// row through the same validation, duplicate checks and services as the
+// create endpoints. A dry run checks every row without creating anything.
+type ImportService struct {
+	jobRepo         *repository.ImportJobRepository
+	patientRepo     *repository.PatientRepository
+	userRepo        *repository.UserRepository
+	patientService  *PatientService
+	doctorService   *DoctorService
+	activityService *ActivityService
+}
+
+func NewImportService(
+	jobRepo *repository.ImportJobRepository,
+	patientRepo *repository.PatientRepository,
+	userRepo *repository.UserRepository,
+	patientService *PatientService,
+	doctorService *DoctorService,
+	activityService *ActivityService,
+) *ImportService {
+	return &ImportService{
+		jobRepo:         jobRepo,
+		patientRepo:     patientRepo,
+		userRepo:        userRepo,
+		patientService:  patientService,
+		doctorService:   doctorService,
+		activityService: activityService,
+	}
+}
+
+// CreateJob checks that the file is a well-formed CSV file with the columns
+// the kind needs and queues it for import.
+func (s *ImportService) CreateJob(ctx context.Context, kind domain.ImportKind, fileName string, content []byte, dryRun bool, creatorID primitive.ObjectID) (*domain.ImportJobEntity, error) {
+	if !kind.IsValid() {
+		return nil, fmt.Errorf("invalid import kind %q", kind)
+	}
+
+	file, err := readImportFile(kind, content)
+	if err != nil {
+		return nil, err
+	}
+	if len(file.rows) == 0 {
+		return nil, errors.New("the file has no rows below the header")
+	}
+
+	job := &domain.ImportJobEntity{
+		Kind:      kind,
+		DryRun:    dryRun,
+		FileName:  fileName,
+		Content:   string(content),
+		Status:    domain.ImportJobPending,
+		TotalRows: len(file.rows),
+		CreatedBy: creatorID,
+	}
+
+	id, err := s.jobRepo.Create(ctx, job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import job: %w", err)
+	}
+	job.ID = id
+
+	return job, nil
+}
+
+func (s *ImportService) GetJobs(ctx context.Context, kind domain.ImportKind) ([]*domain.ImportJobEntity, error) {
+	if kind != "" && !kind.IsValid() {
+		return nil, fmt.Errorf("invalid import kind %q", kind)
+	}
+
+	return s.jobRepo.GetAll(ctx, kind, importListLimit)
+}
+
+func (s *ImportService) GetJob(ctx context.Context, id string) (*domain.ImportJobEntity, error) {
+	jobID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import job: %w", err)
+	}
+
+	return job, nil
+}
+
+// ResultExport returns the uploaded rows with each row's outcome added, so
+// that the rows that were not imported can be fixed and uploaded again.
+func (s *ImportService) ResultExport(job *domain.ImportJobEntity) (*Export, error) {
+	file, err := readImportFile(job.Kind, []byte(job.Content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	results := make(map[int]domain.ImportRowResult, len(job.Results))
+	for _, r := range job.Results {
+		results[r.Row] = r
+	}
+
+	header := append(append([]string{}, file.header...), "import_status", "import_id", "import_errors")
+	rows := make([][]string, 0, len(file.rows))
+	for i, record := range file.rows {
+		r := results[i+2]
+		messages := make([]string, 0, len(r.Errors))
+		for _, e := range r.Errors {
+			messages = append(messages, e.Message)
+		}
+		row := append(append([]string{}, record...), string(r.Status), r.ID, strings.Join(messages, " "))
+		rows = append(rows, row)
+	}
+
+	return NewTableExport(fmt.Sprintf("%s-import-%s", job.Kind, job.ID.Hex()), header, rows), nil
+}
+
+// RunWorker imports queued jobs, checking for new ones every interval, until
+// ctx is cancelled. A job interrupted by shutdown keeps its saved progress and
+// is resumed once its lease runs out.
+func (s *ImportService) RunWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.runQueued(ctx); err != nil {
+			log.Printf("import worker failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ImportService) runQueued(ctx context.Context) error {
+	for ctx.Err() == nil {
+		now := time.Now()
+		job, err := s.jobRepo.ClaimNext(ctx, now, now.Add(importLease))
+		if err != nil {
+			return fmt.Errorf("failed to claim import job: %w", err)
+		}
+		if job == nil {
+			return nil
+		}
+
+		s.run(ctx, job)
+	}
+	return nil
+}
+
+// run imports the rows of a job not processed yet and records the outcome.
+func (s *ImportService) run(ctx context.Context, job *domain.ImportJobEntity) {
+	err := s.process(ctx, job)
+	if ctx.Err() != nil {
+		return
+	}
+
+	status, errMsg := domain.ImportJobCompleted, ""
+	if err != nil {
+		log.Printf("import job %s failed: %v", job.ID.Hex(), err)
+		status, errMsg = domain.ImportJobFailed, err.Error()
+	}
+	if err := s.jobRepo.Finish(ctx, job.ID, status, errMsg); err != nil {
+		log.Printf("import job %s: failed to save status: %v", job.ID.Hex(), err)
+		return
+	}
+
+	s.logJob(ctx, job, err)
+}
+
+func (s *ImportService) process(ctx context.Context, job *domain.ImportJobEntity) error {
+	file, err := readImportFile(job.Kind, []byte(job.Content))
+	if err != nil {
+		return err
+	}
+
+	// Rows already processed by an earlier run still count for the
+	// duplicate checks within the file.
+	start := job.ProcessedRows
+	seen := make(map[string]int)
+	done := make(map[int]domain.ImportRowStatus, len(job.Results))
+	for _, r := range job.Results {
+		done[r.Row] = r.Status
+	}
+
+	var batch []domain.ImportRowResult
+	for i, record := range file.rows {
+		rowNum := i + 2
+		if i < start {
+			if status := done[rowNum]; status == domain.ImportRowCreated || status == domain.ImportRowValid {
+				for _, key := range file.uniqueKeys(job.Kind, record) {
+					seen[key] = rowNum
+				}
+			}
+			continue
+		}
+		result := s.importRow(ctx, job, file, record, seen)
+		if ctx.Err() != nil {
+			// Shutting down; the row is imported again when the job
+			// resumes.
+			break
+		}
+		result.Row = rowNum
+		if result.Status == domain.ImportRowCreated || result.Status == domain.ImportRowValid {
+			for _, key := range file.uniqueKeys(job.Kind, record) {
+				seen[key] = rowNum
+			}
+		}
+		batch = append(batch, result)
+		job.Results = append(job.Results, result)
+
+		if len(batch) == importBatchSize || i == len(file.rows)-1 {
+			if err := s.saveProgress(job, i+1, batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+	}
+
+	if len(batch) > 0 {
+		return s.saveProgress(job, job.ProcessedRows+len(batch), batch)
+	}
+	return nil
+}
+
+// saveProgress saves a batch of results, even while shutting down, so that
+// rows already created are not imported again when the job resumes.
+func (s *ImportService) saveProgress(job *domain.ImportJobEntity, processed int, batch []domain.ImportRowResult) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := s.jobRepo.SaveProgress(ctx, job.ID, processed, batch, time.Now().Add(importLease)); err != nil {
+		return fmt.Errorf("failed to save import progress: %w", err)
+	}
+	job.ProcessedRows = processed
+	return nil
+}
+
+func (s *ImportService) importRow(ctx context.Context, job *domain.ImportJobEntity, file *importFile, record []string, seen map[string]int) domain.ImportRowResult {
+	if job.Kind == domain.ImportDoctors {
+		return s.importDoctor(ctx, job, file, record, seen)
+	}
+	return s.importPatient(ctx, job, file, record, seen)
+}
+
+func (s *ImportService) importPatient(ctx context.Context, job *domain.ImportJobEntity, file *importFile, record []string, seen map[string]int) domain.ImportRowResult {
+	req := domain.CreatePatientRequest{
+		Name:    file.value(record, "name"),
+		Gender:  file.value(record, "gender"),
+		Phone:   file.value(record, "phone"),
+		Email:   file.value(record, "email"),
+		Address: file.value(record, "address"),
+	}
+
+	var rowErrors []domain.ImportRowError
+	if age := file.value(record, "age"); age != "" {
+		n, err := strconv.Atoi(age)
+		if err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{Field: "Age", Message: "The 'Age' field must be a whole number."})
+		}
+		req.Age = n
+	}
+	if result, ok := invalidRow(req, rowErrors); !ok {
+		return result
+	}
+
+	if result, ok := duplicateInFile(file.uniqueKeys(job.Kind, record), seen); !ok {
+		return result
+	}
+	if existing, err := s.patientRepo.GetByEmail(ctx, req.Email); err != nil {
+		return failedRow(fmt.Errorf("error checking email: %w", err))
+	} else if existing != nil {
+		return duplicateRow("Email", "A patient with this email already exists.")
+	}
+	if existing, err := s.patientRepo.GetByPhone(ctx, req.Phone); err != nil {
+		return failedRow(fmt.Errorf("error checking phone: %w", err))
+	} else if existing != nil {
+		return duplicateRow("Phone", "A patient with this phone number already exists.")
+	}
+
+	if job.DryRun {
+		return domain.ImportRowResult{Status: domain.ImportRowValid}
+	}
+
+	patient := domain.PatientEntity{
+		Name:      req.Name,
+		Age:       req.Age,
+		Gender:    req.Gender,
+		Phone:     req.Phone,
+		Email:     req.Email,
+		Address:   req.Address,
+		CreatedBy: job.CreatedBy,
+		UpdatedBy: job.CreatedBy,
+	}
+	id, err := s.patientService.Create(ctx, &patient)
+	if err != nil {
+		return failedRow(err)
+	}
+	return domain.ImportRowResult{Status: domain.ImportRowCreated, ID: id}
+}
+
+func (s *ImportService) importDoctor(ctx context.Context, job *domain.ImportJobEntity, file *importFile, record []string, seen map[string]int) domain.ImportRowResult {
+	req := domain.CreateDoctorRequet{
+		Name:         file.value(record, "name"),
+		Specialty:    file.value(record, "specialty"),
+		Phone:        file.value(record, "phone"),
+		Email:        file.value(record, "email"),
+		DepartmentID: file.value(record, "departmentId"),
+		UserID:       file.value(record, "userId"),
+	}
+	if result, ok := invalidRow(req, nil); !ok {
+		return result
+	}
+
+	if result, ok := duplicateInFile(file.uniqueKeys(job.Kind, record), seen); !ok {
+		return result
+	}
+
+	doctor := domain.DoctorEntity{
+		Name:         req.Name,
+		Specialty:    req.Specialty,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		Availability: []domain.TimeSlot{},
+	}
+	doctor.DepartmentID, _ = optionalObjectID(req.DepartmentID, "department") // validated above
+	doctor.UserID, _ = optionalObjectID(req.UserID, "user")
+	if err := s.doctorService.checkDuplicateDoctor(ctx, &doctor, primitive.NilObjectID); err != nil {
+		return duplicateRow("", capitalize(err.Error())+".")
+	}
+	if err := s.doctorService.checkDepartment(ctx, doctor.DepartmentID); err != nil {
+		return invalidField("DepartmentID", capitalize(err.Error())+".")
+	}
+	if err := s.doctorService.checkUser(ctx, doctor.UserID); err != nil {
+		return invalidField("UserID", capitalize(err.Error())+".")
+	}
+
+	if job.DryRun {
+		return domain.ImportRowResult{Status: domain.ImportRowValid}
+	}
+
+	id, err := s.doctorService.Create(ctx, &doctor, job.CreatedBy)
+	if err != nil {
+		return failedRow(err)
+	}
+	return domain.ImportRowResult{Status: domain.ImportRowCreated, ID: id}
+}
+
+// logJob records a finished job in the activity log.
+func (s *ImportService) logJob(ctx context.Context, job *domain.ImportJobEntity, jobErr error) {
+	importer := job.CreatedBy.Hex()
+	if user, err := s.userRepo.GetByID(ctx, job.CreatedBy); err == nil && user != nil {
+		importer = user.Name
+	}
+
+	skipped := job.Count(domain.ImportRowInvalid) + job.Count(domain.ImportRowDuplicate) + job.Count(domain.ImportRowFailed)
+	title := "Data Imported"
+	description := fmt.Sprintf("%s imported %d of %d %s from %s; %d row(s) skipped.", importer, job.Count(domain.ImportRowCreated), job.TotalRows, job.Kind, job.FileName, skipped)
+	switch {
+	case jobErr != nil:
+		title = "Data Import Failed"
+		description = fmt.Sprintf("%s's %s import from %s failed after %d row(s): %v.", importer, job.Kind, job.FileName, job.ProcessedRows, jobErr)
+	case job.DryRun:
+		title = "Data Import Checked"
+		description = fmt.Sprintf("%s checked %d %s row(s) from %s; %d would be imported, %d have problems.", importer, job.TotalRows, job.Kind, job.FileName, job.Count(domain.ImportRowValid), skipped)
+	}
+
+	if err := s.activityService.CreateActivity(ctx, domain.ActivityTypeImport, title, description); err != nil {
+		log.Printf("import job %s: failed to log activity: %v", job.ID.Hex(), err)
+	}
+}
+
+// importFile is a parsed import file.
+type importFile struct {
+	header []string
+	rows   [][]string
+	// columns maps a column name to its index in the rows.
+	columns map[string]int
+}
+
+// readImportFile parses a CSV file, which may start with the byte order mark
+// spreadsheet programs write, and checks it has the columns of the kind.
+func readImportFile(kind domain.ImportKind, content []byte) (*importFile, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\ufeff"))))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, errors.New("the file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV file: %w", err)
+	}
+
+	spec := importColumns[kind]
+	known := make(map[string]string)
+	for _, name := range append(append([]string{}, spec.required...), spec.optional...) {
+		known[normalizeColumn(name)] = name
+	}
+
+	file := &importFile{header: header, columns: make(map[string]int)}
+	for i, h := range header {
+		if name, ok := known[normalizeColumn(h)]; ok {
+			if _, dup := file.columns[name]; dup {
+				return nil, fmt.Errorf("column %q appears more than once", name)
+			}
+			file.columns[name] = i
+		}
+	}
+
+	var missing []string
+	for _, name := range spec.required {
+		if _, ok := file.columns[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing column(s): %s", strings.Join(missing, ", "))
+	}
+
+	file.rows, err = r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV file: %w", err)
+	}
+	return file, nil
+}
+
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.TrimSpace(name)))
+}
+
+// value returns a cell of a row, or "" when the file has no such column.
+func (f *importFile) value(record []string, column string) string {
+	i, ok := f.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// uniqueKeys returns the values of a row that no other row may share: the
+// email and phone of a patient, and also the name of a doctor.
+func (f *importFile) uniqueKeys(kind domain.ImportKind, record []string) []string {
+	keys := []string{
+		"Email:" + strings.ToLower(f.value(record, "email")),
+		"Phone:" + f.value(record, "phone"),
+	}
+	if kind == domain.ImportDoctors {
+		keys = append(keys, "Name:"+strings.ToLower(f.value(record, "name")))
+	}
+	return keys
+}
+
+// invalidRow validates a row's create request the way the create endpoint
+// does, adding any errors found while reading the row.
+func invalidRow(req any, rowErrors []domain.ImportRowError) (domain.ImportRowResult, bool) {
+	parsed := make(map[string]bool)
+	for _, e := range rowErrors {
+		parsed[e.Field] = true
+	}
+	for _, e := range utils.ValidateStruct(req) {
+		if !parsed[e.Field] {
+			rowErrors = append(rowErrors, domain.ImportRowError{Field: e.Field, Message: e.Message})
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		return domain.ImportRowResult{Status: domain.ImportRowInvalid, Errors: rowErrors}, false
+	}
+	return domain.ImportRowResult{}, true
+}
+
+func duplicateInFile(keys []string, seen map[string]int) (domain.ImportRowResult, bool) {
+	for _, key := range keys {
+		if row, ok := seen[key]; ok {
+			field, _, _ := strings.Cut(key, ":")
+			return duplicateRow(field, fmt.Sprintf("The '%s' field is the same as on row %d.", field, row)), false
+		}
+	}
+	return domain.ImportRowResult{}, true
+}
+
+func duplicateRow(field, message string) domain.ImportRowResult {
+	return domain.ImportRowResult{
+		Status: domain.ImportRowDuplicate,
+		Errors: []domain.ImportRowError{{Field: field, Message: message}},
+	}
+}
+
+func invalidField(field, message string) domain.ImportRowResult {
+	return domain.ImportRowResult{
+		Status: domain.ImportRowInvalid,
+		Errors: []domain.ImportRowError{{Field: field, Message: message}},
+	}
+}
+
+func failedRow(err error) domain.ImportRowResult {
+	return domain.ImportRowResult{
+		Status: domain.ImportRowFailed,
+		Errors: []domain.ImportRowError{{Message: err.Error()}},
+	}
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,565 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/payer"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InsuranceService manages patient insurance policies, eligibility checks and claims.
+type InsuranceService struct {
+	policyRepo      *repository.InsurancePolicyRepository
+	claimRepo       *repository.ClaimRepository
+	patientRepo     *repository.PatientRepository
+	appRepo         *repository.AppointmentRepository
+	invoiceRepo     *repository.InvoiceRepository
+	billingService  *BillingService
+	gateway         payer.Gateway
+	activityService *ActivityService
+	mongoClient     *mongo.Client
+}
+
+func NewInsuranceService(
+	policyRepo *repository.InsurancePolicyRepository,
+	claimRepo *repository.ClaimRepository,
+	patientRepo *repository.PatientRepository,
+	appRepo *repository.AppointmentRepository,
+	invoiceRepo *repository.InvoiceRepository,
+	billingService *BillingService,
+	gateway payer.Gateway,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+) *InsuranceService {
+	return &InsuranceService{
+		policyRepo:      policyRepo,
+		claimRepo:       claimRepo,
+		patientRepo:     patientRepo,
+		appRepo:         appRepo,
+		invoiceRepo:     invoiceRepo,
+		billingService:  billingService,
+		gateway:         gateway,
+		activityService: activityService,
+		mongoClient:     mongoClient,
+	}
+}
+
+func (s *InsuranceService) GetPolicies(ctx context.Context, patientID string) ([]*domain.InsurancePolicyEntity, error) {
+	var patientObjID *primitive.ObjectID
+	if patientID != "" {
+		id, err := primitive.ObjectIDFromHex(patientID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid patient ID format: %w", err)
+		}
+		patientObjID = &id
+	}
+
+	return s.policyRepo.GetAll(ctx, patientObjID)
+}
+
+func (s *InsuranceService) GetPolicyByID(ctx context.Context, id string) (*domain.InsurancePolicyEntity, error) {
+	policyID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	policy, err := s.policyRepo.GetByID(ctx, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get insurance policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+func (s *InsuranceService) CreatePolicy(ctx context.Context, req *domain.InsurancePolicyRequest, creatorID primitive.ObjectID) (string, error) {
+	patientID, err := primitive.ObjectIDFromHex(req.PatientID)
+	if err != nil {
+		return "", fmt.Errorf("invalid patient ID format: %w", err)
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, patientID)
+	if err != nil || patient == nil {
+		return "", errors.New("patient not found")
+	}
+
+	if req.ValidUntil != nil && req.ValidUntil.Before(req.ValidFrom) {
+		return "", errors.New("validUntil must be after validFrom")
+	}
+
+	existing, err := s.policyRepo.GetByPayerAndMember(ctx, req.PayerName, req.MemberNumber)
+	if err != nil {
+		return "", fmt.Errorf("error checking member number: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("a policy with this payer and member number already exists")
+	}
+
+	policy := &domain.InsurancePolicyEntity{
+		PatientID:    patientID,
+		PayerType:    req.PayerType,
+		PayerName:    req.PayerName,
+		MemberNumber: req.MemberNumber,
+		PlanClass:    req.PlanClass,
+		ValidFrom:    req.ValidFrom,
+		ValidUntil:   req.ValidUntil,
+		IsActive:     true,
+		CreatedBy:    creatorID,
+		UpdatedBy:    creatorID,
+	}
+	if req.IsActive != nil {
+		policy.IsActive = *req.IsActive
+	}
+
+	var id primitive.ObjectID
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		id, err = s.policyRepo.Create(sessionContext, policy)
+		if err != nil {
+			return fmt.Errorf("failed to create insurance policy: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeInsurance, "Insurance Policy Added", fmt.Sprintf("%s policy %s has been added for patient %s.", req.PayerName, req.MemberNumber, patient.Name))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for new insurance policy: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *InsuranceService) UpdatePolicy(ctx context.Context, id string, req *domain.InsurancePolicyRequest, updaterID primitive.ObjectID) error {
+	policy, err := s.GetPolicyByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		return errors.New("insurance policy not found")
+	}
+	if req.PatientID != policy.PatientID.Hex() {
+		return errors.New("a policy cannot be moved to another patient")
+	}
+	if req.ValidUntil != nil && req.ValidUntil.Before(req.ValidFrom) {
+		return errors.New("validUntil must be after validFrom")
+	}
+
+	existing, err := s.policyRepo.GetByPayerAndMember(ctx, req.PayerName, req.MemberNumber)
+	if err != nil {
+		return fmt.Errorf("error checking member number: %w", err)
+	}
+	if existing != nil && existing.ID != policy.ID {
+		return errors.New("a policy with this payer and member number already exists")
+	}
+
+	policy.PayerType = req.PayerType
+	policy.PayerName = req.PayerName
+	policy.MemberNumber = req.MemberNumber
+	policy.PlanClass = req.PlanClass
+	policy.ValidFrom = req.ValidFrom
+	policy.ValidUntil = req.ValidUntil
+	if req.IsActive != nil {
+		policy.IsActive = *req.IsActive
+	}
+	policy.UpdatedBy = updaterID
+
+	if err := s.policyRepo.Update(ctx, policy.ID, policy); err != nil {
+		return fmt.Errorf("failed to update insurance policy: %w", err)
+	}
+
+	return nil
+}
+
+// findCoveringPolicy returns the first policy of the patient that covers the date, BPJS first.
+func (s *InsuranceService) findCoveringPolicy(ctx context.Context, patientID primitive.ObjectID, date time.Time) (*domain.InsurancePolicyEntity, error) {
+	policies, err := s.policyRepo.GetAll(ctx, &patientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get insurance policies: %w", err)
+	}
+
+	for _, policy := range policies {
+		if policy.CoversDate(date) {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
+
+// CheckAppointmentEligibility checks the patient's insurance with the payer and
+// stores the result on the appointment.
+func (s *InsuranceService) CheckAppointmentEligibility(ctx context.Context, appointmentID string, updaterID primitive.ObjectID) (*domain.EligibilityCheck, error) {
+	apptID, err := primitive.ObjectIDFromHex(appointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid appointment ID format: %w", err)
+	}
+
+	appointment, err := s.appRepo.GetByID(ctx, apptID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointment: %w", err)
+	}
+	if appointment == nil {
+		return nil, errors.New("appointment not found")
+	}
+
+	policy, err := s.findCoveringPolicy(ctx, appointment.PatientID, appointment.DateTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var check *domain.EligibilityCheck
+	if policy == nil {
+		check = &domain.EligibilityCheck{
+			Eligible:  false,
+			Reason:    "no active insurance policy covers the appointment date",
+			CheckedAt: time.Now(),
+		}
+	} else {
+		check, err = s.gateway.CheckEligibility(ctx, policy, appointment.DateTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check eligibility with payer: %w", err)
+		}
+	}
+
+	appointment.Eligibility = check
+	appointment.UpdatedBy = updaterID
+	if err := s.appRepo.Update(ctx, appointment.ID, appointment); err != nil {
+		return nil, fmt.Errorf("failed to update appointment: %w", err)
+	}
+
+	return check, nil
+}
+
+func (s *InsuranceService) GetClaims(ctx context.Context, status domain.ClaimStatus, payerType domain.PayerType) ([]*domain.ClaimEntity, error) {
+	return s.claimRepo.GetAll(ctx, status, payerType)
+}
+
+func (s *InsuranceService) GetClaimByID(ctx context.Context, id string) (*domain.ClaimEntity, error) {
+	claimID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	claim, err := s.claimRepo.GetByID(ctx, claimID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claim: %w", err)
+	}
+
+	return claim, nil
+}
+
+// CreateClaim generates a draft claim for the outstanding balance of an invoice.
+func (s *InsuranceService) CreateClaim(ctx context.Context, req *domain.CreateClaimRequest, creatorID primitive.ObjectID) (string, error) {
+	invoiceID, err := primitive.ObjectIDFromHex(req.InvoiceID)
+	if err != nil {
+		return "", fmt.Errorf("invalid invoice ID format: %w", err)
+	}
+
+	invoice, err := s.invoiceRepo.GetByID(ctx, invoiceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get invoice: %w", err)
+	}
+	if invoice == nil {
+		return "", errors.New("invoice not found")
+	}
+	if invoice.Status == domain.InvoiceStatusVoid {
+		return "", errors.New("cannot claim a void invoice")
+	}
+	if invoice.Balance() <= 0 {
+		return "", errors.New("invoice has no outstanding balance to claim")
+	}
+
+	existing, err := s.claimRepo.GetOpenByInvoiceID(ctx, invoiceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check existing claims: %w", err)
+	}
+	if existing != nil {
+		return "", fmt.Errorf("invoice is already claimed in %s", existing.Number)
+	}
+
+	serviceDate := invoice.IssuedAt
+	if invoice.AppointmentID != nil {
+		appointment, err := s.appRepo.GetByID(ctx, *invoice.AppointmentID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get appointment: %w", err)
+		}
+		if appointment != nil {
+			serviceDate = appointment.DateTime
+		}
+	}
+
+	var policy *domain.InsurancePolicyEntity
+	if req.PolicyID != "" {
+		policy, err = s.GetPolicyByID(ctx, req.PolicyID)
+		if err != nil {
+			return "", err
+		}
+		if policy == nil || policy.PatientID != invoice.PatientID {
+			return "", errors.New("insurance policy not found for the patient")
+		}
+		if !policy.CoversDate(serviceDate) {
+			return "", errors.New("insurance policy does not cover the service date")
+		}
+	} else {
+		policy, err = s.findCoveringPolicy(ctx, invoice.PatientID, serviceDate)
+		if err != nil {
+			return "", err
+		}
+		if policy == nil {
+			return "", errors.New("patient has no insurance policy covering the service date")
+		}
+	}
+
+	now := time.Now()
+	claim := &domain.ClaimEntity{
+		ID:            primitive.NewObjectID(),
+		InvoiceID:     invoice.ID,
+		InvoiceNumber: invoice.Number,
+		PatientID:     invoice.PatientID,
+		AppointmentID: invoice.AppointmentID,
+		PolicyID:      policy.ID,
+		PayerType:     policy.PayerType,
+		PayerName:     policy.PayerName,
+		MemberNumber:  policy.MemberNumber,
+		ServiceDate:   serviceDate,
+		Items:         invoice.Items,
+		Currency:      invoice.Currency,
+		ClaimedAmount: invoice.Balance(),
+		Status:        domain.ClaimStatusDraft,
+		History: []domain.ClaimStatusChange{{
+			Status:    domain.ClaimStatusDraft,
+			ChangedBy: creatorID,
+			ChangedAt: now,
+		}},
+		CreatedBy: creatorID,
+		UpdatedBy: creatorID,
+	}
+	claim.Number = fmt.Sprintf("CLM-%s-%s", now.Format("20060102"), strings.ToUpper(claim.ID.Hex()[16:]))
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if _, err := s.claimRepo.Create(sessionContext, claim); err != nil {
+			return fmt.Errorf("failed to create claim: %w", err)
+		}
+
+		err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypeInsurance, "Claim Created", fmt.Sprintf("Claim %s to %s has been drafted for invoice %s.", claim.Number, claim.PayerName, invoice.Number))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for new claim: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return claim.ID.Hex(), nil
+}
+
+// SubmitClaim sends a draft claim to the payer.
+func (s *InsuranceService) SubmitClaim(ctx context.Context, id string, updaterID primitive.ObjectID) error {
+	claim, err := s.GetClaimByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if claim == nil {
+		return errors.New("claim not found")
+	}
+	if !claim.Status.CanTransitionTo(domain.ClaimStatusSubmitted) {
+		return fmt.Errorf("cannot submit a claim in status %s", claim.Status)
+	}
+
+	// The claim is moved to Submitted before it is sent, in the same
+	// transaction, so a concurrent submission fails on the status instead of
+	// sending the claim to the payer twice. A failed submission rolls the
+	// status back.
+	from := claim.Status
+	now := time.Now()
+	claim.SubmittedAt = &now
+	s.changeClaimStatus(claim, domain.ClaimStatusSubmitted, "", updaterID)
+
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.updateClaim(sessionContext, claim, from); err != nil {
+			return err
+		}
+
+		reference, err := s.gateway.SubmitClaim(ctx, claim)
+		if err != nil {
+			return fmt.Errorf("payer rejected the submission: %w", err)
+		}
+		claim.PayerReference = reference
+		if err := s.updateClaim(sessionContext, claim, claim.Status); err != nil {
+			return err
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeInsurance, "Claim Submitted", fmt.Sprintf("Claim %s has been submitted to %s (ref %s).", claim.Number, claim.PayerName, reference))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for claim submission: %w", err)
+		}
+		return nil
+	})
+}
+
+// UpdateClaimStatus records the payer's decision on a claim. Paying a claim
+// posts an insurance payment on the invoice.
+func (s *InsuranceService) UpdateClaimStatus(ctx context.Context, id string, req *domain.UpdateClaimStatusRequest, updaterID primitive.ObjectID) error {
+	claim, err := s.GetClaimByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if claim == nil {
+		return errors.New("claim not found")
+	}
+	if !claim.Status.CanTransitionTo(req.Status) {
+		return fmt.Errorf("cannot move a claim from %s to %s", claim.Status, req.Status)
+	}
+
+	switch req.Status {
+	case domain.ClaimStatusApproved:
+		amount := req.Amount
+		if amount == 0 {
+			amount = claim.ClaimedAmount
+		}
+		if amount > claim.ClaimedAmount {
+			return errors.New("approved amount exceeds the claimed amount")
+		}
+		claim.ApprovedAmount = amount
+	case domain.ClaimStatusRejected:
+		if req.Reason == "" {
+			return errors.New("a reason is required to reject a claim")
+		}
+		claim.RejectionReason = req.Reason
+	case domain.ClaimStatusPaid:
+		amount := req.Amount
+		if amount == 0 {
+			amount = claim.ApprovedAmount
+		}
+		if amount > claim.ApprovedAmount {
+			return errors.New("paid amount exceeds the approved amount")
+		}
+		claim.PaidAmount = amount
+	case domain.ClaimStatusDraft:
+		claim.ApprovedAmount = 0
+		claim.RejectionReason = ""
+		claim.SubmittedAt = nil
+	}
+
+	if req.PayerReference != "" {
+		claim.PayerReference = req.PayerReference
+	}
+	from := claim.Status
+	s.changeClaimStatus(claim, req.Status, req.Reason, updaterID)
+
+	// The claim payment is posted with the status change, so a paid claim
+	// always has its payment on the invoice. The claim is updated first, on
+	// the status it was read in, so of two overlapping requests only one
+	// posts the payment.
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.updateClaim(sessionContext, claim, from); err != nil {
+			return err
+		}
+
+		if req.Status == domain.ClaimStatusPaid && claim.PaidAmount > 0 {
+			_, err := s.billingService.recordPayment(sessionContext, &domain.CreatePaymentRequest{
+				InvoiceID: claim.InvoiceID.Hex(),
+				Method:    domain.PaymentMethodInsurance,
+				Amount:    claim.PaidAmount,
+				Reference: claim.Number,
+				Notes:     fmt.Sprintf("%s claim payment", claim.PayerName),
+			}, updaterID)
+			if err != nil {
+				return fmt.Errorf("failed to post claim payment: %w", err)
+			}
+		}
+
+		err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypeInsurance, "Claim Status Updated", fmt.Sprintf("Claim %s status changed to %s.", claim.Number, req.Status))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for claim status update: %w", err)
+		}
+		return nil
+	})
+}
+
+// updateClaim saves the claim if it is still in status from.
+func (s *InsuranceService) updateClaim(ctx context.Context, claim *domain.ClaimEntity, from domain.ClaimStatus) error {
+	updated, err := s.claimRepo.Update(ctx, claim, from)
+	if err != nil {
+		return fmt.Errorf("failed to update claim: %w", err)
+	}
+	if !updated {
+		return fmt.Errorf("claim %s is no longer %s", claim.Number, from)
+	}
+	return nil
+}
+
+func (s *InsuranceService) changeClaimStatus(claim *domain.ClaimEntity, status domain.ClaimStatus, reason string, updaterID primitive.ObjectID) {
+	claim.Status = status
+	claim.UpdatedBy = updaterID
+	claim.History = append(claim.History, domain.ClaimStatusChange{
+		Status:    status,
+		Reason:    reason,
+		ChangedBy: updaterID,
+		ChangedAt: time.Now(),
+	})
+}
+
+// claimExportHeader lists the columns of the claim batch file.
+var claimExportHeader = []string{
+	"claim_number", "payer_type", "payer_name", "member_number", "patient_id",
+	"invoice_number", "service_date", "item_code", "item_description", "quantity",
+	"item_total", "claimed_amount", "currency", "status", "payer_reference",
+}
+
+// ExportClaims renders the matching claims as a CSV batch file with one row per claimed item.
+func (s *InsuranceService) ExportClaims(ctx context.Context, status domain.ClaimStatus, payerType domain.PayerType) ([]byte, error) {
+	claims, err := s.claimRepo.GetAll(ctx, status, payerType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claims: %w", err)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(claimExportHeader); err != nil {
+		return nil, err
+	}
+
+	for _, claim := range claims {
+		for _, item := range claim.Items {
+			record := []string{
+				claim.Number,
+				string(claim.PayerType),
+				claim.PayerName,
+				claim.MemberNumber,
+				claim.PatientID.Hex(),
+				claim.InvoiceNumber,
+				claim.ServiceDate.Format("2006-01-02"),
+				item.Code,
+				item.Description,
+				strconv.Itoa(item.Quantity),
+				strconv.FormatInt(item.Total, 10),
+				strconv.FormatInt(claim.ClaimedAmount, 10),
+				claim.Currency,
+				string(claim.Status),
+				claim.PayerReference,
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write claim batch: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/payer"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func newTestInsuranceService(mt *mtest.T, gateway payer.Gateway) *InsuranceService {
+	activityService := NewActivityService(
+		repository.NewActivityRepository(mt.DB.Collection("activities")),
+		repository.NewOutboxRepository(mt.DB.Collection("outbox")),
+	)
+	invoiceRepo := repository.NewInvoiceRepository(mt.DB.Collection("invoices"))
+	appRepo := repository.NewAppointmentRepository(mt.DB.Collection("appointments"))
+	patientRepo := repository.NewPatientRepository(mt.DB.Collection("patients"))
+
+	billingService := NewBillingService(
+		repository.NewTariffRepository(mt.DB.Collection("tariffs")),
+		invoiceRepo,
+		repository.NewPaymentRepository(mt.DB.Collection("payments")),
+		patientRepo,
+		appRepo,
+		activityService,
+		mt.Client,
+	)
+
+	return NewInsuranceService(
+		repository.NewInsurancePolicyRepository(mt.DB.Collection("insurance_policies")),
+		repository.NewClaimRepository(mt.DB.Collection("claims")),
+		patientRepo,
+		appRepo,
+		invoiceRepo,
+		billingService,
+		gateway,
+		activityService,
+		mt.Client,
+	)
+}
+
+func testClaim(status domain.ClaimStatus) *domain.ClaimEntity {
+	return &domain.ClaimEntity{
+		ID:            primitive.NewObjectID(),
+		Number:        "CLM-20250717-1F000001",
+		InvoiceID:     primitive.NewObjectID(),
+		InvoiceNumber: "INV-20250717-1F000001",
+		PatientID:     primitive.NewObjectID(),
+		PolicyID:      primitive.NewObjectID(),
+		PayerType:     domain.PayerTypeBPJS,
+		PayerName:     "BPJS Kesehatan",
+		MemberNumber:  "0001234567890",
+		ServiceDate:   time.Now(),
+		Currency:      "IDR",
+		ClaimedAmount: 1500000,
+		Status:        status,
+		History:       []domain.ClaimStatusChange{{Status: status, ChangedAt: time.Now()}},
+	}
+}
+
+func TestInsuranceSubmitClaim(t *testing.T) {
+	mt := newMockDB(t)
+	updaterID := primitive.NewObjectID()
+
+	mt.Run("draft is sent to the payer", func(mt *mtest.T) {
+		gateway := payer.NewFakeGateway()
+		s := newTestInsuranceService(mt, gateway)
+		claim := testClaim(domain.ClaimStatusDraft)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, claim)),
+			mockWrite(1),                  // claim submitted
+			mockWrite(1),                  // payer reference
+			mockWrite(1),                  // activity
+			mtest.CreateSuccessResponse(), // commit
+		)
+
+		if err := s.SubmitClaim(context.Background(), claim.ID.Hex(), updaterID); err != nil {
+			t.Fatalf("SubmitClaim() error = %v", err)
+		}
+
+		if got := gateway.Submitted(); !slices.Equal(got, []string{claim.Number}) {
+			t.Errorf("submitted = %v, want %s", got, claim.Number)
+		}
+
+		events := startedEvents(mt)
+		updates := decodeSets[domain.ClaimEntity](t, events, "claims")
+		if len(updates) != 2 || updates[0].Status != domain.ClaimStatusSubmitted {
+			t.Fatalf("claim updates = %+v, want it submitted before it is sent", updates)
+		}
+		updated := updates[1]
+		if updated.Status != domain.ClaimStatusSubmitted {
+			t.Errorf("status = %s, want Submitted", updated.Status)
+		}
+		if updated.PayerReference != "FAKE-"+claim.Number {
+			t.Errorf("payer reference = %q, want the gateway's", updated.PayerReference)
+		}
+		if updated.SubmittedAt == nil {
+			t.Error("submittedAt not set")
+		}
+		if last := updated.History[len(updated.History)-1]; last.Status != domain.ClaimStatusSubmitted || last.ChangedBy != updaterID {
+			t.Errorf("last history entry = %+v, want Submitted by the updater", last)
+		}
+		if names := commandNames(events); !slices.Contains(names, "commitTransaction") {
+			t.Errorf("commands = %v, want the update committed", names)
+		}
+	})
+
+	mt.Run("payer rejection leaves the claim a draft", func(mt *mtest.T) {
+		gateway := payer.NewFakeGateway()
+		s := newTestInsuranceService(mt, gateway)
+		claim := testClaim(domain.ClaimStatusDraft)
+		claim.ClaimedAmount = 0
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, claim)),
+			mockWrite(1),                  // claim submitted
+			mtest.CreateSuccessResponse(), // abort
+		)
+
+		if err := s.SubmitClaim(context.Background(), claim.ID.Hex(), updaterID); err == nil {
+			t.Fatal("SubmitClaim() succeeded for a zero claim")
+		}
+		if got := gateway.Submitted(); len(got) != 0 {
+			t.Errorf("submitted = %v, want none", got)
+		}
+		if names := startedCommands(mt); slices.Contains(names, "commitTransaction") || !slices.Contains(names, "abortTransaction") {
+			t.Errorf("commands = %v, want the status change rolled back", names)
+		}
+	})
+
+	mt.Run("overlapping submission is not sent twice", func(mt *mtest.T) {
+		gateway := payer.NewFakeGateway()
+		s := newTestInsuranceService(mt, gateway)
+		claim := testClaim(domain.ClaimStatusDraft)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, claim)),
+			mockWrite(0),                  // submitted by the other request
+			mtest.CreateSuccessResponse(), // abort
+		)
+
+		if err := s.SubmitClaim(context.Background(), claim.ID.Hex(), updaterID); err == nil {
+			t.Fatal("SubmitClaim() succeeded for a claim that is no longer a draft")
+		}
+		if got := gateway.Submitted(); len(got) != 0 {
+			t.Errorf("submitted = %v, want none", got)
+		}
+	})
+
+	mt.Run("submitted claim is not sent again", func(mt *mtest.T) {
+		gateway := payer.NewFakeGateway()
+		s := newTestInsuranceService(mt, gateway)
+		claim := testClaim(domain.ClaimStatusSubmitted)
+		mt.AddMockResponses(mockFind(mt, mockDoc(t, claim)))
+
+		if err := s.SubmitClaim(context.Background(), claim.ID.Hex(), updaterID); err == nil {
+			t.Fatal("SubmitClaim() succeeded for a submitted claim")
+		}
+		if got := gateway.Submitted(); len(got) != 0 {
+			t.Errorf("submitted = %v, want none", got)
+		}
+	})
+}
+
+func TestInsuranceClaimStatusFlow(t *testing.T) {
+	mt := newMockDB(t)
+	updaterID := primitive.NewObjectID()
+
+	mt.Run("approve defaults to the claimed amount", func(mt *mtest.T) {
+		s := newTestInsuranceService(mt, payer.NewFakeGateway())
+		claim := testClaim(domain.ClaimStatusSubmitted)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, claim)),
+			mockWrite(1),
+			mockWrite(1),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := s.UpdateClaimStatus(context.Background(), claim.ID.Hex(), &domain.UpdateClaimStatusRequest{Status: domain.ClaimStatusApproved}, updaterID)
+		if err != nil {
+			t.Fatalf("UpdateClaimStatus() error = %v", err)
+		}
+
+		updates := decodeSets[domain.ClaimEntity](t, startedEvents(mt), "claims")
+		if len(updates) != 1 || updates[0].Status != domain.ClaimStatusApproved || updates[0].ApprovedAmount != claim.ClaimedAmount {
+			t.Fatalf("updates = %+v, want approved for %d", updates, claim.ClaimedAmount)
+		}
+	})
+
+	mt.Run("paid claim posts an insurance payment", func(mt *mtest.T) {
+		s := newTestInsuranceService(mt, payer.NewFakeGateway())
+		claim := testClaim(domain.ClaimStatusApproved)
+		claim.ApprovedAmount = 1200000
+		invoice := &domain.InvoiceEntity{
+			ID:        claim.InvoiceID,
+			Number:    claim.InvoiceNumber,
+			PatientID: claim.PatientID,
+			Currency:  "IDR",
+			Total:     1500000,
+			Status:    domain.InvoiceStatusUnpaid,
+		}
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, claim)),
+			mockWrite(1), // claim update
+			mockFind(mt, mockDoc(t, invoice)),
+			mockWrite(1), // payment
+			mockWrite(1), // invoice update
+			mockWrite(1), // payment activity
+			mockWrite(1), // claim activity
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := s.UpdateClaimStatus(context.Background(), claim.ID.Hex(), &domain.UpdateClaimStatusRequest{Status: domain.ClaimStatusPaid}, updaterID)
+		if err != nil {
+			t.Fatalf("UpdateClaimStatus() error = %v", err)
+		}
+
+		events := startedEvents(mt)
+		invoices := decodeSets[domain.InvoiceEntity](t, events, "invoices")
+		if len(invoices) != 1 || invoices[0].AmountPaid != claim.ApprovedAmount || invoices[0].Status != domain.InvoiceStatusPartiallyPaid {
+			t.Fatalf("invoice updates = %+v, want %d paid", invoices, claim.ApprovedAmount)
+		}
+		claims := decodeSets[domain.ClaimEntity](t, events, "claims")
+		if len(claims) != 1 || claims[0].Status != domain.ClaimStatusPaid || claims[0].PaidAmount != claim.ApprovedAmount {
+			t.Fatalf("claim updates = %+v, want paid %d", claims, claim.ApprovedAmount)
+		}
+	})
+
+	mt.Run("overlapping payment is posted once", func(mt *mtest.T) {
+		s := newTestInsuranceService(mt, payer.NewFakeGateway())
+		claim := testClaim(domain.ClaimStatusApproved)
+		claim.ApprovedAmount = 1200000
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, claim)),
+			mockWrite(0),                  // paid by the other request
+			mtest.CreateSuccessResponse(), // abort
+		)
+
+		err := s.UpdateClaimStatus(context.Background(), claim.ID.Hex(), &domain.UpdateClaimStatusRequest{Status: domain.ClaimStatusPaid}, updaterID)
+		if err == nil {
+			t.Fatal("UpdateClaimStatus() paid a claim that is no longer approved")
+		}
+		if names := startedCommands(mt); slices.Contains(names, "insert") {
+			t.Errorf("commands = %v, want no payment posted", names)
+		}
+	})
+
+	mt.Run("paying more than approved is refused", func(mt *mtest.T) {
+		s := newTestInsuranceService(mt, payer.NewFakeGateway())
+		claim := testClaim(domain.ClaimStatusApproved)
+		claim.ApprovedAmount = 1000
+		mt.AddMockResponses(mockFind(mt, mockDoc(t, claim)))
+
+		err := s.UpdateClaimStatus(context.Background(), claim.ID.Hex(), &domain.UpdateClaimStatusRequest{Status: domain.ClaimStatusPaid, Amount: 2000}, updaterID)
+		if err == nil {
+			t.Fatal("UpdateClaimStatus() accepted a payment above the approved amount")
+		}
+	})
+
+	mt.Run("rejecting needs a reason", func(mt *mtest.T) {
+		s := newTestInsuranceService(mt, payer.NewFakeGateway())
+		claim := testClaim(domain.ClaimStatusSubmitted)
+		mt.AddMockResponses(mockFind(mt, mockDoc(t, claim)))
+
+		err := s.UpdateClaimStatus(context.Background(), claim.ID.Hex(), &domain.UpdateClaimStatusRequest{Status: domain.ClaimStatusRejected}, updaterID)
+		if err == nil {
+			t.Fatal("UpdateClaimStatus() rejected a claim without a reason")
+		}
+	})
+}
+
+func TestInsuranceCheckAppointmentEligibility(t *testing.T) {
+	mt := newMockDB(t)
+	now := time.Now()
+
+	appointment := &domain.AppointmentEntity{
+		ID:        primitive.NewObjectID(),
+		PatientID: primitive.NewObjectID(),
+		DoctorID:  primitive.NewObjectID(),
+		DateTime:  now,
+		Duration:  30,
+	}
+	policy := &domain.InsurancePolicyEntity{
+		ID:           primitive.NewObjectID(),
+		PatientID:    appointment.PatientID,
+		PayerType:    domain.PayerTypeBPJS,
+		PayerName:    "BPJS Kesehatan",
+		MemberNumber: "0001234567890",
+		ValidFrom:    now.AddDate(-1, 0, 0),
+		IsActive:     true,
+	}
+
+	tests := []struct {
+		name       string
+		ineligible bool
+		policies   []*domain.InsurancePolicyEntity
+		want       bool
+	}{
+		{"covered", false, []*domain.InsurancePolicyEntity{policy}, true},
+		{"payer says no", true, []*domain.InsurancePolicyEntity{policy}, false},
+		{"no policy", false, nil, false},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			gateway := payer.NewFakeGateway()
+			if tt.ineligible {
+				gateway.MarkIneligible(policy.MemberNumber, "premium in arrears")
+			}
+			s := newTestInsuranceService(mt, gateway)
+
+			findPolicies := mockFind(mt)
+			if len(tt.policies) > 0 {
+				findPolicies = mockFind(mt, mockDoc(t, tt.policies[0]))
+			}
+			mt.AddMockResponses(mockFind(mt, mockDoc(t, appointment)), findPolicies, mockWrite(1))
+
+			check, err := s.CheckAppointmentEligibility(context.Background(), appointment.ID.Hex(), primitive.NewObjectID())
+			if err != nil {
+				t.Fatalf("CheckAppointmentEligibility() error = %v", err)
+			}
+			if check.Eligible != tt.want {
+				t.Errorf("eligible = %v (%s), want %v", check.Eligible, check.Reason, tt.want)
+			}
+			if !tt.want && check.Reason == "" {
+				t.Error("ineligible check has no reason")
+			}
+
+			updates := decodeSets[domain.AppointmentEntity](t, startedEvents(mt), "appointments")
+			if len(updates) != 1 || updates[0].Eligibility == nil || updates[0].Eligibility.Eligible != tt.want {
+				t.Errorf("appointment updates = %+v, want the check stored", updates)
+			}
+		})
+	}
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type LabService struct {
+	labRepo         *repository.LabOrderRepository
+	patientRepo     *repository.PatientRepository
+	apptRepo        *repository.AppointmentRepository
+	activityService *ActivityService
+	mongoClient     *mongo.Client
+}
+
+func NewLabService(
+	labRepo *repository.LabOrderRepository,
+	patientRepo *repository.PatientRepository,
+	apptRepo *repository.AppointmentRepository,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+) *LabService {
+	return &LabService{
+		labRepo:         labRepo,
+		patientRepo:     patientRepo,
+		apptRepo:        apptRepo,
+		activityService: activityService,
+		mongoClient:     mongoClient,
+	}
+}
+
+func (s *LabService) GetAll(ctx context.Context) ([]*domain.LabOrderEntity, error) {
+	return s.labRepo.GetAll(ctx)
+}
+
+func (s *LabService) GetByID(ctx context.Context, id string) (*domain.LabOrderEntity, error) {
+	orderID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	order, err := s.labRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lab order: %w", err)
+	}
+
+	return order, nil
+}
+
+func (s *LabService) GetByPatientID(ctx context.Context, patientID string) ([]*domain.LabOrderEntity, error) {
+	patientObjID, err := primitive.ObjectIDFromHex(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient ID format: %w", err)
+	}
+
+	orders, err := s.labRepo.GetByPatientID(ctx, patientObjID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get patient's lab orders: %w", err)
+	}
+
+	return orders, nil
+}
+
+// GetWorklist returns the open orders for the lab, ordered by priority and then by age.
+func (s *LabService) GetWorklist(ctx context.Context) ([]domain.LabWorklistItem, error) {
+	orders, err := s.labRepo.GetByStatuses(ctx, domain.LabOrderStatusOrdered, domain.LabOrderStatusInProgress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lab worklist: %w", err)
+	}
+
+	sort.SliceStable(orders, func(i, j int) bool {
+		return orders[i].Priority.Rank() < orders[j].Priority.Rank()
+	})
+
+	patientNames := make(map[primitive.ObjectID]string)
+	items := make([]domain.LabWorklistItem, 0, len(orders))
+	for _, order := range orders {
+		name, ok := patientNames[order.PatientID]
+		if !ok {
+			patient, err := s.patientRepo.GetByID(ctx, order.PatientID)
+			if err == nil && patient != nil {
+				name = patient.Name
+			}
+			patientNames[order.PatientID] = name
+		}
+
+		items = append(items, domain.LabWorklistItem{
+			Order:       order.ToDTO(),
+			PatientName: name,
+		})
+	}
+
+	return items, nil
+}
+
+func (s *LabService) CreateOrder(ctx context.Context, req *domain.CreateLabOrderRequest, creatorID primitive.ObjectID) (string, error) {
+	patientID, err := primitive.ObjectIDFromHex(req.PatientID)
+	if err != nil {
+		return "", fmt.Errorf("invalid patient ID format: %w", err)
+	}
+	appointmentID, err := primitive.ObjectIDFromHex(req.AppointmentID)
+	if err != nil {
+		return "", fmt.Errorf("invalid appointment ID format: %w", err)
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, patientID)
+	if err != nil || patient == nil {
+		return "", errors.New("patient not found")
+	}
+
+	appointment, err := s.apptRepo.GetByID(ctx, appointmentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get appointment: %w", err)
+	}
+	if appointment == nil {
+		return "", errors.New("appointment not found")
+	}
+	if appointment.PatientID != patientID {
+		return "", errors.New("appointment does not belong to the patient")
+	}
+	if appointment.Status == domain.AppointmentStatusCancelled {
+		return "", errors.New("cannot order labs for a cancelled appointment")
+	}
+
+	order := &domain.LabOrderEntity{
+		PatientID:     patientID,
+		AppointmentID: appointmentID,
+		DoctorID:      appointment.DoctorID,
+		Tests:         req.Tests,
+		Priority:      req.Priority,
+		Status:        domain.LabOrderStatusOrdered,
+		ClinicalNotes: req.ClinicalNotes,
+		CreatedBy:     creatorID,
+		UpdatedBy:     creatorID,
+	}
+
+	var id primitive.ObjectID
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		id, err = s.labRepo.Create(sessionContext, order)
+		if err != nil {
+			return fmt.Errorf("failed to create lab order: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeLab, "Lab Order Placed", fmt.Sprintf("Lab order (%s) for patient %s has been placed with %s priority.", labTestCodes(order.Tests), patient.Name, order.Priority))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for new lab order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id.Hex(), nil
+}
+
+// UpdateStatus moves an open order between Ordered, InProgress and Cancelled.
+// Completion only happens through EnterResults.
+func (s *LabService) UpdateStatus(ctx context.Context, id string, status domain.LabOrderStatus, updaterID primitive.ObjectID) error {
+	order, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return errors.New("lab order not found")
+	}
+
+	if order.Status == domain.LabOrderStatusCompleted || order.Status == domain.LabOrderStatusCancelled {
+		return fmt.Errorf("cannot change status of a %s lab order", strings.ToLower(string(order.Status)))
+	}
+	if status == domain.LabOrderStatusCompleted {
+		return errors.New("lab orders are completed by entering results")
+	}
+
+	order.Status = status
+	order.UpdatedBy = updaterID
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.labRepo.Update(sessionContext, order.ID, order); err != nil {
+			return fmt.Errorf("failed to update lab order status: %w", err)
+		}
+
+		err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypeLab, "Lab Order Status Updated", fmt.Sprintf("Lab order %s status changed to %s.", id, status))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for lab order status update: %w", err)
+		}
+		return nil
+	})
+}
+
+// EnterResults records results for an order and marks it as completed.
+// Results must cover every test on the order. When no flag is supplied it is
+// derived from the numeric value and the reference range.
+func (s *LabService) EnterResults(ctx context.Context, id string, req *domain.EnterLabResultsRequest, labUserID primitive.ObjectID) error {
+	return s.enterResults(ctx, id, req.Results, nil, true, labUserID)
+}
+
+// enterResults records results for an order, replacing earlier results for
+// the same tests, and marks the results of the tests in preliminary as
+// preliminary. With all set the results must cover every test on the order.
+// The order is completed once every test has a final result and is in
+// progress until then.
+func (s *LabService) enterResults(ctx context.Context, id string, reqs []domain.LabResultRequest, preliminary map[string]bool, all bool, labUserID primitive.ObjectID) error {
+	orderID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		order, err := s.labRepo.GetByID(sessionContext, orderID)
+		if err != nil {
+			return fmt.Errorf("failed to get lab order: %w", err)
+		}
+		if order == nil {
+			return errors.New("lab order not found")
+		}
+		if order.Status == domain.LabOrderStatusCompleted || order.Status == domain.LabOrderStatusCancelled {
+			return fmt.Errorf("cannot enter results for a %s lab order", strings.ToLower(string(order.Status)))
+		}
+		from, fromResults := order.Status, order.Results
+
+		testNames := make(map[string]string, len(order.Tests))
+		for _, t := range order.Tests {
+			testNames[t.Code] = t.Name
+		}
+
+		now := time.Now()
+		entered := make(map[string]domain.LabResult, len(reqs))
+		for _, r := range reqs {
+			name, ok := testNames[r.TestCode]
+			if !ok {
+				return fmt.Errorf("test %s was not ordered", r.TestCode)
+			}
+			if _, ok := entered[r.TestCode]; ok {
+				return fmt.Errorf("duplicate result for test %s", r.TestCode)
+			}
+
+			flag := r.Flag
+			if flag == "" {
+				if value, err := strconv.ParseFloat(strings.TrimSpace(r.Value), 64); err == nil {
+					flag = r.ReferenceRange.Evaluate(value)
+				}
+			}
+
+			entered[r.TestCode] = domain.LabResult{
+				TestCode:       r.TestCode,
+				TestName:       name,
+				Value:          r.Value,
+				Unit:           r.Unit,
+				ReferenceRange: r.ReferenceRange,
+				Flag:           flag,
+				Notes:          r.Notes,
+				Preliminary:    preliminary[r.TestCode],
+				ResultedBy:     labUserID,
+				ResultedAt:     now,
+			}
+		}
+
+		if all && len(entered) != len(order.Tests) {
+			return errors.New("results must be entered for every ordered test")
+		}
+
+		// Results are kept in the order of the tests on the order.
+		previous := make(map[string]domain.LabResult, len(order.Results))
+		for _, r := range order.Results {
+			previous[r.TestCode] = r
+		}
+		results := make([]domain.LabResult, 0, len(order.Tests))
+		complete := true
+		for _, t := range order.Tests {
+			r, ok := entered[t.Code]
+			if !ok {
+				r, ok = previous[t.Code]
+			}
+			if !ok {
+				complete = false
+				continue
+			}
+			if r.Preliminary {
+				complete = false
+			}
+			results = append(results, r)
+		}
+
+		order.Results = results
+		order.UpdatedBy = labUserID
+		title := "Lab Results Available"
+		if complete {
+			order.Status = domain.LabOrderStatusCompleted
+			order.CompletedAt = &now
+		} else {
+			order.Status = domain.LabOrderStatusInProgress
+			title = "Partial Lab Results Available"
+		}
+		if order.HasAbnormalResults() {
+			title = "Abnormal " + title
+		}
+
+		patient, err := s.patientRepo.GetByID(sessionContext, order.PatientID)
+		if err != nil || patient == nil {
+			return errors.New("patient not found")
+		}
+
+		updated, err := s.labRepo.UpdateResults(sessionContext, order, from, fromResults)
+		if err != nil {
+			return fmt.Errorf("failed to save lab results: %w", err)
+		}
+		if !updated {
+			return fmt.Errorf("lab order %s was changed while its results were entered", id)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeLab, title, fmt.Sprintf("Results for lab order %s (%s) for patient %s have been entered.", id, labTestCodes(order.Tests), patient.Name))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for lab results: %w", err)
+		}
+		return nil
+	})
+}
+
+func labTestCodes(tests []domain.LabTest) string {
+	codes := make([]string, 0, len(tests))
+	for _, t := range tests {
+		codes = append(codes, t.Code)
+	}
+	return strings.Join(codes, ", ")
+}
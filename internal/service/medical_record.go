@@ -10,17 +10,20 @@ import (
 	"github.com/ekastn/hms-api/internal/domain"
 	"github.com/ekastn/hms-api/internal/repository"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type MedicalRecordService struct {
 	recordRepo      *repository.MedicalRecordRepository
 	activityService *ActivityService
+	mongoClient     *mongo.Client
 }
 
-func NewMedicalRecordService(recordRepo *repository.MedicalRecordRepository, activityService *ActivityService) *MedicalRecordService {
+func NewMedicalRecordService(recordRepo *repository.MedicalRecordRepository, activityService *ActivityService, mongoClient *mongo.Client) *MedicalRecordService {
 	return &MedicalRecordService{
 		recordRepo:      recordRepo,
 		activityService: activityService,
+		mongoClient:     mongoClient,
 	}
 }
 
@@ -42,18 +45,32 @@ func (s *MedicalRecordService) Create(ctx context.Context, record *domain.Medica
 	record.CreatedAt = time.Now()
 	record.UpdatedAt = time.Now()
 
-	id, err := s.recordRepo.Create(ctx, record)
+	var newRecordID string
+
+	err := withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		id, err := s.recordRepo.Create(sessionContext, record)
+		if err != nil {
+			return fmt.Errorf("failed to create medical record: %w", err)
+		}
+		record.ID = id
+		newRecordID = id.Hex()
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeMedicalRecord, "New Medical Record Created", fmt.Sprintf("Medical record for patient %s (diagnosis: %s) has been created.", record.PatientID.Hex(), record.Diagnosis))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for new medical record: %w", err)
+		}
+		err = s.activityService.PublishEvent(sessionContext, domain.EventMedicalRecordCreated, domain.ActivityTypeMedicalRecord, newRecordID, record.ToDTO())
+		if err != nil {
+			return fmt.Errorf("failed to publish medical record event: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create medical record: %w", err)
-	}
-
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeMedicalRecord, "New Medical Record Created", fmt.Sprintf("Medical record for patient %s (diagnosis: %s) has been created.", record.PatientID.Hex(), record.Diagnosis))
-	if err != nil {
-		// Log the error but don't block the medical record creation
-		fmt.Printf("Warning: failed to log activity for new medical record: %v\n", err)
+		return "", err
 	}
 
-	return id.Hex(), nil
+	return newRecordID, nil
 }
 
 func (s *MedicalRecordService) GetByID(ctx context.Context, id string) (*domain.MedicalRecordEntity, error) {
@@ -84,6 +101,21 @@ func (s *MedicalRecordService) GetByPatientID(ctx context.Context, patientID str
 	return records, nil
 }
 
+// GetByPatientIDs returns the medical records of each of the given patients,
+// latest first.
+func (s *MedicalRecordService) GetByPatientIDs(ctx context.Context, patientIDs []primitive.ObjectID) (map[primitive.ObjectID][]*domain.MedicalRecordEntity, error) {
+	records, err := s.recordRepo.GetByPatientIDs(ctx, patientIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get medical records: %w", err)
+	}
+
+	byPatient := make(map[primitive.ObjectID][]*domain.MedicalRecordEntity)
+	for _, record := range records {
+		byPatient[record.PatientID] = append(byPatient[record.PatientID], record)
+	}
+	return byPatient, nil
+}
+
 func (s *MedicalRecordService) GetByDateRange(ctx context.Context, start, end time.Time) ([]*domain.MedicalRecordEntity, error) {
 	records, err := s.recordRepo.GetByDateRange(ctx, start, end)
 	if err != nil {
@@ -117,17 +149,24 @@ func (s *MedicalRecordService) Update(ctx context.Context, id string, record *do
 	record.CreatedBy = existingRecord.CreatedBy
 	record.UpdatedBy = updaterID
 
-	if err := s.recordRepo.Update(ctx, recordID, record); err != nil {
-		return fmt.Errorf("failed to update medical record: %w", err)
-	}
+	record.ID = recordID
 
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeMedicalRecord, "Medical Record Updated", fmt.Sprintf("Medical record %s for patient %s has been updated.", id, record.PatientID.Hex()))
-	if err != nil {
-		// Log the error but don't block the medical record update
-		fmt.Printf("Warning: failed to log activity for medical record update: %v\n", err)
-	}
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.recordRepo.Update(sessionContext, recordID, record); err != nil {
+			return fmt.Errorf("failed to update medical record: %w", err)
+		}
 
-	return nil
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeMedicalRecord, "Medical Record Updated", fmt.Sprintf("Medical record %s for patient %s has been updated.", id, record.PatientID.Hex()))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for medical record update: %w", err)
+		}
+		err = s.activityService.PublishEvent(sessionContext, domain.EventMedicalRecordUpdated, domain.ActivityTypeMedicalRecord, id, record.ToDTO())
+		if err != nil {
+			return fmt.Errorf("failed to publish medical record event: %w", err)
+		}
+
+		return nil
+	})
 }
 
 func (s *MedicalRecordService) Delete(ctx context.Context, id string, updaterID primitive.ObjectID) error {
@@ -149,17 +188,22 @@ func (s *MedicalRecordService) Delete(ctx context.Context, id string, updaterID
 	// Set UpdatedBy before soft deleting
 	existingRecord.UpdatedBy = updaterID
 
-	if err := s.recordRepo.Delete(ctx, recordID); err != nil {
-		return fmt.Errorf("failed to delete medical record: %w", err)
-	}
-
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypeMedicalRecord, "Medical Record Deleted", fmt.Sprintf("Medical record %s has been deleted.", id))
-	if err != nil {
-		// Log the error but don't block the medical record deletion
-		fmt.Printf("Warning: failed to log activity for medical record deletion: %v\n", err)
-	}
-
-	return nil
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.recordRepo.Delete(sessionContext, recordID); err != nil {
+			return fmt.Errorf("failed to delete medical record: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeMedicalRecord, "Medical Record Deleted", fmt.Sprintf("Medical record %s has been deleted.", id))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for medical record deletion: %w", err)
+		}
+		err = s.activityService.PublishEvent(sessionContext, domain.EventMedicalRecordDeleted, domain.ActivityTypeMedicalRecord, id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to publish medical record event: %w", err)
+		}
+
+		return nil
+	})
 }
 
 func validateMedicalRecord(record *domain.MedicalRecordEntity) error {
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// newMockDB returns a mtest runner backed by a mock deployment, so services
+// can be tested without a Mongo server. Responses to the commands a test
+// issues are queued with mt.AddMockResponses, in order.
+func newMockDB(t *testing.T) *mtest.T {
+	return mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+}
+
+// mockDoc encodes v the way the driver would store it.
+func mockDoc(t *testing.T, v interface{}) bson.D {
+	t.Helper()
+
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to encode %T: %v", v, err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("failed to decode %T: %v", v, err)
+	}
+	return doc
+}
+
+// mockFind is the response to a find, or a FindOne, returning docs.
+func mockFind(mt *mtest.T, docs ...bson.D) bson.D {
+	return mtest.CreateCursorResponse(0, mt.Coll.Database().Name()+"."+mt.Coll.Name(), mtest.FirstBatch, docs...)
+}
+
+// mockCount is the response to a CountDocuments returning n.
+func mockCount(mt *mtest.T, n int) bson.D {
+	if n == 0 {
+		return mockFind(mt)
+	}
+	return mockFind(mt, bson.D{{Key: "n", Value: n}})
+}
+
+// mockWrite is the response to a write that matched and changed n documents.
+func mockWrite(n int) bson.D {
+	return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n}, bson.E{Key: "nModified", Value: n})
+}
+
+// startedCommands returns the names of the commands issued since the last
+// call.
+func startedCommands(mt *mtest.T) []string {
+	return commandNames(startedEvents(mt))
+}
+
+func commandNames(events []*event.CommandStartedEvent) []string {
+	var names []string
+	for _, e := range events {
+		names = append(names, e.CommandName)
+	}
+	return names
+}
+
+// startedEvents returns the commands issued since the last call.
+func startedEvents(mt *mtest.T) []*event.CommandStartedEvent {
+	var events []*event.CommandStartedEvent
+	for e := mt.GetStartedEvent(); e != nil; e = mt.GetStartedEvent() {
+		events = append(events, e)
+	}
+	return events
+}
+
+// decodeSets decodes the $set documents of the updates sent to the
+// collection into new values of T, in order.
+func decodeSets[T any](t *testing.T, events []*event.CommandStartedEvent, collection string) []*T {
+	t.Helper()
+
+	var docs []*T
+	for _, e := range events {
+		if e.CommandName != "update" || e.Command.Lookup("update").StringValue() != collection {
+			continue
+		}
+		updates, err := e.Command.Lookup("updates").Array().Values()
+		if err != nil {
+			t.Fatalf("failed to read updates: %v", err)
+		}
+		for _, u := range updates {
+			doc := new(T)
+			if err := bson.Unmarshal(u.Document().Lookup("u", "$set").Document(), doc); err != nil {
+				t.Fatalf("failed to decode update: %v", err)
+			}
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
+
+// decodeInserts decodes the documents inserted into the collection into new
+// values of T, in order.
+func decodeInserts[T any](t *testing.T, events []*event.CommandStartedEvent, collection string) []*T {
+	t.Helper()
+
+	var docs []*T
+	for _, e := range events {
+		if e.CommandName != "insert" || e.Command.Lookup("insert").StringValue() != collection {
+			continue
+		}
+		inserted, err := e.Command.Lookup("documents").Array().Values()
+		if err != nil {
+			t.Fatalf("failed to read documents: %v", err)
+		}
+		for _, d := range inserted {
+			doc := new(T)
+			if err := bson.Unmarshal(d.Document(), doc); err != nil {
+				t.Fatalf("failed to decode document: %v", err)
+			}
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
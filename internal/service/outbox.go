@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/events"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	outboxBatchSize   = 100
+	outboxLease       = time.Minute
+	outboxBaseBackoff = time.Second
+	outboxMaxBackoff  = 5 * time.Minute
+	outboxRetention   = 7 * 24 * time.Hour
+	outboxListLimit   = 200
+)
+
+// OutboxDispatcher delivers the events services wrote to the outbox: it
+// creates the activity feed entry, publishes to the event stream and queues
+// webhooks, HL7 messages and SATUSEHAT submissions. Delivery is
+// at-least-once; every step is idempotent on the event ID, so a message that
+// is retried after a partial failure does not create duplicates. A message
+// that still fails after maxAttempts is marked dead and left for an admin to
+// requeue.
+type OutboxDispatcher struct {
+	outboxRepo       *repository.OutboxRepository
+	activityRepo     *repository.ActivityRepository
+	eventBus         *events.Bus
+	webhookService   *WebhookService
+	hl7Service       *HL7Service
+	satusehatService *SatuSehatService
+	maxAttempts      int
+}
+
+func NewOutboxDispatcher(
+	outboxRepo *repository.OutboxRepository,
+	activityRepo *repository.ActivityRepository,
+	eventBus *events.Bus,
+	webhookService *WebhookService,
+	hl7Service *HL7Service,
+	satusehatService *SatuSehatService,
+	maxAttempts int,
+) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		outboxRepo:       outboxRepo,
+		activityRepo:     activityRepo,
+		eventBus:         eventBus,
+		webhookService:   webhookService,
+		hl7Service:       hl7Service,
+		satusehatService: satusehatService,
+		maxAttempts:      maxAttempts,
+	}
+}
+
+// Run dispatches due messages every interval until ctx is cancelled.
+// Dispatched messages are purged after a week.
+func (d *OutboxDispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastPurge := time.Time{}
+	for {
+		if err := d.dispatchDue(ctx); err != nil {
+			log.Printf("outbox dispatch failed: %v", err)
+		}
+
+		if time.Since(lastPurge) > time.Hour {
+			if _, err := d.outboxRepo.DeleteDispatchedBefore(ctx, time.Now().Add(-outboxRetention)); err != nil {
+				log.Printf("outbox purge failed: %v", err)
+			}
+			lastPurge = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *OutboxDispatcher) dispatchDue(ctx context.Context) error {
+	now := time.Now()
+	msgs, err := d.outboxRepo.GetDue(ctx, now, outboxBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get outbox messages: %w", err)
+	}
+
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		claimed, err := d.outboxRepo.Claim(ctx, msg.ID, now, now.Add(outboxLease))
+		if err != nil {
+			return fmt.Errorf("failed to claim outbox message: %w", err)
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := d.dispatch(ctx, msg); err != nil {
+			attempts := msg.Attempts + 1
+			if attempts >= d.maxAttempts {
+				log.Printf("outbox message %s (%s) is dead after %d attempts: %v", msg.ID.Hex(), msg.Type, attempts, err)
+				if err := d.outboxRepo.MarkDead(ctx, msg.ID, err.Error()); err != nil {
+					return fmt.Errorf("failed to record outbox failure: %w", err)
+				}
+				continue
+			}
+
+			log.Printf("outbox message %s (%s) failed, attempt %d: %v", msg.ID.Hex(), msg.Type, attempts, err)
+			if err := d.outboxRepo.MarkFailed(ctx, msg.ID, err.Error(), time.Now().Add(backoff(outboxBaseBackoff, outboxMaxBackoff, attempts))); err != nil {
+				return fmt.Errorf("failed to record outbox failure: %w", err)
+			}
+			continue
+		}
+
+		if err := d.outboxRepo.MarkDispatched(ctx, msg.ID, time.Now()); err != nil {
+			return fmt.Errorf("failed to mark outbox message dispatched: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetDead returns the most recent messages that were given up on.
+func (d *OutboxDispatcher) GetDead(ctx context.Context) ([]*domain.OutboxMessageEntity, error) {
+	return d.outboxRepo.GetByStatus(ctx, domain.OutboxStatusDead, outboxListLimit)
+}
+
+// Requeue puts a dead message back in the queue with a fresh attempt budget.
+func (d *OutboxDispatcher) Requeue(ctx context.Context, id string) error {
+	msgID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	requeued, err := d.outboxRepo.Requeue(ctx, msgID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to requeue outbox message: %w", err)
+	}
+	if !requeued {
+		return errors.New("dead outbox message not found")
+	}
+	return nil
+}
+
+func (d *OutboxDispatcher) dispatch(ctx context.Context, msg *domain.OutboxMessageEntity) error {
+	if msg.Activity != nil {
+		if err := d.activityRepo.CreateIfAbsent(ctx, msg.Activity); err != nil {
+			return fmt.Errorf("failed to create activity: %w", err)
+		}
+	}
+
+	event := msg.ToEvent()
+
+	if d.webhookService != nil {
+		if err := d.webhookService.Enqueue(ctx, event); err != nil {
+			return err
+		}
+	}
+	if d.hl7Service != nil {
+		if err := d.hl7Service.Enqueue(ctx, event); err != nil {
+			return err
+		}
+	}
+	if d.satusehatService != nil {
+		if err := d.satusehatService.Enqueue(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	// The event stream is in-memory and best effort: publish last so a retry
+	// after a failure above does not show the event twice.
+	d.eventBus.Publish(event)
+
+	return nil
+}
@@ -9,26 +9,33 @@ import (
 	"github.com/ekastn/hms-api/internal/domain"
 	"github.com/ekastn/hms-api/internal/repository"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type PatientService struct {
 	docRepo         *repository.PatientRepository
 	apptRepo        *repository.AppointmentRepository
 	recordRepo      *repository.MedicalRecordRepository
+	labRepo         *repository.LabOrderRepository
 	activityService *ActivityService
+	mongoClient     *mongo.Client
 }
 
 func NewPatientService(
 	repo *repository.PatientRepository,
 	apptRepo *repository.AppointmentRepository,
 	recordRepo *repository.MedicalRecordRepository,
+	labRepo *repository.LabOrderRepository,
 	activityService *ActivityService,
+	mongoClient *mongo.Client,
 ) *PatientService {
 	return &PatientService{
 		docRepo:         repo,
 		apptRepo:        apptRepo,
 		recordRepo:      recordRepo,
+		labRepo:         labRepo,
 		activityService: activityService,
+		mongoClient:     mongoClient,
 	}
 }
 
@@ -50,6 +57,21 @@ func (s *PatientService) GetByID(ctx context.Context, id string) (*domain.Patien
 	return patient, nil
 }
 
+// GetByIDs returns the patients with the given IDs by ID. Unknown IDs are
+// left out.
+func (s *PatientService) GetByIDs(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*domain.PatientEntity, error) {
+	patients, err := s.docRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get patients: %w", err)
+	}
+
+	byID := make(map[primitive.ObjectID]*domain.PatientEntity, len(patients))
+	for _, patient := range patients {
+		byID[patient.ID] = patient
+	}
+	return byID, nil
+}
+
 func (s *PatientService) GetPatientDetail(ctx context.Context, id string) (*domain.PatientDetailResponse, error) {
 	// Get patient
 	patient, err := s.GetByID(ctx, id)
@@ -72,6 +94,12 @@ func (s *PatientService) GetPatientDetail(ctx context.Context, id string) (*doma
 		return nil, fmt.Errorf("failed to get patient medical records: %w", err)
 	}
 
+	// Get lab results
+	labOrders, err := s.labRepo.GetCompletedByPatientID(ctx, patient.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get patient lab results: %w", err)
+	}
+
 	// Convert to DTOs
 	var appointmentDTOs []domain.AppointmentDTO
 	for _, appt := range appointments {
@@ -83,10 +111,16 @@ func (s *PatientService) GetPatientDetail(ctx context.Context, id string) (*doma
 		recordDTOs = append(recordDTOs, record.ToDTO())
 	}
 
+	var labDTOs []domain.LabOrderDTO
+	for _, order := range labOrders {
+		labDTOs = append(labDTOs, order.ToDTO())
+	}
+
 	return &domain.PatientDetailResponse{
 		Patient:            patient.ToDTO(),
 		RecentAppointments: appointmentDTOs,
 		MedicalHistory:     recordDTOs,
+		LabResults:         labDTOs,
 	}, nil
 }
 
@@ -102,19 +136,33 @@ func (s *PatientService) Create(ctx context.Context, patient *domain.PatientEnti
 	patient.UpdatedAt = now
 	patient.LastVisit = now
 
-	// Create patient in repository
-	id, err := s.docRepo.Create(ctx, patient)
+	var newPatientID string
+
+	err := withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		// Create patient in repository
+		id, err := s.docRepo.Create(sessionContext, patient)
+		if err != nil {
+			return fmt.Errorf("failed to create patient: %w", err)
+		}
+		patient.ID = id
+		newPatientID = id.Hex()
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypePatient, "New Patient Registered", fmt.Sprintf("Patient %s (%s) has been registered.", patient.Name, patient.Email))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for new patient: %w", err)
+		}
+		err = s.activityService.PublishEvent(sessionContext, domain.EventPatientCreated, domain.ActivityTypePatient, newPatientID, patient.ToDTO())
+		if err != nil {
+			return fmt.Errorf("failed to publish patient event: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create patient: %w", err)
-	}
-
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypePatient, "New Patient Registered", fmt.Sprintf("Patient %s (%s) has been registered.", patient.Name, patient.Email))
-	if err != nil {
-		// Log the error but don't block the patient creation
-		fmt.Printf("Warning: failed to log activity for new patient: %v\n", err)
+		return "", err
 	}
 
-	return id.Hex(), nil
+	return newPatientID, nil
 }
 
 func (s *PatientService) Update(ctx context.Context, id string, patient *domain.PatientEntity, updaterID primitive.ObjectID) error {
@@ -148,18 +196,23 @@ func (s *PatientService) Update(ctx context.Context, id string, patient *domain.
 	patient.UpdatedBy = updaterID
 	patient.ID = patientID
 
-	// Update patient in repository
-	if err := s.docRepo.Update(ctx, patientID, patient); err != nil {
-		return fmt.Errorf("failed to update patient: %w", err)
-	}
-
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypePatient, "Patient Information Updated", fmt.Sprintf("Patient %s (%s) information has been updated.", patient.Name, patient.Email))
-	if err != nil {
-		// Log the error but don't block the patient update
-		fmt.Printf("Warning: failed to log activity for patient update: %v\n", err)
-	}
-
-	return nil
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		// Update patient in repository
+		if err := s.docRepo.Update(sessionContext, patientID, patient); err != nil {
+			return fmt.Errorf("failed to update patient: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypePatient, "Patient Information Updated", fmt.Sprintf("Patient %s (%s) information has been updated.", patient.Name, patient.Email))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for patient update: %w", err)
+		}
+		err = s.activityService.PublishEvent(sessionContext, domain.EventPatientUpdated, domain.ActivityTypePatient, id, patient.ToDTO())
+		if err != nil {
+			return fmt.Errorf("failed to publish patient event: %w", err)
+		}
+
+		return nil
+	})
 }
 
 func validatePatientFields(patient *domain.PatientEntity) error {
@@ -186,20 +239,28 @@ func (s *PatientService) Delete(ctx context.Context, id string, updaterID primit
 	if err != nil {
 		return fmt.Errorf("failed to get patient: %w", err)
 	}
+	if existingPatient == nil {
+		return errors.New("patient not found")
+	}
 
 	// Set UpdatedBy before soft deleting
 	existingPatient.UpdatedBy = updaterID
 
-	// Delete patient from repository
-	if err := s.docRepo.Delete(ctx, patientID); err != nil {
-		return fmt.Errorf("failed to delete patient: %w", err)
-	}
-
-	err = s.activityService.CreateActivity(ctx, domain.ActivityTypePatient, "Patient Deleted", fmt.Sprintf("Patient with ID %s has been deleted.", id))
-	if err != nil {
-		// Log the error but don't block the patient deletion
-		fmt.Printf("Warning: failed to log activity for patient deletion: %v\n", err)
-	}
-
-	return nil
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		// Delete patient from repository
+		if err := s.docRepo.Delete(sessionContext, patientID); err != nil {
+			return fmt.Errorf("failed to delete patient: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypePatient, "Patient Deleted", fmt.Sprintf("Patient with ID %s has been deleted.", id))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for patient deletion: %w", err)
+		}
+		err = s.activityService.PublishEvent(sessionContext, domain.EventPatientDeleted, domain.ActivityTypePatient, id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to publish patient event: %w", err)
+		}
+
+		return nil
+	})
 }
@@ -0,0 +1,720 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const movementListLimit = 200
+
+// PharmacyService manages the pharmacy item catalogue, stock batches and the
+// stock movement ledger.
+type PharmacyService struct {
+	itemRepo        *repository.PharmacyItemRepository
+	batchRepo       *repository.StockBatchRepository
+	movementRepo    *repository.StockMovementRepository
+	patientRepo     *repository.PatientRepository
+	activityService *ActivityService
+	mongoClient     *mongo.Client
+	nearExpiryDays  int
+}
+
+func NewPharmacyService(
+	itemRepo *repository.PharmacyItemRepository,
+	batchRepo *repository.StockBatchRepository,
+	movementRepo *repository.StockMovementRepository,
+	patientRepo *repository.PatientRepository,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+	nearExpiryDays int,
+) *PharmacyService {
+	return &PharmacyService{
+		itemRepo:        itemRepo,
+		batchRepo:       batchRepo,
+		movementRepo:    movementRepo,
+		patientRepo:     patientRepo,
+		activityService: activityService,
+		mongoClient:     mongoClient,
+		nearExpiryDays:  nearExpiryDays,
+	}
+}
+
+func (s *PharmacyService) GetAllItems(ctx context.Context) ([]*domain.PharmacyItemEntity, error) {
+	return s.itemRepo.GetAll(ctx)
+}
+
+func (s *PharmacyService) GetItemByID(ctx context.Context, id string) (*domain.PharmacyItemEntity, error) {
+	itemID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pharmacy item: %w", err)
+	}
+
+	return item, nil
+}
+
+func (s *PharmacyService) CreateItem(ctx context.Context, req *domain.PharmacyItemRequest, creatorID primitive.ObjectID) (string, error) {
+	existing, err := s.itemRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return "", fmt.Errorf("error checking item code: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("pharmacy item with this code already exists")
+	}
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	item := &domain.PharmacyItemEntity{
+		Code:         req.Code,
+		Name:         req.Name,
+		Form:         req.Form,
+		Strength:     req.Strength,
+		Unit:         req.Unit,
+		ReorderLevel: req.ReorderLevel,
+		IsActive:     isActive,
+		CreatedBy:    creatorID,
+		UpdatedBy:    creatorID,
+	}
+
+	id, err := s.itemRepo.Create(ctx, item)
+	if err != nil {
+		return "", fmt.Errorf("failed to create pharmacy item: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *PharmacyService) UpdateItem(ctx context.Context, id string, req *domain.PharmacyItemRequest, updaterID primitive.ObjectID) error {
+	itemID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, itemID)
+	if err != nil {
+		return fmt.Errorf("failed to get pharmacy item: %w", err)
+	}
+	if item == nil {
+		return errors.New("pharmacy item not found")
+	}
+
+	existing, err := s.itemRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return fmt.Errorf("error checking item code: %w", err)
+	}
+	if existing != nil && existing.ID != item.ID {
+		return errors.New("pharmacy item with this code already exists")
+	}
+
+	item.Code = req.Code
+	item.Name = req.Name
+	item.Form = req.Form
+	item.Strength = req.Strength
+	item.Unit = req.Unit
+	item.ReorderLevel = req.ReorderLevel
+	if req.IsActive != nil {
+		item.IsActive = *req.IsActive
+	}
+	item.UpdatedBy = updaterID
+
+	return s.itemRepo.Update(ctx, item.ID, item)
+}
+
+// ReceiveStock books goods received into a batch. Receiving the same batch
+// number at the same location again tops up the existing batch.
+func (s *PharmacyService) ReceiveStock(ctx context.Context, req *domain.ReceiveStockRequest, creatorID primitive.ObjectID) (string, error) {
+	itemID, err := primitive.ObjectIDFromHex(req.ItemID)
+	if err != nil {
+		return "", fmt.Errorf("invalid item ID format: %w", err)
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, itemID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get pharmacy item: %w", err)
+	}
+	if item == nil {
+		return "", errors.New("pharmacy item not found")
+	}
+	if !item.IsActive {
+		return "", errors.New("pharmacy item is inactive")
+	}
+
+	now := time.Now()
+	if !req.ExpiryDate.After(now) {
+		return "", errors.New("cannot receive stock that is already expired")
+	}
+
+	var batchID primitive.ObjectID
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		batch, err := s.batchRepo.GetByBatchNumber(sessionContext, item.ID, req.BatchNumber, req.Location)
+		if err != nil {
+			return fmt.Errorf("failed to get stock batch: %w", err)
+		}
+
+		if batch != nil {
+			if !batch.ExpiryDate.Equal(req.ExpiryDate) {
+				return errors.New("batch already exists with a different expiry date")
+			}
+			if _, err := s.batchRepo.Adjust(sessionContext, batch.ID, req.Quantity); err != nil {
+				return fmt.Errorf("failed to update stock batch: %w", err)
+			}
+			batchID = batch.ID
+		} else {
+			batch = &domain.StockBatchEntity{
+				ItemID:      item.ID,
+				BatchNumber: req.BatchNumber,
+				Location:    req.Location,
+				ExpiryDate:  req.ExpiryDate,
+				Quantity:    req.Quantity,
+				UnitCost:    req.UnitCost,
+				Supplier:    req.Supplier,
+				ReceivedAt:  now,
+			}
+			batchID, err = s.batchRepo.Create(sessionContext, batch)
+			if err != nil {
+				return fmt.Errorf("failed to create stock batch: %w", err)
+			}
+		}
+
+		movement := &domain.StockMovementEntity{
+			ItemID:      item.ID,
+			BatchID:     batchID,
+			BatchNumber: req.BatchNumber,
+			Location:    req.Location,
+			Type:        domain.StockMovementReceived,
+			Quantity:    req.Quantity,
+			Reference:   req.Reference,
+			CreatedBy:   creatorID,
+		}
+		if _, err := s.movementRepo.Create(sessionContext, movement); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+
+		if err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypePharmacy, "Goods Received", fmt.Sprintf("%d %s of %s received into batch %s at %s.", req.Quantity, item.Unit, item.Name, req.BatchNumber, req.Location)); err != nil {
+			return fmt.Errorf("failed to log activity for goods received: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return batchID.Hex(), nil
+}
+
+// Dispense issues items to a patient. Stock is taken from the unexpired
+// batches at the location, first-expiry-first-out, and the whole dispense is
+// rolled back if any item does not have enough stock.
+func (s *PharmacyService) Dispense(ctx context.Context, req *domain.DispenseRequest, creatorID primitive.ObjectID) ([]*domain.StockMovementEntity, error) {
+	patientID, err := primitive.ObjectIDFromHex(req.PatientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient ID format: %w", err)
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, fmt.Errorf("failed to get patient: %w", err)
+	}
+	if patient.IsDeleted {
+		return nil, errors.New("patient not found")
+	}
+
+	var recordID *primitive.ObjectID
+	if req.MedicalRecordID != "" {
+		id, err := primitive.ObjectIDFromHex(req.MedicalRecordID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid medical record ID format: %w", err)
+		}
+		recordID = &id
+	}
+
+	items := make(map[primitive.ObjectID]*domain.PharmacyItemEntity)
+	for _, line := range req.Items {
+		itemID, err := primitive.ObjectIDFromHex(line.ItemID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item ID format: %w", err)
+		}
+		if _, ok := items[itemID]; ok {
+			continue
+		}
+
+		item, err := s.itemRepo.GetByID(ctx, itemID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pharmacy item: %w", err)
+		}
+		if item == nil {
+			return nil, fmt.Errorf("pharmacy item %s not found", line.ItemID)
+		}
+		if !item.IsActive {
+			return nil, fmt.Errorf("pharmacy item %s is inactive", item.Name)
+		}
+		items[itemID] = item
+	}
+
+	dispensed := make(map[primitive.ObjectID]int, len(items))
+	for _, line := range req.Items {
+		itemID, _ := primitive.ObjectIDFromHex(line.ItemID)
+		dispensed[itemID] += line.Quantity
+	}
+
+	var movements []*domain.StockMovementEntity
+	now := time.Now()
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		for _, line := range req.Items {
+			itemID, _ := primitive.ObjectIDFromHex(line.ItemID)
+			item := items[itemID]
+
+			batches, err := s.batchRepo.GetDispensable(sessionContext, itemID, req.Location, now)
+			if err != nil {
+				return fmt.Errorf("failed to get stock batches: %w", err)
+			}
+
+			remaining := line.Quantity
+			for _, batch := range batches {
+				if remaining == 0 {
+					break
+				}
+
+				take := min(remaining, batch.Quantity)
+				ok, err := s.batchRepo.Adjust(sessionContext, batch.ID, -take)
+				if err != nil {
+					return fmt.Errorf("failed to update stock batch: %w", err)
+				}
+				if !ok {
+					return fmt.Errorf("stock of batch %s changed while dispensing, please retry", batch.BatchNumber)
+				}
+
+				movement := &domain.StockMovementEntity{
+					ItemID:          itemID,
+					BatchID:         batch.ID,
+					BatchNumber:     batch.BatchNumber,
+					Location:        req.Location,
+					Type:            domain.StockMovementDispensed,
+					Quantity:        -take,
+					PatientID:       &patientID,
+					MedicalRecordID: recordID,
+					Reference:       req.Reference,
+					CreatedBy:       creatorID,
+				}
+				id, err := s.movementRepo.Create(sessionContext, movement)
+				if err != nil {
+					return fmt.Errorf("failed to record stock movement: %w", err)
+				}
+				movement.ID = id
+				movements = append(movements, movement)
+
+				remaining -= take
+			}
+
+			if remaining > 0 {
+				return fmt.Errorf("insufficient stock of %s at %s: %d %s short", item.Name, req.Location, remaining, item.Unit)
+			}
+		}
+
+		if err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypePharmacy, "Items Dispensed", fmt.Sprintf("%d item(s) dispensed to %s at %s.", len(req.Items), patient.Name, req.Location)); err != nil {
+			return fmt.Errorf("failed to log activity for dispense: %w", err)
+		}
+
+		for _, item := range items {
+			if err := s.checkLowStock(sessionContext, item, req.Location, dispensed[item.ID]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+// AdjustStock applies a manual correction to a batch, e.g. after a stock count.
+func (s *PharmacyService) AdjustStock(ctx context.Context, req *domain.AdjustStockRequest, creatorID primitive.ObjectID) error {
+	batchID, err := primitive.ObjectIDFromHex(req.BatchID)
+	if err != nil {
+		return fmt.Errorf("invalid batch ID format: %w", err)
+	}
+
+	batch, item, err := s.getBatchWithItem(ctx, batchID)
+	if err != nil {
+		return err
+	}
+
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		ok, err := s.batchRepo.Adjust(sessionContext, batch.ID, req.Delta)
+		if err != nil {
+			return fmt.Errorf("failed to update stock batch: %w", err)
+		}
+		if !ok {
+			return errors.New("adjustment would make the batch quantity negative")
+		}
+
+		movement := &domain.StockMovementEntity{
+			ItemID:      batch.ItemID,
+			BatchID:     batch.ID,
+			BatchNumber: batch.BatchNumber,
+			Location:    batch.Location,
+			Type:        domain.StockMovementAdjusted,
+			Quantity:    req.Delta,
+			Reason:      req.Reason,
+			CreatedBy:   creatorID,
+		}
+		if _, err := s.movementRepo.Create(sessionContext, movement); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+
+		if err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypePharmacy, "Stock Adjusted", fmt.Sprintf("Batch %s of %s at %s adjusted by %d: %s.", batch.BatchNumber, item.Name, batch.Location, req.Delta, req.Reason)); err != nil {
+			return fmt.Errorf("failed to log activity for stock adjustment: %w", err)
+		}
+
+		if req.Delta < 0 && batch.ExpiryDate.After(time.Now()) {
+			if err := s.checkLowStock(sessionContext, item, batch.Location, -req.Delta); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ExpireBatch writes off whatever is left of a batch as expired.
+func (s *PharmacyService) ExpireBatch(ctx context.Context, id string, creatorID primitive.ObjectID) error {
+	batchID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	batch, item, err := s.getBatchWithItem(ctx, batchID)
+	if err != nil {
+		return err
+	}
+	if batch.Quantity == 0 {
+		return errors.New("batch has no stock left to write off")
+	}
+
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		ok, err := s.batchRepo.Adjust(sessionContext, batch.ID, -batch.Quantity)
+		if err != nil {
+			return fmt.Errorf("failed to update stock batch: %w", err)
+		}
+		if !ok {
+			return errors.New("stock of the batch changed, please retry")
+		}
+
+		movement := &domain.StockMovementEntity{
+			ItemID:      batch.ItemID,
+			BatchID:     batch.ID,
+			BatchNumber: batch.BatchNumber,
+			Location:    batch.Location,
+			Type:        domain.StockMovementExpired,
+			Quantity:    -batch.Quantity,
+			Reason:      fmt.Sprintf("Expired on %s", batch.ExpiryDate.Format("2006-01-02")),
+			CreatedBy:   creatorID,
+		}
+		if _, err := s.movementRepo.Create(sessionContext, movement); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+
+		if err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypePharmacy, "Stock Expired", fmt.Sprintf("%d %s of %s in batch %s at %s written off as expired.", batch.Quantity, item.Unit, item.Name, batch.BatchNumber, batch.Location)); err != nil {
+			return fmt.Errorf("failed to log activity for expired stock: %w", err)
+		}
+
+		if batch.ExpiryDate.After(time.Now()) {
+			if err := s.checkLowStock(sessionContext, item, batch.Location, batch.Quantity); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *PharmacyService) GetBatches(ctx context.Context, itemIDHex, location string) ([]*domain.StockBatchEntity, error) {
+	var itemID *primitive.ObjectID
+	if itemIDHex != "" {
+		id, err := primitive.ObjectIDFromHex(itemIDHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item ID format: %w", err)
+		}
+		itemID = &id
+	}
+
+	return s.batchRepo.GetAll(ctx, itemID, location)
+}
+
+func (s *PharmacyService) GetMovements(ctx context.Context, itemIDHex string, movementType domain.StockMovementType) ([]*domain.StockMovementEntity, error) {
+	var itemID *primitive.ObjectID
+	if itemIDHex != "" {
+		id, err := primitive.ObjectIDFromHex(itemIDHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item ID format: %w", err)
+		}
+		itemID = &id
+	}
+	if movementType != "" && !movementType.IsValid() {
+		return nil, fmt.Errorf("invalid movement type: %s", movementType)
+	}
+
+	return s.movementRepo.GetAll(ctx, itemID, movementType, movementListLimit)
+}
+
+// GetStockLevels returns the dispensable (unexpired) stock of every item per
+// location, optionally restricted to one location.
+func (s *PharmacyService) GetStockLevels(ctx context.Context, location string) ([]domain.StockLevel, error) {
+	items, err := s.itemRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pharmacy items: %w", err)
+	}
+
+	batches, err := s.batchRepo.GetAll(ctx, nil, location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock batches: %w", err)
+	}
+
+	itemByID := make(map[primitive.ObjectID]*domain.PharmacyItemEntity, len(items))
+	for _, item := range items {
+		itemByID[item.ID] = item
+	}
+
+	type levelKey struct {
+		itemID   primitive.ObjectID
+		location string
+	}
+
+	now := time.Now()
+	levelByKey := make(map[levelKey]*domain.StockLevel)
+	for _, batch := range batches {
+		item, ok := itemByID[batch.ItemID]
+		if !ok || batch.IsExpired(now) {
+			continue
+		}
+
+		key := levelKey{batch.ItemID, batch.Location}
+		level, ok := levelByKey[key]
+		if !ok {
+			level = &domain.StockLevel{
+				ItemID:       item.ID.Hex(),
+				Code:         item.Code,
+				Name:         item.Name,
+				Location:     batch.Location,
+				ReorderLevel: item.ReorderLevel,
+			}
+			levelByKey[key] = level
+		}
+
+		level.Quantity += batch.Quantity
+		if level.NearestExpiry == nil || batch.ExpiryDate.Before(*level.NearestExpiry) {
+			expiry := batch.ExpiryDate
+			level.NearestExpiry = &expiry
+		}
+	}
+
+	levels := make([]domain.StockLevel, 0, len(levelByKey))
+	for _, level := range levelByKey {
+		level.IsLow = level.Quantity < level.ReorderLevel
+		levels = append(levels, *level)
+	}
+
+	sort.Slice(levels, func(i, j int) bool {
+		if levels[i].Name != levels[j].Name {
+			return levels[i].Name < levels[j].Name
+		}
+		return levels[i].Location < levels[j].Location
+	})
+
+	return levels, nil
+}
+
+// GetAlerts returns the current low-stock levels and the batches that expire
+// within the near-expiry window.
+func (s *PharmacyService) GetAlerts(ctx context.Context, location string) ([]domain.StockAlert, error) {
+	levels, err := s.GetStockLevels(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := []domain.StockAlert{}
+	for _, level := range levels {
+		if !level.IsLow {
+			continue
+		}
+		alerts = append(alerts, domain.StockAlert{
+			Type:     domain.StockAlertLowStock,
+			ItemID:   level.ItemID,
+			Code:     level.Code,
+			Name:     level.Name,
+			Location: level.Location,
+			Quantity: level.Quantity,
+			Message:  fmt.Sprintf("%s at %s is below the reorder level (%d/%d)", level.Name, level.Location, level.Quantity, level.ReorderLevel),
+		})
+	}
+
+	batches, err := s.batchRepo.GetAll(ctx, nil, location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock batches: %w", err)
+	}
+
+	cutoff := s.nearExpiryCutoff(time.Now())
+	for _, batch := range batches {
+		if batch.ExpiryDate.After(cutoff) {
+			continue
+		}
+
+		item, err := s.itemRepo.GetByID(ctx, batch.ItemID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pharmacy item: %w", err)
+		}
+		if item == nil {
+			continue
+		}
+
+		expiry := batch.ExpiryDate
+		alerts = append(alerts, domain.StockAlert{
+			Type:        domain.StockAlertNearExpiry,
+			ItemID:      item.ID.Hex(),
+			Code:        item.Code,
+			Name:        item.Name,
+			Location:    batch.Location,
+			BatchNumber: batch.BatchNumber,
+			Quantity:    batch.Quantity,
+			ExpiryDate:  &expiry,
+			Message:     nearExpiryMessage(item, batch),
+		})
+	}
+
+	return alerts, nil
+}
+
+// RunExpiryScanner periodically reports batches entering the near-expiry
+// window to the activity feed. Each batch is reported once. It blocks until
+// ctx is cancelled.
+func (s *PharmacyService) RunExpiryScanner(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.scanNearExpiry(ctx); err != nil {
+			log.Printf("pharmacy expiry scan failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PharmacyService) scanNearExpiry(ctx context.Context) error {
+	now := time.Now()
+	batches, err := s.batchRepo.GetExpiringUnalerted(ctx, s.nearExpiryCutoff(now))
+	if err != nil {
+		return fmt.Errorf("failed to get expiring batches: %w", err)
+	}
+
+	for _, batch := range batches {
+		item, err := s.itemRepo.GetByID(ctx, batch.ItemID)
+		if err != nil {
+			return fmt.Errorf("failed to get pharmacy item: %w", err)
+		}
+		if item == nil {
+			continue
+		}
+
+		err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+			if err := s.batchRepo.MarkExpiryAlerted(sessionContext, batch.ID, now); err != nil {
+				return fmt.Errorf("failed to mark batch as alerted: %w", err)
+			}
+			if err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypePharmacy, "Near Expiry", nearExpiryMessage(item, batch)); err != nil {
+				return fmt.Errorf("failed to log activity for near expiry: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkLowStock logs a low-stock activity when taking removed units of
+// dispensable stock of an item at a location took it below its reorder
+// level. Stock that was already below the level is not reported again, so
+// each drop is reported once rather than on every dispense. ctx must be the
+// session context of the transaction that changed the stock.
+func (s *PharmacyService) checkLowStock(ctx context.Context, item *domain.PharmacyItemEntity, location string, removed int) error {
+	if item.ReorderLevel <= 0 {
+		return nil
+	}
+
+	quantity, err := s.batchRepo.SumDispensable(ctx, item.ID, location, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to check stock level of %s: %w", item.Name, err)
+	}
+	if quantity >= item.ReorderLevel || quantity+removed < item.ReorderLevel {
+		return nil
+	}
+
+	err = s.activityService.CreateActivity(ctx, domain.ActivityTypePharmacy, "Low Stock", fmt.Sprintf("%s at %s is below the reorder level (%d/%d).", item.Name, location, quantity, item.ReorderLevel))
+	if err != nil {
+		return fmt.Errorf("failed to log activity for low stock: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PharmacyService) getBatchWithItem(ctx context.Context, batchID primitive.ObjectID) (*domain.StockBatchEntity, *domain.PharmacyItemEntity, error) {
+	batch, err := s.batchRepo.GetByID(ctx, batchID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get stock batch: %w", err)
+	}
+	if batch == nil {
+		return nil, nil, errors.New("stock batch not found")
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, batch.ItemID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get pharmacy item: %w", err)
+	}
+	if item == nil {
+		return nil, nil, errors.New("pharmacy item not found")
+	}
+
+	return batch, item, nil
+}
+
+func (s *PharmacyService) nearExpiryCutoff(now time.Time) time.Time {
+	return now.AddDate(0, 0, s.nearExpiryDays)
+}
+
+func nearExpiryMessage(item *domain.PharmacyItemEntity, batch *domain.StockBatchEntity) string {
+	if batch.IsExpired(time.Now()) {
+		return fmt.Sprintf("Batch %s of %s at %s expired on %s (%d %s left)", batch.BatchNumber, item.Name, batch.Location, batch.ExpiryDate.Format("2006-01-02"), batch.Quantity, item.Unit)
+	}
+	return fmt.Sprintf("Batch %s of %s at %s expires on %s (%d %s left)", batch.BatchNumber, item.Name, batch.Location, batch.ExpiryDate.Format("2006-01-02"), batch.Quantity, item.Unit)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func newTestPharmacyService(mt *mtest.T) *PharmacyService {
+	return NewPharmacyService(
+		repository.NewPharmacyItemRepository(mt.DB.Collection("pharmacy_items")),
+		repository.NewStockBatchRepository(mt.DB.Collection("stock_batches")),
+		repository.NewStockMovementRepository(mt.DB.Collection("stock_movements")),
+		repository.NewPatientRepository(mt.DB.Collection("patients")),
+		NewActivityService(
+			repository.NewActivityRepository(mt.DB.Collection("activities")),
+			repository.NewOutboxRepository(mt.DB.Collection("outbox")),
+		),
+		mt.Client,
+		90,
+	)
+}
+
+// activityTitles returns the titles of the activities written to the outbox
+// since the last call.
+func activityTitles(mt *mtest.T) []string {
+	var titles []string
+	for _, msg := range decodeInserts[domain.OutboxMessageEntity](mt.T, startedEvents(mt), "outbox") {
+		if msg.Activity != nil {
+			titles = append(titles, msg.Activity.Title)
+		}
+	}
+	return titles
+}
+
+func TestPharmacyLowStock(t *testing.T) {
+	mt := newMockDB(t)
+	creatorID := primitive.NewObjectID()
+	item := &domain.PharmacyItemEntity{
+		ID:           primitive.NewObjectID(),
+		Code:         "PCT500",
+		Name:         "Paracetamol",
+		Unit:         "tablet",
+		ReorderLevel: 100,
+		IsActive:     true,
+	}
+
+	// adjust takes 10 from a batch and reports the stock left at the location.
+	adjust := func(mt *mtest.T, left int, expiry time.Time, alerted bool) []string {
+		s := newTestPharmacyService(mt)
+		batch := &domain.StockBatchEntity{
+			ID:          primitive.NewObjectID(),
+			ItemID:      item.ID,
+			BatchNumber: "B2025-07",
+			Location:    "MAIN",
+			ExpiryDate:  expiry,
+			Quantity:    50,
+		}
+		responses := []bson.D{
+			mockFind(mt, mockDoc(mt.T, batch)),
+			mockFind(mt, mockDoc(mt.T, item)),
+			mockWrite(1), // batch
+			mockWrite(1), // movement
+			mockWrite(1), // activity
+		}
+		if expiry.After(time.Now()) {
+			responses = append(responses, mockFind(mt, bson.D{{Key: "quantity", Value: left}}))
+		}
+		if alerted {
+			responses = append(responses, mockWrite(1))
+		}
+		responses = append(responses, mtest.CreateSuccessResponse()) // commit
+		mt.AddMockResponses(responses...)
+
+		req := &domain.AdjustStockRequest{BatchID: batch.ID.Hex(), Delta: -10, Reason: "Stock count correction"}
+		if err := s.AdjustStock(context.Background(), req, creatorID); err != nil {
+			mt.Fatalf("AdjustStock() error = %v", err)
+		}
+		return activityTitles(mt)
+	}
+	nextYear := time.Now().AddDate(1, 0, 0)
+
+	mt.Run("dropping below the reorder level is reported", func(mt *mtest.T) {
+		titles := adjust(mt, 95, nextYear, true)
+		if len(titles) != 2 || titles[1] != "Low Stock" {
+			mt.Errorf("activities = %v, want a low-stock alert", titles)
+		}
+	})
+
+	mt.Run("stock already below the reorder level is not reported again", func(mt *mtest.T) {
+		titles := adjust(mt, 80, nextYear, false)
+		if len(titles) != 1 {
+			mt.Errorf("activities = %v, want no low-stock alert", titles)
+		}
+	})
+
+	mt.Run("stock above the reorder level is not reported", func(mt *mtest.T) {
+		titles := adjust(mt, 100, nextYear, false)
+		if len(titles) != 1 {
+			mt.Errorf("activities = %v, want no low-stock alert", titles)
+		}
+	})
+
+	mt.Run("expired stock does not count", func(mt *mtest.T) {
+		titles := adjust(mt, 0, time.Now().AddDate(0, -1, 0), false)
+		if len(titles) != 1 {
+			mt.Errorf("activities = %v, want no low-stock alert", titles)
+		}
+	})
+}
+
+func TestPharmacyDispensePatient(t *testing.T) {
+	mt := newMockDB(t)
+	creatorID := primitive.NewObjectID()
+	dispense := func(mt *mtest.T, s *PharmacyService) error {
+		req := &domain.DispenseRequest{
+			PatientID: primitive.NewObjectID().Hex(),
+			Location:  "MAIN",
+			Items:     []domain.DispenseItemRequest{{ItemID: primitive.NewObjectID().Hex(), Quantity: 1}},
+		}
+		_, err := s.Dispense(context.Background(), req, creatorID)
+		return err
+	}
+
+	mt.Run("unknown patient is not found", func(mt *mtest.T) {
+		s := newTestPharmacyService(mt)
+		mt.AddMockResponses(mockFind(mt))
+
+		if err := dispense(mt, s); err == nil || err.Error() != "patient not found" {
+			mt.Errorf("Dispense() error = %v, want patient not found", err)
+		}
+	})
+
+	mt.Run("deleted patient is not found", func(mt *mtest.T) {
+		s := newTestPharmacyService(mt)
+		patient := &domain.PatientEntity{ID: primitive.NewObjectID(), Name: "Budi Santoso", IsDeleted: true}
+		mt.AddMockResponses(mockFind(mt, mockDoc(mt.T, patient)))
+
+		if err := dispense(mt, s); err == nil || err.Error() != "patient not found" {
+			mt.Errorf("Dispense() error = %v, want patient not found", err)
+		}
+	})
+}
@@ -0,0 +1,495 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// queueBoardUpNext is how many waiting tickets the display board shows.
+const queueBoardUpNext = 5
+
+// QueueService runs the daily outpatient queues: walk-in tickets, check-in of
+// scheduled appointments and calling patients in.
+type QueueService struct {
+	queueRepo       *repository.QueueRepository
+	ticketRepo      *repository.QueueTicketRepository
+	appointmentRepo *repository.AppointmentRepository
+	patientRepo     *repository.PatientRepository
+	doctorRepo      *repository.DoctorRepository
+	activityService *ActivityService
+	mongoClient     *mongo.Client
+	defaultDuration int
+	location        *time.Location
+}
+
+func NewQueueService(
+	queueRepo *repository.QueueRepository,
+	ticketRepo *repository.QueueTicketRepository,
+	appointmentRepo *repository.AppointmentRepository,
+	patientRepo *repository.PatientRepository,
+	doctorRepo *repository.DoctorRepository,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+	defaultDuration int,
+	location *time.Location,
+) *QueueService {
+	if location == nil {
+		location = time.Local
+	}
+
+	return &QueueService{
+		queueRepo:       queueRepo,
+		ticketRepo:      ticketRepo,
+		appointmentRepo: appointmentRepo,
+		patientRepo:     patientRepo,
+		doctorRepo:      doctorRepo,
+		activityService: activityService,
+		mongoClient:     mongoClient,
+		defaultDuration: defaultDuration,
+		location:        location,
+	}
+}
+
+// today returns the queue date for the given time in the hospital time zone.
+func (s *QueueService) today(t time.Time) string {
+	return t.In(s.location).Format(domain.QueueDateFormat)
+}
+
+// GetQueues returns the queues of a date (today when empty), optionally
+// filtered by doctor or polyclinic.
+func (s *QueueService) GetQueues(ctx context.Context, date, doctorID, polyclinic string) ([]*domain.QueueEntity, error) {
+	if date == "" {
+		date = s.today(time.Now())
+	} else if _, err := time.Parse(domain.QueueDateFormat, date); err != nil {
+		return nil, errors.New("invalid date format, use YYYY-MM-DD")
+	}
+
+	var doctorFilter *primitive.ObjectID
+	if doctorID != "" {
+		id, err := primitive.ObjectIDFromHex(doctorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid doctor ID format: %w", err)
+		}
+		doctorFilter = &id
+	}
+
+	return s.queueRepo.GetAll(ctx, date, doctorFilter, polyclinic)
+}
+
+// GetQueueDetail returns the queue with its tickets, in calling order, and
+// the estimated wait of each waiting ticket.
+func (s *QueueService) GetQueueDetail(ctx context.Context, id string) (*domain.QueueDetailResponse, error) {
+	queue, tickets, err := s.loadQueue(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	avg := s.averageDuration(tickets)
+	return &domain.QueueDetailResponse{
+		Queue:                  *queue,
+		Tickets:                s.estimate(tickets, avg),
+		AverageDurationMinutes: avg,
+	}, nil
+}
+
+// GetBoard returns what the waiting-room display board shows: the tickets
+// being called and the next ones up, without patient names.
+func (s *QueueService) GetBoard(ctx context.Context, id string) (*domain.QueueBoardResponse, error) {
+	queue, tickets, err := s.loadQueue(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	board := &domain.QueueBoardResponse{
+		QueueID:    queue.ID.Hex(),
+		Date:       queue.Date,
+		Name:       queue.Polyclinic,
+		NowServing: []domain.QueueBoardTicket{},
+		UpNext:     []domain.QueueBoardTicket{},
+		UpdatedAt:  queue.UpdatedAt,
+	}
+
+	if queue.DoctorID != nil {
+		doctor, err := s.doctorRepo.GetByID(ctx, *queue.DoctorID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get doctor: %w", err)
+		}
+		if doctor != nil {
+			board.Name = doctor.Name
+		}
+	}
+
+	for _, ticket := range s.estimate(tickets, s.averageDuration(tickets)) {
+		if ticket.UpdatedAt.After(board.UpdatedAt) {
+			board.UpdatedAt = ticket.UpdatedAt
+		}
+
+		switch ticket.Status {
+		case domain.QueueTicketCalled:
+			board.NowServing = append(board.NowServing, domain.QueueBoardTicket{Code: ticket.Code, Counter: ticket.Counter})
+		case domain.QueueTicketWaiting:
+			board.WaitingCount++
+			if len(board.UpNext) < queueBoardUpNext {
+				board.UpNext = append(board.UpNext, domain.QueueBoardTicket{Code: ticket.Code, EstimatedWaitMinutes: ticket.EstimatedWaitMinutes})
+			}
+		}
+	}
+
+	return board, nil
+}
+
+// IssueWalkIn issues a ticket in today's queue of the doctor or polyclinic.
+func (s *QueueService) IssueWalkIn(ctx context.Context, req *domain.WalkInTicketRequest, issuerID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	var doctorID *primitive.ObjectID
+	if req.DoctorID != "" {
+		id, err := primitive.ObjectIDFromHex(req.DoctorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid doctor ID format: %w", err)
+		}
+		doctor, err := s.doctorRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get doctor: %w", err)
+		}
+		if doctor == nil || doctor.IsDeleted {
+			return nil, errors.New("doctor not found")
+		}
+		doctorID = &id
+	}
+
+	ticket := &domain.QueueTicketEntity{
+		Source:      domain.QueueTicketWalkIn,
+		PatientName: req.PatientName,
+		Duration:    s.defaultDuration,
+		Notes:       req.Notes,
+	}
+
+	if req.PatientID != "" {
+		patientID, err := primitive.ObjectIDFromHex(req.PatientID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid patient ID format: %w", err)
+		}
+		patient, err := s.patientRepo.GetByID(ctx, patientID)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, errors.New("patient not found")
+			}
+			return nil, fmt.Errorf("failed to get patient: %w", err)
+		}
+		ticket.PatientID = &patientID
+		ticket.PatientName = patient.Name
+	}
+
+	if err := s.issue(ctx, doctorID, req.Polyclinic, ticket, issuerID); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// CheckIn puts a patient with an appointment today into the doctor's queue.
+// Checking in twice returns the ticket issued the first time.
+func (s *QueueService) CheckIn(ctx context.Context, req *domain.CheckInRequest, issuerID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	appointmentID, err := primitive.ObjectIDFromHex(req.AppointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid appointment ID format: %w", err)
+	}
+
+	existing, err := s.ticketRepo.GetByAppointmentID(ctx, appointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing ticket: %w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	appointment, err := s.appointmentRepo.GetByID(ctx, appointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointment: %w", err)
+	}
+	if appointment == nil {
+		return nil, errors.New("appointment not found")
+	}
+	if appointment.Status != domain.AppointmentStatusScheduled && appointment.Status != domain.AppointmentStatusConfirmed {
+		return nil, fmt.Errorf("cannot check in an appointment that is %s", appointment.Status)
+	}
+	if s.today(appointment.DateTime) != s.today(time.Now()) {
+		return nil, errors.New("appointment is not scheduled for today")
+	}
+
+	ticket := &domain.QueueTicketEntity{
+		Source:        domain.QueueTicketAppointment,
+		PatientID:     &appointment.PatientID,
+		AppointmentID: &appointment.ID,
+		Priority:      appointment.Triage.Priority(),
+		Triage:        appointment.Triage,
+		Duration:      appointment.Duration,
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, appointment.PatientID)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to get patient: %w", err)
+	}
+	if patient != nil {
+		ticket.PatientName = patient.Name
+	}
+
+	if err := s.issue(ctx, &appointment.DoctorID, "", ticket, issuerID); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// issue numbers the ticket in today's queue and stores it.
+func (s *QueueService) issue(ctx context.Context, doctorID *primitive.ObjectID, polyclinic string, ticket *domain.QueueTicketEntity, issuerID primitive.ObjectID) error {
+	now := time.Now()
+	ticket.Status = domain.QueueTicketWaiting
+	ticket.IssuedAt = now
+	ticket.IssuedBy = issuerID
+	ticket.UpdatedBy = issuerID
+	ticket.UpdatedAt = now
+
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		queue, err := s.queueRepo.GetOrCreate(sessionContext, s.today(now), doctorID, polyclinic)
+		if err != nil {
+			return fmt.Errorf("failed to open queue: %w", err)
+		}
+
+		number, err := s.queueRepo.NextNumber(sessionContext, queue.ID)
+		if err != nil {
+			return fmt.Errorf("failed to number ticket: %w", err)
+		}
+
+		ticket.QueueID = queue.ID
+		ticket.Number = number
+		ticket.Code = fmt.Sprintf("%s-%03d", ticket.Source.Prefix(), number)
+
+		id, err := s.ticketRepo.Create(sessionContext, ticket)
+		if err != nil {
+			return fmt.Errorf("failed to create ticket: %w", err)
+		}
+		ticket.ID = id
+
+		if err := s.publish(sessionContext, domain.EventQueueTicketIssued, ticket); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// CallNext calls the next waiting ticket to the counter. The ticket called at
+// the counter before it is completed.
+func (s *QueueService) CallNext(ctx context.Context, queueID, counter string, updaterID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	queueObjID, err := primitive.ObjectIDFromHex(queueID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid queue ID format: %w", err)
+	}
+
+	queue, err := s.queueRepo.GetByID(ctx, queueObjID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue: %w", err)
+	}
+	if queue == nil {
+		return nil, errors.New("queue not found")
+	}
+
+	var called *domain.QueueTicketEntity
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		now := time.Now()
+
+		previous, err := s.ticketRepo.GetCalled(sessionContext, queueObjID, counter)
+		if err != nil {
+			return fmt.Errorf("failed to get called tickets: %w", err)
+		}
+		for _, ticket := range previous {
+			ticket.Status = domain.QueueTicketCompleted
+			ticket.CompletedAt = &now
+			ticket.UpdatedBy = updaterID
+			ticket.UpdatedAt = now
+			if err := s.ticketRepo.Update(sessionContext, ticket); err != nil {
+				return fmt.Errorf("failed to complete ticket: %w", err)
+			}
+			if err := s.publish(sessionContext, domain.EventQueueTicketUpdated, ticket); err != nil {
+				return err
+			}
+		}
+
+		called, err = s.ticketRepo.CallNext(sessionContext, queueObjID, counter, now, updaterID)
+		if err != nil {
+			return fmt.Errorf("failed to call next ticket: %w", err)
+		}
+		if called != nil {
+			if err := s.publish(sessionContext, domain.EventQueueTicketCalled, called); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if called == nil {
+		return nil, errors.New("no patients waiting")
+	}
+	return called, nil
+}
+
+// Skip moves a ticket whose patient did not answer the call out of the queue.
+// It can be recalled later.
+func (s *QueueService) Skip(ctx context.Context, ticketID string, updaterID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	return s.transition(ctx, ticketID, updaterID, domain.EventQueueTicketUpdated, func(ticket *domain.QueueTicketEntity, now time.Time) error {
+		if ticket.Status != domain.QueueTicketWaiting && ticket.Status != domain.QueueTicketCalled {
+			return fmt.Errorf("cannot skip a ticket that is %s", ticket.Status)
+		}
+		ticket.Status = domain.QueueTicketSkipped
+		return nil
+	})
+}
+
+// Recall calls a called or skipped ticket again, e.g. when the patient turns
+// up late.
+func (s *QueueService) Recall(ctx context.Context, ticketID string, updaterID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	return s.transition(ctx, ticketID, updaterID, domain.EventQueueTicketCalled, func(ticket *domain.QueueTicketEntity, now time.Time) error {
+		if ticket.Status != domain.QueueTicketCalled && ticket.Status != domain.QueueTicketSkipped {
+			return fmt.Errorf("cannot recall a ticket that is %s", ticket.Status)
+		}
+		ticket.Status = domain.QueueTicketCalled
+		ticket.CalledAt = &now
+		ticket.RecallCount++
+		return nil
+	})
+}
+
+// Complete marks a called ticket as served.
+func (s *QueueService) Complete(ctx context.Context, ticketID string, updaterID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	return s.transition(ctx, ticketID, updaterID, domain.EventQueueTicketUpdated, func(ticket *domain.QueueTicketEntity, now time.Time) error {
+		if ticket.Status != domain.QueueTicketCalled {
+			return fmt.Errorf("cannot complete a ticket that is %s", ticket.Status)
+		}
+		ticket.Status = domain.QueueTicketCompleted
+		ticket.CompletedAt = &now
+		return nil
+	})
+}
+
+// transition applies change to a ticket and publishes eventType for it.
+func (s *QueueService) transition(ctx context.Context, ticketID string, updaterID primitive.ObjectID, eventType domain.EventType, change func(*domain.QueueTicketEntity, time.Time) error) (*domain.QueueTicketEntity, error) {
+	id, err := primitive.ObjectIDFromHex(ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ticket ID format: %w", err)
+	}
+
+	ticket, err := s.ticketRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+	if ticket == nil {
+		return nil, errors.New("ticket not found")
+	}
+
+	now := time.Now()
+	if err := change(ticket, now); err != nil {
+		return nil, err
+	}
+	ticket.UpdatedBy = updaterID
+	ticket.UpdatedAt = now
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.ticketRepo.Update(sessionContext, ticket); err != nil {
+			return fmt.Errorf("failed to update ticket: %w", err)
+		}
+
+		if err := s.publish(sessionContext, eventType, ticket); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// publish writes a queue event to the outbox in the caller's transaction.
+// Display boards listen for these on the event stream.
+func (s *QueueService) publish(ctx context.Context, eventType domain.EventType, ticket *domain.QueueTicketEntity) error {
+	err := s.activityService.PublishEvent(ctx, eventType, domain.ActivityTypeQueue, ticket.ID.Hex(), ticket)
+	if err != nil {
+		return fmt.Errorf("failed to publish queue event: %w", err)
+	}
+	return nil
+}
+
+func (s *QueueService) loadQueue(ctx context.Context, id string) (*domain.QueueEntity, []*domain.QueueTicketEntity, error) {
+	queueID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid queue ID format: %w", err)
+	}
+
+	queue, err := s.queueRepo.GetByID(ctx, queueID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get queue: %w", err)
+	}
+	if queue == nil {
+		return nil, nil, errors.New("queue not found")
+	}
+
+	tickets, err := s.ticketRepo.GetByQueueID(ctx, queueID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get tickets: %w", err)
+	}
+
+	return queue, tickets, nil
+}
+
+// averageDuration is the mean expected service time of the queue's tickets,
+// taken from the appointment Duration for checked-in patients.
+func (s *QueueService) averageDuration(tickets []*domain.QueueTicketEntity) int {
+	total, count := 0, 0
+	for _, ticket := range tickets {
+		if ticket.Duration > 0 {
+			total += ticket.Duration
+			count++
+		}
+	}
+	if count == 0 {
+		return s.defaultDuration
+	}
+	return total / count
+}
+
+// estimate numbers the waiting tickets and estimates their wait: one average
+// duration for every ticket ahead, plus one for the patient being seen.
+func (s *QueueService) estimate(tickets []*domain.QueueTicketEntity, avg int) []domain.QueueTicketDTO {
+	serving := 0
+	for _, ticket := range tickets {
+		if ticket.Status == domain.QueueTicketCalled {
+			serving = 1
+			break
+		}
+	}
+
+	dtos := make([]domain.QueueTicketDTO, 0, len(tickets))
+	position := 0
+	for _, ticket := range tickets {
+		dto := domain.QueueTicketDTO{QueueTicketEntity: *ticket}
+		if ticket.Status == domain.QueueTicketWaiting {
+			position++
+			dto.Position = position
+			dto.EstimatedWaitMinutes = (position - 1 + serving) * avg
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos
+}
@@ -0,0 +1,454 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ReferralService manages referrals of patients between doctors and
+// specialties, from sending through to the appointment they result in.
+type ReferralService struct {
+	referralRepo    *repository.ReferralRepository
+	patientRepo     *repository.PatientRepository
+	doctorRepo      *repository.DoctorRepository
+	recordRepo      *repository.MedicalRecordRepository
+	appointmentRepo *repository.AppointmentRepository
+	activityService *ActivityService
+	mongoClient     *mongo.Client
+}
+
+func NewReferralService(
+	referralRepo *repository.ReferralRepository,
+	patientRepo *repository.PatientRepository,
+	doctorRepo *repository.DoctorRepository,
+	recordRepo *repository.MedicalRecordRepository,
+	appointmentRepo *repository.AppointmentRepository,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+) *ReferralService {
+	return &ReferralService{
+		referralRepo:    referralRepo,
+		patientRepo:     patientRepo,
+		doctorRepo:      doctorRepo,
+		recordRepo:      recordRepo,
+		appointmentRepo: appointmentRepo,
+		activityService: activityService,
+		mongoClient:     mongoClient,
+	}
+}
+
+// GetAll returns referrals, newest first, optionally filtered by patient,
+// referring doctor and status.
+func (s *ReferralService) GetAll(ctx context.Context, patientID, fromDoctorID string, status domain.ReferralStatus) ([]*domain.ReferralEntity, error) {
+	var patientFilter, doctorFilter *primitive.ObjectID
+
+	if patientID != "" {
+		id, err := primitive.ObjectIDFromHex(patientID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid patient ID format: %w", err)
+		}
+		patientFilter = &id
+	}
+
+	if fromDoctorID != "" {
+		id, err := primitive.ObjectIDFromHex(fromDoctorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid doctor ID format: %w", err)
+		}
+		doctorFilter = &id
+	}
+
+	if status != "" && !status.IsValid() {
+		return nil, fmt.Errorf("invalid referral status: %s", status)
+	}
+
+	return s.referralRepo.GetAll(ctx, patientFilter, doctorFilter, status)
+}
+
+func (s *ReferralService) GetByID(ctx context.Context, id string) (*domain.ReferralEntity, error) {
+	referralID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	referral, err := s.referralRepo.GetByID(ctx, referralID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referral: %w", err)
+	}
+
+	return referral, nil
+}
+
+// GetInbox returns the referrals waiting on a specialty, or on a doctor and
+// their specialty, most urgent and then oldest first. Without a status it
+// returns the open referrals, Sent and Accepted.
+func (s *ReferralService) GetInbox(ctx context.Context, specialty, doctorID string, status domain.ReferralStatus) ([]domain.ReferralInboxItem, error) {
+	var doctorFilter *primitive.ObjectID
+
+	if doctorID != "" {
+		id, err := primitive.ObjectIDFromHex(doctorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid doctor ID format: %w", err)
+		}
+		doctor, err := s.doctorRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get doctor: %w", err)
+		}
+		if doctor == nil || doctor.IsDeleted {
+			return nil, errors.New("doctor not found")
+		}
+		doctorFilter = &id
+		specialty = doctor.Specialty
+	}
+	if specialty == "" {
+		return nil, errors.New("specialty or doctorId is required")
+	}
+
+	statuses := []domain.ReferralStatus{domain.ReferralStatusSent, domain.ReferralStatusAccepted}
+	if status != "" {
+		if !status.IsValid() {
+			return nil, fmt.Errorf("invalid referral status: %s", status)
+		}
+		statuses = []domain.ReferralStatus{status}
+	}
+
+	referrals, err := s.referralRepo.GetInbox(ctx, specialty, doctorFilter, statuses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referral inbox: %w", err)
+	}
+
+	sort.SliceStable(referrals, func(i, j int) bool {
+		if ri, rj := referrals[i].Urgency.Rank(), referrals[j].Urgency.Rank(); ri != rj {
+			return ri < rj
+		}
+		return referrals[i].CreatedAt.Before(referrals[j].CreatedAt)
+	})
+
+	patientNames := make(map[primitive.ObjectID]string)
+	doctorNames := make(map[primitive.ObjectID]string)
+	items := make([]domain.ReferralInboxItem, 0, len(referrals))
+	for _, referral := range referrals {
+		patientName, ok := patientNames[referral.PatientID]
+		if !ok {
+			patient, err := s.patientRepo.GetByID(ctx, referral.PatientID)
+			if err == nil && patient != nil {
+				patientName = patient.Name
+			}
+			patientNames[referral.PatientID] = patientName
+		}
+
+		doctorName, ok := doctorNames[referral.FromDoctorID]
+		if !ok {
+			doctor, err := s.doctorRepo.GetByID(ctx, referral.FromDoctorID)
+			if err == nil && doctor != nil {
+				doctorName = doctor.Name
+			}
+			doctorNames[referral.FromDoctorID] = doctorName
+		}
+
+		items = append(items, domain.ReferralInboxItem{
+			Referral:       *referral,
+			PatientName:    patientName,
+			FromDoctorName: doctorName,
+		})
+	}
+
+	return items, nil
+}
+
+func (s *ReferralService) Create(ctx context.Context, req *domain.CreateReferralRequest, creatorID primitive.ObjectID) (*domain.ReferralEntity, error) {
+	patientID, err := primitive.ObjectIDFromHex(req.PatientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient ID format: %w", err)
+	}
+	fromDoctorID, err := primitive.ObjectIDFromHex(req.FromDoctorID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid doctor ID format: %w", err)
+	}
+
+	patient, err := s.patientRepo.GetByID(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, fmt.Errorf("failed to get patient: %w", err)
+	}
+
+	fromDoctor, err := s.getDoctor(ctx, fromDoctorID)
+	if err != nil {
+		return nil, err
+	}
+
+	referral := &domain.ReferralEntity{
+		PatientID:    patientID,
+		FromDoctorID: fromDoctorID,
+		Reason:       req.Reason,
+		Urgency:      req.Urgency,
+		Status:       domain.ReferralStatusSent,
+		CreatedBy:    creatorID,
+		UpdatedBy:    creatorID,
+	}
+
+	if req.ToDoctorID != "" {
+		toDoctorID, err := primitive.ObjectIDFromHex(req.ToDoctorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid doctor ID format: %w", err)
+		}
+		if toDoctorID == fromDoctorID {
+			return nil, errors.New("a doctor cannot refer a patient to themselves")
+		}
+		toDoctor, err := s.getDoctor(ctx, toDoctorID)
+		if err != nil {
+			return nil, err
+		}
+		if req.ToSpecialty != "" && !strings.EqualFold(req.ToSpecialty, toDoctor.Specialty) {
+			return nil, fmt.Errorf("doctor %s is not in %s", toDoctor.Name, req.ToSpecialty)
+		}
+		referral.ToDoctorID = &toDoctorID
+		referral.ToSpecialty = toDoctor.Specialty
+	} else {
+		doctors, err := s.doctorRepo.GetBySpecialty(ctx, req.ToSpecialty)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get doctors of specialty: %w", err)
+		}
+		if len(doctors) == 0 {
+			return nil, fmt.Errorf("no doctors in specialty %s", req.ToSpecialty)
+		}
+		// Store the specialty as it is spelled on the doctors
+		referral.ToSpecialty = doctors[0].Specialty
+	}
+
+	for _, hex := range req.RecordIDs {
+		recordID, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid medical record ID format: %w", err)
+		}
+		record, err := s.recordRepo.FindByID(ctx, recordID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get medical record: %w", err)
+		}
+		if record == nil || record.IsDeleted {
+			return nil, fmt.Errorf("medical record %s not found", hex)
+		}
+		if record.PatientID != patientID {
+			return nil, fmt.Errorf("medical record %s does not belong to the patient", hex)
+		}
+		referral.RecordIDs = append(referral.RecordIDs, recordID)
+	}
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		id, err := s.referralRepo.Create(sessionContext, referral)
+		if err != nil {
+			return fmt.Errorf("failed to create referral: %w", err)
+		}
+		referral.ID = id
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeReferral, "Patient Referred", fmt.Sprintf("%s referred patient %s to %s (%s).", fromDoctor.Name, patient.Name, referral.ToSpecialty, referral.Urgency))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for new referral: %w", err)
+		}
+		if err := s.publish(sessionContext, domain.EventReferralCreated, referral); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return referral, nil
+}
+
+// Respond accepts or declines a sent referral. A specialty referral can be
+// assigned to the accepting doctor.
+func (s *ReferralService) Respond(ctx context.Context, id string, req *domain.RespondReferralRequest, responderID primitive.ObjectID) (*domain.ReferralEntity, error) {
+	referral, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if referral.Status != domain.ReferralStatusSent {
+		return nil, fmt.Errorf("cannot respond to a referral that is %s", referral.Status)
+	}
+
+	if req.Status == domain.ReferralStatusAccepted && req.DoctorID != "" {
+		doctorID, err := primitive.ObjectIDFromHex(req.DoctorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid doctor ID format: %w", err)
+		}
+		if err := s.assign(ctx, referral, doctorID); err != nil {
+			return nil, err
+		}
+	}
+
+	from := referral.Status
+	now := time.Now()
+	referral.Status = req.Status
+	referral.ResponseNotes = req.Notes
+	referral.RespondedBy = responderID
+	referral.RespondedAt = &now
+	referral.UpdatedBy = responderID
+
+	title := "Referral Accepted"
+	if req.Status == domain.ReferralStatusDeclined {
+		title = "Referral Declined"
+	}
+
+	err = s.save(ctx, referral, from, domain.EventReferralStatusChanged, title, fmt.Sprintf("Referral %s to %s has been %s.", id, referral.ToSpecialty, strings.ToLower(string(req.Status))))
+	if err != nil {
+		return nil, err
+	}
+	return referral, nil
+}
+
+// LinkAppointment records the appointment booked for an accepted referral.
+// The appointment must be for the referred patient with the referred doctor,
+// or with a doctor of the referred specialty, who is then assigned.
+func (s *ReferralService) LinkAppointment(ctx context.Context, id string, req *domain.LinkReferralAppointmentRequest, updaterID primitive.ObjectID) (*domain.ReferralEntity, error) {
+	referral, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if referral.Status != domain.ReferralStatusAccepted {
+		return nil, errors.New("only accepted referrals can be linked to an appointment")
+	}
+
+	appointmentID, err := primitive.ObjectIDFromHex(req.AppointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid appointment ID format: %w", err)
+	}
+	appointment, err := s.appointmentRepo.GetByID(ctx, appointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointment: %w", err)
+	}
+	if appointment == nil {
+		return nil, errors.New("appointment not found")
+	}
+	if appointment.PatientID != referral.PatientID {
+		return nil, errors.New("appointment does not belong to the referred patient")
+	}
+	if appointment.Status == domain.AppointmentStatusCancelled {
+		return nil, errors.New("cannot link a cancelled appointment")
+	}
+
+	if err := s.assign(ctx, referral, appointment.DoctorID); err != nil {
+		return nil, err
+	}
+
+	referral.AppointmentID = &appointmentID
+	referral.UpdatedBy = updaterID
+
+	err = s.save(ctx, referral, domain.ReferralStatusAccepted, domain.EventReferralUpdated, "Referral Appointment Booked", fmt.Sprintf("Appointment %s on %s booked for referral %s.", req.AppointmentID, appointment.DateTime.Format(time.RFC3339), id))
+	if err != nil {
+		return nil, err
+	}
+	return referral, nil
+}
+
+// Complete closes an accepted referral once the patient has been seen.
+func (s *ReferralService) Complete(ctx context.Context, id string, updaterID primitive.ObjectID) (*domain.ReferralEntity, error) {
+	referral, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if referral.Status != domain.ReferralStatusAccepted {
+		return nil, errors.New("only accepted referrals can be completed")
+	}
+
+	from := referral.Status
+	now := time.Now()
+	referral.Status = domain.ReferralStatusCompleted
+	referral.CompletedAt = &now
+	referral.UpdatedBy = updaterID
+
+	err = s.save(ctx, referral, from, domain.EventReferralStatusChanged, "Referral Completed", fmt.Sprintf("Referral %s to %s has been completed.", id, referral.ToSpecialty))
+	if err != nil {
+		return nil, err
+	}
+	return referral, nil
+}
+
+// assign sets the receiving doctor of the referral, checking they are the
+// referred doctor or belong to the referred specialty.
+func (s *ReferralService) assign(ctx context.Context, referral *domain.ReferralEntity, doctorID primitive.ObjectID) error {
+	if referral.ToDoctorID != nil {
+		if *referral.ToDoctorID != doctorID {
+			return errors.New("referral is addressed to another doctor")
+		}
+		return nil
+	}
+
+	doctor, err := s.getDoctor(ctx, doctorID)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(doctor.Specialty, referral.ToSpecialty) {
+		return fmt.Errorf("doctor %s is not in %s", doctor.Name, referral.ToSpecialty)
+	}
+
+	referral.ToDoctorID = &doctorID
+	return nil
+}
+
+func (s *ReferralService) getDoctor(ctx context.Context, id primitive.ObjectID) (*domain.DoctorEntity, error) {
+	doctor, err := s.doctorRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get doctor: %w", err)
+	}
+	if doctor == nil || doctor.IsDeleted {
+		return nil, fmt.Errorf("doctor %s not found", id.Hex())
+	}
+	return doctor, nil
+}
+
+func (s *ReferralService) load(ctx context.Context, id string) (*domain.ReferralEntity, error) {
+	referral, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if referral == nil {
+		return nil, errors.New("referral not found")
+	}
+	return referral, nil
+}
+
+// save updates the referral if it is still in status from, logs the activity
+// and publishes eventType in one transaction.
+func (s *ReferralService) save(ctx context.Context, referral *domain.ReferralEntity, from domain.ReferralStatus, eventType domain.EventType, title, description string) error {
+	return withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		updated, err := s.referralRepo.Update(sessionContext, referral, from)
+		if err != nil {
+			return fmt.Errorf("failed to update referral: %w", err)
+		}
+		if !updated {
+			return fmt.Errorf("referral %s is no longer %s", referral.ID.Hex(), from)
+		}
+
+		if err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypeReferral, title, description); err != nil {
+			return fmt.Errorf("failed to log activity for referral: %w", err)
+		}
+		if err := s.publish(sessionContext, eventType, referral); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// publish writes a referral event to the outbox in the caller's transaction.
+func (s *ReferralService) publish(ctx context.Context, eventType domain.EventType, referral *domain.ReferralEntity) error {
+	err := s.activityService.PublishEvent(ctx, eventType, domain.ActivityTypeReferral, referral.ID.Hex(), referral)
+	if err != nil {
+		return fmt.Errorf("failed to publish referral event: %w", err)
+	}
+	return nil
+}
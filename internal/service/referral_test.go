@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func newTestReferralService(mt *mtest.T) *ReferralService {
+	return NewReferralService(
+		repository.NewReferralRepository(mt.DB.Collection("referrals")),
+		repository.NewPatientRepository(mt.DB.Collection("patients")),
+		repository.NewDoctorRepository(mt.DB.Collection("doctors")),
+		repository.NewMedicalRecordRepository(mt.DB.Collection("medical_records")),
+		repository.NewAppointmentRepository(mt.DB.Collection("appointments")),
+		NewActivityService(
+			repository.NewActivityRepository(mt.DB.Collection("activities")),
+			repository.NewOutboxRepository(mt.DB.Collection("outbox")),
+		),
+		mt.Client,
+	)
+}
+
+func testReferral(status domain.ReferralStatus) *domain.ReferralEntity {
+	return &domain.ReferralEntity{
+		ID:           primitive.NewObjectID(),
+		PatientID:    primitive.NewObjectID(),
+		FromDoctorID: primitive.NewObjectID(),
+		ToSpecialty:  "Cardiology",
+		Reason:       "Exertional chest pain, abnormal ECG",
+		Urgency:      domain.ReferralUrgencyUrgent,
+		Status:       status,
+	}
+}
+
+func TestReferralComplete(t *testing.T) {
+	mt := newMockDB(t)
+	updaterID := primitive.NewObjectID()
+
+	mt.Run("accepted referral is completed", func(mt *mtest.T) {
+		s := newTestReferralService(mt)
+		referral := testReferral(domain.ReferralStatusAccepted)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, referral)),
+			mockWrite(1),                  // referral update
+			mockWrite(1),                  // activity
+			mockWrite(1),                  // event
+			mtest.CreateSuccessResponse(), // commit
+		)
+
+		got, err := s.Complete(context.Background(), referral.ID.Hex(), updaterID)
+		if err != nil {
+			mt.Fatalf("Complete() error = %v", err)
+		}
+		if got.Status != domain.ReferralStatusCompleted || got.CompletedAt == nil {
+			mt.Errorf("Complete() = %+v, want it completed", got)
+		}
+
+		for _, e := range startedEvents(mt) {
+			if e.CommandName != "update" {
+				continue
+			}
+			filter := e.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("q")
+			if status := filter.Document().Lookup("status").StringValue(); status != string(domain.ReferralStatusAccepted) {
+				mt.Errorf("update filter status = %q, want %q", status, domain.ReferralStatusAccepted)
+			}
+		}
+	})
+
+	mt.Run("referral declined meanwhile is not completed", func(mt *mtest.T) {
+		s := newTestReferralService(mt)
+		referral := testReferral(domain.ReferralStatusAccepted)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(t, referral)),
+			mockWrite(0),                  // changed by the other request
+			mtest.CreateSuccessResponse(), // abort
+		)
+
+		if _, err := s.Complete(context.Background(), referral.ID.Hex(), updaterID); err == nil {
+			mt.Fatal("Complete() completed a referral that is no longer accepted")
+		}
+	})
+}
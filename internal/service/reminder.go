@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/notify"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const reminderListLimit = 200
+
+// ReminderSettings holds the hospital-wide reminder defaults. Patients can
+// override the channels and language in their reminder preferences.
+type ReminderSettings struct {
+	// Offsets are how long before the appointment reminders go out, e.g. 24h and 2h.
+	Offsets  []time.Duration
+	Channels []domain.ReminderChannel
+	Language domain.ReminderLanguage
+	// Location is the time zone appointment times are shown in.
+	Location *time.Location
+}
+
+// ReminderService sends appointment reminders to patients and manages their
+// reminder preferences.
+type ReminderService struct {
+	reminderRepo    *repository.ReminderRepository
+	prefRepo        *repository.ReminderPreferenceRepository
+	appointmentRepo *repository.AppointmentRepository
+	patientRepo     *repository.PatientRepository
+	doctorRepo      *repository.DoctorRepository
+	linkService     *AppointmentLinkService
+	email           notify.EmailSender
+	sms             notify.SMSSender
+	whatsapp        notify.WhatsAppSender
+	settings        ReminderSettings
+}
+
+func NewReminderService(
+	reminderRepo *repository.ReminderRepository,
+	prefRepo *repository.ReminderPreferenceRepository,
+	appointmentRepo *repository.AppointmentRepository,
+	patientRepo *repository.PatientRepository,
+	doctorRepo *repository.DoctorRepository,
+	linkService *AppointmentLinkService,
+	email notify.EmailSender,
+	sms notify.SMSSender,
+	whatsapp notify.WhatsAppSender,
+	settings ReminderSettings,
+) *ReminderService {
+	sort.Slice(settings.Offsets, func(i, j int) bool { return settings.Offsets[i] < settings.Offsets[j] })
+	if settings.Location == nil {
+		settings.Location = time.Local
+	}
+
+	return &ReminderService{
+		reminderRepo:    reminderRepo,
+		prefRepo:        prefRepo,
+		appointmentRepo: appointmentRepo,
+		patientRepo:     patientRepo,
+		doctorRepo:      doctorRepo,
+		linkService:     linkService,
+		email:           email,
+		sms:             sms,
+		whatsapp:        whatsapp,
+		settings:        settings,
+	}
+}
+
+// GetPreference returns the reminder preferences of a patient, falling back to
+// the hospital defaults when the patient has none.
+func (s *ReminderService) GetPreference(ctx context.Context, patientID string) (*domain.ReminderPreferenceEntity, error) {
+	patientObjID, err := primitive.ObjectIDFromHex(patientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient ID format: %w", err)
+	}
+
+	pref, err := s.prefRepo.GetByPatientID(ctx, patientObjID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminder preferences: %w", err)
+	}
+	if pref == nil {
+		pref = &domain.ReminderPreferenceEntity{PatientID: patientObjID}
+	}
+
+	if len(pref.Channels) == 0 {
+		pref.Channels = s.settings.Channels
+	}
+	if pref.Language == "" {
+		pref.Language = s.settings.Language
+	}
+
+	return pref, nil
+}
+
+func (s *ReminderService) UpdatePreference(ctx context.Context, patientID string, req *domain.ReminderPreferenceRequest, updaterID primitive.ObjectID) error {
+	patientObjID, err := primitive.ObjectIDFromHex(patientID)
+	if err != nil {
+		return fmt.Errorf("invalid patient ID format: %w", err)
+	}
+
+	if _, err := s.patientRepo.GetByID(ctx, patientObjID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return errors.New("patient not found")
+		}
+		return fmt.Errorf("failed to get patient: %w", err)
+	}
+
+	pref := &domain.ReminderPreferenceEntity{
+		PatientID: patientObjID,
+		OptOut:    req.OptOut,
+		Language:  req.Language,
+		Channels:  req.Channels,
+		UpdatedBy: updaterID,
+		UpdatedAt: time.Now(),
+	}
+
+	if err := s.prefRepo.Save(ctx, pref); err != nil {
+		return fmt.Errorf("failed to save reminder preferences: %w", err)
+	}
+
+	return nil
+}
+
+// GetReminders returns the most recent reminders, optionally filtered by
+// appointment, patient and status.
+func (s *ReminderService) GetReminders(ctx context.Context, appointmentID, patientID string, status domain.ReminderStatus) ([]*domain.ReminderEntity, error) {
+	var apptFilter, patientFilter *primitive.ObjectID
+
+	if appointmentID != "" {
+		id, err := primitive.ObjectIDFromHex(appointmentID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid appointment ID format: %w", err)
+		}
+		apptFilter = &id
+	}
+
+	if patientID != "" {
+		id, err := primitive.ObjectIDFromHex(patientID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid patient ID format: %w", err)
+		}
+		patientFilter = &id
+	}
+
+	if status != "" && !status.IsValid() {
+		return nil, fmt.Errorf("invalid reminder status: %s", status)
+	}
+
+	return s.reminderRepo.GetAll(ctx, apptFilter, patientFilter, status, reminderListLimit)
+}
+
+// RunScheduler periodically sends the reminders that have become due and
+// tells patients about appointments moved for an emergency. It blocks until
+// ctx is cancelled.
+func (s *ReminderService) RunScheduler(ctx context.Context, interval time.Duration) {
+	if len(s.settings.Offsets) == 0 {
+		log.Println("appointment reminders disabled: no offsets configured")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if len(s.settings.Offsets) > 0 {
+			if err := s.sendDue(ctx, time.Now()); err != nil {
+				log.Printf("appointment reminder run failed: %v", err)
+			}
+		}
+		if err := s.sendRescheduled(ctx); err != nil {
+			log.Printf("rescheduled appointment notice run failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendDue sends one reminder per upcoming appointment and channel for the
+// tightest offset the appointment has entered. Offsets that were missed, for
+// instance because the appointment was booked an hour before, are not sent.
+func (s *ReminderService) sendDue(ctx context.Context, now time.Time) error {
+	maxOffset := s.settings.Offsets[len(s.settings.Offsets)-1]
+
+	statuses := []domain.AppointmentStatus{domain.AppointmentStatusScheduled, domain.AppointmentStatusConfirmed}
+	appointments, err := s.appointmentRepo.GetByStatusAndTimeRange(ctx, statuses, now, now.Add(maxOffset))
+	if err != nil {
+		return fmt.Errorf("failed to get upcoming appointments: %w", err)
+	}
+
+	for _, appointment := range appointments {
+		until := appointment.DateTime.Sub(now)
+
+		var offset time.Duration
+		for _, o := range s.settings.Offsets {
+			if o >= until {
+				offset = o
+				break
+			}
+		}
+
+		if err := s.notify(ctx, appointment, domain.ReminderKindReminder, offset); err != nil {
+			log.Printf("failed to send reminders for appointment %s: %v", appointment.ID.Hex(), err)
+		}
+	}
+
+	return nil
+}
+
+// sendRescheduled tells patients that their appointment was moved to make
+// room for an emergency.
+func (s *ReminderService) sendRescheduled(ctx context.Context) error {
+	appointments, err := s.appointmentRepo.GetBumpedUnnotified(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get rescheduled appointments: %w", err)
+	}
+
+	for _, appointment := range appointments {
+		if err := s.notify(ctx, appointment, domain.ReminderKindRescheduled, 0); err != nil {
+			log.Printf("failed to send rescheduled notice for appointment %s: %v", appointment.ID.Hex(), err)
+			continue
+		}
+		if err := s.appointmentRepo.MarkBumpNotified(ctx, appointment.ID, time.Now()); err != nil {
+			log.Printf("failed to mark appointment %s as notified: %v", appointment.ID.Hex(), err)
+		}
+	}
+
+	return nil
+}
+
+// notify sends a message of the kind about the appointment on each of the
+// patient's channels, unless the patient opted out.
+func (s *ReminderService) notify(ctx context.Context, appointment *domain.AppointmentEntity, kind domain.ReminderKind, offset time.Duration) error {
+	patient, err := s.patientRepo.GetByID(ctx, appointment.PatientID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		return fmt.Errorf("failed to get patient: %w", err)
+	}
+	if patient.IsDeleted {
+		return nil
+	}
+
+	pref, err := s.GetPreference(ctx, patient.ID.Hex())
+	if err != nil {
+		return err
+	}
+	if pref.OptOut {
+		return nil
+	}
+
+	data := notify.ReminderData{
+		PatientName: patient.Name,
+		Location:    appointment.Location,
+		DateTime:    appointment.DateTime.In(s.settings.Location),
+	}
+	if appointment.Bump != nil {
+		data.PreviousDateTime = appointment.Bump.PreviousDateTime.In(s.settings.Location)
+	}
+
+	doctor, err := s.doctorRepo.GetByID(ctx, appointment.DoctorID)
+	if err != nil {
+		return fmt.Errorf("failed to get doctor: %w", err)
+	}
+	if doctor != nil {
+		data.DoctorName = doctor.Name
+	}
+
+	data.ConfirmURL, data.CancelURL, err = s.linkService.Links(appointment, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range pref.Channels {
+		reminder := &domain.ReminderEntity{
+			AppointmentID:   appointment.ID,
+			PatientID:       patient.ID,
+			Kind:            kind,
+			AppointmentTime: appointment.DateTime,
+			OffsetMinutes:   int(offset / time.Minute),
+			Channel:         channel,
+			Language:        pref.Language,
+		}
+
+		claimed, err := s.reminderRepo.Claim(ctx, reminder)
+		if err != nil {
+			return fmt.Errorf("failed to claim reminder: %w", err)
+		}
+		if !claimed {
+			continue
+		}
+
+		s.send(ctx, reminder, patient, data)
+
+		if err := s.reminderRepo.Finish(ctx, reminder); err != nil {
+			return fmt.Errorf("failed to save reminder: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// send delivers a claimed reminder over its channel and records the outcome
+// on it. A reminder is never retried: sending it twice is worse than not at all.
+func (s *ReminderService) send(ctx context.Context, reminder *domain.ReminderEntity, patient *domain.PatientEntity, data notify.ReminderData) {
+	isEmail := reminder.Channel == domain.ReminderChannelEmail
+
+	reminder.Recipient = patient.Phone
+	if isEmail {
+		reminder.Recipient = patient.Email
+	}
+	if reminder.Recipient == "" {
+		reminder.Status = domain.ReminderStatusSkipped
+		reminder.Error = fmt.Sprintf("patient has no %s contact", reminder.Channel)
+		return
+	}
+
+	render := notify.RenderReminder
+	if reminder.Kind == domain.ReminderKindRescheduled {
+		render = notify.RenderRescheduled
+	}
+
+	subject, body, err := render(string(reminder.Language), isEmail, data)
+	if err != nil {
+		reminder.Status = domain.ReminderStatusFailed
+		reminder.Error = err.Error()
+		return
+	}
+	reminder.Message = body
+	if isEmail {
+		reminder.Subject = subject
+	}
+
+	switch reminder.Channel {
+	case domain.ReminderChannelEmail:
+		err = s.email.SendEmail(ctx, reminder.Recipient, subject, body)
+	case domain.ReminderChannelSMS:
+		err = s.sms.SendSMS(ctx, reminder.Recipient, body)
+	case domain.ReminderChannelWhatsApp:
+		err = s.whatsapp.SendWhatsApp(ctx, reminder.Recipient, body)
+	default:
+		err = fmt.Errorf("unsupported channel: %s", reminder.Channel)
+	}
+
+	if err != nil {
+		reminder.Status = domain.ReminderStatusFailed
+		reminder.Error = err.Error()
+		return
+	}
+
+	now := time.Now()
+	reminder.Status = domain.ReminderStatusSent
+	reminder.SentAt = &now
+}
@@ -0,0 +1,735 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// maxRosterDays caps how many days a roster or coverage query may span.
+const maxRosterDays = 62
+
+// RosterService manages shift templates, the shifts staff are rostered on,
+// swaps between them and on-call cover. It also decides whether a doctor can
+// be booked at a time, from the roster or from the doctor's static
+// availability depending on the configured source.
+type RosterService struct {
+	templateRepo       *repository.ShiftTemplateRepository
+	assignmentRepo     *repository.ShiftAssignmentRepository
+	swapRepo           *repository.ShiftSwapRepository
+	userRepo           *repository.UserRepository
+	doctorRepo         *repository.DoctorRepository
+	activityService    *ActivityService
+	mongoClient        *mongo.Client
+	location           *time.Location
+	availabilitySource domain.AvailabilitySource
+}
+
+func NewRosterService(
+	templateRepo *repository.ShiftTemplateRepository,
+	assignmentRepo *repository.ShiftAssignmentRepository,
+	swapRepo *repository.ShiftSwapRepository,
+	userRepo *repository.UserRepository,
+	doctorRepo *repository.DoctorRepository,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+	location *time.Location,
+	availabilitySource domain.AvailabilitySource,
+) *RosterService {
+	return &RosterService{
+		templateRepo:       templateRepo,
+		assignmentRepo:     assignmentRepo,
+		swapRepo:           swapRepo,
+		userRepo:           userRepo,
+		doctorRepo:         doctorRepo,
+		activityService:    activityService,
+		mongoClient:        mongoClient,
+		location:           location,
+		availabilitySource: availabilitySource,
+	}
+}
+
+func (s *RosterService) GetAllTemplates(ctx context.Context) ([]*domain.ShiftTemplateEntity, error) {
+	return s.templateRepo.GetAll(ctx, false)
+}
+
+func (s *RosterService) GetTemplateByID(ctx context.Context, id string) (*domain.ShiftTemplateEntity, error) {
+	templateID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	template, err := s.templateRepo.GetByID(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shift template: %w", err)
+	}
+
+	return template, nil
+}
+
+func (s *RosterService) CreateTemplate(ctx context.Context, req *domain.ShiftTemplateRequest, creatorID primitive.ObjectID) (string, error) {
+	existing, err := s.templateRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return "", fmt.Errorf("error checking shift template code: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("shift template with this code already exists")
+	}
+
+	template := &domain.ShiftTemplateEntity{
+		IsActive:  true,
+		CreatedBy: creatorID,
+	}
+	if err := applyTemplateRequest(template, req, creatorID); err != nil {
+		return "", err
+	}
+
+	id, err := s.templateRepo.Create(ctx, template)
+	if err != nil {
+		return "", fmt.Errorf("failed to create shift template: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+// UpdateTemplate changes a shift template. Shifts already rostered keep the
+// times they were given.
+func (s *RosterService) UpdateTemplate(ctx context.Context, id string, req *domain.ShiftTemplateRequest, updaterID primitive.ObjectID) error {
+	template, err := s.GetTemplateByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if template == nil {
+		return errors.New("shift template not found")
+	}
+
+	existing, err := s.templateRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return fmt.Errorf("error checking shift template code: %w", err)
+	}
+	if existing != nil && existing.ID != template.ID {
+		return errors.New("shift template with this code already exists")
+	}
+
+	if err := applyTemplateRequest(template, req, updaterID); err != nil {
+		return err
+	}
+
+	if err := s.templateRepo.Update(ctx, template.ID, template); err != nil {
+		return fmt.Errorf("failed to update shift template: %w", err)
+	}
+
+	return nil
+}
+
+func applyTemplateRequest(template *domain.ShiftTemplateEntity, req *domain.ShiftTemplateRequest, updaterID primitive.ObjectID) error {
+	seen := make(map[domain.Role]bool)
+	for _, c := range req.Coverage {
+		if seen[c.Role] {
+			return fmt.Errorf("coverage for role %s is given more than once", c.Role)
+		}
+		seen[c.Role] = true
+	}
+
+	template.Name = req.Name
+	template.Code = req.Code
+	template.Start = req.Start
+	template.End = req.End
+	template.DaysOfWeek = req.DaysOfWeek
+	template.Coverage = req.Coverage
+	if req.IsActive != nil {
+		template.IsActive = *req.IsActive
+	}
+	template.UpdatedBy = updaterID
+	return nil
+}
+
+// GetAssignments returns the scheduled shifts dated from..to inclusive,
+// optionally only those of a user or role. Both dates default to today.
+func (s *RosterService) GetAssignments(ctx context.Context, from, to, userID string, role domain.Role) ([]*domain.ShiftAssignmentEntity, error) {
+	from, to, _, err := s.dateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	userFilter, err := optionalObjectID(userID, "user")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.assignmentRepo.GetByDateRange(ctx, from, to, userFilter, role)
+}
+
+// CreateAssignment rosters a staff member on a shift. The shift must run on
+// the date and must not overlap another shift of theirs.
+func (s *RosterService) CreateAssignment(ctx context.Context, req *domain.CreateShiftAssignmentRequest, creatorID primitive.ObjectID) (*domain.ShiftAssignmentEntity, error) {
+	userID, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format: %w", err)
+	}
+	templateID, err := primitive.ObjectIDFromHex(req.TemplateID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid shift template ID format: %w", err)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	template, err := s.templateRepo.GetByID(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shift template: %w", err)
+	}
+	if template == nil {
+		return nil, errors.New("shift template not found")
+	}
+	if !template.IsActive {
+		return nil, fmt.Errorf("shift template %s is not active", template.Name)
+	}
+
+	date, err := time.ParseInLocation(domain.RosterDateFormat, req.Date, s.location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date: %w", err)
+	}
+	if !template.RunsOn(date.Weekday()) {
+		return nil, fmt.Errorf("shift %s does not run on %s", template.Name, date.Weekday())
+	}
+	start, end, err := template.Window(date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid shift template hours: %w", err)
+	}
+
+	assignment := &domain.ShiftAssignmentEntity{
+		UserID:     userID,
+		Role:       user.Role,
+		TemplateID: templateID,
+		Date:       req.Date,
+		Start:      start,
+		End:        end,
+		OnCall:     req.OnCall,
+		Status:     domain.ShiftAssignmentScheduled,
+		Notes:      req.Notes,
+		CreatedBy:  creatorID,
+		UpdatedBy:  creatorID,
+	}
+
+	kind := "shift"
+	if assignment.OnCall {
+		kind = "on-call shift"
+	}
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.checkFree(sessionContext, userID, start, end); err != nil {
+			return err
+		}
+
+		id, err := s.assignmentRepo.Create(sessionContext, assignment)
+		if err != nil {
+			return fmt.Errorf("failed to create shift assignment: %w", err)
+		}
+		assignment.ID = id
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeRoster, "Shift Assigned", fmt.Sprintf("%s has been rostered on the %s %s of %s.", user.Name, template.Name, kind, req.Date))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for shift assignment: %w", err)
+		}
+		return s.publish(sessionContext, domain.EventShiftAssigned, assignment.ID, assignment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// CancelAssignment takes a staff member off a shift. Shifts with a pending
+// swap request must have the swap decided first.
+func (s *RosterService) CancelAssignment(ctx context.Context, id string, updaterID primitive.ObjectID) (*domain.ShiftAssignmentEntity, error) {
+	assignment, err := s.loadAssignment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if assignment.Status != domain.ShiftAssignmentScheduled {
+		return nil, fmt.Errorf("cannot cancel a shift that is %s", assignment.Status)
+	}
+
+	pending, err := s.swapRepo.CountPendingForAssignment(ctx, assignment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check shift swaps: %w", err)
+	}
+	if pending > 0 {
+		return nil, errors.New("shift has a pending swap request")
+	}
+
+	assignment.Status = domain.ShiftAssignmentCancelled
+	assignment.UpdatedBy = updaterID
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.assignmentRepo.Update(sessionContext, assignment); err != nil {
+			return fmt.Errorf("failed to update shift assignment: %w", err)
+		}
+
+		err := s.activityService.CreateActivity(sessionContext, domain.ActivityTypeRoster, "Shift Cancelled", fmt.Sprintf("Shift %s of %s has been cancelled.", id, assignment.Date))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for shift cancellation: %w", err)
+		}
+		return s.publish(sessionContext, domain.EventShiftCancelled, assignment.ID, assignment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// GetSwaps returns swap requests, newest first, optionally filtered by status
+// and by a user on either side.
+func (s *RosterService) GetSwaps(ctx context.Context, status domain.ShiftSwapStatus, userID string) ([]*domain.ShiftSwapEntity, error) {
+	if status != "" && !status.IsValid() {
+		return nil, fmt.Errorf("invalid shift swap status: %s", status)
+	}
+
+	userFilter, err := optionalObjectID(userID, "user")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.swapRepo.GetAll(ctx, status, userFilter)
+}
+
+// RequestSwap asks to hand a shift over to a colleague of the same role, or to
+// trade it for one of theirs. Staff can only offer their own shifts; admins
+// and management can offer anyone's.
+func (s *RosterService) RequestSwap(ctx context.Context, req *domain.CreateShiftSwapRequest, requesterID primitive.ObjectID, requesterRole domain.Role) (*domain.ShiftSwapEntity, error) {
+	assignment, err := s.loadAssignment(ctx, req.AssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSwappable(assignment); err != nil {
+		return nil, err
+	}
+	if assignment.UserID != requesterID && requesterRole != domain.RoleAdmin && requesterRole != domain.RoleManagement {
+		return nil, errors.New("only your own shifts can be swapped")
+	}
+
+	toUserID, err := primitive.ObjectIDFromHex(req.ToUserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID format: %w", err)
+	}
+	if toUserID == assignment.UserID {
+		return nil, errors.New("a shift cannot be swapped with its own holder")
+	}
+	toUser, err := s.userRepo.GetByID(ctx, toUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if toUser == nil {
+		return nil, errors.New("user not found")
+	}
+	if toUser.Role != assignment.Role {
+		return nil, fmt.Errorf("a %s shift can only be swapped with another %s", assignment.Role, assignment.Role)
+	}
+
+	swap := &domain.ShiftSwapEntity{
+		AssignmentID: assignment.ID,
+		FromUserID:   assignment.UserID,
+		ToUserID:     toUserID,
+		Reason:       req.Reason,
+		Status:       domain.ShiftSwapPending,
+		RequestedBy:  requesterID,
+	}
+
+	if req.TargetAssignmentID != "" {
+		target, err := s.loadAssignment(ctx, req.TargetAssignmentID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkSwappable(target); err != nil {
+			return nil, err
+		}
+		if target.UserID != toUserID {
+			return nil, errors.New("the shift asked for in exchange is not the colleague's")
+		}
+		swap.TargetAssignmentID = &target.ID
+	}
+
+	for _, id := range []*primitive.ObjectID{&swap.AssignmentID, swap.TargetAssignmentID} {
+		if id == nil {
+			continue
+		}
+		pending, err := s.swapRepo.CountPendingForAssignment(ctx, *id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check shift swaps: %w", err)
+		}
+		if pending > 0 {
+			return nil, fmt.Errorf("shift %s already has a pending swap request", id.Hex())
+		}
+	}
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		id, err := s.swapRepo.Create(sessionContext, swap)
+		if err != nil {
+			return fmt.Errorf("failed to create shift swap: %w", err)
+		}
+		swap.ID = id
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeRoster, "Shift Swap Requested", fmt.Sprintf("Swap of the shift of %s to %s has been requested.", assignment.Date, toUser.Name))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for shift swap: %w", err)
+		}
+		return s.publish(sessionContext, domain.EventShiftSwapRequested, swap.ID, swap)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swap, nil
+}
+
+// DecideSwap approves or rejects a pending swap. Approving moves the shift to
+// the colleague, and the colleague's shift back when it was a trade, provided
+// neither ends up with overlapping shifts.
+func (s *RosterService) DecideSwap(ctx context.Context, id string, req *domain.DecideShiftSwapRequest, deciderID primitive.ObjectID) (*domain.ShiftSwapEntity, error) {
+	swapID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	var swap *domain.ShiftSwapEntity
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		swap, err = s.swapRepo.GetByID(sessionContext, swapID)
+		if err != nil {
+			return fmt.Errorf("failed to get shift swap: %w", err)
+		}
+		if swap == nil {
+			return errors.New("shift swap not found")
+		}
+		if swap.Status != domain.ShiftSwapPending {
+			return fmt.Errorf("cannot decide a shift swap that is %s", swap.Status)
+		}
+
+		if req.Status == domain.ShiftSwapApproved {
+			if err := s.exchange(sessionContext, swap, deciderID); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		swap.Status = req.Status
+		swap.DecisionNotes = req.Notes
+		swap.DecidedBy = deciderID
+		swap.DecidedAt = &now
+		if err := s.swapRepo.Update(sessionContext, swap); err != nil {
+			return fmt.Errorf("failed to update shift swap: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeRoster, "Shift Swap "+string(req.Status), fmt.Sprintf("Shift swap %s has been %s.", id, req.Status))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for shift swap: %w", err)
+		}
+		return s.publish(sessionContext, domain.EventShiftSwapDecided, swap.ID, swap)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swap, nil
+}
+
+// exchange carries out an approved swap.
+func (s *RosterService) exchange(ctx context.Context, swap *domain.ShiftSwapEntity, updaterID primitive.ObjectID) error {
+	assignment, err := s.assignmentRepo.GetByID(ctx, swap.AssignmentID)
+	if err != nil {
+		return fmt.Errorf("failed to get shift assignment: %w", err)
+	}
+	if assignment == nil || assignment.UserID != swap.FromUserID {
+		return errors.New("the shift has changed hands since the swap was requested")
+	}
+	if err := checkSwappable(assignment); err != nil {
+		return err
+	}
+
+	var target *domain.ShiftAssignmentEntity
+	if swap.TargetAssignmentID != nil {
+		target, err = s.assignmentRepo.GetByID(ctx, *swap.TargetAssignmentID)
+		if err != nil {
+			return fmt.Errorf("failed to get shift assignment: %w", err)
+		}
+		if target == nil || target.UserID != swap.ToUserID {
+			return errors.New("the shift asked for in exchange has changed hands since the swap was requested")
+		}
+		if err := checkSwappable(target); err != nil {
+			return err
+		}
+	}
+
+	// Each side must be free for the shift they take, apart from the one
+	// they give away
+	if err := s.checkFree(ctx, swap.ToUserID, assignment.Start, assignment.End, target); err != nil {
+		return err
+	}
+	if target != nil {
+		if err := s.checkFree(ctx, swap.FromUserID, target.Start, target.End, assignment); err != nil {
+			return err
+		}
+	}
+
+	assignment.UserID = swap.ToUserID
+	assignment.UpdatedBy = updaterID
+	if err := s.assignmentRepo.Update(ctx, assignment); err != nil {
+		return fmt.Errorf("failed to update shift assignment: %w", err)
+	}
+	if target != nil {
+		target.UserID = swap.FromUserID
+		target.UpdatedBy = updaterID
+		if err := s.assignmentRepo.Update(ctx, target); err != nil {
+			return fmt.Errorf("failed to update shift assignment: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetCoverage compares the shifts rostered from..to against what the active
+// shift templates need, per day, shift and role. On-call shifts do not count
+// towards cover. With gapsOnly only understaffed slots are returned.
+func (s *RosterService) GetCoverage(ctx context.Context, from, to string, gapsOnly bool) ([]domain.CoverageSlot, error) {
+	from, to, first, err := s.dateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.templateRepo.GetAll(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shift templates: %w", err)
+	}
+
+	assignments, err := s.assignmentRepo.GetByDateRange(ctx, from, to, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shift assignments: %w", err)
+	}
+
+	type slotKey struct {
+		date       string
+		templateID primitive.ObjectID
+		role       domain.Role
+	}
+	assigned := make(map[slotKey][]string)
+	for _, a := range assignments {
+		if a.OnCall {
+			continue
+		}
+		key := slotKey{a.Date, a.TemplateID, a.Role}
+		assigned[key] = append(assigned[key], a.UserID.Hex())
+	}
+
+	slots := []domain.CoverageSlot{}
+	for day := first; day.Format(domain.RosterDateFormat) <= to; day = day.AddDate(0, 0, 1) {
+		date := day.Format(domain.RosterDateFormat)
+		for _, template := range templates {
+			if !template.RunsOn(day.Weekday()) {
+				continue
+			}
+			for _, c := range template.Coverage {
+				userIDs := assigned[slotKey{date, template.ID, c.Role}]
+				shortfall := c.Count - len(userIDs)
+				if shortfall < 0 {
+					shortfall = 0
+				}
+				if gapsOnly && shortfall == 0 {
+					continue
+				}
+				slots = append(slots, domain.CoverageSlot{
+					Date:         date,
+					TemplateID:   template.ID.Hex(),
+					TemplateName: template.Name,
+					Role:         c.Role,
+					Required:     c.Count,
+					Assigned:     len(userIDs),
+					Shortfall:    shortfall,
+					UserIDs:      userIDs,
+				})
+			}
+		}
+	}
+
+	return slots, nil
+}
+
+// GetOnCall returns who is on call at the time, RFC 3339, or now when empty.
+func (s *RosterService) GetOnCall(ctx context.Context, at string) ([]domain.OnCallEntry, error) {
+	when := time.Now()
+	if at != "" {
+		parsed, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time, expected RFC 3339: %w", err)
+		}
+		when = parsed
+	}
+
+	assignments, err := s.assignmentRepo.GetActiveAt(ctx, when, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get on-call shifts: %w", err)
+	}
+
+	entries := make([]domain.OnCallEntry, 0, len(assignments))
+	for _, a := range assignments {
+		var name string
+		user, err := s.userRepo.GetByID(ctx, a.UserID)
+		if err == nil && user != nil {
+			name = user.Name
+		}
+		entries = append(entries, domain.OnCallEntry{Assignment: *a, UserName: name})
+	}
+
+	return entries, nil
+}
+
+// CheckDoctorAvailability reports why the doctor cannot be booked for
+// [start, end), or nil when they can. With the roster as the source the slot
+// must lie within one of the doctor's shifts; on-call shifts only count for
+// emergencies. With the static source the slot must lie within the doctor's
+// availability, which emergencies may book outside of.
+func (s *RosterService) CheckDoctorAvailability(ctx context.Context, doctorID primitive.ObjectID, start, end time.Time, emergency bool) error {
+	if s.availabilitySource != domain.AvailabilityStatic && s.availabilitySource != domain.AvailabilityRoster {
+		return nil
+	}
+
+	doctor, err := s.doctorRepo.GetByID(ctx, doctorID)
+	if err != nil {
+		return fmt.Errorf("failed to get doctor: %w", err)
+	}
+	if doctor == nil {
+		return errors.New("doctor not found")
+	}
+
+	if s.availabilitySource == domain.AvailabilityStatic {
+		if emergency {
+			return nil
+		}
+		hours := make([]domain.OperatingHours, 0, len(doctor.Availability))
+		for _, slot := range doctor.Availability {
+			hours = append(hours, domain.OperatingHours{
+				DayOfWeek: slot.DayOfWeek,
+				Open:      slot.StartTime.In(s.location).Format(domain.OperatingHoursFormat),
+				Close:     slot.EndTime.In(s.location).Format(domain.OperatingHoursFormat),
+			})
+		}
+		if len(hours) == 0 || !domain.OpenDuring(hours, start.In(s.location), end.In(s.location)) {
+			return fmt.Errorf("doctor %s is not available at the requested time", doctor.Name)
+		}
+		return nil
+	}
+
+	if doctor.UserID == nil {
+		return fmt.Errorf("doctor %s has no user account to be rostered with", doctor.Name)
+	}
+
+	shifts, err := s.assignmentRepo.GetOverlapping(ctx, *doctor.UserID, start, end)
+	if err != nil {
+		return fmt.Errorf("failed to get doctor's shifts: %w", err)
+	}
+	for _, shift := range shifts {
+		if shift.OnCall && !emergency {
+			continue
+		}
+		if !shift.Start.After(start) && !shift.End.Before(end) {
+			return nil
+		}
+	}
+	return fmt.Errorf("doctor %s is not rostered at the requested time", doctor.Name)
+}
+
+// checkFree returns an error when the user has a scheduled shift overlapping
+// [start, end), other than the ones given.
+func (s *RosterService) checkFree(ctx context.Context, userID primitive.ObjectID, start, end time.Time, except ...*domain.ShiftAssignmentEntity) error {
+	overlapping, err := s.assignmentRepo.GetOverlapping(ctx, userID, start, end)
+	if err != nil {
+		return fmt.Errorf("failed to check for overlapping shifts: %w", err)
+	}
+
+outer:
+	for _, a := range overlapping {
+		for _, e := range except {
+			if e != nil && e.ID == a.ID {
+				continue outer
+			}
+		}
+		return fmt.Errorf("user %s already has a shift from %s to %s", userID.Hex(), a.Start.In(s.location).Format(time.RFC3339), a.End.In(s.location).Format(time.RFC3339))
+	}
+	return nil
+}
+
+// checkSwappable makes sure the shift is still scheduled and has not started.
+func checkSwappable(assignment *domain.ShiftAssignmentEntity) error {
+	if assignment.Status != domain.ShiftAssignmentScheduled {
+		return fmt.Errorf("shift %s is %s", assignment.ID.Hex(), assignment.Status)
+	}
+	if !assignment.Start.After(time.Now()) {
+		return fmt.Errorf("shift %s has already started", assignment.ID.Hex())
+	}
+	return nil
+}
+
+// dateRange validates a from..to range of roster dates, defaulting both to
+// today, and returns it with the first day at midnight.
+func (s *RosterService) dateRange(from, to string) (string, string, time.Time, error) {
+	today := time.Now().In(s.location).Format(domain.RosterDateFormat)
+	if from == "" {
+		from = today
+	}
+	if to == "" {
+		to = from
+	}
+
+	first, err := time.ParseInLocation(domain.RosterDateFormat, from, s.location)
+	if err != nil {
+		return "", "", time.Time{}, fmt.Errorf("invalid from date: %w", err)
+	}
+	last, err := time.ParseInLocation(domain.RosterDateFormat, to, s.location)
+	if err != nil {
+		return "", "", time.Time{}, fmt.Errorf("invalid to date: %w", err)
+	}
+	if last.Before(first) {
+		return "", "", time.Time{}, errors.New("to date is before from date")
+	}
+	if last.Sub(first) > maxRosterDays*24*time.Hour {
+		return "", "", time.Time{}, fmt.Errorf("date range is longer than %d days", maxRosterDays)
+	}
+
+	return from, to, first, nil
+}
+
+func (s *RosterService) loadAssignment(ctx context.Context, id string) (*domain.ShiftAssignmentEntity, error) {
+	assignmentID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid shift assignment ID format: %w", err)
+	}
+
+	assignment, err := s.assignmentRepo.GetByID(ctx, assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shift assignment: %w", err)
+	}
+	if assignment == nil {
+		return nil, errors.New("shift assignment not found")
+	}
+	return assignment, nil
+}
+
+// publish writes a roster event to the outbox in the caller's transaction.
+func (s *RosterService) publish(ctx context.Context, eventType domain.EventType, entityID primitive.ObjectID, data interface{}) error {
+	err := s.activityService.PublishEvent(ctx, eventType, domain.ActivityTypeRoster, entityID.Hex(), data)
+	if err != nil {
+		return fmt.Errorf("failed to publish roster event: %w", err)
+	}
+	return nil
+}
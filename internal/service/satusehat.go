@@ -0,0 +1,761 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/fhir"
+	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/ekastn/hms-api/internal/satusehat"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	satusehatBatchSize   = 50
+	satusehatLease       = 2 * time.Minute
+	satusehatListLimit   = 200
+	satusehatAttemptLog  = 20
+	satusehatDefaultDays = 30
+	maxSatuSehatDays     = 366
+)
+
+// errSatuSehatSkip means a queued record has nothing to send.
+var errSatuSehatSkip = errors.New("nothing to send")
+
+// SatuSehatSettings configures the SATUSEHAT integration.
+type SatuSehatSettings struct {
+	OrganizationID string
+	// LocationID is the location of encounters in clinic rooms that have no
+	// SATUSEHAT location of their own.
+	LocationID  string
+	MaxAttempts int
+	Location    *time.Location
+}
+
+// SatuSehatService submits completed appointments to SATUSEHAT as Encounters
+// and the diagnoses of medical records as Conditions. Changes are queued
+// from the outbox, one sync entry per record, and submitted with
+// exponential backoff like webhooks; an entry that SATUSEHAT rejects or
+// that runs out of attempts is dead-lettered. Patients, doctors and clinic
+// rooms are referenced by the SATUSEHAT IDs admins register for them.
+type SatuSehatService struct {
+	syncRepo        *repository.SatuSehatSyncRepository
+	referenceRepo   *repository.SatuSehatReferenceRepository
+	appointmentRepo *repository.AppointmentRepository
+	recordRepo      *repository.MedicalRecordRepository
+	patientRepo     *repository.PatientRepository
+	doctorRepo      *repository.DoctorRepository
+	client          satusehat.Client
+	mapper          *satusehat.Mapper
+	settings        SatuSehatSettings
+}
+
+// NewSatuSehatService creates the service. A nil client disables submission;
+// the reference mappings and the reconciliation report still work.
+func NewSatuSehatService(
+	syncRepo *repository.SatuSehatSyncRepository,
+	referenceRepo *repository.SatuSehatReferenceRepository,
+	appointmentRepo *repository.AppointmentRepository,
+	recordRepo *repository.MedicalRecordRepository,
+	patientRepo *repository.PatientRepository,
+	doctorRepo *repository.DoctorRepository,
+	client satusehat.Client,
+	settings SatuSehatSettings,
+) *SatuSehatService {
+	if settings.Location == nil {
+		settings.Location = time.Local
+	}
+	return &SatuSehatService{
+		syncRepo:        syncRepo,
+		referenceRepo:   referenceRepo,
+		appointmentRepo: appointmentRepo,
+		recordRepo:      recordRepo,
+		patientRepo:     patientRepo,
+		doctorRepo:      doctorRepo,
+		client:          client,
+		mapper:          satusehat.NewMapper(settings.OrganizationID),
+		settings:        settings,
+	}
+}
+
+func (s *SatuSehatService) GetSyncs(ctx context.Context, sourceType domain.SatuSehatSourceType, status domain.SatuSehatSyncStatus, patientID string) ([]*domain.SatuSehatSyncEntity, error) {
+	if sourceType != "" && !sourceType.IsValid() {
+		return nil, fmt.Errorf("invalid source type: %s", sourceType)
+	}
+	if status != "" && !status.IsValid() {
+		return nil, fmt.Errorf("invalid sync status: %s", status)
+	}
+
+	var patient *primitive.ObjectID
+	if patientID != "" {
+		id, err := primitive.ObjectIDFromHex(patientID)
+		if err != nil {
+			return nil, errors.New("invalid patient ID format")
+		}
+		patient = &id
+	}
+
+	return s.syncRepo.GetAll(ctx, sourceType, status, patient, satusehatListLimit)
+}
+
+func (s *SatuSehatService) GetSyncByID(ctx context.Context, id string) (*domain.SatuSehatSyncEntity, error) {
+	syncID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	sync, err := s.syncRepo.GetByID(ctx, syncID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SATUSEHAT sync: %w", err)
+	}
+
+	return sync, nil
+}
+
+// RetrySync puts a failed or dead-lettered entry back in the queue with a
+// fresh attempt budget.
+func (s *SatuSehatService) RetrySync(ctx context.Context, id string) error {
+	sync, err := s.GetSyncByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sync == nil {
+		return errors.New("SATUSEHAT sync not found")
+	}
+	if sync.Status == domain.SatuSehatSyncSynced {
+		return errors.New("record has already been synced")
+	}
+
+	return s.syncRepo.Enqueue(ctx, sync.SourceType, sync.SourceID, sync.ResourceType, sync.PatientID, sync.Deleted, time.Now())
+}
+
+func (s *SatuSehatService) GetReferences(ctx context.Context, kind domain.SatuSehatReferenceKind) ([]*domain.SatuSehatReferenceEntity, error) {
+	if kind != "" && !kind.IsValid() {
+		return nil, fmt.Errorf("invalid reference kind: %s", kind)
+	}
+	return s.referenceRepo.GetAll(ctx, kind)
+}
+
+// SetReference registers the SATUSEHAT ID of a patient, doctor or clinic
+// room. Records that failed for lack of it are not requeued by themselves;
+// retry them or run a backfill.
+func (s *SatuSehatService) SetReference(ctx context.Context, kind domain.SatuSehatReferenceKind, localID string, req domain.SatuSehatReferenceRequest, updaterID primitive.ObjectID) (*domain.SatuSehatReferenceEntity, error) {
+	if !kind.IsValid() {
+		return nil, fmt.Errorf("invalid reference kind: %s", kind)
+	}
+	id, err := primitive.ObjectIDFromHex(localID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	ref, err := s.referenceRepo.Set(ctx, kind, id, strings.TrimSpace(req.SatuSehatID), updaterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set SATUSEHAT reference: %w", err)
+	}
+	return ref, nil
+}
+
+func (s *SatuSehatService) DeleteReference(ctx context.Context, kind domain.SatuSehatReferenceKind, localID string) error {
+	if !kind.IsValid() {
+		return fmt.Errorf("invalid reference kind: %s", kind)
+	}
+	id, err := primitive.ObjectIDFromHex(localID)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+	return s.referenceRepo.Delete(ctx, kind, id)
+}
+
+// Enqueue queues the appointment or medical record an event is about: an
+// appointment once it is completed, and a medical record with a diagnosis
+// whenever it changes. A reopened appointment, a deleted record or one
+// whose diagnosis was cleared is requeued only if it was queued before, so
+// that what was already sent is marked entered in error. Other events, and
+// all events when submission is disabled, are ignored.
+func (s *SatuSehatService) Enqueue(ctx context.Context, event domain.Event) error {
+	if s.client == nil {
+		return nil
+	}
+
+	switch event.Type {
+	case domain.EventAppointmentStatusChanged, domain.EventAppointmentUpdated:
+		id, err := primitive.ObjectIDFromHex(event.EntityID)
+		if err != nil {
+			return nil
+		}
+		appointment, err := s.appointmentRepo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get appointment: %w", err)
+		}
+		if appointment == nil {
+			return nil
+		}
+		if appointment.Status != domain.AppointmentStatusCompleted {
+			// An appointment reopened after its encounter was sent is
+			// requeued so the encounter is marked entered in error.
+			existing, err := s.syncRepo.GetBySource(ctx, domain.SatuSehatSourceAppointment, id)
+			if err != nil {
+				return fmt.Errorf("failed to get SATUSEHAT sync: %w", err)
+			}
+			if existing == nil || existing.SatuSehatID == "" {
+				return nil
+			}
+		}
+		return s.enqueueAppointment(ctx, appointment)
+
+	case domain.EventMedicalRecordCreated, domain.EventMedicalRecordUpdated, domain.EventMedicalRecordDeleted:
+		id, err := primitive.ObjectIDFromHex(event.EntityID)
+		if err != nil {
+			return nil
+		}
+		record, err := s.recordRepo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get medical record: %w", err)
+		}
+		if record != nil && !record.IsDeleted && strings.TrimSpace(record.Diagnosis) != "" {
+			return s.enqueueRecord(ctx, record)
+		}
+
+		existing, err := s.syncRepo.GetBySource(ctx, domain.SatuSehatSourceMedicalRecord, id)
+		if err != nil {
+			return fmt.Errorf("failed to get SATUSEHAT sync: %w", err)
+		}
+		if existing == nil {
+			return nil
+		}
+		if err := s.syncRepo.Enqueue(ctx, existing.SourceType, existing.SourceID, existing.ResourceType, existing.PatientID, true, time.Now()); err != nil {
+			return fmt.Errorf("failed to queue SATUSEHAT sync: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *SatuSehatService) enqueueAppointment(ctx context.Context, a *domain.AppointmentEntity) error {
+	if err := s.syncRepo.Enqueue(ctx, domain.SatuSehatSourceAppointment, a.ID, fhir.TypeEncounter, a.PatientID, false, time.Now()); err != nil {
+		return fmt.Errorf("failed to queue SATUSEHAT sync: %w", err)
+	}
+	return nil
+}
+
+func (s *SatuSehatService) enqueueRecord(ctx context.Context, r *domain.MedicalRecordEntity) error {
+	if err := s.syncRepo.Enqueue(ctx, domain.SatuSehatSourceMedicalRecord, r.ID, fhir.TypeCondition, r.PatientID, false, time.Now()); err != nil {
+		return fmt.Errorf("failed to queue SATUSEHAT sync: %w", err)
+	}
+	return nil
+}
+
+// Backfill queues the completed appointments and diagnosed medical records
+// in a period that were never queued or failed, e.g. those that predate the
+// integration or were missing a reference mapping. Dates are YYYY-MM-DD in
+// the hospital's time zone, both inclusive.
+func (s *SatuSehatService) Backfill(ctx context.Context, from, to string) (*domain.SatuSehatBackfillResult, error) {
+	if s.client == nil {
+		return nil, errors.New("SATUSEHAT integration is not configured")
+	}
+
+	from, to, start, end, err := s.dateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	appointments, records, err := s.sources(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.SatuSehatBackfillResult{From: from, To: to}
+
+	// Appointments go first so that the encounters the conditions reference
+	// are sent before them.
+	syncs, err := s.syncRepo.GetBySources(ctx, domain.SatuSehatSourceAppointment, appointmentIDs(appointments))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SATUSEHAT syncs: %w", err)
+	}
+	for _, a := range appointments {
+		if !needsBackfill(syncs[a.ID]) {
+			continue
+		}
+		if err := s.enqueueAppointment(ctx, a); err != nil {
+			return nil, err
+		}
+		result.Encounters++
+	}
+
+	syncs, err = s.syncRepo.GetBySources(ctx, domain.SatuSehatSourceMedicalRecord, recordIDs(records))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SATUSEHAT syncs: %w", err)
+	}
+	for _, r := range records {
+		if !needsBackfill(syncs[r.ID]) {
+			continue
+		}
+		if err := s.enqueueRecord(ctx, r); err != nil {
+			return nil, err
+		}
+		result.Conditions++
+	}
+
+	return result, nil
+}
+
+func needsBackfill(sync *domain.SatuSehatSyncEntity) bool {
+	return sync == nil || sync.Status == domain.SatuSehatSyncFailed || sync.Status == domain.SatuSehatSyncDeadLetter
+}
+
+// Reconcile compares the completed appointments and diagnosed medical
+// records in a period with their sync entries, counting them by status and
+// listing those that are not in SATUSEHAT.
+func (s *SatuSehatService) Reconcile(ctx context.Context, from, to string) (*domain.SatuSehatReconciliationReport, error) {
+	from, to, start, end, err := s.dateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	appointments, records, err := s.sources(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.SatuSehatReconciliationReport{From: from, To: to, Unsynced: []domain.SatuSehatReconciliationItem{}}
+
+	syncs, err := s.syncRepo.GetBySources(ctx, domain.SatuSehatSourceAppointment, appointmentIDs(appointments))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SATUSEHAT syncs: %w", err)
+	}
+	for _, a := range appointments {
+		if item := reconcile(&report.Encounters, syncs[a.ID]); item != nil {
+			item.SourceType = domain.SatuSehatSourceAppointment
+			item.SourceID = a.ID.Hex()
+			item.PatientID = a.PatientID.Hex()
+			item.Date = a.DateTime
+			report.Unsynced = append(report.Unsynced, *item)
+		}
+	}
+
+	syncs, err = s.syncRepo.GetBySources(ctx, domain.SatuSehatSourceMedicalRecord, recordIDs(records))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SATUSEHAT syncs: %w", err)
+	}
+	for _, r := range records {
+		if item := reconcile(&report.Conditions, syncs[r.ID]); item != nil {
+			item.SourceType = domain.SatuSehatSourceMedicalRecord
+			item.SourceID = r.ID.Hex()
+			item.PatientID = r.PatientID.Hex()
+			item.Date = r.Date
+			report.Unsynced = append(report.Unsynced, *item)
+		}
+	}
+
+	sort.SliceStable(report.Unsynced, func(i, j int) bool {
+		return report.Unsynced[i].Date.Before(report.Unsynced[j].Date)
+	})
+	return report, nil
+}
+
+// reconcile counts a record under its sync status and returns the report
+// item for it when it is not synced.
+func reconcile(counts *domain.SatuSehatReconciliationCounts, sync *domain.SatuSehatSyncEntity) *domain.SatuSehatReconciliationItem {
+	counts.Total++
+	if sync == nil {
+		counts.Missing++
+		return &domain.SatuSehatReconciliationItem{Status: "Missing"}
+	}
+
+	switch sync.Status {
+	case domain.SatuSehatSyncSynced:
+		counts.Synced++
+		return nil
+	case domain.SatuSehatSyncPending:
+		counts.Pending++
+	case domain.SatuSehatSyncFailed:
+		counts.Failed++
+	case domain.SatuSehatSyncDeadLetter:
+		counts.DeadLetter++
+	case domain.SatuSehatSyncSkipped:
+		counts.Skipped++
+	}
+	return &domain.SatuSehatReconciliationItem{
+		Status:       string(sync.Status),
+		Error:        sync.Error,
+		AttemptCount: sync.AttemptCount,
+	}
+}
+
+// sources returns the completed appointments and the diagnosed medical
+// records in [start, end).
+func (s *SatuSehatService) sources(ctx context.Context, start, end time.Time) ([]*domain.AppointmentEntity, []*domain.MedicalRecordEntity, error) {
+	all, err := s.appointmentRepo.Search(ctx, nil, nil, start, end, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get appointments: %w", err)
+	}
+	var appointments []*domain.AppointmentEntity
+	for _, a := range all {
+		if a.Status == domain.AppointmentStatusCompleted {
+			appointments = append(appointments, a)
+		}
+	}
+
+	allRecords, err := s.recordRepo.Search(ctx, nil, start, end, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get medical records: %w", err)
+	}
+	var records []*domain.MedicalRecordEntity
+	for _, r := range allRecords {
+		if strings.TrimSpace(r.Diagnosis) != "" {
+			records = append(records, r)
+		}
+	}
+
+	return appointments, records, nil
+}
+
+// dateRange validates a from..to range of dates, defaulting to the last 30
+// days, and returns it with the [start, end) instants bounding it.
+func (s *SatuSehatService) dateRange(from, to string) (string, string, time.Time, time.Time, error) {
+	today := time.Now().In(s.settings.Location)
+	if to == "" {
+		to = today.Format(domain.SatuSehatDateFormat)
+	}
+	last, err := time.ParseInLocation(domain.SatuSehatDateFormat, to, s.settings.Location)
+	if err != nil {
+		return "", "", time.Time{}, time.Time{}, fmt.Errorf("invalid to date: %w", err)
+	}
+	if from == "" {
+		from = last.AddDate(0, 0, -(satusehatDefaultDays - 1)).Format(domain.SatuSehatDateFormat)
+	}
+	first, err := time.ParseInLocation(domain.SatuSehatDateFormat, from, s.settings.Location)
+	if err != nil {
+		return "", "", time.Time{}, time.Time{}, fmt.Errorf("invalid from date: %w", err)
+	}
+
+	if last.Before(first) {
+		return "", "", time.Time{}, time.Time{}, errors.New("to date is before from date")
+	}
+	if last.Sub(first) > maxSatuSehatDays*24*time.Hour {
+		return "", "", time.Time{}, time.Time{}, fmt.Errorf("date range is longer than %d days", maxSatuSehatDays)
+	}
+
+	return from, to, first, last.AddDate(0, 0, 1), nil
+}
+
+func appointmentIDs(appointments []*domain.AppointmentEntity) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, len(appointments))
+	for i, a := range appointments {
+		ids[i] = a.ID
+	}
+	return ids
+}
+
+func recordIDs(records []*domain.MedicalRecordEntity) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, len(records))
+	for i, r := range records {
+		ids[i] = r.ID
+	}
+	return ids
+}
+
+// Run submits due sync entries every interval until ctx is cancelled. It
+// returns at once when submission is disabled.
+func (s *SatuSehatService) Run(ctx context.Context, interval time.Duration) {
+	if s.client == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.syncDue(ctx); err != nil {
+			log.Printf("SATUSEHAT sync failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SatuSehatService) syncDue(ctx context.Context) error {
+	now := time.Now()
+	syncs, err := s.syncRepo.GetDue(ctx, now, satusehatBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get due SATUSEHAT syncs: %w", err)
+	}
+
+	for _, sync := range syncs {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		claimed, err := s.syncRepo.Claim(ctx, sync.ID, now, now.Add(satusehatLease))
+		if err != nil {
+			return fmt.Errorf("failed to claim SATUSEHAT sync: %w", err)
+		}
+		if !claimed {
+			continue
+		}
+
+		s.attempt(ctx, sync)
+	}
+
+	return nil
+}
+
+// attempt submits an entry once and records the outcome. Resources
+// SATUSEHAT rejects are dead-lettered at once, since sending them again
+// will not help until the data is fixed; everything else, including a
+// missing reference mapping, is retried with backoff.
+func (s *SatuSehatService) attempt(ctx context.Context, sync *domain.SatuSehatSyncEntity) {
+	started := time.Now()
+	attempt := domain.SatuSehatAttempt{AttemptedAt: started}
+
+	var createdID string
+	resource, err := s.resource(ctx, sync)
+	if err == nil {
+		if payload, encodeErr := json.Marshal(resource); encodeErr == nil {
+			sync.Payload = string(payload)
+		}
+		if sync.SatuSehatID == "" {
+			createdID, err = s.client.Create(ctx, sync.ResourceType, resource)
+		} else {
+			err = s.client.Update(ctx, sync.ResourceType, sync.SatuSehatID, resource)
+		}
+	}
+	attempt.DurationMs = time.Since(started).Milliseconds()
+
+	skipped := errors.Is(err, errSatuSehatSkip)
+	if err != nil && !skipped {
+		attempt.Error = err.Error()
+		var apiErr *satusehat.Error
+		if errors.As(err, &apiErr) {
+			attempt.StatusCode = apiErr.StatusCode
+		}
+	}
+
+	sync.AttemptCount++
+	sync.Attempts = append(sync.Attempts, attempt)
+	if len(sync.Attempts) > satusehatAttemptLog {
+		sync.Attempts = sync.Attempts[len(sync.Attempts)-satusehatAttemptLog:]
+	}
+	sync.Error = attempt.Error
+	if createdID != "" {
+		sync.SatuSehatID = createdID
+	}
+
+	switch {
+	case skipped:
+		sync.Status = domain.SatuSehatSyncSkipped
+		sync.NextAttemptAt = nil
+	case err == nil:
+		sync.Status = domain.SatuSehatSyncSynced
+		sync.SyncedAt = &started
+		sync.NextAttemptAt = nil
+	case !satusehat.IsTemporary(err) || sync.AttemptCount >= s.settings.MaxAttempts:
+		sync.Status = domain.SatuSehatSyncDeadLetter
+		sync.NextAttemptAt = nil
+		log.Printf("SATUSEHAT %s for %s %s dead-lettered after %d attempts: %s", sync.ResourceType, sync.SourceType, sync.SourceID.Hex(), sync.AttemptCount, attempt.Error)
+	default:
+		sync.Status = domain.SatuSehatSyncFailed
+		next := time.Now().Add(backoff(retryBaseBackoff, retryMaxBackoff, sync.AttemptCount))
+		sync.NextAttemptAt = &next
+	}
+
+	current, err := s.syncRepo.Update(ctx, sync)
+	if err != nil {
+		log.Printf("SATUSEHAT sync %s: failed to record attempt: %v", sync.ID.Hex(), err)
+		return
+	}
+	// The source changed while it was being sent, so the entry was queued
+	// again; keep the new resource's ID so the next attempt updates it.
+	if !current && createdID != "" {
+		if err := s.syncRepo.SetSatuSehatID(ctx, sync.ID, createdID); err != nil {
+			log.Printf("SATUSEHAT sync %s: failed to record ID %s: %v", sync.ID.Hex(), createdID, err)
+		}
+	}
+}
+
+// resource builds the resource to send for an entry, or returns
+// errSatuSehatSkip when there is nothing to send.
+func (s *SatuSehatService) resource(ctx context.Context, sync *domain.SatuSehatSyncEntity) (any, error) {
+	switch sync.SourceType {
+	case domain.SatuSehatSourceAppointment:
+		return s.encounter(ctx, sync)
+	case domain.SatuSehatSourceMedicalRecord:
+		return s.condition(ctx, sync)
+	}
+	return nil, fmt.Errorf("unknown source type %s", sync.SourceType)
+}
+
+func (s *SatuSehatService) encounter(ctx context.Context, sync *domain.SatuSehatSyncEntity) (*satusehat.Encounter, error) {
+	appointment, err := s.appointmentRepo.GetByID(ctx, sync.SourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointment: %w", err)
+	}
+	if appointment == nil || (appointment.Status != domain.AppointmentStatusCompleted && sync.SatuSehatID == "") {
+		return nil, errSatuSehatSkip
+	}
+
+	patient, err := s.patientRef(ctx, appointment.PatientID)
+	if err != nil {
+		return nil, err
+	}
+	practitioner, err := s.practitionerRef(ctx, appointment.DoctorID)
+	if err != nil {
+		return nil, err
+	}
+	location, err := s.locationRef(ctx, appointment)
+	if err != nil {
+		return nil, err
+	}
+
+	encounter := s.mapper.Encounter(appointment, patient, practitioner, location)
+	encounter.ID = sync.SatuSehatID
+	if appointment.Status != domain.AppointmentStatusCompleted {
+		// The appointment was reopened after its encounter was sent.
+		encounter.Status = "entered-in-error"
+	}
+	return encounter, nil
+}
+
+func (s *SatuSehatService) condition(ctx context.Context, sync *domain.SatuSehatSyncEntity) (*satusehat.Condition, error) {
+	var record *domain.MedicalRecordEntity
+	if !sync.Deleted {
+		var err error
+		record, err = s.recordRepo.FindByID(ctx, sync.SourceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get medical record: %w", err)
+		}
+		if record != nil && (record.IsDeleted || strings.TrimSpace(record.Diagnosis) == "") {
+			record = nil
+		}
+	}
+
+	if record == nil {
+		// Withdraw the condition that was sent, if any, by resending it as
+		// entered in error.
+		if sync.SatuSehatID == "" || sync.Payload == "" {
+			return nil, errSatuSehatSkip
+		}
+		var condition satusehat.Condition
+		if err := json.Unmarshal([]byte(sync.Payload), &condition); err != nil {
+			return nil, fmt.Errorf("failed to decode the condition last sent: %w", err)
+		}
+		condition.ID = sync.SatuSehatID
+		condition.EnteredInError()
+		return &condition, nil
+	}
+
+	patient, err := s.patientRef(ctx, record.PatientID)
+	if err != nil {
+		return nil, err
+	}
+	encounterID, err := s.encounterID(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := s.mapper.Condition(record, patient, encounterID)
+	condition.ID = sync.SatuSehatID
+	return condition, nil
+}
+
+// encounterID returns the SATUSEHAT ID of the encounter a medical record was
+// made in: that of the patient's completed appointment with the same doctor
+// on the same day, closest to the record's time.
+func (s *SatuSehatService) encounterID(ctx context.Context, r *domain.MedicalRecordEntity) (string, error) {
+	date := r.Date.In(s.settings.Location)
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, s.settings.Location)
+
+	appointments, err := s.appointmentRepo.Search(ctx, &r.PatientID, &r.DoctorID, start, start.AddDate(0, 0, 1), 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to get appointments: %w", err)
+	}
+
+	var visit *domain.AppointmentEntity
+	for _, a := range appointments {
+		if a.Status != domain.AppointmentStatusCompleted {
+			continue
+		}
+		if visit == nil || absDuration(a.DateTime.Sub(r.Date)) < absDuration(visit.DateTime.Sub(r.Date)) {
+			visit = a
+		}
+	}
+	if visit == nil {
+		return "", fmt.Errorf("no completed appointment with doctor %s on %s to link the diagnosis to", r.DoctorID.Hex(), date.Format(domain.SatuSehatDateFormat))
+	}
+
+	sync, err := s.syncRepo.GetBySource(ctx, domain.SatuSehatSourceAppointment, visit.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get SATUSEHAT sync: %w", err)
+	}
+	if sync == nil || sync.SatuSehatID == "" {
+		return "", fmt.Errorf("encounter of appointment %s is not synced yet", visit.ID.Hex())
+	}
+	return sync.SatuSehatID, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func (s *SatuSehatService) patientRef(ctx context.Context, patientID primitive.ObjectID) (satusehat.Ref, error) {
+	ref, err := s.reference(ctx, domain.SatuSehatReferencePatient, patientID)
+	if err != nil {
+		return satusehat.Ref{}, err
+	}
+	if patient, err := s.patientRepo.GetByID(ctx, patientID); err == nil {
+		ref.Display = patient.Name
+	}
+	return ref, nil
+}
+
+func (s *SatuSehatService) practitionerRef(ctx context.Context, doctorID primitive.ObjectID) (satusehat.Ref, error) {
+	ref, err := s.reference(ctx, domain.SatuSehatReferencePractitioner, doctorID)
+	if err != nil {
+		return satusehat.Ref{}, err
+	}
+	if doctor, err := s.doctorRepo.GetByID(ctx, doctorID); err == nil && doctor != nil {
+		ref.Display = doctor.Name
+	}
+	return ref, nil
+}
+
+// locationRef returns the location of the appointment's clinic room, or the
+// hospital's default location.
+func (s *SatuSehatService) locationRef(ctx context.Context, a *domain.AppointmentEntity) (satusehat.Ref, error) {
+	if a.RoomID != nil {
+		ref, err := s.referenceRepo.Get(ctx, domain.SatuSehatReferenceLocation, *a.RoomID)
+		if err != nil {
+			return satusehat.Ref{}, fmt.Errorf("failed to get SATUSEHAT reference: %w", err)
+		}
+		if ref != nil {
+			return satusehat.Ref{ID: ref.SatuSehatID, Display: a.Location}, nil
+		}
+	}
+	if s.settings.LocationID == "" {
+		return satusehat.Ref{}, errors.New("no SATUSEHAT location for the appointment's room and no default location")
+	}
+	return satusehat.Ref{ID: s.settings.LocationID, Display: a.Location}, nil
+}
+
+func (s *SatuSehatService) reference(ctx context.Context, kind domain.SatuSehatReferenceKind, localID primitive.ObjectID) (satusehat.Ref, error) {
+	ref, err := s.referenceRepo.Get(ctx, kind, localID)
+	if err != nil {
+		return satusehat.Ref{}, fmt.Errorf("failed to get SATUSEHAT reference: %w", err)
+	}
+	if ref == nil {
+		return satusehat.Ref{}, fmt.Errorf("no SATUSEHAT ID for %s %s", kind, localID.Hex())
+	}
+	return satusehat.Ref{ID: ref.SatuSehatID}, nil
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/ekastn/hms-api/internal/satusehat"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// stubSatuSehat is a satusehat.Client answering every request with err, or
+// with createdID for creates.
+type stubSatuSehat struct {
+	createdID string
+	err       error
+	creates   int
+	updates   []string
+}
+
+func (c *stubSatuSehat) Create(ctx context.Context, resourceType string, resource any) (string, error) {
+	c.creates++
+	if c.err != nil {
+		return "", c.err
+	}
+	return c.createdID, nil
+}
+
+func (c *stubSatuSehat) Update(ctx context.Context, resourceType, id string, resource any) error {
+	c.updates = append(c.updates, id)
+	return c.err
+}
+
+func newTestSatuSehatService(mt *mtest.T, client satusehat.Client) *SatuSehatService {
+	return NewSatuSehatService(
+		repository.NewSatuSehatSyncRepository(mt.DB.Collection("satusehat_syncs")),
+		repository.NewSatuSehatReferenceRepository(mt.DB.Collection("satusehat_references")),
+		repository.NewAppointmentRepository(mt.DB.Collection("appointments")),
+		repository.NewMedicalRecordRepository(mt.DB.Collection("medical_records")),
+		repository.NewPatientRepository(mt.DB.Collection("patients")),
+		repository.NewDoctorRepository(mt.DB.Collection("doctors")),
+		client,
+		SatuSehatSettings{OrganizationID: "100000001", LocationID: "loc-1", MaxAttempts: 3, Location: time.UTC},
+	)
+}
+
+func TestSatuSehatAttempt(t *testing.T) {
+	mt := newMockDB(t)
+
+	patient := &domain.PatientEntity{ID: primitive.NewObjectID(), Name: "Siti Rahma"}
+	doctor := &domain.DoctorEntity{ID: primitive.NewObjectID(), Name: "Dr. Budi"}
+	appointment := &domain.AppointmentEntity{
+		ID:        primitive.NewObjectID(),
+		PatientID: patient.ID,
+		DoctorID:  doctor.ID,
+		Type:      domain.AppointmentTypeConsultation,
+		DateTime:  time.Date(2025, 7, 17, 3, 0, 0, 0, time.UTC),
+		Duration:  30,
+		Status:    domain.AppointmentStatusCompleted,
+		Location:  "Room 101",
+	}
+	reference := func(kind domain.SatuSehatReferenceKind, localID primitive.ObjectID, id string) *domain.SatuSehatReferenceEntity {
+		return &domain.SatuSehatReferenceEntity{ID: primitive.NewObjectID(), Kind: kind, LocalID: localID, SatuSehatID: id}
+	}
+	queued := func(attempts int) *domain.SatuSehatSyncEntity {
+		return &domain.SatuSehatSyncEntity{
+			ID:           primitive.NewObjectID(),
+			SourceType:   domain.SatuSehatSourceAppointment,
+			SourceID:     appointment.ID,
+			ResourceType: "Encounter",
+			PatientID:    patient.ID,
+			Status:       domain.SatuSehatSyncPending,
+			Revision:     1,
+			AttemptCount: attempts,
+		}
+	}
+	// sent queues the responses to an attempt that builds the encounter and
+	// saves the entry with the given result.
+	sent := func(mt *mtest.T, saved int) {
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(mt.T, appointment)),
+			mockFind(mt, mockDoc(mt.T, reference(domain.SatuSehatReferencePatient, patient.ID, "P0001"))),
+			mockFind(mt, mockDoc(mt.T, patient)),
+			mockFind(mt, mockDoc(mt.T, reference(domain.SatuSehatReferencePractitioner, doctor.ID, "N0001"))),
+			mockFind(mt, mockDoc(mt.T, doctor)),
+			mockWrite(saved),
+		)
+	}
+
+	mt.Run("created encounter is synced", func(mt *mtest.T) {
+		client := &stubSatuSehat{createdID: "enc-1"}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(0)
+		sent(mt, 1)
+
+		s.attempt(context.Background(), sync)
+
+		if client.creates != 1 || sync.Status != domain.SatuSehatSyncSynced || sync.SatuSehatID != "enc-1" {
+			mt.Fatalf("creates = %d, sync = %+v", client.creates, sync)
+		}
+		if sync.SyncedAt == nil || sync.NextAttemptAt != nil || sync.AttemptCount != 1 || sync.Error != "" {
+			mt.Errorf("sync = %+v", sync)
+		}
+		if !strings.Contains(sync.Payload, `"reference":"Patient/P0001"`) || !strings.Contains(sync.Payload, `"reference":"Location/loc-1"`) {
+			mt.Errorf("payload = %s", sync.Payload)
+		}
+		if saved := decodeSets[domain.SatuSehatSyncEntity](mt.T, startedEvents(mt), "satusehat_syncs"); len(saved) != 1 || saved[0].Status != domain.SatuSehatSyncSynced {
+			mt.Errorf("saved = %+v", saved)
+		}
+	})
+
+	mt.Run("encounter already sent is updated", func(mt *mtest.T) {
+		client := &stubSatuSehat{}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(0)
+		sync.SatuSehatID = "enc-1"
+		sent(mt, 1)
+
+		s.attempt(context.Background(), sync)
+
+		if client.creates != 0 || len(client.updates) != 1 || client.updates[0] != "enc-1" || sync.Status != domain.SatuSehatSyncSynced {
+			mt.Errorf("creates = %d, updates = %v, status = %s", client.creates, client.updates, sync.Status)
+		}
+	})
+
+	mt.Run("temporary error is retried with backoff", func(mt *mtest.T) {
+		client := &stubSatuSehat{err: &satusehat.Error{StatusCode: http.StatusServiceUnavailable, Message: "maintenance"}}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(0)
+		sent(mt, 1)
+
+		before := time.Now()
+		s.attempt(context.Background(), sync)
+
+		if sync.Status != domain.SatuSehatSyncFailed || sync.NextAttemptAt == nil {
+			mt.Fatalf("sync = %+v", sync)
+		}
+		if wait := sync.NextAttemptAt.Sub(before); wait < retryBaseBackoff || wait > retryBaseBackoff+5*time.Second {
+			mt.Errorf("next attempt in %v, want %v", wait, retryBaseBackoff)
+		}
+		if len(sync.Attempts) != 1 || sync.Attempts[0].StatusCode != http.StatusServiceUnavailable || sync.Error == "" {
+			mt.Errorf("attempts = %+v, error = %q", sync.Attempts, sync.Error)
+		}
+	})
+
+	mt.Run("temporary error on the last attempt is dead-lettered", func(mt *mtest.T) {
+		client := &stubSatuSehat{err: &satusehat.Error{Message: "connection reset"}}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(2)
+		sent(mt, 1)
+
+		s.attempt(context.Background(), sync)
+
+		if sync.Status != domain.SatuSehatSyncDeadLetter || sync.NextAttemptAt != nil || sync.AttemptCount != 3 {
+			mt.Errorf("sync = %+v", sync)
+		}
+	})
+
+	mt.Run("rejected encounter is dead-lettered", func(mt *mtest.T) {
+		client := &stubSatuSehat{err: &satusehat.Error{StatusCode: http.StatusBadRequest, Message: "subject is required"}}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(0)
+		sent(mt, 1)
+
+		s.attempt(context.Background(), sync)
+
+		if sync.Status != domain.SatuSehatSyncDeadLetter || sync.AttemptCount != 1 {
+			mt.Errorf("sync = %+v", sync)
+		}
+	})
+
+	mt.Run("accepted create with an unreadable response is not sent again", func(mt *mtest.T) {
+		client := &stubSatuSehat{err: &satusehat.Error{StatusCode: http.StatusCreated, Message: "Encounter response has no id, it may have been created"}}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(0)
+		sent(mt, 1)
+
+		s.attempt(context.Background(), sync)
+
+		if sync.Status != domain.SatuSehatSyncDeadLetter || sync.NextAttemptAt != nil {
+			mt.Errorf("sync = %+v, want it dead-lettered rather than retried", sync)
+		}
+	})
+
+	mt.Run("missing reference is retried without sending", func(mt *mtest.T) {
+		client := &stubSatuSehat{createdID: "enc-1"}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(0)
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(mt.T, appointment)),
+			mockFind(mt), // no patient reference
+			mockWrite(1),
+		)
+
+		s.attempt(context.Background(), sync)
+
+		if client.creates != 0 || sync.Status != domain.SatuSehatSyncFailed || !strings.Contains(sync.Error, "no SATUSEHAT ID for patient") {
+			mt.Errorf("creates = %d, sync = %+v", client.creates, sync)
+		}
+	})
+
+	mt.Run("appointment that is no longer completed is skipped", func(mt *mtest.T) {
+		client := &stubSatuSehat{createdID: "enc-1"}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(0)
+		reopened := *appointment
+		reopened.Status = domain.AppointmentStatusConfirmed
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(mt.T, &reopened)),
+			mockWrite(1),
+		)
+
+		s.attempt(context.Background(), sync)
+
+		if client.creates != 0 || sync.Status != domain.SatuSehatSyncSkipped || sync.Error != "" {
+			mt.Errorf("creates = %d, sync = %+v", client.creates, sync)
+		}
+	})
+
+	mt.Run("ID of a resource created while the source changed is kept", func(mt *mtest.T) {
+		client := &stubSatuSehat{createdID: "enc-1"}
+		s := newTestSatuSehatService(mt, client)
+		sync := queued(0)
+		sent(mt, 0) // requeued in the meantime
+		mt.AddMockResponses(mockWrite(1))
+
+		s.attempt(context.Background(), sync)
+
+		events := startedEvents(mt)
+		if got := commandNames(events); strings.Join(got[len(got)-2:], ",") != "update,update" {
+			mt.Fatalf("commands = %v", got)
+		}
+		last := events[len(events)-1].Command.Lookup("updates").Array().Index(0).Value().Document()
+		if id := last.Lookup("u", "$set", "satusehatId").StringValue(); id != "enc-1" {
+			mt.Errorf("recorded ID = %q, want enc-1", id)
+		}
+	})
+}
+
+func TestSatuSehatRetrySync(t *testing.T) {
+	mt := newMockDB(t)
+
+	mt.Run("dead-lettered entry is requeued", func(mt *mtest.T) {
+		s := newTestSatuSehatService(mt, &stubSatuSehat{})
+		sync := &domain.SatuSehatSyncEntity{
+			ID:           primitive.NewObjectID(),
+			SourceType:   domain.SatuSehatSourceMedicalRecord,
+			SourceID:     primitive.NewObjectID(),
+			ResourceType: "Condition",
+			Status:       domain.SatuSehatSyncDeadLetter,
+			AttemptCount: 3,
+		}
+		mt.AddMockResponses(
+			mockFind(mt, mockDoc(mt.T, sync)),
+			mockWrite(1),
+		)
+
+		if err := s.RetrySync(context.Background(), sync.ID.Hex()); err != nil {
+			mt.Fatal(err)
+		}
+		if got := startedCommands(mt); strings.Join(got, ",") != "find,update" {
+			mt.Errorf("commands = %v", got)
+		}
+	})
+
+	mt.Run("synced entry is not requeued", func(mt *mtest.T) {
+		s := newTestSatuSehatService(mt, &stubSatuSehat{})
+		sync := &domain.SatuSehatSyncEntity{ID: primitive.NewObjectID(), Status: domain.SatuSehatSyncSynced}
+		mt.AddMockResponses(mockFind(mt, mockDoc(mt.T, sync)))
+
+		if err := s.RetrySync(context.Background(), sync.ID.Hex()); err == nil {
+			mt.Error("expected an error")
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// withTransaction runs fn in a transaction, committing when it succeeds.
+// Activities and events written with the session context in fn are only
+// delivered if it commits.
+func withTransaction(ctx context.Context, client *mongo.Client, fn func(sessionContext mongo.SessionContext) error) error {
+	// Start a session for transaction
+	session, err := client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	err = mongo.WithSession(ctx, session, func(sessionContext mongo.SessionContext) error {
+		if err = session.StartTransaction(); err != nil {
+			return err
+		}
+		if err := fn(sessionContext); err != nil {
+			return err
+		}
+		return session.CommitTransaction(sessionContext)
+	})
+	if err != nil {
+		session.AbortTransaction(ctx)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// TriageService records Emergency Severity Index assessments on emergency
+// appointments and queue tickets. The ESI level sets the ticket's priority
+// in the queue.
+type TriageService struct {
+	appointmentRepo *repository.AppointmentRepository
+	ticketRepo      *repository.QueueTicketRepository
+	activityService *ActivityService
+	mongoClient     *mongo.Client
+}
+
+func NewTriageService(
+	appointmentRepo *repository.AppointmentRepository,
+	ticketRepo *repository.QueueTicketRepository,
+	activityService *ActivityService,
+	mongoClient *mongo.Client,
+) *TriageService {
+	return &TriageService{
+		appointmentRepo: appointmentRepo,
+		ticketRepo:      ticketRepo,
+		activityService: activityService,
+		mongoClient:     mongoClient,
+	}
+}
+
+// TriageAppointment records the triage of an emergency appointment. When the
+// patient is already in the queue, the ticket's priority follows.
+func (s *TriageService) TriageAppointment(ctx context.Context, id string, req *domain.TriageRequest, assessorID primitive.ObjectID) (*domain.AppointmentEntity, error) {
+	appointmentID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	appointment, err := s.appointmentRepo.GetByID(ctx, appointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appointment: %w", err)
+	}
+	if appointment == nil {
+		return nil, errors.New("appointment not found")
+	}
+	if appointment.Type != domain.AppointmentTypeEmergency {
+		return nil, errors.New("only emergency appointments are triaged")
+	}
+	if appointment.Status == domain.AppointmentStatusCancelled || appointment.Status == domain.AppointmentStatusCompleted {
+		return nil, fmt.Errorf("cannot triage an appointment that is %s", appointment.Status)
+	}
+
+	ticket, err := s.ticketRepo.GetByAppointmentID(ctx, appointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue ticket: %w", err)
+	}
+
+	triage := s.assessment(req, assessorID)
+	appointment.Triage = triage
+	appointment.UpdatedBy = assessorID
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.appointmentRepo.Update(sessionContext, appointmentID, appointment); err != nil {
+			return fmt.Errorf("failed to update appointment: %w", err)
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeAppointment, "Patient Triaged", fmt.Sprintf("Emergency appointment %s triaged as ESI %d: %s.", id, triage.ESILevel, triage.ChiefComplaint))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for triage: %w", err)
+		}
+		err = s.activityService.PublishEvent(sessionContext, domain.EventAppointmentTriaged, domain.ActivityTypeAppointment, id, appointment.ToDTO())
+		if err != nil {
+			return fmt.Errorf("failed to publish appointment event: %w", err)
+		}
+
+		// Only waiting tickets move in the queue; the rest keep their record
+		if ticket != nil && ticket.Status == domain.QueueTicketWaiting {
+			if err := s.updateTicket(sessionContext, ticket, triage, assessorID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+// TriageTicket records the triage of a patient waiting in the queue, such as
+// a walk-in at the emergency polyclinic, and moves the ticket up accordingly.
+func (s *TriageService) TriageTicket(ctx context.Context, id string, req *domain.TriageRequest, assessorID primitive.ObjectID) (*domain.QueueTicketEntity, error) {
+	ticketID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ticket ID format: %w", err)
+	}
+
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+	if ticket == nil {
+		return nil, errors.New("ticket not found")
+	}
+	if ticket.Status != domain.QueueTicketWaiting {
+		return nil, fmt.Errorf("cannot triage a ticket that is %s", ticket.Status)
+	}
+
+	triage := s.assessment(req, assessorID)
+
+	err = withTransaction(ctx, s.mongoClient, func(sessionContext mongo.SessionContext) error {
+		if err := s.updateTicket(sessionContext, ticket, triage, assessorID); err != nil {
+			return err
+		}
+
+		err = s.activityService.CreateActivity(sessionContext, domain.ActivityTypeQueue, "Patient Triaged", fmt.Sprintf("Queue ticket %s triaged as ESI %d: %s.", ticket.Code, triage.ESILevel, triage.ChiefComplaint))
+		if err != nil {
+			return fmt.Errorf("failed to log activity for triage: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// updateTicket stores the triage on the ticket, re-prioritises it and
+// publishes the change so display boards reorder.
+func (s *TriageService) updateTicket(ctx context.Context, ticket *domain.QueueTicketEntity, triage *domain.TriageAssessment, updaterID primitive.ObjectID) error {
+	ticket.Triage = triage
+	ticket.Priority = triage.Priority()
+	ticket.UpdatedBy = updaterID
+	ticket.UpdatedAt = triage.AssessedAt
+
+	if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+		return fmt.Errorf("failed to update ticket: %w", err)
+	}
+
+	err := s.activityService.PublishEvent(ctx, domain.EventQueueTicketTriaged, domain.ActivityTypeQueue, ticket.ID.Hex(), ticket)
+	if err != nil {
+		return fmt.Errorf("failed to publish queue event: %w", err)
+	}
+	return nil
+}
+
+func (s *TriageService) assessment(req *domain.TriageRequest, assessorID primitive.ObjectID) *domain.TriageAssessment {
+	return &domain.TriageAssessment{
+		ESILevel:       req.ESILevel,
+		ChiefComplaint: req.ChiefComplaint,
+		Vitals:         req.Vitals,
+		Notes:          req.Notes,
+		AssessedBy:     assessorID,
+		AssessedAt:     time.Now(),
+	}
+}
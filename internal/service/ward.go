@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WardService manages wards, rooms and beds and builds the bed occupancy board.
+type WardService struct {
+	wardRepo        *repository.WardRepository
+	roomRepo        *repository.RoomRepository
+	bedRepo         *repository.BedRepository
+	admissionRepo   *repository.AdmissionRepository
+	patientRepo     *repository.PatientRepository
+	activityService *ActivityService
+}
+
+func NewWardService(
+	wardRepo *repository.WardRepository,
+	roomRepo *repository.RoomRepository,
+	bedRepo *repository.BedRepository,
+	admissionRepo *repository.AdmissionRepository,
+	patientRepo *repository.PatientRepository,
+	activityService *ActivityService,
+) *WardService {
+	return &WardService{
+		wardRepo:        wardRepo,
+		roomRepo:        roomRepo,
+		bedRepo:         bedRepo,
+		admissionRepo:   admissionRepo,
+		patientRepo:     patientRepo,
+		activityService: activityService,
+	}
+}
+
+func (s *WardService) GetAllWards(ctx context.Context) ([]*domain.WardEntity, error) {
+	return s.wardRepo.GetAll(ctx)
+}
+
+func (s *WardService) GetWardByID(ctx context.Context, id string) (*domain.WardEntity, error) {
+	wardID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	ward, err := s.wardRepo.GetByID(ctx, wardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ward: %w", err)
+	}
+
+	return ward, nil
+}
+
+func (s *WardService) CreateWard(ctx context.Context, req *domain.WardRequest, creatorID primitive.ObjectID) (string, error) {
+	existing, err := s.wardRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return "", fmt.Errorf("error checking ward code: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("ward with this code already exists")
+	}
+
+	ward := &domain.WardEntity{
+		Name:      req.Name,
+		Code:      req.Code,
+		Floor:     req.Floor,
+		Specialty: req.Specialty,
+		IsActive:  true,
+		CreatedBy: creatorID,
+		UpdatedBy: creatorID,
+	}
+	if req.IsActive != nil {
+		ward.IsActive = *req.IsActive
+	}
+
+	id, err := s.wardRepo.Create(ctx, ward)
+	if err != nil {
+		return "", fmt.Errorf("failed to create ward: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *WardService) UpdateWard(ctx context.Context, id string, req *domain.WardRequest, updaterID primitive.ObjectID) error {
+	ward, err := s.GetWardByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if ward == nil {
+		return errors.New("ward not found")
+	}
+
+	existing, err := s.wardRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return fmt.Errorf("error checking ward code: %w", err)
+	}
+	if existing != nil && existing.ID != ward.ID {
+		return errors.New("ward with this code already exists")
+	}
+
+	ward.Name = req.Name
+	ward.Code = req.Code
+	ward.Floor = req.Floor
+	ward.Specialty = req.Specialty
+	if req.IsActive != nil {
+		ward.IsActive = *req.IsActive
+	}
+	ward.UpdatedBy = updaterID
+
+	if err := s.wardRepo.Update(ctx, ward.ID, ward); err != nil {
+		return fmt.Errorf("failed to update ward: %w", err)
+	}
+
+	return nil
+}
+
+func (s *WardService) GetRoomsByWard(ctx context.Context, wardID string) ([]*domain.RoomEntity, error) {
+	wardObjID, err := primitive.ObjectIDFromHex(wardID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ward ID format: %w", err)
+	}
+
+	return s.roomRepo.GetByWardID(ctx, wardObjID)
+}
+
+func (s *WardService) CreateRoom(ctx context.Context, req *domain.CreateRoomRequest, creatorID primitive.ObjectID) (string, error) {
+	ward, err := s.GetWardByID(ctx, req.WardID)
+	if err != nil {
+		return "", err
+	}
+	if ward == nil {
+		return "", errors.New("ward not found")
+	}
+
+	existing, err := s.roomRepo.GetByWardAndNumber(ctx, ward.ID, req.Number)
+	if err != nil {
+		return "", fmt.Errorf("error checking room number: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("room with this number already exists in the ward")
+	}
+
+	room := &domain.RoomEntity{
+		WardID:    ward.ID,
+		Number:    req.Number,
+		Class:     req.Class,
+		CreatedBy: creatorID,
+		UpdatedBy: creatorID,
+	}
+
+	id, err := s.roomRepo.Create(ctx, room)
+	if err != nil {
+		return "", fmt.Errorf("failed to create room: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+func (s *WardService) GetBedsByWard(ctx context.Context, wardID string) ([]*domain.BedEntity, error) {
+	wardObjID, err := primitive.ObjectIDFromHex(wardID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ward ID format: %w", err)
+	}
+
+	return s.bedRepo.GetByWardID(ctx, wardObjID)
+}
+
+func (s *WardService) CreateBed(ctx context.Context, req *domain.CreateBedRequest, creatorID primitive.ObjectID) (string, error) {
+	roomID, err := primitive.ObjectIDFromHex(req.RoomID)
+	if err != nil {
+		return "", fmt.Errorf("invalid room ID format: %w", err)
+	}
+
+	room, err := s.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get room: %w", err)
+	}
+	if room == nil {
+		return "", errors.New("room not found")
+	}
+
+	existing, err := s.bedRepo.GetByRoomAndLabel(ctx, roomID, req.Label)
+	if err != nil {
+		return "", fmt.Errorf("error checking bed label: %w", err)
+	}
+	if existing != nil {
+		return "", errors.New("bed with this label already exists in the room")
+	}
+
+	bed := &domain.BedEntity{
+		WardID:    room.WardID,
+		RoomID:    room.ID,
+		Label:     req.Label,
+		Status:    domain.BedStatusAvailable,
+		CreatedBy: creatorID,
+		UpdatedBy: creatorID,
+	}
+
+	id, err := s.bedRepo.Create(ctx, bed)
+	if err != nil {
+		return "", fmt.Errorf("failed to create bed: %w", err)
+	}
+
+	return id.Hex(), nil
+}
+
+// UpdateBedStatus changes the housekeeping status of a bed. Occupancy is only
+// changed through admissions, so occupied beds are rejected.
+func (s *WardService) UpdateBedStatus(ctx context.Context, id string, status domain.BedStatus, updaterID primitive.ObjectID) error {
+	bedID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	if status == domain.BedStatusOccupied {
+		return errors.New("beds are occupied through admissions")
+	}
+
+	ok, err := s.bedRepo.SetStatus(ctx, bedID, status, updaterID)
+	if err != nil {
+		return fmt.Errorf("failed to update bed status: %w", err)
+	}
+	if !ok {
+		return errors.New("bed not found or currently occupied")
+	}
+
+	return nil
+}
+
+// GetOccupancyStats returns hospital-wide bed occupancy counts.
+func (s *WardService) GetOccupancyStats(ctx context.Context) (domain.BedOccupancyStats, error) {
+	counts, err := s.bedRepo.CountByStatus(ctx, nil)
+	if err != nil {
+		return domain.BedOccupancyStats{}, fmt.Errorf("failed to count beds: %w", err)
+	}
+
+	return domain.NewBedOccupancyStats(counts), nil
+}
+
+// GetOccupancyBoard returns every active ward with its beds and current occupants.
+func (s *WardService) GetOccupancyBoard(ctx context.Context) ([]domain.WardBoard, error) {
+	wards, err := s.wardRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wards: %w", err)
+	}
+
+	admissions, err := s.admissionRepo.GetAll(ctx, domain.AdmissionStatusAdmitted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admissions: %w", err)
+	}
+	admissionsByID := make(map[primitive.ObjectID]*domain.AdmissionEntity, len(admissions))
+	for _, a := range admissions {
+		admissionsByID[a.ID] = a
+	}
+
+	boards := make([]domain.WardBoard, 0, len(wards))
+	for _, ward := range wards {
+		if !ward.IsActive {
+			continue
+		}
+
+		rooms, err := s.roomRepo.GetByWardID(ctx, ward.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rooms: %w", err)
+		}
+		roomNumbers := make(map[primitive.ObjectID]string, len(rooms))
+		for _, room := range rooms {
+			roomNumbers[room.ID] = room.Number
+		}
+
+		beds, err := s.bedRepo.GetByWardID(ctx, ward.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get beds: %w", err)
+		}
+
+		counts := make(map[domain.BedStatus]int64)
+		entries := make([]domain.BedBoardEntry, 0, len(beds))
+		for _, bed := range beds {
+			counts[bed.Status]++
+			entry := domain.BedBoardEntry{
+				Bed:        bed.ToDTO(),
+				RoomNumber: roomNumbers[bed.RoomID],
+			}
+
+			if bed.CurrentAdmissionID != nil {
+				if admission, ok := admissionsByID[*bed.CurrentAdmissionID]; ok {
+					entry.PatientID = admission.PatientID.Hex()
+					admittedAt := admission.AdmittedAt
+					entry.AdmittedAt = &admittedAt
+					if patient, err := s.patientRepo.GetByID(ctx, admission.PatientID); err == nil && patient != nil {
+						entry.PatientName = patient.Name
+					}
+				}
+			}
+			entries = append(entries, entry)
+		}
+
+		boards = append(boards, domain.WardBoard{
+			Ward:  ward.ToDTO(),
+			Stats: domain.NewBedOccupancyStats(counts),
+			Beds:  entries,
+		})
+	}
+
+	return boards, nil
+}
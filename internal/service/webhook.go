@@ -0,0 +1,404 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/ekastn/hms-api/internal/webhook"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	webhookBatchSize    = 50
+	webhookLease        = 2 * time.Minute
+	webhookListLimit    = 200
+	webhookMaxErrorBody = 512
+)
+
+// WebhookService manages webhook subscriptions and delivers domain events to
+// them. Deliveries are queued in Mongo and sent by a background dispatcher
+// with exponential backoff; deliveries that keep failing end up in the
+// dead-letter list.
+type WebhookService struct {
+	subscriptionRepo *repository.WebhookSubscriptionRepository
+	deliveryRepo     *repository.WebhookDeliveryRepository
+	httpClient       *http.Client
+	maxAttempts      int
+}
+
+func NewWebhookService(
+	subscriptionRepo *repository.WebhookSubscriptionRepository,
+	deliveryRepo *repository.WebhookDeliveryRepository,
+	httpClient *http.Client,
+	maxAttempts int,
+) *WebhookService {
+	return &WebhookService{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		httpClient:       httpClient,
+		maxAttempts:      maxAttempts,
+	}
+}
+
+func (s *WebhookService) GetAllSubscriptions(ctx context.Context) ([]*domain.WebhookSubscriptionEntity, error) {
+	return s.subscriptionRepo.GetAll(ctx)
+}
+
+func (s *WebhookService) GetSubscriptionByID(ctx context.Context, id string) (*domain.WebhookSubscriptionEntity, error) {
+	subscriptionID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	sub, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+// CreateSubscription creates a subscription. When no secret is given one is
+// generated; the secret is only returned here, so the caller must store it.
+func (s *WebhookService) CreateSubscription(ctx context.Context, req *domain.WebhookSubscriptionRequest, creatorID primitive.ObjectID) (string, string, error) {
+	if err := validateEventTypes(req.EventTypes); err != nil {
+		return "", "", err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := generateWebhookSecret()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		secret = generated
+	}
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	sub := &domain.WebhookSubscriptionEntity{
+		Name:       req.Name,
+		URL:        req.URL,
+		Secret:     secret,
+		EventTypes: req.EventTypes,
+		IsActive:   isActive,
+		CreatedBy:  creatorID,
+		UpdatedBy:  creatorID,
+	}
+
+	id, err := s.subscriptionRepo.Create(ctx, sub)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	return id.Hex(), secret, nil
+}
+
+// UpdateSubscription updates a subscription. The secret is only rotated when a
+// new one is given.
+func (s *WebhookService) UpdateSubscription(ctx context.Context, id string, req *domain.WebhookSubscriptionRequest, updaterID primitive.ObjectID) error {
+	if err := validateEventTypes(req.EventTypes); err != nil {
+		return err
+	}
+
+	sub, err := s.GetSubscriptionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sub == nil {
+		return errors.New("webhook subscription not found")
+	}
+
+	sub.Name = req.Name
+	sub.URL = req.URL
+	sub.EventTypes = req.EventTypes
+	if req.Secret != "" {
+		sub.Secret = req.Secret
+	}
+	if req.IsActive != nil {
+		sub.IsActive = *req.IsActive
+	}
+	sub.UpdatedBy = updaterID
+
+	return s.subscriptionRepo.Update(ctx, sub.ID, sub)
+}
+
+func (s *WebhookService) DeleteSubscription(ctx context.Context, id string) error {
+	sub, err := s.GetSubscriptionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sub == nil {
+		return errors.New("webhook subscription not found")
+	}
+
+	return s.subscriptionRepo.Delete(ctx, sub.ID)
+}
+
+func (s *WebhookService) GetDeliveries(ctx context.Context, subscriptionIDHex string, status domain.WebhookDeliveryStatus) ([]*domain.WebhookDeliveryEntity, error) {
+	var subscriptionID *primitive.ObjectID
+	if subscriptionIDHex != "" {
+		id, err := primitive.ObjectIDFromHex(subscriptionIDHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscription ID format: %w", err)
+		}
+		subscriptionID = &id
+	}
+	if status != "" && !status.IsValid() {
+		return nil, fmt.Errorf("invalid delivery status: %s", status)
+	}
+
+	return s.deliveryRepo.GetAll(ctx, subscriptionID, status, webhookListLimit)
+}
+
+// RetryDelivery puts a dead-lettered or failed delivery back in the queue with
+// a fresh attempt budget.
+func (s *WebhookService) RetryDelivery(ctx context.Context, id string) error {
+	deliveryID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	delivery, err := s.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	if delivery == nil {
+		return errors.New("webhook delivery not found")
+	}
+	if delivery.Status == domain.WebhookDeliveryDelivered {
+		return errors.New("webhook delivery has already been delivered")
+	}
+
+	delivery.Status = domain.WebhookDeliveryPending
+	delivery.AttemptCount = 0
+	delivery.NextAttemptAt = time.Now()
+
+	return s.deliveryRepo.Update(ctx, delivery.ID, delivery)
+}
+
+// Enqueue queues a delivery of the event for every active subscription that
+// wants it. Subscriptions that already have a delivery of the event are
+// skipped, so enqueuing the same event twice is harmless.
+func (s *WebhookService) Enqueue(ctx context.Context, event domain.Event) error {
+	subs, err := s.subscriptionRepo.GetActiveByEventType(ctx, event.Type)
+	if err != nil {
+		return fmt.Errorf("failed to get webhook subscriptions: %w", err)
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	for _, sub := range subs {
+		if err := s.enqueueFor(ctx, sub, event, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendTest queues a ping event for a subscription regardless of its event filter.
+func (s *WebhookService) SendTest(ctx context.Context, id string) (string, error) {
+	sub, err := s.GetSubscriptionByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if sub == nil {
+		return "", errors.New("webhook subscription not found")
+	}
+
+	event := domain.Event{
+		ID:        primitive.NewObjectID().Hex(),
+		Type:      domain.EventWebhookPing,
+		EntityID:  sub.ID.Hex(),
+		Timestamp: time.Now(),
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	if err := s.enqueueFor(ctx, sub, event, payload); err != nil {
+		return "", err
+	}
+
+	return event.ID, nil
+}
+
+func (s *WebhookService) enqueueFor(ctx context.Context, sub *domain.WebhookSubscriptionEntity, event domain.Event, payload []byte) error {
+	exists, err := s.deliveryRepo.ExistsForEvent(ctx, sub.ID, event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check webhook delivery: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	delivery := &domain.WebhookDeliveryEntity{
+		SubscriptionID: sub.ID,
+		EventID:        event.ID,
+		EventType:      event.Type,
+		URL:            sub.URL,
+		Payload:        string(payload),
+		Status:         domain.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+	}
+
+	if _, err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		return fmt.Errorf("failed to queue webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// RunDispatcher sends due deliveries every interval until ctx is cancelled.
+func (s *WebhookService) RunDispatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.dispatchDue(ctx); err != nil {
+			log.Printf("webhook dispatch failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *WebhookService) dispatchDue(ctx context.Context) error {
+	now := time.Now()
+	deliveries, err := s.deliveryRepo.GetDue(ctx, now, webhookBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get due deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		claimed, err := s.deliveryRepo.Claim(ctx, delivery.ID, now, now.Add(webhookLease))
+		if err != nil {
+			return fmt.Errorf("failed to claim delivery: %w", err)
+		}
+		if !claimed {
+			continue
+		}
+
+		s.attempt(ctx, delivery)
+	}
+
+	return nil
+}
+
+// attempt sends a delivery once and records the outcome.
+func (s *WebhookService) attempt(ctx context.Context, delivery *domain.WebhookDeliveryEntity) {
+	sub, err := s.subscriptionRepo.GetByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		log.Printf("webhook delivery %s: failed to get subscription: %v", delivery.ID.Hex(), err)
+		return
+	}
+
+	started := time.Now()
+	attempt := domain.WebhookAttempt{AttemptedAt: started}
+
+	if sub == nil {
+		attempt.Error = "subscription was deleted"
+	} else {
+		attempt.StatusCode, err = s.send(ctx, sub, delivery)
+		if err != nil {
+			attempt.Error = err.Error()
+		}
+	}
+	attempt.DurationMs = time.Since(started).Milliseconds()
+
+	delivery.AttemptCount++
+	delivery.Attempts = append(delivery.Attempts, attempt)
+
+	switch {
+	case attempt.Error == "":
+		delivery.Status = domain.WebhookDeliveryDelivered
+		delivery.DeliveredAt = &started
+	case sub == nil || delivery.AttemptCount >= s.maxAttempts:
+		delivery.Status = domain.WebhookDeliveryDeadLetter
+		log.Printf("webhook delivery %s to %s dead-lettered after %d attempts: %s", delivery.ID.Hex(), delivery.URL, delivery.AttemptCount, attempt.Error)
+	default:
+		delivery.Status = domain.WebhookDeliveryFailed
+		delivery.NextAttemptAt = time.Now().Add(backoff(retryBaseBackoff, retryMaxBackoff, delivery.AttemptCount))
+	}
+
+	if err := s.deliveryRepo.Update(ctx, delivery.ID, delivery); err != nil {
+		log.Printf("webhook delivery %s: failed to record attempt: %v", delivery.ID.Hex(), err)
+	}
+}
+
+// send posts the payload signed with the subscription secret. Any 2xx response
+// counts as delivered.
+func (s *WebhookService) send(ctx context.Context, sub *domain.WebhookSubscriptionEntity, delivery *domain.WebhookDeliveryEntity) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "hms-api-webhooks")
+	req.Header.Set(webhook.HeaderEvent, string(delivery.EventType))
+	req.Header.Set(webhook.HeaderDelivery, delivery.ID.Hex())
+	req.Header.Set(webhook.HeaderTimestamp, fmt.Sprint(timestamp))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(sub.Secret, timestamp, body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, webhookMaxErrorBody))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(snippet))
+	}
+
+	return resp.StatusCode, nil
+}
+
+func validateEventTypes(eventTypes []domain.EventType) error {
+	for _, eventType := range eventTypes {
+		if !eventType.IsValid() {
+			return fmt.Errorf("unknown event type: %s", eventType)
+		}
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekastn/hms-api/internal/domain"
+	"github.com/ekastn/hms-api/internal/repository"
+	"github.com/ekastn/hms-api/internal/webhook"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func newTestWebhookService(mt *mtest.T, maxAttempts int) *WebhookService {
+	return NewWebhookService(
+		repository.NewWebhookSubscriptionRepository(mt.Coll),
+		repository.NewWebhookDeliveryRepository(mt.Coll),
+		&http.Client{Timeout: 5 * time.Second},
+		maxAttempts,
+	)
+}
+
+func testSubscription(url string) *domain.WebhookSubscriptionEntity {
+	return &domain.WebhookSubscriptionEntity{
+		ID:         primitive.NewObjectID(),
+		Name:       "Vendor",
+		URL:        url,
+		Secret:     "a-long-shared-signing-secret",
+		EventTypes: []domain.EventType{domain.EventAppointmentCreated},
+		IsActive:   true,
+	}
+}
+
+func testDelivery(sub *domain.WebhookSubscriptionEntity) *domain.WebhookDeliveryEntity {
+	return &domain.WebhookDeliveryEntity{
+		ID:             primitive.NewObjectID(),
+		SubscriptionID: sub.ID,
+		EventID:        primitive.NewObjectID().Hex(),
+		EventType:      domain.EventAppointmentCreated,
+		URL:            sub.URL,
+		Payload:        `{"type":"appointment.created"}`,
+		Status:         domain.WebhookDeliveryPending,
+	}
+}
+
+func TestWebhookSendIsSigned(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	s := &WebhookService{httpClient: server.Client()}
+	sub := testSubscription(server.URL)
+	delivery := testDelivery(sub)
+
+	status, err := s.send(context.Background(), sub, delivery)
+	if err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+	if status != http.StatusNoContent {
+		t.Errorf("send() status = %d, want %d", status, http.StatusNoContent)
+	}
+
+	if string(body) != delivery.Payload {
+		t.Errorf("body = %s, want %s", body, delivery.Payload)
+	}
+	if got := received.Header.Get(webhook.HeaderEvent); got != string(delivery.EventType) {
+		t.Errorf("%s = %q, want %q", webhook.HeaderEvent, got, delivery.EventType)
+	}
+	if got := received.Header.Get(webhook.HeaderDelivery); got != delivery.ID.Hex() {
+		t.Errorf("%s = %q, want %q", webhook.HeaderDelivery, got, delivery.ID.Hex())
+	}
+	if !webhook.Verify(sub.Secret, received.Header.Get(webhook.HeaderTimestamp), received.Header.Get(webhook.HeaderSignature), body, time.Minute) {
+		t.Error("receiver could not verify the signature")
+	}
+	if webhook.Verify("another-secret-entirely", received.Header.Get(webhook.HeaderTimestamp), received.Header.Get(webhook.HeaderSignature), body, time.Minute) {
+		t.Error("signature verified with the wrong secret")
+	}
+}
+
+func TestWebhookSendRejectsNon2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "vendor is down", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s := &WebhookService{httpClient: server.Client()}
+	sub := testSubscription(server.URL)
+
+	status, err := s.send(context.Background(), sub, testDelivery(sub))
+	if err == nil {
+		t.Fatal("send() succeeded on a 503")
+	}
+	if status != http.StatusServiceUnavailable {
+		t.Errorf("send() status = %d, want %d", status, http.StatusServiceUnavailable)
+	}
+	if !strings.Contains(err.Error(), "vendor is down") {
+		t.Errorf("send() error = %q, want the response body in it", err)
+	}
+}
+
+func TestWebhookAttempt(t *testing.T) {
+	mt := newMockDB(t)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ok.Close()
+
+	mt.Run("delivered", func(mt *mtest.T) {
+		s := newTestWebhookService(mt, 3)
+		sub := testSubscription(ok.URL)
+		delivery := testDelivery(sub)
+		mt.AddMockResponses(mockFind(mt, mockDoc(t, sub)), mockWrite(1))
+
+		s.attempt(context.Background(), delivery)
+
+		if delivery.Status != domain.WebhookDeliveryDelivered || delivery.DeliveredAt == nil {
+			t.Fatalf("status = %s, deliveredAt = %v, want Delivered", delivery.Status, delivery.DeliveredAt)
+		}
+		if len(delivery.Attempts) != 1 || delivery.Attempts[0].StatusCode != http.StatusOK {
+			t.Errorf("attempts = %+v, want one 200", delivery.Attempts)
+		}
+	})
+
+	mt.Run("failure is retried with backoff", func(mt *mtest.T) {
+		s := newTestWebhookService(mt, 3)
+		sub := testSubscription(failing.URL)
+		delivery := testDelivery(sub)
+		delivery.AttemptCount = 1
+		mt.AddMockResponses(mockFind(mt, mockDoc(t, sub)), mockWrite(1))
+
+		before := time.Now()
+		s.attempt(context.Background(), delivery)
+
+		if delivery.Status != domain.WebhookDeliveryFailed {
+			t.Fatalf("status = %s, want Failed", delivery.Status)
+		}
+		if delivery.AttemptCount != 2 {
+			t.Errorf("attemptCount = %d, want 2", delivery.AttemptCount)
+		}
+		if wait := delivery.NextAttemptAt.Sub(before); wait < backoff(retryBaseBackoff, retryMaxBackoff, 2) || wait > backoff(retryBaseBackoff, retryMaxBackoff, 2)+time.Minute {
+			t.Errorf("next attempt in %v, want about %v", wait, backoff(retryBaseBackoff, retryMaxBackoff, 2))
+		}
+		if delivery.Attempts[0].StatusCode != http.StatusInternalServerError || delivery.Attempts[0].Error == "" {
+			t.Errorf("attempt = %+v, want the 500 recorded", delivery.Attempts[0])
+		}
+	})
+
+	mt.Run("dead-lettered after max attempts", func(mt *mtest.T) {
+		s := newTestWebhookService(mt, 3)
+		sub := testSubscription(failing.URL)
+		delivery := testDelivery(sub)
+		delivery.AttemptCount = 2
+		mt.AddMockResponses(mockFind(mt, mockDoc(t, sub)), mockWrite(1))
+
+		s.attempt(context.Background(), delivery)
+
+		if delivery.Status != domain.WebhookDeliveryDeadLetter {
+			t.Fatalf("status = %s, want DeadLetter", delivery.Status)
+		}
+	})
+
+	mt.Run("dead-lettered when the subscription is gone", func(mt *mtest.T) {
+		s := newTestWebhookService(mt, 3)
+		sub := testSubscription(ok.URL)
+		delivery := testDelivery(sub)
+		mt.AddMockResponses(mockFind(mt), mockWrite(1))
+
+		s.attempt(context.Background(), delivery)
+
+		if delivery.Status != domain.WebhookDeliveryDeadLetter {
+			t.Fatalf("status = %s, want DeadLetter", delivery.Status)
+		}
+		if delivery.Attempts[0].Error != "subscription was deleted" {
+			t.Errorf("error = %q, want the subscription to be reported deleted", delivery.Attempts[0].Error)
+		}
+	})
+}
+
+func TestWebhookEnqueueIsIdempotent(t *testing.T) {
+	mt := newMockDB(t)
+	event := domain.Event{
+		ID:        primitive.NewObjectID().Hex(),
+		Type:      domain.EventAppointmentCreated,
+		Topic:     domain.ActivityTypeAppointment,
+		Timestamp: time.Now(),
+	}
+
+	mt.Run("new event is queued", func(mt *mtest.T) {
+		s := newTestWebhookService(mt, 3)
+		sub := testSubscription("https://vendor.example.com/hooks")
+		mt.AddMockResponses(mockFind(mt, mockDoc(t, sub)), mockCount(mt, 0), mockWrite(1))
+
+		if err := s.Enqueue(context.Background(), event); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+		if got := startedCommands(mt); !slices.Contains(got, "insert") {
+			t.Errorf("commands = %v, want an insert", got)
+		}
+	})
+
+	mt.Run("already queued event is skipped", func(mt *mtest.T) {
+		s := newTestWebhookService(mt, 3)
+		sub := testSubscription("https://vendor.example.com/hooks")
+		mt.AddMockResponses(mockFind(mt, mockDoc(t, sub)), mockCount(mt, 1))
+
+		if err := s.Enqueue(context.Background(), event); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+		if got := startedCommands(mt); slices.Contains(got, "insert") {
+			t.Errorf("commands = %v, want no insert", got)
+		}
+	})
+
+	mt.Run("no subscribers", func(mt *mtest.T) {
+		s := newTestWebhookService(mt, 3)
+		mt.AddMockResponses(mockFind(mt))
+
+		if err := s.Enqueue(context.Background(), event); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+		if got := startedCommands(mt); len(got) != 1 {
+			t.Errorf("commands = %v, want only the subscription lookup", got)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrInvalidToken  = errors.New("invalid or expired JWT")
+	ErrInvalidClaims = errors.New("invalid JWT claims")
+)
+
+// Token holds the claims of a JWT issued at login.
+type Token struct {
+	UserID string
+	Role   string
+	// ExpiresAt is zero when the token does not expire.
+	ExpiresAt time.Time
+}
+
+// ParseToken verifies a JWT issued at login and returns the user ID, role
+// and expiry it carries.
+func ParseToken(secret, tokenString string) (*Token, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(secret), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrInvalidClaims
+	}
+
+	parsed := &Token{}
+	parsed.UserID, _ = claims["sub"].(string) // subject is the user ID
+	parsed.Role, _ = claims["role"].(string)
+	if parsed.UserID == "" {
+		return nil, ErrInvalidClaims
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		parsed.ExpiresAt = exp.Time
+	}
+	return parsed, nil
+}
@@ -0,0 +1,55 @@
+// Package webhook holds the signing scheme shared by the webhook dispatcher and
+// receivers.
+//
+// Every delivery carries the headers below. The signature is the hex encoded
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret, so a
+// receiver can check both the origin and the freshness of a request.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-HMS-Event"
+	HeaderDelivery  = "X-HMS-Delivery"
+	HeaderTimestamp = "X-HMS-Timestamp"
+	HeaderSignature = "X-HMS-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature header value for body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature header against the body and rejects timestamps
+// further than tolerance from now.
+func Verify(secret, timestampHeader, signature string, body []byte, tolerance time.Duration) bool {
+	timestamp, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	age := time.Since(time.Unix(timestamp, 0))
+	if age > tolerance || age < -tolerance {
+		return false
+	}
+
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+
+	expected := Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
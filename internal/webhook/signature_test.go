@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":"66a1f0c2e4b0a1b2c3d4e5f6","type":"appointment.created"}`)
+	now := time.Now().Unix()
+	signature := Sign("secret", now, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		body      []byte
+		want      bool
+	}{
+		{"valid", "secret", strconv.FormatInt(now, 10), signature, body, true},
+		{"wrong secret", "other", strconv.FormatInt(now, 10), signature, body, false},
+		{"tampered body", "secret", strconv.FormatInt(now, 10), signature, []byte(`{"id":"x"}`), false},
+		{"timestamp not signed", "secret", strconv.FormatInt(now-1, 10), signature, body, false},
+		{"too old", "secret", strconv.FormatInt(now-600, 10), Sign("secret", now-600, body), body, false},
+		{"too far ahead", "secret", strconv.FormatInt(now+600, 10), Sign("secret", now+600, body), body, false},
+		{"bad timestamp", "secret", "yesterday", signature, body, false},
+		{"missing prefix", "secret", strconv.FormatInt(now, 10), signature[len(signaturePrefix):], body, false},
+		{"empty signature", "secret", strconv.FormatInt(now, 10), "", body, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.timestamp, tt.signature, tt.body, 5*time.Minute); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignIsDeterministic(t *testing.T) {
+	body := []byte("{}")
+	if Sign("secret", 1700000000, body) != Sign("secret", 1700000000, body) {
+		t.Fatal("Sign returned different signatures for the same input")
+	}
+	if Sign("secret", 1700000000, body) == Sign("secret", 1700000001, body) {
+		t.Fatal("Sign ignored the timestamp")
+	}
+}